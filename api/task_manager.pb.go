@@ -0,0 +1,5231 @@
+// Protocol Buffer definitions for the EisenKan TaskManager service.
+// Messages mirror the Go types of internal/managers/task_manager so that a
+// client can drive a board served by another process.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: task_manager.proto
+
+package api
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Priority places a task in a quadrant of the Eisenhower matrix
+type Priority struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Urgent        bool                   `protobuf:"varint,1,opt,name=urgent,proto3" json:"urgent,omitempty"`
+	Important     bool                   `protobuf:"varint,2,opt,name=important,proto3" json:"important,omitempty"`
+	Label         string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Priority) Reset() {
+	*x = Priority{}
+	mi := &file_task_manager_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Priority) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Priority) ProtoMessage() {}
+
+func (x *Priority) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Priority.ProtoReflect.Descriptor instead.
+func (*Priority) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Priority) GetUrgent() bool {
+	if x != nil {
+		return x.Urgent
+	}
+	return false
+}
+
+func (x *Priority) GetImportant() bool {
+	if x != nil {
+		return x.Important
+	}
+	return false
+}
+
+func (x *Priority) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+// TaskRequest mirrors task_manager.TaskRequest
+type TaskRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Description           string                 `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Priority              *Priority              `protobuf:"bytes,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	WorkflowStatus        string                 `protobuf:"bytes,3,opt,name=workflow_status,json=workflowStatus,proto3" json:"workflow_status,omitempty"`
+	Tags                  []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Deadline              *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	PriorityPromotionDate *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=priority_promotion_date,json=priorityPromotionDate,proto3" json:"priority_promotion_date,omitempty"`
+	ParentTaskId          *string                `protobuf:"bytes,7,opt,name=parent_task_id,json=parentTaskId,proto3,oneof" json:"parent_task_id,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *TaskRequest) Reset() {
+	*x = TaskRequest{}
+	mi := &file_task_manager_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskRequest) ProtoMessage() {}
+
+func (x *TaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskRequest.ProtoReflect.Descriptor instead.
+func (*TaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TaskRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TaskRequest) GetPriority() *Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return nil
+}
+
+func (x *TaskRequest) GetWorkflowStatus() string {
+	if x != nil {
+		return x.WorkflowStatus
+	}
+	return ""
+}
+
+func (x *TaskRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *TaskRequest) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *TaskRequest) GetPriorityPromotionDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PriorityPromotionDate
+	}
+	return nil
+}
+
+func (x *TaskRequest) GetParentTaskId() string {
+	if x != nil && x.ParentTaskId != nil {
+		return *x.ParentTaskId
+	}
+	return ""
+}
+
+// TaskResponse mirrors task_manager.TaskResponse
+type TaskResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Description           string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Priority              *Priority              `protobuf:"bytes,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	WorkflowStatus        string                 `protobuf:"bytes,4,opt,name=workflow_status,json=workflowStatus,proto3" json:"workflow_status,omitempty"`
+	Tags                  []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	Deadline              *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	PriorityPromotionDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=priority_promotion_date,json=priorityPromotionDate,proto3" json:"priority_promotion_date,omitempty"`
+	ParentTaskId          *string                `protobuf:"bytes,8,opt,name=parent_task_id,json=parentTaskId,proto3,oneof" json:"parent_task_id,omitempty"`
+	SubtaskIds            []string               `protobuf:"bytes,9,rep,name=subtask_ids,json=subtaskIds,proto3" json:"subtask_ids,omitempty"`
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt             *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *TaskResponse) Reset() {
+	*x = TaskResponse{}
+	mi := &file_task_manager_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskResponse) ProtoMessage() {}
+
+func (x *TaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskResponse.ProtoReflect.Descriptor instead.
+func (*TaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TaskResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TaskResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TaskResponse) GetPriority() *Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return nil
+}
+
+func (x *TaskResponse) GetWorkflowStatus() string {
+	if x != nil {
+		return x.WorkflowStatus
+	}
+	return ""
+}
+
+func (x *TaskResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *TaskResponse) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *TaskResponse) GetPriorityPromotionDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PriorityPromotionDate
+	}
+	return nil
+}
+
+func (x *TaskResponse) GetParentTaskId() string {
+	if x != nil && x.ParentTaskId != nil {
+		return *x.ParentTaskId
+	}
+	return ""
+}
+
+func (x *TaskResponse) GetSubtaskIds() []string {
+	if x != nil {
+		return x.SubtaskIds
+	}
+	return nil
+}
+
+func (x *TaskResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TaskResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// ArchivedTask mirrors task_manager.ArchivedTaskResponse
+type ArchivedTask struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *TaskResponse          `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	ArchivedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchivedTask) Reset() {
+	*x = ArchivedTask{}
+	mi := &file_task_manager_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivedTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivedTask) ProtoMessage() {}
+
+func (x *ArchivedTask) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivedTask.ProtoReflect.Descriptor instead.
+func (*ArchivedTask) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ArchivedTask) GetTask() *TaskResponse {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *ArchivedTask) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+// DateRange mirrors board_access.DateRange; unset bounds are open
+type DateRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DateRange) Reset() {
+	*x = DateRange{}
+	mi := &file_task_manager_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DateRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateRange) ProtoMessage() {}
+
+func (x *DateRange) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateRange.ProtoReflect.Descriptor instead.
+func (*DateRange) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DateRange) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *DateRange) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+// QueryCriteria mirrors task_manager.QueryCriteria
+type QueryCriteria struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Columns               []string               `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	Sections              []string               `protobuf:"bytes,2,rep,name=sections,proto3" json:"sections,omitempty"`
+	Priority              *Priority              `protobuf:"bytes,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	Tags                  []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	DateRange             *DateRange             `protobuf:"bytes,5,opt,name=date_range,json=dateRange,proto3" json:"date_range,omitempty"`
+	PriorityPromotionDate *DateRange             `protobuf:"bytes,6,opt,name=priority_promotion_date,json=priorityPromotionDate,proto3" json:"priority_promotion_date,omitempty"`
+	ParentTaskId          *string                `protobuf:"bytes,7,opt,name=parent_task_id,json=parentTaskId,proto3,oneof" json:"parent_task_id,omitempty"`
+	Hierarchy             string                 `protobuf:"bytes,8,opt,name=hierarchy,proto3" json:"hierarchy,omitempty"` // "all", "top_level_only" or "subtasks_only"
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *QueryCriteria) Reset() {
+	*x = QueryCriteria{}
+	mi := &file_task_manager_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryCriteria) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCriteria) ProtoMessage() {}
+
+func (x *QueryCriteria) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryCriteria.ProtoReflect.Descriptor instead.
+func (*QueryCriteria) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryCriteria) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *QueryCriteria) GetSections() []string {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *QueryCriteria) GetPriority() *Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return nil
+}
+
+func (x *QueryCriteria) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *QueryCriteria) GetDateRange() *DateRange {
+	if x != nil {
+		return x.DateRange
+	}
+	return nil
+}
+
+func (x *QueryCriteria) GetPriorityPromotionDate() *DateRange {
+	if x != nil {
+		return x.PriorityPromotionDate
+	}
+	return nil
+}
+
+func (x *QueryCriteria) GetParentTaskId() string {
+	if x != nil && x.ParentTaskId != nil {
+		return *x.ParentTaskId
+	}
+	return ""
+}
+
+func (x *QueryCriteria) GetHierarchy() string {
+	if x != nil {
+		return x.Hierarchy
+	}
+	return ""
+}
+
+// TaskIdentifier names a single task
+type TaskIdentifier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskIdentifier) Reset() {
+	*x = TaskIdentifier{}
+	mi := &file_task_manager_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskIdentifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskIdentifier) ProtoMessage() {}
+
+func (x *TaskIdentifier) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskIdentifier.ProtoReflect.Descriptor instead.
+func (*TaskIdentifier) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TaskIdentifier) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+// DeleteTaskRequest deletes a task together with its subtasks
+type DeleteTaskRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TaskId            string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	ExpectedUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expected_updated_at,json=expectedUpdatedAt,proto3" json:"expected_updated_at,omitempty"` // unset skips the check
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DeleteTaskRequest) Reset() {
+	*x = DeleteTaskRequest{}
+	mi := &file_task_manager_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskRequest) ProtoMessage() {}
+
+func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *DeleteTaskRequest) GetExpectedUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedUpdatedAt
+	}
+	return nil
+}
+
+// ArchiveTaskRequest archives a task and applies the cascade policy to its subtasks
+type ArchiveTaskRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TaskId            string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	CascadePolicy     string                 `protobuf:"bytes,2,opt,name=cascade_policy,json=cascadePolicy,proto3" json:"cascade_policy,omitempty"`               // "no_action", "archive_subtasks", "delete_subtasks" or "promote_subtasks"
+	ExpectedUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expected_updated_at,json=expectedUpdatedAt,proto3" json:"expected_updated_at,omitempty"` // unset skips the check
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ArchiveTaskRequest) Reset() {
+	*x = ArchiveTaskRequest{}
+	mi := &file_task_manager_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveTaskRequest) ProtoMessage() {}
+
+func (x *ArchiveTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveTaskRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ArchiveTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ArchiveTaskRequest) GetCascadePolicy() string {
+	if x != nil {
+		return x.CascadePolicy
+	}
+	return ""
+}
+
+func (x *ArchiveTaskRequest) GetExpectedUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedUpdatedAt
+	}
+	return nil
+}
+
+// CascadeTaskRequest restores a task and applies the cascade policy to its subtasks
+type CascadeTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	CascadePolicy string                 `protobuf:"bytes,2,opt,name=cascade_policy,json=cascadePolicy,proto3" json:"cascade_policy,omitempty"` // "no_action", "archive_subtasks", "delete_subtasks" or "promote_subtasks"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CascadeTaskRequest) Reset() {
+	*x = CascadeTaskRequest{}
+	mi := &file_task_manager_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CascadeTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CascadeTaskRequest) ProtoMessage() {}
+
+func (x *CascadeTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CascadeTaskRequest.ProtoReflect.Descriptor instead.
+func (*CascadeTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CascadeTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *CascadeTaskRequest) GetCascadePolicy() string {
+	if x != nil {
+		return x.CascadePolicy
+	}
+	return ""
+}
+
+// TaskList is a list of tasks
+type TaskList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*TaskResponse        `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskList) Reset() {
+	*x = TaskList{}
+	mi := &file_task_manager_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskList) ProtoMessage() {}
+
+func (x *TaskList) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskList.ProtoReflect.Descriptor instead.
+func (*TaskList) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TaskList) GetTasks() []*TaskResponse {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+// ArchivedTaskList is a list of archived tasks
+type ArchivedTaskList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*ArchivedTask        `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchivedTaskList) Reset() {
+	*x = ArchivedTaskList{}
+	mi := &file_task_manager_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivedTaskList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivedTaskList) ProtoMessage() {}
+
+func (x *ArchivedTaskList) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivedTaskList.ProtoReflect.Descriptor instead.
+func (*ArchivedTaskList) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ArchivedTaskList) GetTasks() []*ArchivedTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+// UpdateTaskRequest replaces the data of a task
+type UpdateTaskRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TaskId            string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Task              *TaskRequest           `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	ExpectedUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expected_updated_at,json=expectedUpdatedAt,proto3" json:"expected_updated_at,omitempty"` // unset skips the check
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateTaskRequest) Reset() {
+	*x = UpdateTaskRequest{}
+	mi := &file_task_manager_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskRequest) ProtoMessage() {}
+
+func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetTask() *TaskRequest {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetExpectedUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedUpdatedAt
+	}
+	return nil
+}
+
+// ChangeTaskStatusRequest moves a task to another workflow status
+type ChangeTaskStatusRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TaskId            string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	WorkflowStatus    string                 `protobuf:"bytes,2,opt,name=workflow_status,json=workflowStatus,proto3" json:"workflow_status,omitempty"`
+	ExpectedUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expected_updated_at,json=expectedUpdatedAt,proto3" json:"expected_updated_at,omitempty"` // unset skips the check
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ChangeTaskStatusRequest) Reset() {
+	*x = ChangeTaskStatusRequest{}
+	mi := &file_task_manager_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeTaskStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeTaskStatusRequest) ProtoMessage() {}
+
+func (x *ChangeTaskStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeTaskStatusRequest.ProtoReflect.Descriptor instead.
+func (*ChangeTaskStatusRequest) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ChangeTaskStatusRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ChangeTaskStatusRequest) GetWorkflowStatus() string {
+	if x != nil {
+		return x.WorkflowStatus
+	}
+	return ""
+}
+
+func (x *ChangeTaskStatusRequest) GetExpectedUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedUpdatedAt
+	}
+	return nil
+}
+
+// PurgeArchiveRequest removes archived tasks older than the given age
+type PurgeArchiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OlderThan     *durationpb.Duration   `protobuf:"bytes,1,opt,name=older_than,json=olderThan,proto3" json:"older_than,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeArchiveRequest) Reset() {
+	*x = PurgeArchiveRequest{}
+	mi := &file_task_manager_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeArchiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeArchiveRequest) ProtoMessage() {}
+
+func (x *PurgeArchiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeArchiveRequest.ProtoReflect.Descriptor instead.
+func (*PurgeArchiveRequest) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PurgeArchiveRequest) GetOlderThan() *durationpb.Duration {
+	if x != nil {
+		return x.OlderThan
+	}
+	return nil
+}
+
+// PurgeArchiveResponse lists the purged task IDs
+type PurgeArchiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskIds       []string               `protobuf:"bytes,1,rep,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeArchiveResponse) Reset() {
+	*x = PurgeArchiveResponse{}
+	mi := &file_task_manager_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeArchiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeArchiveResponse) ProtoMessage() {}
+
+func (x *PurgeArchiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeArchiveResponse.ProtoReflect.Descriptor instead.
+func (*PurgeArchiveResponse) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PurgeArchiveResponse) GetTaskIds() []string {
+	if x != nil {
+		return x.TaskIds
+	}
+	return nil
+}
+
+// RuleViolation mirrors engines.RuleViolation
+type RuleViolation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Priority      int32                  `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Details       string                 `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleViolation) Reset() {
+	*x = RuleViolation{}
+	mi := &file_task_manager_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleViolation) ProtoMessage() {}
+
+func (x *RuleViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleViolation.ProtoReflect.Descriptor instead.
+func (*RuleViolation) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RuleViolation) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *RuleViolation) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *RuleViolation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RuleViolation) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *RuleViolation) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+// ValidationResult mirrors task_manager.ValidationResult
+type ValidationResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Violations    []*RuleViolation       `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidationResult) Reset() {
+	*x = ValidationResult{}
+	mi := &file_task_manager_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationResult) ProtoMessage() {}
+
+func (x *ValidationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationResult.ProtoReflect.Descriptor instead.
+func (*ValidationResult) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ValidationResult) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidationResult) GetViolations() []*RuleViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+// Rule mirrors resource_access.Rule
+type Rule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	TriggerType   string                 `protobuf:"bytes,4,opt,name=trigger_type,json=triggerType,proto3" json:"trigger_type,omitempty"`
+	Conditions    *structpb.Struct       `protobuf:"bytes,5,opt,name=conditions,proto3" json:"conditions,omitempty"`
+	Actions       *structpb.Struct       `protobuf:"bytes,6,opt,name=actions,proto3" json:"actions,omitempty"`
+	Priority      int32                  `protobuf:"varint,7,opt,name=priority,proto3" json:"priority,omitempty"`
+	Enabled       bool                   `protobuf:"varint,8,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rule) Reset() {
+	*x = Rule{}
+	mi := &file_task_manager_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule) ProtoMessage() {}
+
+func (x *Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule.ProtoReflect.Descriptor instead.
+func (*Rule) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Rule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Rule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Rule) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Rule) GetTriggerType() string {
+	if x != nil {
+		return x.TriggerType
+	}
+	return ""
+}
+
+func (x *Rule) GetConditions() *structpb.Struct {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *Rule) GetActions() *structpb.Struct {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *Rule) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Rule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Rule) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// RuleDependencies lists the rules depending on a rule
+type RuleDependencies struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleIds       []string               `protobuf:"bytes,1,rep,name=rule_ids,json=ruleIds,proto3" json:"rule_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleDependencies) Reset() {
+	*x = RuleDependencies{}
+	mi := &file_task_manager_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleDependencies) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleDependencies) ProtoMessage() {}
+
+func (x *RuleDependencies) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleDependencies.ProtoReflect.Descriptor instead.
+func (*RuleDependencies) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RuleDependencies) GetRuleIds() []string {
+	if x != nil {
+		return x.RuleIds
+	}
+	return nil
+}
+
+// RuleSet mirrors resource_access.RuleSet
+type RuleSet struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Version       string                       `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Rules         []*Rule                      `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	Dependencies  map[string]*RuleDependencies `protobuf:"bytes,3,rep,name=dependencies,proto3" json:"dependencies,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Metadata      map[string]string            `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleSet) Reset() {
+	*x = RuleSet{}
+	mi := &file_task_manager_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleSet) ProtoMessage() {}
+
+func (x *RuleSet) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleSet.ProtoReflect.Descriptor instead.
+func (*RuleSet) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RuleSet) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *RuleSet) GetRules() []*Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *RuleSet) GetDependencies() map[string]*RuleDependencies {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+func (x *RuleSet) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// SimulateRulesRequest selects the rule set to simulate and the start of the replayed history
+type SimulateRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleSet       *RuleSet               `protobuf:"bytes,1,opt,name=rule_set,json=ruleSet,proto3" json:"rule_set,omitempty"` // unset simulates the rules of the board
+	Since         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`                    // unset replays the whole history
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulateRulesRequest) Reset() {
+	*x = SimulateRulesRequest{}
+	mi := &file_task_manager_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateRulesRequest) ProtoMessage() {}
+
+func (x *SimulateRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateRulesRequest.ProtoReflect.Descriptor instead.
+func (*SimulateRulesRequest) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SimulateRulesRequest) GetRuleSet() *RuleSet {
+	if x != nil {
+		return x.RuleSet
+	}
+	return nil
+}
+
+func (x *SimulateRulesRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+// SimulatedViolation mirrors engines.SimulatedViolation
+type SimulatedViolation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Violation     *RuleViolation         `protobuf:"bytes,1,opt,name=violation,proto3" json:"violation,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	TaskTitle     string                 `protobuf:"bytes,3,opt,name=task_title,json=taskTitle,proto3" json:"task_title,omitempty"`
+	EventType     string                 `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	FromColumn    string                 `protobuf:"bytes,5,opt,name=from_column,json=fromColumn,proto3" json:"from_column,omitempty"`
+	ToColumn      string                 `protobuf:"bytes,6,opt,name=to_column,json=toColumn,proto3" json:"to_column,omitempty"`
+	Commit        string                 `protobuf:"bytes,7,opt,name=commit,proto3" json:"commit,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulatedViolation) Reset() {
+	*x = SimulatedViolation{}
+	mi := &file_task_manager_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulatedViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulatedViolation) ProtoMessage() {}
+
+func (x *SimulatedViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulatedViolation.ProtoReflect.Descriptor instead.
+func (*SimulatedViolation) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SimulatedViolation) GetViolation() *RuleViolation {
+	if x != nil {
+		return x.Violation
+	}
+	return nil
+}
+
+func (x *SimulatedViolation) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *SimulatedViolation) GetTaskTitle() string {
+	if x != nil {
+		return x.TaskTitle
+	}
+	return ""
+}
+
+func (x *SimulatedViolation) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *SimulatedViolation) GetFromColumn() string {
+	if x != nil {
+		return x.FromColumn
+	}
+	return ""
+}
+
+func (x *SimulatedViolation) GetToColumn() string {
+	if x != nil {
+		return x.ToColumn
+	}
+	return ""
+}
+
+func (x *SimulatedViolation) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *SimulatedViolation) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// SimulationReport mirrors engines.SimulationReport
+type SimulationReport struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Since             *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`
+	RulesSimulated    int32                  `protobuf:"varint,2,opt,name=rules_simulated,json=rulesSimulated,proto3" json:"rules_simulated,omitempty"`
+	TasksEvaluated    int32                  `protobuf:"varint,3,opt,name=tasks_evaluated,json=tasksEvaluated,proto3" json:"tasks_evaluated,omitempty"`
+	RevisionsReplayed int32                  `protobuf:"varint,4,opt,name=revisions_replayed,json=revisionsReplayed,proto3" json:"revisions_replayed,omitempty"`
+	ChangesReplayed   int32                  `protobuf:"varint,5,opt,name=changes_replayed,json=changesReplayed,proto3" json:"changes_replayed,omitempty"`
+	Violations        []*SimulatedViolation  `protobuf:"bytes,6,rep,name=violations,proto3" json:"violations,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SimulationReport) Reset() {
+	*x = SimulationReport{}
+	mi := &file_task_manager_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulationReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulationReport) ProtoMessage() {}
+
+func (x *SimulationReport) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulationReport.ProtoReflect.Descriptor instead.
+func (*SimulationReport) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SimulationReport) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *SimulationReport) GetRulesSimulated() int32 {
+	if x != nil {
+		return x.RulesSimulated
+	}
+	return 0
+}
+
+func (x *SimulationReport) GetTasksEvaluated() int32 {
+	if x != nil {
+		return x.TasksEvaluated
+	}
+	return 0
+}
+
+func (x *SimulationReport) GetRevisionsReplayed() int32 {
+	if x != nil {
+		return x.RevisionsReplayed
+	}
+	return 0
+}
+
+func (x *SimulationReport) GetChangesReplayed() int32 {
+	if x != nil {
+		return x.ChangesReplayed
+	}
+	return 0
+}
+
+func (x *SimulationReport) GetViolations() []*SimulatedViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+// RuleViolations is attached to FAILED_PRECONDITION errors when business rules reject an operation
+type RuleViolations struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     string                 `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	Violations    []*RuleViolation       `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleViolations) Reset() {
+	*x = RuleViolations{}
+	mi := &file_task_manager_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleViolations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleViolations) ProtoMessage() {}
+
+func (x *RuleViolations) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleViolations.ProtoReflect.Descriptor instead.
+func (*RuleViolations) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RuleViolations) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *RuleViolations) GetViolations() []*RuleViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+// TaskModified is attached to ABORTED errors when a task no longer has the update time a modification expected
+type TaskModified struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskModified) Reset() {
+	*x = TaskModified{}
+	mi := &file_task_manager_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskModified) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskModified) ProtoMessage() {}
+
+func (x *TaskModified) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskModified.ProtoReflect.Descriptor instead.
+func (*TaskModified) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{25}
+}
+
+// BoardPath names a board directory
+type BoardPath struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BoardPath     string                 `protobuf:"bytes,1,opt,name=board_path,json=boardPath,proto3" json:"board_path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardPath) Reset() {
+	*x = BoardPath{}
+	mi := &file_task_manager_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardPath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardPath) ProtoMessage() {}
+
+func (x *BoardPath) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardPath.ProtoReflect.Descriptor instead.
+func (*BoardPath) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BoardPath) GetBoardPath() string {
+	if x != nil {
+		return x.BoardPath
+	}
+	return ""
+}
+
+// BoardValidationResponse mirrors task_manager.BoardValidationResponse
+type BoardValidationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsValid       bool                   `protobuf:"varint,1,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
+	GitRepoValid  bool                   `protobuf:"varint,2,opt,name=git_repo_valid,json=gitRepoValid,proto3" json:"git_repo_valid,omitempty"`
+	ConfigValid   bool                   `protobuf:"varint,3,opt,name=config_valid,json=configValid,proto3" json:"config_valid,omitempty"`
+	DataIntegrity bool                   `protobuf:"varint,4,opt,name=data_integrity,json=dataIntegrity,proto3" json:"data_integrity,omitempty"`
+	Issues        []string               `protobuf:"bytes,5,rep,name=issues,proto3" json:"issues,omitempty"`
+	Warnings      []string               `protobuf:"bytes,6,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardValidationResponse) Reset() {
+	*x = BoardValidationResponse{}
+	mi := &file_task_manager_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardValidationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardValidationResponse) ProtoMessage() {}
+
+func (x *BoardValidationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardValidationResponse.ProtoReflect.Descriptor instead.
+func (*BoardValidationResponse) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BoardValidationResponse) GetIsValid() bool {
+	if x != nil {
+		return x.IsValid
+	}
+	return false
+}
+
+func (x *BoardValidationResponse) GetGitRepoValid() bool {
+	if x != nil {
+		return x.GitRepoValid
+	}
+	return false
+}
+
+func (x *BoardValidationResponse) GetConfigValid() bool {
+	if x != nil {
+		return x.ConfigValid
+	}
+	return false
+}
+
+func (x *BoardValidationResponse) GetDataIntegrity() bool {
+	if x != nil {
+		return x.DataIntegrity
+	}
+	return false
+}
+
+func (x *BoardValidationResponse) GetIssues() []string {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *BoardValidationResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// BoardRepairResponse mirrors task_manager.BoardRepairResponse
+type BoardRepairResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Actions       []string                 `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+	Commit        string                   `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	Validation    *BoardValidationResponse `protobuf:"bytes,3,opt,name=validation,proto3" json:"validation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardRepairResponse) Reset() {
+	*x = BoardRepairResponse{}
+	mi := &file_task_manager_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardRepairResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardRepairResponse) ProtoMessage() {}
+
+func (x *BoardRepairResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardRepairResponse.ProtoReflect.Descriptor instead.
+func (*BoardRepairResponse) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BoardRepairResponse) GetActions() []string {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *BoardRepairResponse) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *BoardRepairResponse) GetValidation() *BoardValidationResponse {
+	if x != nil {
+		return x.Validation
+	}
+	return nil
+}
+
+// BoardMetadataResponse mirrors task_manager.BoardMetadataResponse
+type BoardMetadataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	TaskCount     int32                  `protobuf:"varint,3,opt,name=task_count,json=taskCount,proto3" json:"task_count,omitempty"`
+	ColumnCounts  map[string]int32       `protobuf:"bytes,4,rep,name=column_counts,json=columnCounts,proto3" json:"column_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	SchemaVersion string                 `protobuf:"bytes,5,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ModifiedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=modified_at,json=modifiedAt,proto3" json:"modified_at,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Columns       []*BoardColumn         `protobuf:"bytes,9,rep,name=columns,proto3" json:"columns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardMetadataResponse) Reset() {
+	*x = BoardMetadataResponse{}
+	mi := &file_task_manager_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardMetadataResponse) ProtoMessage() {}
+
+func (x *BoardMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardMetadataResponse.ProtoReflect.Descriptor instead.
+func (*BoardMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BoardMetadataResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *BoardMetadataResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *BoardMetadataResponse) GetTaskCount() int32 {
+	if x != nil {
+		return x.TaskCount
+	}
+	return 0
+}
+
+func (x *BoardMetadataResponse) GetColumnCounts() map[string]int32 {
+	if x != nil {
+		return x.ColumnCounts
+	}
+	return nil
+}
+
+func (x *BoardMetadataResponse) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *BoardMetadataResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *BoardMetadataResponse) GetModifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ModifiedAt
+	}
+	return nil
+}
+
+func (x *BoardMetadataResponse) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *BoardMetadataResponse) GetColumns() []*BoardColumn {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+// BoardColumn mirrors task_manager.BoardColumn
+type BoardColumn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Color         string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	WipLimit      int32                  `protobuf:"varint,4,opt,name=wip_limit,json=wipLimit,proto3" json:"wip_limit,omitempty"`
+	Done          bool                   `protobuf:"varint,5,opt,name=done,proto3" json:"done,omitempty"`
+	EntryPolicy   string                 `protobuf:"bytes,6,opt,name=entry_policy,json=entryPolicy,proto3" json:"entry_policy,omitempty"`
+	ExitPolicy    string                 `protobuf:"bytes,7,opt,name=exit_policy,json=exitPolicy,proto3" json:"exit_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardColumn) Reset() {
+	*x = BoardColumn{}
+	mi := &file_task_manager_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardColumn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardColumn) ProtoMessage() {}
+
+func (x *BoardColumn) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardColumn.ProtoReflect.Descriptor instead.
+func (*BoardColumn) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BoardColumn) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BoardColumn) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BoardColumn) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *BoardColumn) GetWipLimit() int32 {
+	if x != nil {
+		return x.WipLimit
+	}
+	return 0
+}
+
+func (x *BoardColumn) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *BoardColumn) GetEntryPolicy() string {
+	if x != nil {
+		return x.EntryPolicy
+	}
+	return ""
+}
+
+func (x *BoardColumn) GetExitPolicy() string {
+	if x != nil {
+		return x.ExitPolicy
+	}
+	return ""
+}
+
+// BoardMetadataRequest mirrors task_manager.BoardMetadataRequest
+type BoardMetadataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardMetadataRequest) Reset() {
+	*x = BoardMetadataRequest{}
+	mi := &file_task_manager_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardMetadataRequest) ProtoMessage() {}
+
+func (x *BoardMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardMetadataRequest.ProtoReflect.Descriptor instead.
+func (*BoardMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BoardMetadataRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *BoardMetadataRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *BoardMetadataRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// UpdateBoardMetadataRequest changes the metadata of a board
+type UpdateBoardMetadataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BoardPath     string                 `protobuf:"bytes,1,opt,name=board_path,json=boardPath,proto3" json:"board_path,omitempty"`
+	Metadata      *BoardMetadataRequest  `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBoardMetadataRequest) Reset() {
+	*x = UpdateBoardMetadataRequest{}
+	mi := &file_task_manager_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBoardMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBoardMetadataRequest) ProtoMessage() {}
+
+func (x *UpdateBoardMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBoardMetadataRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBoardMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateBoardMetadataRequest) GetBoardPath() string {
+	if x != nil {
+		return x.BoardPath
+	}
+	return ""
+}
+
+func (x *UpdateBoardMetadataRequest) GetMetadata() *BoardMetadataRequest {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// BoardRemote mirrors task_manager.BoardRemote; removal only uses the name
+type BoardRemote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardRemote) Reset() {
+	*x = BoardRemote{}
+	mi := &file_task_manager_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardRemote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardRemote) ProtoMessage() {}
+
+func (x *BoardRemote) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardRemote.ProtoReflect.Descriptor instead.
+func (*BoardRemote) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BoardRemote) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BoardRemote) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// BoardRemoteList holds the remotes of a board
+type BoardRemoteList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Remotes       []*BoardRemote         `protobuf:"bytes,1,rep,name=remotes,proto3" json:"remotes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardRemoteList) Reset() {
+	*x = BoardRemoteList{}
+	mi := &file_task_manager_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardRemoteList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardRemoteList) ProtoMessage() {}
+
+func (x *BoardRemoteList) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardRemoteList.ProtoReflect.Descriptor instead.
+func (*BoardRemoteList) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BoardRemoteList) GetRemotes() []*BoardRemote {
+	if x != nil {
+		return x.Remotes
+	}
+	return nil
+}
+
+// SyncBoardRequest selects the remote to synchronise with; empty selects origin
+type SyncBoardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Remote        string                 `protobuf:"bytes,1,opt,name=remote,proto3" json:"remote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncBoardRequest) Reset() {
+	*x = SyncBoardRequest{}
+	mi := &file_task_manager_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncBoardRequest) ProtoMessage() {}
+
+func (x *SyncBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncBoardRequest.ProtoReflect.Descriptor instead.
+func (*SyncBoardRequest) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SyncBoardRequest) GetRemote() string {
+	if x != nil {
+		return x.Remote
+	}
+	return ""
+}
+
+// SyncResponse mirrors task_manager.SyncResponse
+type SyncResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Remote               string                 `protobuf:"bytes,1,opt,name=remote,proto3" json:"remote,omitempty"`
+	UpToDate             bool                   `protobuf:"varint,2,opt,name=up_to_date,json=upToDate,proto3" json:"up_to_date,omitempty"`
+	FastForward          bool                   `protobuf:"varint,3,opt,name=fast_forward,json=fastForward,proto3" json:"fast_forward,omitempty"`
+	MergeCommit          string                 `protobuf:"bytes,4,opt,name=merge_commit,json=mergeCommit,proto3" json:"merge_commit,omitempty"`
+	ChangedTaskIds       []string               `protobuf:"bytes,5,rep,name=changed_task_ids,json=changedTaskIds,proto3" json:"changed_task_ids,omitempty"`
+	ConfigurationChanged bool                   `protobuf:"varint,6,opt,name=configuration_changed,json=configurationChanged,proto3" json:"configuration_changed,omitempty"`
+	Conflicts            []*TaskFieldConflict   `protobuf:"bytes,7,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SyncResponse) Reset() {
+	*x = SyncResponse{}
+	mi := &file_task_manager_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncResponse) ProtoMessage() {}
+
+func (x *SyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncResponse.ProtoReflect.Descriptor instead.
+func (*SyncResponse) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SyncResponse) GetRemote() string {
+	if x != nil {
+		return x.Remote
+	}
+	return ""
+}
+
+func (x *SyncResponse) GetUpToDate() bool {
+	if x != nil {
+		return x.UpToDate
+	}
+	return false
+}
+
+func (x *SyncResponse) GetFastForward() bool {
+	if x != nil {
+		return x.FastForward
+	}
+	return false
+}
+
+func (x *SyncResponse) GetMergeCommit() string {
+	if x != nil {
+		return x.MergeCommit
+	}
+	return ""
+}
+
+func (x *SyncResponse) GetChangedTaskIds() []string {
+	if x != nil {
+		return x.ChangedTaskIds
+	}
+	return nil
+}
+
+func (x *SyncResponse) GetConfigurationChanged() bool {
+	if x != nil {
+		return x.ConfigurationChanged
+	}
+	return false
+}
+
+func (x *SyncResponse) GetConflicts() []*TaskFieldConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+// TaskFieldConflict mirrors task_manager.TaskFieldConflict
+type TaskFieldConflict struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	LocalValue    string                 `protobuf:"bytes,3,opt,name=local_value,json=localValue,proto3" json:"local_value,omitempty"`
+	RemoteValue   string                 `protobuf:"bytes,4,opt,name=remote_value,json=remoteValue,proto3" json:"remote_value,omitempty"`
+	Resolution    string                 `protobuf:"bytes,5,opt,name=resolution,proto3" json:"resolution,omitempty"` // "local", "remote" or "unresolved"
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskFieldConflict) Reset() {
+	*x = TaskFieldConflict{}
+	mi := &file_task_manager_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskFieldConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskFieldConflict) ProtoMessage() {}
+
+func (x *TaskFieldConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskFieldConflict.ProtoReflect.Descriptor instead.
+func (*TaskFieldConflict) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *TaskFieldConflict) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TaskFieldConflict) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *TaskFieldConflict) GetLocalValue() string {
+	if x != nil {
+		return x.LocalValue
+	}
+	return ""
+}
+
+func (x *TaskFieldConflict) GetRemoteValue() string {
+	if x != nil {
+		return x.RemoteValue
+	}
+	return ""
+}
+
+func (x *TaskFieldConflict) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+func (x *TaskFieldConflict) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// UndoResponse mirrors task_manager.UndoResponse
+type UndoResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Description    string                 `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Commit         string                 `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	ChangedTaskIds []string               `protobuf:"bytes,3,rep,name=changed_task_ids,json=changedTaskIds,proto3" json:"changed_task_ids,omitempty"`
+	CanUndo        bool                   `protobuf:"varint,4,opt,name=can_undo,json=canUndo,proto3" json:"can_undo,omitempty"`
+	CanRedo        bool                   `protobuf:"varint,5,opt,name=can_redo,json=canRedo,proto3" json:"can_redo,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UndoResponse) Reset() {
+	*x = UndoResponse{}
+	mi := &file_task_manager_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoResponse) ProtoMessage() {}
+
+func (x *UndoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoResponse.ProtoReflect.Descriptor instead.
+func (*UndoResponse) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UndoResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UndoResponse) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *UndoResponse) GetChangedTaskIds() []string {
+	if x != nil {
+		return x.ChangedTaskIds
+	}
+	return nil
+}
+
+func (x *UndoResponse) GetCanUndo() bool {
+	if x != nil {
+		return x.CanUndo
+	}
+	return false
+}
+
+func (x *UndoResponse) GetCanRedo() bool {
+	if x != nil {
+		return x.CanRedo
+	}
+	return false
+}
+
+// ListBoardRevisionsRequest limits the number of revisions; 0 lists all of them
+type ListBoardRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBoardRevisionsRequest) Reset() {
+	*x = ListBoardRevisionsRequest{}
+	mi := &file_task_manager_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBoardRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBoardRevisionsRequest) ProtoMessage() {}
+
+func (x *ListBoardRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBoardRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListBoardRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListBoardRevisionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// BoardRevision mirrors task_manager.BoardRevision
+type BoardRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Commit        string                 `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	Author        string                 `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardRevision) Reset() {
+	*x = BoardRevision{}
+	mi := &file_task_manager_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardRevision) ProtoMessage() {}
+
+func (x *BoardRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardRevision.ProtoReflect.Descriptor instead.
+func (*BoardRevision) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *BoardRevision) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *BoardRevision) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *BoardRevision) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *BoardRevision) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// BoardRevisionList holds board revisions, newest first
+type BoardRevisionList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*BoardRevision       `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardRevisionList) Reset() {
+	*x = BoardRevisionList{}
+	mi := &file_task_manager_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardRevisionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardRevisionList) ProtoMessage() {}
+
+func (x *BoardRevisionList) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardRevisionList.ProtoReflect.Descriptor instead.
+func (*BoardRevisionList) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *BoardRevisionList) GetRevisions() []*BoardRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+// LoadBoardAtRequest selects a revision by commit or, without a commit, by time
+type LoadBoardAtRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Commit        string                 `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoadBoardAtRequest) Reset() {
+	*x = LoadBoardAtRequest{}
+	mi := &file_task_manager_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadBoardAtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadBoardAtRequest) ProtoMessage() {}
+
+func (x *LoadBoardAtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadBoardAtRequest.ProtoReflect.Descriptor instead.
+func (*LoadBoardAtRequest) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *LoadBoardAtRequest) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *LoadBoardAtRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+// BoardSnapshot mirrors task_manager.BoardSnapshotResponse
+type BoardSnapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      *BoardRevision         `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Tasks         []*TaskResponse        `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	ArchivedTasks []*ArchivedTask        `protobuf:"bytes,3,rep,name=archived_tasks,json=archivedTasks,proto3" json:"archived_tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardSnapshot) Reset() {
+	*x = BoardSnapshot{}
+	mi := &file_task_manager_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardSnapshot) ProtoMessage() {}
+
+func (x *BoardSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardSnapshot.ProtoReflect.Descriptor instead.
+func (*BoardSnapshot) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *BoardSnapshot) GetRevision() *BoardRevision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+func (x *BoardSnapshot) GetTasks() []*TaskResponse {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *BoardSnapshot) GetArchivedTasks() []*ArchivedTask {
+	if x != nil {
+		return x.ArchivedTasks
+	}
+	return nil
+}
+
+// RestoreTaskFromRequest selects the task and the revision to restore it from
+type RestoreTaskFromRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Commit        string                 `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTaskFromRequest) Reset() {
+	*x = RestoreTaskFromRequest{}
+	mi := &file_task_manager_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTaskFromRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTaskFromRequest) ProtoMessage() {}
+
+func (x *RestoreTaskFromRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTaskFromRequest.ProtoReflect.Descriptor instead.
+func (*RestoreTaskFromRequest) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RestoreTaskFromRequest) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *RestoreTaskFromRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+// GetTaskHistoryRequest selects a task and limits the number of revisions; 0 returns the latest 100
+type GetTaskHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskHistoryRequest) Reset() {
+	*x = GetTaskHistoryRequest{}
+	mi := &file_task_manager_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskHistoryRequest) ProtoMessage() {}
+
+func (x *GetTaskHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetTaskHistoryRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *GetTaskHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// TaskFieldChange mirrors task_manager.TaskFieldChange
+type TaskFieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Before        string                 `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After         string                 `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskFieldChange) Reset() {
+	*x = TaskFieldChange{}
+	mi := &file_task_manager_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskFieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskFieldChange) ProtoMessage() {}
+
+func (x *TaskFieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskFieldChange.ProtoReflect.Descriptor instead.
+func (*TaskFieldChange) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *TaskFieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *TaskFieldChange) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *TaskFieldChange) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+// TaskRevision mirrors task_manager.TaskRevision
+type TaskRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      *BoardRevision         `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Operation     string                 `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	Changes       []*TaskFieldChange     `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskRevision) Reset() {
+	*x = TaskRevision{}
+	mi := &file_task_manager_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskRevision) ProtoMessage() {}
+
+func (x *TaskRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskRevision.ProtoReflect.Descriptor instead.
+func (*TaskRevision) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *TaskRevision) GetRevision() *BoardRevision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+func (x *TaskRevision) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *TaskRevision) GetChanges() []*TaskFieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// TaskRevisionList holds the revisions of a task, newest first
+type TaskRevisionList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*TaskRevision        `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskRevisionList) Reset() {
+	*x = TaskRevisionList{}
+	mi := &file_task_manager_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskRevisionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskRevisionList) ProtoMessage() {}
+
+func (x *TaskRevisionList) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskRevisionList.ProtoReflect.Descriptor instead.
+func (*TaskRevisionList) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *TaskRevisionList) GetRevisions() []*TaskRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+// BatchRequest mirrors task_manager.BatchRequest
+type BatchRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Operation      string                 `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"` // "change_status", "change_priority" or "archive"
+	TaskIds        []string               `protobuf:"bytes,2,rep,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty"`
+	WorkflowStatus string                 `protobuf:"bytes,3,opt,name=workflow_status,json=workflowStatus,proto3" json:"workflow_status,omitempty"`
+	Priority       *Priority              `protobuf:"bytes,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	CascadePolicy  string                 `protobuf:"bytes,5,opt,name=cascade_policy,json=cascadePolicy,proto3" json:"cascade_policy,omitempty"` // subtasks of "archive"
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BatchRequest) Reset() {
+	*x = BatchRequest{}
+	mi := &file_task_manager_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchRequest) ProtoMessage() {}
+
+func (x *BatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchRequest.ProtoReflect.Descriptor instead.
+func (*BatchRequest) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *BatchRequest) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *BatchRequest) GetTaskIds() []string {
+	if x != nil {
+		return x.TaskIds
+	}
+	return nil
+}
+
+func (x *BatchRequest) GetWorkflowStatus() string {
+	if x != nil {
+		return x.WorkflowStatus
+	}
+	return ""
+}
+
+func (x *BatchRequest) GetPriority() *Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return nil
+}
+
+func (x *BatchRequest) GetCascadePolicy() string {
+	if x != nil {
+		return x.CascadePolicy
+	}
+	return ""
+}
+
+// BatchResponse mirrors task_manager.BatchResponse
+type BatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*TaskResponse        `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	Commit        string                 `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchResponse) Reset() {
+	*x = BatchResponse{}
+	mi := &file_task_manager_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResponse) ProtoMessage() {}
+
+func (x *BatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResponse.ProtoReflect.Descriptor instead.
+func (*BatchResponse) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *BatchResponse) GetTasks() []*TaskResponse {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *BatchResponse) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+// RunScheduledRulesRequest gives the time a scheduled run evaluates the time-triggered rules at
+type RunScheduledRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Now           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=now,proto3" json:"now,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunScheduledRulesRequest) Reset() {
+	*x = RunScheduledRulesRequest{}
+	mi := &file_task_manager_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunScheduledRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunScheduledRulesRequest) ProtoMessage() {}
+
+func (x *RunScheduledRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunScheduledRulesRequest.ProtoReflect.Descriptor instead.
+func (*RunScheduledRulesRequest) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RunScheduledRulesRequest) GetNow() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Now
+	}
+	return nil
+}
+
+// ScheduledTrigger mirrors task_manager.ScheduledTrigger
+type ScheduledTrigger struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	RuleName      string                 `protobuf:"bytes,2,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`                          // "automation" or "notification"
+	TriggerType   string                 `protobuf:"bytes,4,opt,name=trigger_type,json=triggerType,proto3" json:"trigger_type,omitempty"` // "due_date", "promotion_date" or "column_age"
+	TaskId        string                 `protobuf:"bytes,5,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduledTrigger) Reset() {
+	*x = ScheduledTrigger{}
+	mi := &file_task_manager_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledTrigger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledTrigger) ProtoMessage() {}
+
+func (x *ScheduledTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledTrigger.ProtoReflect.Descriptor instead.
+func (*ScheduledTrigger) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ScheduledTrigger) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *ScheduledTrigger) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *ScheduledTrigger) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ScheduledTrigger) GetTriggerType() string {
+	if x != nil {
+		return x.TriggerType
+	}
+	return ""
+}
+
+func (x *ScheduledTrigger) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ScheduledTrigger) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ScheduledRulesResponse mirrors task_manager.ScheduledRulesResponse
+type ScheduledRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunAt         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=run_at,json=runAt,proto3" json:"run_at,omitempty"`
+	Triggered     []*ScheduledTrigger    `protobuf:"bytes,2,rep,name=triggered,proto3" json:"triggered,omitempty"`
+	Promoted      []*TaskResponse        `protobuf:"bytes,3,rep,name=promoted,proto3" json:"promoted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduledRulesResponse) Reset() {
+	*x = ScheduledRulesResponse{}
+	mi := &file_task_manager_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledRulesResponse) ProtoMessage() {}
+
+func (x *ScheduledRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledRulesResponse.ProtoReflect.Descriptor instead.
+func (*ScheduledRulesResponse) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ScheduledRulesResponse) GetRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RunAt
+	}
+	return nil
+}
+
+func (x *ScheduledRulesResponse) GetTriggered() []*ScheduledTrigger {
+	if x != nil {
+		return x.Triggered
+	}
+	return nil
+}
+
+func (x *ScheduledRulesResponse) GetPromoted() []*TaskResponse {
+	if x != nil {
+		return x.Promoted
+	}
+	return nil
+}
+
+// BatchFailure is attached to the error of a failed batch
+type BatchFailure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // error of the failed task
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchFailure) Reset() {
+	*x = BatchFailure{}
+	mi := &file_task_manager_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchFailure) ProtoMessage() {}
+
+func (x *BatchFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchFailure.ProtoReflect.Descriptor instead.
+func (*BatchFailure) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *BatchFailure) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *BatchFailure) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// BoardStatistics mirrors board_access.BoardStatistics
+type BoardStatistics struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TotalTasks           int32                  `protobuf:"varint,1,opt,name=total_tasks,json=totalTasks,proto3" json:"total_tasks,omitempty"`
+	ActiveTasks          int32                  `protobuf:"varint,2,opt,name=active_tasks,json=activeTasks,proto3" json:"active_tasks,omitempty"`
+	CompletedTasks       int32                  `protobuf:"varint,3,opt,name=completed_tasks,json=completedTasks,proto3" json:"completed_tasks,omitempty"`
+	TasksByColumn        map[string]int32       `protobuf:"bytes,4,rep,name=tasks_by_column,json=tasksByColumn,proto3" json:"tasks_by_column,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	TasksByPriority      map[string]int32       `protobuf:"bytes,5,rep,name=tasks_by_priority,json=tasksByPriority,proto3" json:"tasks_by_priority,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	AverageTaskAgeDays   float64                `protobuf:"fixed64,6,opt,name=average_task_age_days,json=averageTaskAgeDays,proto3" json:"average_task_age_days,omitempty"`
+	OldestTaskAgeDays    float64                `protobuf:"fixed64,7,opt,name=oldest_task_age_days,json=oldestTaskAgeDays,proto3" json:"oldest_task_age_days,omitempty"`
+	AverageColumnAgeDays float64                `protobuf:"fixed64,8,opt,name=average_column_age_days,json=averageColumnAgeDays,proto3" json:"average_column_age_days,omitempty"`
+	LastActivity         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_activity,json=lastActivity,proto3" json:"last_activity,omitempty"`
+	BoardHealthScore     float64                `protobuf:"fixed64,10,opt,name=board_health_score,json=boardHealthScore,proto3" json:"board_health_score,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *BoardStatistics) Reset() {
+	*x = BoardStatistics{}
+	mi := &file_task_manager_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardStatistics) ProtoMessage() {}
+
+func (x *BoardStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardStatistics.ProtoReflect.Descriptor instead.
+func (*BoardStatistics) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *BoardStatistics) GetTotalTasks() int32 {
+	if x != nil {
+		return x.TotalTasks
+	}
+	return 0
+}
+
+func (x *BoardStatistics) GetActiveTasks() int32 {
+	if x != nil {
+		return x.ActiveTasks
+	}
+	return 0
+}
+
+func (x *BoardStatistics) GetCompletedTasks() int32 {
+	if x != nil {
+		return x.CompletedTasks
+	}
+	return 0
+}
+
+func (x *BoardStatistics) GetTasksByColumn() map[string]int32 {
+	if x != nil {
+		return x.TasksByColumn
+	}
+	return nil
+}
+
+func (x *BoardStatistics) GetTasksByPriority() map[string]int32 {
+	if x != nil {
+		return x.TasksByPriority
+	}
+	return nil
+}
+
+func (x *BoardStatistics) GetAverageTaskAgeDays() float64 {
+	if x != nil {
+		return x.AverageTaskAgeDays
+	}
+	return 0
+}
+
+func (x *BoardStatistics) GetOldestTaskAgeDays() float64 {
+	if x != nil {
+		return x.OldestTaskAgeDays
+	}
+	return 0
+}
+
+func (x *BoardStatistics) GetAverageColumnAgeDays() float64 {
+	if x != nil {
+		return x.AverageColumnAgeDays
+	}
+	return 0
+}
+
+func (x *BoardStatistics) GetLastActivity() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastActivity
+	}
+	return nil
+}
+
+func (x *BoardStatistics) GetBoardHealthScore() float64 {
+	if x != nil {
+		return x.BoardHealthScore
+	}
+	return 0
+}
+
+// FlowMetricsRequest selects the board and reporting range of a flow metrics report
+type FlowMetricsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BoardPath     string                 `protobuf:"bytes,1,opt,name=board_path,json=boardPath,proto3" json:"board_path,omitempty"`
+	DateRange     *DateRange             `protobuf:"bytes,2,opt,name=date_range,json=dateRange,proto3" json:"date_range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlowMetricsRequest) Reset() {
+	*x = FlowMetricsRequest{}
+	mi := &file_task_manager_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlowMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlowMetricsRequest) ProtoMessage() {}
+
+func (x *FlowMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlowMetricsRequest.ProtoReflect.Descriptor instead.
+func (*FlowMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *FlowMetricsRequest) GetBoardPath() string {
+	if x != nil {
+		return x.BoardPath
+	}
+	return ""
+}
+
+func (x *FlowMetricsRequest) GetDateRange() *DateRange {
+	if x != nil {
+		return x.DateRange
+	}
+	return nil
+}
+
+// FlowTimeStatistics mirrors board_access.FlowTimeStatistics
+type FlowTimeStatistics struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	AverageDays   float64                `protobuf:"fixed64,2,opt,name=average_days,json=averageDays,proto3" json:"average_days,omitempty"`
+	MedianDays    float64                `protobuf:"fixed64,3,opt,name=median_days,json=medianDays,proto3" json:"median_days,omitempty"`
+	P85Days       float64                `protobuf:"fixed64,4,opt,name=p85_days,json=p85Days,proto3" json:"p85_days,omitempty"`
+	MaxDays       float64                `protobuf:"fixed64,5,opt,name=max_days,json=maxDays,proto3" json:"max_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlowTimeStatistics) Reset() {
+	*x = FlowTimeStatistics{}
+	mi := &file_task_manager_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlowTimeStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlowTimeStatistics) ProtoMessage() {}
+
+func (x *FlowTimeStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlowTimeStatistics.ProtoReflect.Descriptor instead.
+func (*FlowTimeStatistics) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *FlowTimeStatistics) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *FlowTimeStatistics) GetAverageDays() float64 {
+	if x != nil {
+		return x.AverageDays
+	}
+	return 0
+}
+
+func (x *FlowTimeStatistics) GetMedianDays() float64 {
+	if x != nil {
+		return x.MedianDays
+	}
+	return 0
+}
+
+func (x *FlowTimeStatistics) GetP85Days() float64 {
+	if x != nil {
+		return x.P85Days
+	}
+	return 0
+}
+
+func (x *FlowTimeStatistics) GetMaxDays() float64 {
+	if x != nil {
+		return x.MaxDays
+	}
+	return 0
+}
+
+// TaskFlow mirrors board_access.TaskFlow
+type TaskFlow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Quadrant      string                 `protobuf:"bytes,3,opt,name=quadrant,proto3" json:"quadrant,omitempty"`
+	Column        string                 `protobuf:"bytes,4,opt,name=column,proto3" json:"column,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	LeadTimeDays  float64                `protobuf:"fixed64,8,opt,name=lead_time_days,json=leadTimeDays,proto3" json:"lead_time_days,omitempty"`
+	CycleTimeDays float64                `protobuf:"fixed64,9,opt,name=cycle_time_days,json=cycleTimeDays,proto3" json:"cycle_time_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskFlow) Reset() {
+	*x = TaskFlow{}
+	mi := &file_task_manager_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskFlow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskFlow) ProtoMessage() {}
+
+func (x *TaskFlow) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskFlow.ProtoReflect.Descriptor instead.
+func (*TaskFlow) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *TaskFlow) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TaskFlow) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TaskFlow) GetQuadrant() string {
+	if x != nil {
+		return x.Quadrant
+	}
+	return ""
+}
+
+func (x *TaskFlow) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *TaskFlow) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TaskFlow) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *TaskFlow) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *TaskFlow) GetLeadTimeDays() float64 {
+	if x != nil {
+		return x.LeadTimeDays
+	}
+	return 0
+}
+
+func (x *TaskFlow) GetCycleTimeDays() float64 {
+	if x != nil {
+		return x.CycleTimeDays
+	}
+	return 0
+}
+
+// ThroughputPeriod mirrors board_access.ThroughputPeriod
+type ThroughputPeriod struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WeekStart     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=week_start,json=weekStart,proto3" json:"week_start,omitempty"`
+	Completed     int32                  `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	ByQuadrant    map[string]int32       `protobuf:"bytes,3,rep,name=by_quadrant,json=byQuadrant,proto3" json:"by_quadrant,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ThroughputPeriod) Reset() {
+	*x = ThroughputPeriod{}
+	mi := &file_task_manager_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ThroughputPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThroughputPeriod) ProtoMessage() {}
+
+func (x *ThroughputPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThroughputPeriod.ProtoReflect.Descriptor instead.
+func (*ThroughputPeriod) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ThroughputPeriod) GetWeekStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WeekStart
+	}
+	return nil
+}
+
+func (x *ThroughputPeriod) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *ThroughputPeriod) GetByQuadrant() map[string]int32 {
+	if x != nil {
+		return x.ByQuadrant
+	}
+	return nil
+}
+
+// ColumnCounts maps column names to task counts
+type ColumnCounts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Columns       map[string]int32       `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ColumnCounts) Reset() {
+	*x = ColumnCounts{}
+	mi := &file_task_manager_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ColumnCounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColumnCounts) ProtoMessage() {}
+
+func (x *ColumnCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColumnCounts.ProtoReflect.Descriptor instead.
+func (*ColumnCounts) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ColumnCounts) GetColumns() map[string]int32 {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+// CumulativeFlowPoint mirrors board_access.CumulativeFlowPoint
+type CumulativeFlowPoint struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Date          *timestamppb.Timestamp   `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Columns       map[string]int32         `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Quadrants     map[string]*ColumnCounts `protobuf:"bytes,3,rep,name=quadrants,proto3" json:"quadrants,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Wip           int32                    `protobuf:"varint,4,opt,name=wip,proto3" json:"wip,omitempty"`
+	WipByQuadrant map[string]int32         `protobuf:"bytes,5,rep,name=wip_by_quadrant,json=wipByQuadrant,proto3" json:"wip_by_quadrant,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CumulativeFlowPoint) Reset() {
+	*x = CumulativeFlowPoint{}
+	mi := &file_task_manager_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CumulativeFlowPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CumulativeFlowPoint) ProtoMessage() {}
+
+func (x *CumulativeFlowPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CumulativeFlowPoint.ProtoReflect.Descriptor instead.
+func (*CumulativeFlowPoint) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CumulativeFlowPoint) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *CumulativeFlowPoint) GetColumns() map[string]int32 {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *CumulativeFlowPoint) GetQuadrants() map[string]*ColumnCounts {
+	if x != nil {
+		return x.Quadrants
+	}
+	return nil
+}
+
+func (x *CumulativeFlowPoint) GetWip() int32 {
+	if x != nil {
+		return x.Wip
+	}
+	return 0
+}
+
+func (x *CumulativeFlowPoint) GetWipByQuadrant() map[string]int32 {
+	if x != nil {
+		return x.WipByQuadrant
+	}
+	return nil
+}
+
+// FlowMetrics mirrors board_access.FlowMetrics
+type FlowMetrics struct {
+	state               protoimpl.MessageState         `protogen:"open.v1"`
+	From                *timestamppb.Timestamp         `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                  *timestamppb.Timestamp         `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Columns             []string                       `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
+	DoneColumn          string                         `protobuf:"bytes,4,opt,name=done_column,json=doneColumn,proto3" json:"done_column,omitempty"`
+	Tasks               []*TaskFlow                    `protobuf:"bytes,5,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	LeadTime            *FlowTimeStatistics            `protobuf:"bytes,6,opt,name=lead_time,json=leadTime,proto3" json:"lead_time,omitempty"`
+	CycleTime           *FlowTimeStatistics            `protobuf:"bytes,7,opt,name=cycle_time,json=cycleTime,proto3" json:"cycle_time,omitempty"`
+	LeadTimeByQuadrant  map[string]*FlowTimeStatistics `protobuf:"bytes,8,rep,name=lead_time_by_quadrant,json=leadTimeByQuadrant,proto3" json:"lead_time_by_quadrant,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CycleTimeByQuadrant map[string]*FlowTimeStatistics `protobuf:"bytes,9,rep,name=cycle_time_by_quadrant,json=cycleTimeByQuadrant,proto3" json:"cycle_time_by_quadrant,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Throughput          []*ThroughputPeriod            `protobuf:"bytes,10,rep,name=throughput,proto3" json:"throughput,omitempty"`
+	CumulativeFlow      []*CumulativeFlowPoint         `protobuf:"bytes,11,rep,name=cumulative_flow,json=cumulativeFlow,proto3" json:"cumulative_flow,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *FlowMetrics) Reset() {
+	*x = FlowMetrics{}
+	mi := &file_task_manager_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlowMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlowMetrics) ProtoMessage() {}
+
+func (x *FlowMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlowMetrics.ProtoReflect.Descriptor instead.
+func (*FlowMetrics) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *FlowMetrics) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *FlowMetrics) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *FlowMetrics) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *FlowMetrics) GetDoneColumn() string {
+	if x != nil {
+		return x.DoneColumn
+	}
+	return ""
+}
+
+func (x *FlowMetrics) GetTasks() []*TaskFlow {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *FlowMetrics) GetLeadTime() *FlowTimeStatistics {
+	if x != nil {
+		return x.LeadTime
+	}
+	return nil
+}
+
+func (x *FlowMetrics) GetCycleTime() *FlowTimeStatistics {
+	if x != nil {
+		return x.CycleTime
+	}
+	return nil
+}
+
+func (x *FlowMetrics) GetLeadTimeByQuadrant() map[string]*FlowTimeStatistics {
+	if x != nil {
+		return x.LeadTimeByQuadrant
+	}
+	return nil
+}
+
+func (x *FlowMetrics) GetCycleTimeByQuadrant() map[string]*FlowTimeStatistics {
+	if x != nil {
+		return x.CycleTimeByQuadrant
+	}
+	return nil
+}
+
+func (x *FlowMetrics) GetThroughput() []*ThroughputPeriod {
+	if x != nil {
+		return x.Throughput
+	}
+	return nil
+}
+
+func (x *FlowMetrics) GetCumulativeFlow() []*CumulativeFlowPoint {
+	if x != nil {
+		return x.CumulativeFlow
+	}
+	return nil
+}
+
+// LoadContextRequest selects the UI context to load
+type LoadContextRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoadContextRequest) Reset() {
+	*x = LoadContextRequest{}
+	mi := &file_task_manager_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadContextRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadContextRequest) ProtoMessage() {}
+
+func (x *LoadContextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadContextRequest.ProtoReflect.Descriptor instead.
+func (*LoadContextRequest) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *LoadContextRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+// ContextData mirrors task_manager.ContextData
+type ContextData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Data          *structpb.Struct       `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContextData) Reset() {
+	*x = ContextData{}
+	mi := &file_task_manager_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContextData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContextData) ProtoMessage() {}
+
+func (x *ContextData) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContextData.ProtoReflect.Descriptor instead.
+func (*ContextData) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ContextData) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ContextData) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ContextData) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ContextData) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// TaskEvent mirrors task_manager.TaskEvent
+type TaskEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Type           string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // "created", "updated", "moved", "archived", "deleted", "reloaded", "conflicted" or "notified"
+	TaskId         string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Task           *TaskResponse          `protobuf:"bytes,3,opt,name=task,proto3" json:"task,omitempty"` // unset for deleted tasks
+	PreviousStatus string                 `protobuf:"bytes,4,opt,name=previous_status,json=previousStatus,proto3" json:"previous_status,omitempty"`
+	OccurredAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Conflicts      []*TaskFieldConflict   `protobuf:"bytes,6,rep,name=conflicts,proto3" json:"conflicts,omitempty"`       // set for conflicted tasks
+	Notification   *RuleNotification      `protobuf:"bytes,7,opt,name=notification,proto3" json:"notification,omitempty"` // set for notified tasks
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TaskEvent) Reset() {
+	*x = TaskEvent{}
+	mi := &file_task_manager_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskEvent) ProtoMessage() {}
+
+func (x *TaskEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskEvent.ProtoReflect.Descriptor instead.
+func (*TaskEvent) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *TaskEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TaskEvent) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TaskEvent) GetTask() *TaskResponse {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *TaskEvent) GetPreviousStatus() string {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return ""
+}
+
+func (x *TaskEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *TaskEvent) GetConflicts() []*TaskFieldConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *TaskEvent) GetNotification() *RuleNotification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+// RuleNotification mirrors task_manager.RuleNotification
+type RuleNotification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	RuleName      string                 `protobuf:"bytes,2,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	TriggerType   string                 `protobuf:"bytes,3,opt,name=trigger_type,json=triggerType,proto3" json:"trigger_type,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleNotification) Reset() {
+	*x = RuleNotification{}
+	mi := &file_task_manager_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleNotification) ProtoMessage() {}
+
+func (x *RuleNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_task_manager_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleNotification.ProtoReflect.Descriptor instead.
+func (*RuleNotification) Descriptor() ([]byte, []int) {
+	return file_task_manager_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RuleNotification) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *RuleNotification) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *RuleNotification) GetTriggerType() string {
+	if x != nil {
+		return x.TriggerType
+	}
+	return ""
+}
+
+func (x *RuleNotification) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_task_manager_proto protoreflect.FileDescriptor
+
+const file_task_manager_proto_rawDesc = "" +
+	"\n" +
+	"\x12task_manager.proto\x12\veisenkan.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"V\n" +
+	"\bPriority\x12\x16\n" +
+	"\x06urgent\x18\x01 \x01(\bR\x06urgent\x12\x1c\n" +
+	"\timportant\x18\x02 \x01(\bR\timportant\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\"\xe9\x02\n" +
+	"\vTaskRequest\x12 \n" +
+	"\vdescription\x18\x01 \x01(\tR\vdescription\x121\n" +
+	"\bpriority\x18\x02 \x01(\v2\x15.eisenkan.v1.PriorityR\bpriority\x12'\n" +
+	"\x0fworkflow_status\x18\x03 \x01(\tR\x0eworkflowStatus\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x126\n" +
+	"\bdeadline\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\x12R\n" +
+	"\x17priority_promotion_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x15priorityPromotionDate\x12)\n" +
+	"\x0eparent_task_id\x18\a \x01(\tH\x00R\fparentTaskId\x88\x01\x01B\x11\n" +
+	"\x0f_parent_task_id\"\x91\x04\n" +
+	"\fTaskResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x121\n" +
+	"\bpriority\x18\x03 \x01(\v2\x15.eisenkan.v1.PriorityR\bpriority\x12'\n" +
+	"\x0fworkflow_status\x18\x04 \x01(\tR\x0eworkflowStatus\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x126\n" +
+	"\bdeadline\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\x12R\n" +
+	"\x17priority_promotion_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x15priorityPromotionDate\x12)\n" +
+	"\x0eparent_task_id\x18\b \x01(\tH\x00R\fparentTaskId\x88\x01\x01\x12\x1f\n" +
+	"\vsubtask_ids\x18\t \x03(\tR\n" +
+	"subtaskIds\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x11\n" +
+	"\x0f_parent_task_id\"z\n" +
+	"\fArchivedTask\x12-\n" +
+	"\x04task\x18\x01 \x01(\v2\x19.eisenkan.v1.TaskResponseR\x04task\x12;\n" +
+	"\varchived_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"archivedAt\"g\n" +
+	"\tDateRange\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\xef\x02\n" +
+	"\rQueryCriteria\x12\x18\n" +
+	"\acolumns\x18\x01 \x03(\tR\acolumns\x12\x1a\n" +
+	"\bsections\x18\x02 \x03(\tR\bsections\x121\n" +
+	"\bpriority\x18\x03 \x01(\v2\x15.eisenkan.v1.PriorityR\bpriority\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x125\n" +
+	"\n" +
+	"date_range\x18\x05 \x01(\v2\x16.eisenkan.v1.DateRangeR\tdateRange\x12N\n" +
+	"\x17priority_promotion_date\x18\x06 \x01(\v2\x16.eisenkan.v1.DateRangeR\x15priorityPromotionDate\x12)\n" +
+	"\x0eparent_task_id\x18\a \x01(\tH\x00R\fparentTaskId\x88\x01\x01\x12\x1c\n" +
+	"\thierarchy\x18\b \x01(\tR\thierarchyB\x11\n" +
+	"\x0f_parent_task_id\")\n" +
+	"\x0eTaskIdentifier\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\"x\n" +
+	"\x11DeleteTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12J\n" +
+	"\x13expected_updated_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x11expectedUpdatedAt\"\xa0\x01\n" +
+	"\x12ArchiveTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12%\n" +
+	"\x0ecascade_policy\x18\x02 \x01(\tR\rcascadePolicy\x12J\n" +
+	"\x13expected_updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x11expectedUpdatedAt\"T\n" +
+	"\x12CascadeTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12%\n" +
+	"\x0ecascade_policy\x18\x02 \x01(\tR\rcascadePolicy\";\n" +
+	"\bTaskList\x12/\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x19.eisenkan.v1.TaskResponseR\x05tasks\"C\n" +
+	"\x10ArchivedTaskList\x12/\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x19.eisenkan.v1.ArchivedTaskR\x05tasks\"\xa6\x01\n" +
+	"\x11UpdateTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12,\n" +
+	"\x04task\x18\x02 \x01(\v2\x18.eisenkan.v1.TaskRequestR\x04task\x12J\n" +
+	"\x13expected_updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x11expectedUpdatedAt\"\xa7\x01\n" +
+	"\x17ChangeTaskStatusRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12'\n" +
+	"\x0fworkflow_status\x18\x02 \x01(\tR\x0eworkflowStatus\x12J\n" +
+	"\x13expected_updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x11expectedUpdatedAt\"O\n" +
+	"\x13PurgeArchiveRequest\x128\n" +
+	"\n" +
+	"older_than\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\tolderThan\"1\n" +
+	"\x14PurgeArchiveResponse\x12\x19\n" +
+	"\btask_ids\x18\x01 \x03(\tR\ataskIds\"\x94\x01\n" +
+	"\rRuleViolation\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\x12\x1a\n" +
+	"\bpriority\x18\x02 \x01(\x05R\bpriority\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12\x18\n" +
+	"\adetails\x18\x05 \x01(\tR\adetails\"d\n" +
+	"\x10ValidationResult\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12:\n" +
+	"\n" +
+	"violations\x18\x02 \x03(\v2\x1a.eisenkan.v1.RuleViolationR\n" +
+	"violations\"\x85\x03\n" +
+	"\x04Rule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12!\n" +
+	"\ftrigger_type\x18\x04 \x01(\tR\vtriggerType\x127\n" +
+	"\n" +
+	"conditions\x18\x05 \x01(\v2\x17.google.protobuf.StructR\n" +
+	"conditions\x121\n" +
+	"\aactions\x18\x06 \x01(\v2\x17.google.protobuf.StructR\aactions\x12\x1a\n" +
+	"\bpriority\x18\a \x01(\x05R\bpriority\x12\x18\n" +
+	"\aenabled\x18\b \x01(\bR\aenabled\x12;\n" +
+	"\bmetadata\x18\t \x03(\v2\x1f.eisenkan.v1.Rule.MetadataEntryR\bmetadata\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"-\n" +
+	"\x10RuleDependencies\x12\x19\n" +
+	"\brule_ids\x18\x01 \x03(\tR\aruleIds\"\xf5\x02\n" +
+	"\aRuleSet\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12'\n" +
+	"\x05rules\x18\x02 \x03(\v2\x11.eisenkan.v1.RuleR\x05rules\x12J\n" +
+	"\fdependencies\x18\x03 \x03(\v2&.eisenkan.v1.RuleSet.DependenciesEntryR\fdependencies\x12>\n" +
+	"\bmetadata\x18\x04 \x03(\v2\".eisenkan.v1.RuleSet.MetadataEntryR\bmetadata\x1a^\n" +
+	"\x11DependenciesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x123\n" +
+	"\x05value\x18\x02 \x01(\v2\x1d.eisenkan.v1.RuleDependenciesR\x05value:\x028\x01\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"y\n" +
+	"\x14SimulateRulesRequest\x12/\n" +
+	"\brule_set\x18\x01 \x01(\v2\x14.eisenkan.v1.RuleSetR\aruleSet\x120\n" +
+	"\x05since\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\"\xb8\x02\n" +
+	"\x12SimulatedViolation\x128\n" +
+	"\tviolation\x18\x01 \x01(\v2\x1a.eisenkan.v1.RuleViolationR\tviolation\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x1d\n" +
+	"\n" +
+	"task_title\x18\x03 \x01(\tR\ttaskTitle\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tR\teventType\x12\x1f\n" +
+	"\vfrom_column\x18\x05 \x01(\tR\n" +
+	"fromColumn\x12\x1b\n" +
+	"\tto_column\x18\x06 \x01(\tR\btoColumn\x12\x16\n" +
+	"\x06commit\x18\a \x01(\tR\x06commit\x12;\n" +
+	"\voccurred_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\xb1\x02\n" +
+	"\x10SimulationReport\x120\n" +
+	"\x05since\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x12'\n" +
+	"\x0frules_simulated\x18\x02 \x01(\x05R\x0erulesSimulated\x12'\n" +
+	"\x0ftasks_evaluated\x18\x03 \x01(\x05R\x0etasksEvaluated\x12-\n" +
+	"\x12revisions_replayed\x18\x04 \x01(\x05R\x11revisionsReplayed\x12)\n" +
+	"\x10changes_replayed\x18\x05 \x01(\x05R\x0fchangesReplayed\x12?\n" +
+	"\n" +
+	"violations\x18\x06 \x03(\v2\x1f.eisenkan.v1.SimulatedViolationR\n" +
+	"violations\"j\n" +
+	"\x0eRuleViolations\x12\x1c\n" +
+	"\toperation\x18\x01 \x01(\tR\toperation\x12:\n" +
+	"\n" +
+	"violations\x18\x02 \x03(\v2\x1a.eisenkan.v1.RuleViolationR\n" +
+	"violations\"\x0e\n" +
+	"\fTaskModified\"*\n" +
+	"\tBoardPath\x12\x1d\n" +
+	"\n" +
+	"board_path\x18\x01 \x01(\tR\tboardPath\"\xd8\x01\n" +
+	"\x17BoardValidationResponse\x12\x19\n" +
+	"\bis_valid\x18\x01 \x01(\bR\aisValid\x12$\n" +
+	"\x0egit_repo_valid\x18\x02 \x01(\bR\fgitRepoValid\x12!\n" +
+	"\fconfig_valid\x18\x03 \x01(\bR\vconfigValid\x12%\n" +
+	"\x0edata_integrity\x18\x04 \x01(\bR\rdataIntegrity\x12\x16\n" +
+	"\x06issues\x18\x05 \x03(\tR\x06issues\x12\x1a\n" +
+	"\bwarnings\x18\x06 \x03(\tR\bwarnings\"\x8d\x01\n" +
+	"\x13BoardRepairResponse\x12\x18\n" +
+	"\aactions\x18\x01 \x03(\tR\aactions\x12\x16\n" +
+	"\x06commit\x18\x02 \x01(\tR\x06commit\x12D\n" +
+	"\n" +
+	"validation\x18\x03 \x01(\v2$.eisenkan.v1.BoardValidationResponseR\n" +
+	"validation\"\xe8\x04\n" +
+	"\x15BoardMetadataResponse\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"task_count\x18\x03 \x01(\x05R\ttaskCount\x12Y\n" +
+	"\rcolumn_counts\x18\x04 \x03(\v24.eisenkan.v1.BoardMetadataResponse.ColumnCountsEntryR\fcolumnCounts\x12%\n" +
+	"\x0eschema_version\x18\x05 \x01(\tR\rschemaVersion\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\vmodified_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"modifiedAt\x12L\n" +
+	"\bmetadata\x18\b \x03(\v20.eisenkan.v1.BoardMetadataResponse.MetadataEntryR\bmetadata\x122\n" +
+	"\acolumns\x18\t \x03(\v2\x18.eisenkan.v1.BoardColumnR\acolumns\x1a?\n" +
+	"\x11ColumnCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xbc\x01\n" +
+	"\vBoardColumn\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\x12\x1b\n" +
+	"\twip_limit\x18\x04 \x01(\x05R\bwipLimit\x12\x12\n" +
+	"\x04done\x18\x05 \x01(\bR\x04done\x12!\n" +
+	"\fentry_policy\x18\x06 \x01(\tR\ventryPolicy\x12\x1f\n" +
+	"\vexit_policy\x18\a \x01(\tR\n" +
+	"exitPolicy\"\xd8\x01\n" +
+	"\x14BoardMetadataRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12K\n" +
+	"\bmetadata\x18\x03 \x03(\v2/.eisenkan.v1.BoardMetadataRequest.MetadataEntryR\bmetadata\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"z\n" +
+	"\x1aUpdateBoardMetadataRequest\x12\x1d\n" +
+	"\n" +
+	"board_path\x18\x01 \x01(\tR\tboardPath\x12=\n" +
+	"\bmetadata\x18\x02 \x01(\v2!.eisenkan.v1.BoardMetadataRequestR\bmetadata\"3\n" +
+	"\vBoardRemote\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\"E\n" +
+	"\x0fBoardRemoteList\x122\n" +
+	"\aremotes\x18\x01 \x03(\v2\x18.eisenkan.v1.BoardRemoteR\aremotes\"*\n" +
+	"\x10SyncBoardRequest\x12\x16\n" +
+	"\x06remote\x18\x01 \x01(\tR\x06remote\"\xa7\x02\n" +
+	"\fSyncResponse\x12\x16\n" +
+	"\x06remote\x18\x01 \x01(\tR\x06remote\x12\x1c\n" +
+	"\n" +
+	"up_to_date\x18\x02 \x01(\bR\bupToDate\x12!\n" +
+	"\ffast_forward\x18\x03 \x01(\bR\vfastForward\x12!\n" +
+	"\fmerge_commit\x18\x04 \x01(\tR\vmergeCommit\x12(\n" +
+	"\x10changed_task_ids\x18\x05 \x03(\tR\x0echangedTaskIds\x123\n" +
+	"\x15configuration_changed\x18\x06 \x01(\bR\x14configurationChanged\x12<\n" +
+	"\tconflicts\x18\a \x03(\v2\x1e.eisenkan.v1.TaskFieldConflictR\tconflicts\"\xbe\x01\n" +
+	"\x11TaskFieldConflict\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12\x1f\n" +
+	"\vlocal_value\x18\x03 \x01(\tR\n" +
+	"localValue\x12!\n" +
+	"\fremote_value\x18\x04 \x01(\tR\vremoteValue\x12\x1e\n" +
+	"\n" +
+	"resolution\x18\x05 \x01(\tR\n" +
+	"resolution\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\"\xa8\x01\n" +
+	"\fUndoResponse\x12 \n" +
+	"\vdescription\x18\x01 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06commit\x18\x02 \x01(\tR\x06commit\x12(\n" +
+	"\x10changed_task_ids\x18\x03 \x03(\tR\x0echangedTaskIds\x12\x19\n" +
+	"\bcan_undo\x18\x04 \x01(\bR\acanUndo\x12\x19\n" +
+	"\bcan_redo\x18\x05 \x01(\bR\acanRedo\"1\n" +
+	"\x19ListBoardRevisionsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"\x93\x01\n" +
+	"\rBoardRevision\x12\x16\n" +
+	"\x06commit\x18\x01 \x01(\tR\x06commit\x12\x16\n" +
+	"\x06author\x18\x02 \x01(\tR\x06author\x128\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"M\n" +
+	"\x11BoardRevisionList\x128\n" +
+	"\trevisions\x18\x01 \x03(\v2\x1a.eisenkan.v1.BoardRevisionR\trevisions\"X\n" +
+	"\x12LoadBoardAtRequest\x12\x16\n" +
+	"\x06commit\x18\x01 \x01(\tR\x06commit\x12*\n" +
+	"\x02at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"\xba\x01\n" +
+	"\rBoardSnapshot\x126\n" +
+	"\brevision\x18\x01 \x01(\v2\x1a.eisenkan.v1.BoardRevisionR\brevision\x12/\n" +
+	"\x05tasks\x18\x02 \x03(\v2\x19.eisenkan.v1.TaskResponseR\x05tasks\x12@\n" +
+	"\x0earchived_tasks\x18\x03 \x03(\v2\x19.eisenkan.v1.ArchivedTaskR\rarchivedTasks\"I\n" +
+	"\x16RestoreTaskFromRequest\x12\x16\n" +
+	"\x06commit\x18\x01 \x01(\tR\x06commit\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\"F\n" +
+	"\x15GetTaskHistoryRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"U\n" +
+	"\x0fTaskFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x16\n" +
+	"\x06before\x18\x02 \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\x03 \x01(\tR\x05after\"\x9c\x01\n" +
+	"\fTaskRevision\x126\n" +
+	"\brevision\x18\x01 \x01(\v2\x1a.eisenkan.v1.BoardRevisionR\brevision\x12\x1c\n" +
+	"\toperation\x18\x02 \x01(\tR\toperation\x126\n" +
+	"\achanges\x18\x03 \x03(\v2\x1c.eisenkan.v1.TaskFieldChangeR\achanges\"K\n" +
+	"\x10TaskRevisionList\x127\n" +
+	"\trevisions\x18\x01 \x03(\v2\x19.eisenkan.v1.TaskRevisionR\trevisions\"\xca\x01\n" +
+	"\fBatchRequest\x12\x1c\n" +
+	"\toperation\x18\x01 \x01(\tR\toperation\x12\x19\n" +
+	"\btask_ids\x18\x02 \x03(\tR\ataskIds\x12'\n" +
+	"\x0fworkflow_status\x18\x03 \x01(\tR\x0eworkflowStatus\x121\n" +
+	"\bpriority\x18\x04 \x01(\v2\x15.eisenkan.v1.PriorityR\bpriority\x12%\n" +
+	"\x0ecascade_policy\x18\x05 \x01(\tR\rcascadePolicy\"X\n" +
+	"\rBatchResponse\x12/\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x19.eisenkan.v1.TaskResponseR\x05tasks\x12\x16\n" +
+	"\x06commit\x18\x02 \x01(\tR\x06commit\"H\n" +
+	"\x18RunScheduledRulesRequest\x12,\n" +
+	"\x03now\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x03now\"\xba\x01\n" +
+	"\x10ScheduledTrigger\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\x12\x1b\n" +
+	"\trule_name\x18\x02 \x01(\tR\bruleName\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12!\n" +
+	"\ftrigger_type\x18\x04 \x01(\tR\vtriggerType\x12\x17\n" +
+	"\atask_id\x18\x05 \x01(\tR\x06taskId\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\"\xbf\x01\n" +
+	"\x16ScheduledRulesResponse\x121\n" +
+	"\x06run_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05runAt\x12;\n" +
+	"\ttriggered\x18\x02 \x03(\v2\x1d.eisenkan.v1.ScheduledTriggerR\ttriggered\x125\n" +
+	"\bpromoted\x18\x03 \x03(\v2\x19.eisenkan.v1.TaskResponseR\bpromoted\"A\n" +
+	"\fBatchFailure\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xc6\x05\n" +
+	"\x0fBoardStatistics\x12\x1f\n" +
+	"\vtotal_tasks\x18\x01 \x01(\x05R\n" +
+	"totalTasks\x12!\n" +
+	"\factive_tasks\x18\x02 \x01(\x05R\vactiveTasks\x12'\n" +
+	"\x0fcompleted_tasks\x18\x03 \x01(\x05R\x0ecompletedTasks\x12W\n" +
+	"\x0ftasks_by_column\x18\x04 \x03(\v2/.eisenkan.v1.BoardStatistics.TasksByColumnEntryR\rtasksByColumn\x12]\n" +
+	"\x11tasks_by_priority\x18\x05 \x03(\v21.eisenkan.v1.BoardStatistics.TasksByPriorityEntryR\x0ftasksByPriority\x121\n" +
+	"\x15average_task_age_days\x18\x06 \x01(\x01R\x12averageTaskAgeDays\x12/\n" +
+	"\x14oldest_task_age_days\x18\a \x01(\x01R\x11oldestTaskAgeDays\x125\n" +
+	"\x17average_column_age_days\x18\b \x01(\x01R\x14averageColumnAgeDays\x12?\n" +
+	"\rlast_activity\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\flastActivity\x12,\n" +
+	"\x12board_health_score\x18\n" +
+	" \x01(\x01R\x10boardHealthScore\x1a@\n" +
+	"\x12TasksByColumnEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1aB\n" +
+	"\x14TasksByPriorityEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"j\n" +
+	"\x12FlowMetricsRequest\x12\x1d\n" +
+	"\n" +
+	"board_path\x18\x01 \x01(\tR\tboardPath\x125\n" +
+	"\n" +
+	"date_range\x18\x02 \x01(\v2\x16.eisenkan.v1.DateRangeR\tdateRange\"\xa4\x01\n" +
+	"\x12FlowTimeStatistics\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\x12!\n" +
+	"\faverage_days\x18\x02 \x01(\x01R\vaverageDays\x12\x1f\n" +
+	"\vmedian_days\x18\x03 \x01(\x01R\n" +
+	"medianDays\x12\x19\n" +
+	"\bp85_days\x18\x04 \x01(\x01R\ap85Days\x12\x19\n" +
+	"\bmax_days\x18\x05 \x01(\x01R\amaxDays\"\xf0\x02\n" +
+	"\bTaskFlow\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1a\n" +
+	"\bquadrant\x18\x03 \x01(\tR\bquadrant\x12\x16\n" +
+	"\x06column\x18\x04 \x01(\tR\x06column\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"started_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12=\n" +
+	"\fcompleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12$\n" +
+	"\x0elead_time_days\x18\b \x01(\x01R\fleadTimeDays\x12&\n" +
+	"\x0fcycle_time_days\x18\t \x01(\x01R\rcycleTimeDays\"\xfa\x01\n" +
+	"\x10ThroughputPeriod\x129\n" +
+	"\n" +
+	"week_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tweekStart\x12\x1c\n" +
+	"\tcompleted\x18\x02 \x01(\x05R\tcompleted\x12N\n" +
+	"\vby_quadrant\x18\x03 \x03(\v2-.eisenkan.v1.ThroughputPeriod.ByQuadrantEntryR\n" +
+	"byQuadrant\x1a=\n" +
+	"\x0fByQuadrantEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\x8c\x01\n" +
+	"\fColumnCounts\x12@\n" +
+	"\acolumns\x18\x01 \x03(\v2&.eisenkan.v1.ColumnCounts.ColumnsEntryR\acolumns\x1a:\n" +
+	"\fColumnsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xa3\x04\n" +
+	"\x13CumulativeFlowPoint\x12.\n" +
+	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12G\n" +
+	"\acolumns\x18\x02 \x03(\v2-.eisenkan.v1.CumulativeFlowPoint.ColumnsEntryR\acolumns\x12M\n" +
+	"\tquadrants\x18\x03 \x03(\v2/.eisenkan.v1.CumulativeFlowPoint.QuadrantsEntryR\tquadrants\x12\x10\n" +
+	"\x03wip\x18\x04 \x01(\x05R\x03wip\x12[\n" +
+	"\x0fwip_by_quadrant\x18\x05 \x03(\v23.eisenkan.v1.CumulativeFlowPoint.WipByQuadrantEntryR\rwipByQuadrant\x1a:\n" +
+	"\fColumnsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1aW\n" +
+	"\x0eQuadrantsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.eisenkan.v1.ColumnCountsR\x05value:\x028\x01\x1a@\n" +
+	"\x12WipByQuadrantEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xf7\x06\n" +
+	"\vFlowMetrics\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x18\n" +
+	"\acolumns\x18\x03 \x03(\tR\acolumns\x12\x1f\n" +
+	"\vdone_column\x18\x04 \x01(\tR\n" +
+	"doneColumn\x12+\n" +
+	"\x05tasks\x18\x05 \x03(\v2\x15.eisenkan.v1.TaskFlowR\x05tasks\x12<\n" +
+	"\tlead_time\x18\x06 \x01(\v2\x1f.eisenkan.v1.FlowTimeStatisticsR\bleadTime\x12>\n" +
+	"\n" +
+	"cycle_time\x18\a \x01(\v2\x1f.eisenkan.v1.FlowTimeStatisticsR\tcycleTime\x12c\n" +
+	"\x15lead_time_by_quadrant\x18\b \x03(\v20.eisenkan.v1.FlowMetrics.LeadTimeByQuadrantEntryR\x12leadTimeByQuadrant\x12f\n" +
+	"\x16cycle_time_by_quadrant\x18\t \x03(\v21.eisenkan.v1.FlowMetrics.CycleTimeByQuadrantEntryR\x13cycleTimeByQuadrant\x12=\n" +
+	"\n" +
+	"throughput\x18\n" +
+	" \x03(\v2\x1d.eisenkan.v1.ThroughputPeriodR\n" +
+	"throughput\x12I\n" +
+	"\x0fcumulative_flow\x18\v \x03(\v2 .eisenkan.v1.CumulativeFlowPointR\x0ecumulativeFlow\x1af\n" +
+	"\x17LeadTimeByQuadrantEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x125\n" +
+	"\x05value\x18\x02 \x01(\v2\x1f.eisenkan.v1.FlowTimeStatisticsR\x05value:\x028\x01\x1ag\n" +
+	"\x18CycleTimeByQuadrantEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x125\n" +
+	"\x05value\x18\x02 \x01(\v2\x1f.eisenkan.v1.FlowTimeStatisticsR\x05value:\x028\x01\"(\n" +
+	"\x12LoadContextRequest\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\"\xe9\x01\n" +
+	"\vContextData\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12+\n" +
+	"\x04data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\x04data\x12B\n" +
+	"\bmetadata\x18\x04 \x03(\v2&.eisenkan.v1.ContextData.MetadataEntryR\bmetadata\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xce\x02\n" +
+	"\tTaskEvent\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12-\n" +
+	"\x04task\x18\x03 \x01(\v2\x19.eisenkan.v1.TaskResponseR\x04task\x12'\n" +
+	"\x0fprevious_status\x18\x04 \x01(\tR\x0epreviousStatus\x12;\n" +
+	"\voccurred_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12<\n" +
+	"\tconflicts\x18\x06 \x03(\v2\x1e.eisenkan.v1.TaskFieldConflictR\tconflicts\x12A\n" +
+	"\fnotification\x18\a \x01(\v2\x1d.eisenkan.v1.RuleNotificationR\fnotification\"\x85\x01\n" +
+	"\x10RuleNotification\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\x12\x1b\n" +
+	"\trule_name\x18\x02 \x01(\tR\bruleName\x12!\n" +
+	"\ftrigger_type\x18\x03 \x01(\tR\vtriggerType\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage2\xac\x14\n" +
+	"\x12TaskManagerService\x12A\n" +
+	"\n" +
+	"CreateTask\x12\x18.eisenkan.v1.TaskRequest\x1a\x19.eisenkan.v1.TaskResponse\x12G\n" +
+	"\n" +
+	"UpdateTask\x12\x1e.eisenkan.v1.UpdateTaskRequest\x1a\x19.eisenkan.v1.TaskResponse\x12A\n" +
+	"\aGetTask\x12\x1b.eisenkan.v1.TaskIdentifier\x1a\x19.eisenkan.v1.TaskResponse\x12D\n" +
+	"\n" +
+	"DeleteTask\x12\x1e.eisenkan.v1.DeleteTaskRequest\x1a\x16.google.protobuf.Empty\x12>\n" +
+	"\tListTasks\x12\x1a.eisenkan.v1.QueryCriteria\x1a\x15.eisenkan.v1.TaskList\x12S\n" +
+	"\x10ChangeTaskStatus\x12$.eisenkan.v1.ChangeTaskStatusRequest\x1a\x19.eisenkan.v1.TaskResponse\x12G\n" +
+	"\fValidateTask\x12\x18.eisenkan.v1.TaskRequest\x1a\x1d.eisenkan.v1.ValidationResult\x12Q\n" +
+	"\rSimulateRules\x12!.eisenkan.v1.SimulateRulesRequest\x1a\x1d.eisenkan.v1.SimulationReport\x12J\n" +
+	"\x19ProcessPriorityPromotions\x12\x16.google.protobuf.Empty\x1a\x15.eisenkan.v1.TaskList\x12_\n" +
+	"\x11RunScheduledRules\x12%.eisenkan.v1.RunScheduledRulesRequest\x1a#.eisenkan.v1.ScheduledRulesResponse\x12I\n" +
+	"\vArchiveTask\x12\x1f.eisenkan.v1.ArchiveTaskRequest\x1a\x19.eisenkan.v1.TaskResponse\x12J\n" +
+	"\x11ListArchivedTasks\x12\x16.google.protobuf.Empty\x1a\x1d.eisenkan.v1.ArchivedTaskList\x12I\n" +
+	"\vRestoreTask\x12\x1f.eisenkan.v1.CascadeTaskRequest\x1a\x19.eisenkan.v1.TaskResponse\x12S\n" +
+	"\fPurgeArchive\x12 .eisenkan.v1.PurgeArchiveRequest\x1a!.eisenkan.v1.PurgeArchiveResponse\x12V\n" +
+	"\x16ValidateBoardDirectory\x12\x16.eisenkan.v1.BoardPath\x1a$.eisenkan.v1.BoardValidationResponse\x12G\n" +
+	"\vRepairBoard\x12\x16.google.protobuf.Empty\x1a .eisenkan.v1.BoardRepairResponse\x12N\n" +
+	"\x10GetBoardMetadata\x12\x16.eisenkan.v1.BoardPath\x1a\".eisenkan.v1.BoardMetadataResponse\x12J\n" +
+	"\x12GetBoardStatistics\x12\x16.eisenkan.v1.BoardPath\x1a\x1c.eisenkan.v1.BoardStatistics\x12K\n" +
+	"\x0eGetFlowMetrics\x12\x1f.eisenkan.v1.FlowMetricsRequest\x1a\x18.eisenkan.v1.FlowMetrics\x12b\n" +
+	"\x13UpdateBoardMetadata\x12'.eisenkan.v1.UpdateBoardMetadataRequest\x1a\".eisenkan.v1.BoardMetadataResponse\x12H\n" +
+	"\x10ListBoardRemotes\x12\x16.google.protobuf.Empty\x1a\x1c.eisenkan.v1.BoardRemoteList\x12B\n" +
+	"\x0eAddBoardRemote\x12\x18.eisenkan.v1.BoardRemote\x1a\x16.google.protobuf.Empty\x12E\n" +
+	"\x11RemoveBoardRemote\x12\x18.eisenkan.v1.BoardRemote\x1a\x16.google.protobuf.Empty\x12E\n" +
+	"\tSyncBoard\x12\x1d.eisenkan.v1.SyncBoardRequest\x1a\x19.eisenkan.v1.SyncResponse\x129\n" +
+	"\x04Undo\x12\x16.google.protobuf.Empty\x1a\x19.eisenkan.v1.UndoResponse\x129\n" +
+	"\x04Redo\x12\x16.google.protobuf.Empty\x1a\x19.eisenkan.v1.UndoResponse\x12\\\n" +
+	"\x12ListBoardRevisions\x12&.eisenkan.v1.ListBoardRevisionsRequest\x1a\x1e.eisenkan.v1.BoardRevisionList\x12J\n" +
+	"\vLoadBoardAt\x12\x1f.eisenkan.v1.LoadBoardAtRequest\x1a\x1a.eisenkan.v1.BoardSnapshot\x12Q\n" +
+	"\x0fRestoreTaskFrom\x12#.eisenkan.v1.RestoreTaskFromRequest\x1a\x19.eisenkan.v1.TaskResponse\x12S\n" +
+	"\x0eGetTaskHistory\x12\".eisenkan.v1.GetTaskHistoryRequest\x1a\x1d.eisenkan.v1.TaskRevisionList\x12E\n" +
+	"\fExecuteBatch\x12\x19.eisenkan.v1.BatchRequest\x1a\x1a.eisenkan.v1.BatchResponse\x12H\n" +
+	"\vLoadContext\x12\x1f.eisenkan.v1.LoadContextRequest\x1a\x18.eisenkan.v1.ContextData\x12@\n" +
+	"\fStoreContext\x12\x18.eisenkan.v1.ContextData\x1a\x16.google.protobuf.Empty\x12G\n" +
+	"\x13SubscribeTaskEvents\x12\x16.google.protobuf.Empty\x1a\x16.eisenkan.v1.TaskEvent0\x01B$Z\"github.com/rknuus/eisenkan/api;apib\x06proto3"
+
+var (
+	file_task_manager_proto_rawDescOnce sync.Once
+	file_task_manager_proto_rawDescData []byte
+)
+
+func file_task_manager_proto_rawDescGZIP() []byte {
+	file_task_manager_proto_rawDescOnce.Do(func() {
+		file_task_manager_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_task_manager_proto_rawDesc), len(file_task_manager_proto_rawDesc)))
+	})
+	return file_task_manager_proto_rawDescData
+}
+
+var file_task_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 83)
+var file_task_manager_proto_goTypes = []any{
+	(*Priority)(nil),                   // 0: eisenkan.v1.Priority
+	(*TaskRequest)(nil),                // 1: eisenkan.v1.TaskRequest
+	(*TaskResponse)(nil),               // 2: eisenkan.v1.TaskResponse
+	(*ArchivedTask)(nil),               // 3: eisenkan.v1.ArchivedTask
+	(*DateRange)(nil),                  // 4: eisenkan.v1.DateRange
+	(*QueryCriteria)(nil),              // 5: eisenkan.v1.QueryCriteria
+	(*TaskIdentifier)(nil),             // 6: eisenkan.v1.TaskIdentifier
+	(*DeleteTaskRequest)(nil),          // 7: eisenkan.v1.DeleteTaskRequest
+	(*ArchiveTaskRequest)(nil),         // 8: eisenkan.v1.ArchiveTaskRequest
+	(*CascadeTaskRequest)(nil),         // 9: eisenkan.v1.CascadeTaskRequest
+	(*TaskList)(nil),                   // 10: eisenkan.v1.TaskList
+	(*ArchivedTaskList)(nil),           // 11: eisenkan.v1.ArchivedTaskList
+	(*UpdateTaskRequest)(nil),          // 12: eisenkan.v1.UpdateTaskRequest
+	(*ChangeTaskStatusRequest)(nil),    // 13: eisenkan.v1.ChangeTaskStatusRequest
+	(*PurgeArchiveRequest)(nil),        // 14: eisenkan.v1.PurgeArchiveRequest
+	(*PurgeArchiveResponse)(nil),       // 15: eisenkan.v1.PurgeArchiveResponse
+	(*RuleViolation)(nil),              // 16: eisenkan.v1.RuleViolation
+	(*ValidationResult)(nil),           // 17: eisenkan.v1.ValidationResult
+	(*Rule)(nil),                       // 18: eisenkan.v1.Rule
+	(*RuleDependencies)(nil),           // 19: eisenkan.v1.RuleDependencies
+	(*RuleSet)(nil),                    // 20: eisenkan.v1.RuleSet
+	(*SimulateRulesRequest)(nil),       // 21: eisenkan.v1.SimulateRulesRequest
+	(*SimulatedViolation)(nil),         // 22: eisenkan.v1.SimulatedViolation
+	(*SimulationReport)(nil),           // 23: eisenkan.v1.SimulationReport
+	(*RuleViolations)(nil),             // 24: eisenkan.v1.RuleViolations
+	(*TaskModified)(nil),               // 25: eisenkan.v1.TaskModified
+	(*BoardPath)(nil),                  // 26: eisenkan.v1.BoardPath
+	(*BoardValidationResponse)(nil),    // 27: eisenkan.v1.BoardValidationResponse
+	(*BoardRepairResponse)(nil),        // 28: eisenkan.v1.BoardRepairResponse
+	(*BoardMetadataResponse)(nil),      // 29: eisenkan.v1.BoardMetadataResponse
+	(*BoardColumn)(nil),                // 30: eisenkan.v1.BoardColumn
+	(*BoardMetadataRequest)(nil),       // 31: eisenkan.v1.BoardMetadataRequest
+	(*UpdateBoardMetadataRequest)(nil), // 32: eisenkan.v1.UpdateBoardMetadataRequest
+	(*BoardRemote)(nil),                // 33: eisenkan.v1.BoardRemote
+	(*BoardRemoteList)(nil),            // 34: eisenkan.v1.BoardRemoteList
+	(*SyncBoardRequest)(nil),           // 35: eisenkan.v1.SyncBoardRequest
+	(*SyncResponse)(nil),               // 36: eisenkan.v1.SyncResponse
+	(*TaskFieldConflict)(nil),          // 37: eisenkan.v1.TaskFieldConflict
+	(*UndoResponse)(nil),               // 38: eisenkan.v1.UndoResponse
+	(*ListBoardRevisionsRequest)(nil),  // 39: eisenkan.v1.ListBoardRevisionsRequest
+	(*BoardRevision)(nil),              // 40: eisenkan.v1.BoardRevision
+	(*BoardRevisionList)(nil),          // 41: eisenkan.v1.BoardRevisionList
+	(*LoadBoardAtRequest)(nil),         // 42: eisenkan.v1.LoadBoardAtRequest
+	(*BoardSnapshot)(nil),              // 43: eisenkan.v1.BoardSnapshot
+	(*RestoreTaskFromRequest)(nil),     // 44: eisenkan.v1.RestoreTaskFromRequest
+	(*GetTaskHistoryRequest)(nil),      // 45: eisenkan.v1.GetTaskHistoryRequest
+	(*TaskFieldChange)(nil),            // 46: eisenkan.v1.TaskFieldChange
+	(*TaskRevision)(nil),               // 47: eisenkan.v1.TaskRevision
+	(*TaskRevisionList)(nil),           // 48: eisenkan.v1.TaskRevisionList
+	(*BatchRequest)(nil),               // 49: eisenkan.v1.BatchRequest
+	(*BatchResponse)(nil),              // 50: eisenkan.v1.BatchResponse
+	(*RunScheduledRulesRequest)(nil),   // 51: eisenkan.v1.RunScheduledRulesRequest
+	(*ScheduledTrigger)(nil),           // 52: eisenkan.v1.ScheduledTrigger
+	(*ScheduledRulesResponse)(nil),     // 53: eisenkan.v1.ScheduledRulesResponse
+	(*BatchFailure)(nil),               // 54: eisenkan.v1.BatchFailure
+	(*BoardStatistics)(nil),            // 55: eisenkan.v1.BoardStatistics
+	(*FlowMetricsRequest)(nil),         // 56: eisenkan.v1.FlowMetricsRequest
+	(*FlowTimeStatistics)(nil),         // 57: eisenkan.v1.FlowTimeStatistics
+	(*TaskFlow)(nil),                   // 58: eisenkan.v1.TaskFlow
+	(*ThroughputPeriod)(nil),           // 59: eisenkan.v1.ThroughputPeriod
+	(*ColumnCounts)(nil),               // 60: eisenkan.v1.ColumnCounts
+	(*CumulativeFlowPoint)(nil),        // 61: eisenkan.v1.CumulativeFlowPoint
+	(*FlowMetrics)(nil),                // 62: eisenkan.v1.FlowMetrics
+	(*LoadContextRequest)(nil),         // 63: eisenkan.v1.LoadContextRequest
+	(*ContextData)(nil),                // 64: eisenkan.v1.ContextData
+	(*TaskEvent)(nil),                  // 65: eisenkan.v1.TaskEvent
+	(*RuleNotification)(nil),           // 66: eisenkan.v1.RuleNotification
+	nil,                                // 67: eisenkan.v1.Rule.MetadataEntry
+	nil,                                // 68: eisenkan.v1.RuleSet.DependenciesEntry
+	nil,                                // 69: eisenkan.v1.RuleSet.MetadataEntry
+	nil,                                // 70: eisenkan.v1.BoardMetadataResponse.ColumnCountsEntry
+	nil,                                // 71: eisenkan.v1.BoardMetadataResponse.MetadataEntry
+	nil,                                // 72: eisenkan.v1.BoardMetadataRequest.MetadataEntry
+	nil,                                // 73: eisenkan.v1.BoardStatistics.TasksByColumnEntry
+	nil,                                // 74: eisenkan.v1.BoardStatistics.TasksByPriorityEntry
+	nil,                                // 75: eisenkan.v1.ThroughputPeriod.ByQuadrantEntry
+	nil,                                // 76: eisenkan.v1.ColumnCounts.ColumnsEntry
+	nil,                                // 77: eisenkan.v1.CumulativeFlowPoint.ColumnsEntry
+	nil,                                // 78: eisenkan.v1.CumulativeFlowPoint.QuadrantsEntry
+	nil,                                // 79: eisenkan.v1.CumulativeFlowPoint.WipByQuadrantEntry
+	nil,                                // 80: eisenkan.v1.FlowMetrics.LeadTimeByQuadrantEntry
+	nil,                                // 81: eisenkan.v1.FlowMetrics.CycleTimeByQuadrantEntry
+	nil,                                // 82: eisenkan.v1.ContextData.MetadataEntry
+	(*timestamppb.Timestamp)(nil),      // 83: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),        // 84: google.protobuf.Duration
+	(*structpb.Struct)(nil),            // 85: google.protobuf.Struct
+	(*emptypb.Empty)(nil),              // 86: google.protobuf.Empty
+}
+var file_task_manager_proto_depIdxs = []int32{
+	0,   // 0: eisenkan.v1.TaskRequest.priority:type_name -> eisenkan.v1.Priority
+	83,  // 1: eisenkan.v1.TaskRequest.deadline:type_name -> google.protobuf.Timestamp
+	83,  // 2: eisenkan.v1.TaskRequest.priority_promotion_date:type_name -> google.protobuf.Timestamp
+	0,   // 3: eisenkan.v1.TaskResponse.priority:type_name -> eisenkan.v1.Priority
+	83,  // 4: eisenkan.v1.TaskResponse.deadline:type_name -> google.protobuf.Timestamp
+	83,  // 5: eisenkan.v1.TaskResponse.priority_promotion_date:type_name -> google.protobuf.Timestamp
+	83,  // 6: eisenkan.v1.TaskResponse.created_at:type_name -> google.protobuf.Timestamp
+	83,  // 7: eisenkan.v1.TaskResponse.updated_at:type_name -> google.protobuf.Timestamp
+	2,   // 8: eisenkan.v1.ArchivedTask.task:type_name -> eisenkan.v1.TaskResponse
+	83,  // 9: eisenkan.v1.ArchivedTask.archived_at:type_name -> google.protobuf.Timestamp
+	83,  // 10: eisenkan.v1.DateRange.from:type_name -> google.protobuf.Timestamp
+	83,  // 11: eisenkan.v1.DateRange.to:type_name -> google.protobuf.Timestamp
+	0,   // 12: eisenkan.v1.QueryCriteria.priority:type_name -> eisenkan.v1.Priority
+	4,   // 13: eisenkan.v1.QueryCriteria.date_range:type_name -> eisenkan.v1.DateRange
+	4,   // 14: eisenkan.v1.QueryCriteria.priority_promotion_date:type_name -> eisenkan.v1.DateRange
+	83,  // 15: eisenkan.v1.DeleteTaskRequest.expected_updated_at:type_name -> google.protobuf.Timestamp
+	83,  // 16: eisenkan.v1.ArchiveTaskRequest.expected_updated_at:type_name -> google.protobuf.Timestamp
+	2,   // 17: eisenkan.v1.TaskList.tasks:type_name -> eisenkan.v1.TaskResponse
+	3,   // 18: eisenkan.v1.ArchivedTaskList.tasks:type_name -> eisenkan.v1.ArchivedTask
+	1,   // 19: eisenkan.v1.UpdateTaskRequest.task:type_name -> eisenkan.v1.TaskRequest
+	83,  // 20: eisenkan.v1.UpdateTaskRequest.expected_updated_at:type_name -> google.protobuf.Timestamp
+	83,  // 21: eisenkan.v1.ChangeTaskStatusRequest.expected_updated_at:type_name -> google.protobuf.Timestamp
+	84,  // 22: eisenkan.v1.PurgeArchiveRequest.older_than:type_name -> google.protobuf.Duration
+	16,  // 23: eisenkan.v1.ValidationResult.violations:type_name -> eisenkan.v1.RuleViolation
+	85,  // 24: eisenkan.v1.Rule.conditions:type_name -> google.protobuf.Struct
+	85,  // 25: eisenkan.v1.Rule.actions:type_name -> google.protobuf.Struct
+	67,  // 26: eisenkan.v1.Rule.metadata:type_name -> eisenkan.v1.Rule.MetadataEntry
+	18,  // 27: eisenkan.v1.RuleSet.rules:type_name -> eisenkan.v1.Rule
+	68,  // 28: eisenkan.v1.RuleSet.dependencies:type_name -> eisenkan.v1.RuleSet.DependenciesEntry
+	69,  // 29: eisenkan.v1.RuleSet.metadata:type_name -> eisenkan.v1.RuleSet.MetadataEntry
+	20,  // 30: eisenkan.v1.SimulateRulesRequest.rule_set:type_name -> eisenkan.v1.RuleSet
+	83,  // 31: eisenkan.v1.SimulateRulesRequest.since:type_name -> google.protobuf.Timestamp
+	16,  // 32: eisenkan.v1.SimulatedViolation.violation:type_name -> eisenkan.v1.RuleViolation
+	83,  // 33: eisenkan.v1.SimulatedViolation.occurred_at:type_name -> google.protobuf.Timestamp
+	83,  // 34: eisenkan.v1.SimulationReport.since:type_name -> google.protobuf.Timestamp
+	22,  // 35: eisenkan.v1.SimulationReport.violations:type_name -> eisenkan.v1.SimulatedViolation
+	16,  // 36: eisenkan.v1.RuleViolations.violations:type_name -> eisenkan.v1.RuleViolation
+	27,  // 37: eisenkan.v1.BoardRepairResponse.validation:type_name -> eisenkan.v1.BoardValidationResponse
+	70,  // 38: eisenkan.v1.BoardMetadataResponse.column_counts:type_name -> eisenkan.v1.BoardMetadataResponse.ColumnCountsEntry
+	83,  // 39: eisenkan.v1.BoardMetadataResponse.created_at:type_name -> google.protobuf.Timestamp
+	83,  // 40: eisenkan.v1.BoardMetadataResponse.modified_at:type_name -> google.protobuf.Timestamp
+	71,  // 41: eisenkan.v1.BoardMetadataResponse.metadata:type_name -> eisenkan.v1.BoardMetadataResponse.MetadataEntry
+	30,  // 42: eisenkan.v1.BoardMetadataResponse.columns:type_name -> eisenkan.v1.BoardColumn
+	72,  // 43: eisenkan.v1.BoardMetadataRequest.metadata:type_name -> eisenkan.v1.BoardMetadataRequest.MetadataEntry
+	31,  // 44: eisenkan.v1.UpdateBoardMetadataRequest.metadata:type_name -> eisenkan.v1.BoardMetadataRequest
+	33,  // 45: eisenkan.v1.BoardRemoteList.remotes:type_name -> eisenkan.v1.BoardRemote
+	37,  // 46: eisenkan.v1.SyncResponse.conflicts:type_name -> eisenkan.v1.TaskFieldConflict
+	83,  // 47: eisenkan.v1.BoardRevision.timestamp:type_name -> google.protobuf.Timestamp
+	40,  // 48: eisenkan.v1.BoardRevisionList.revisions:type_name -> eisenkan.v1.BoardRevision
+	83,  // 49: eisenkan.v1.LoadBoardAtRequest.at:type_name -> google.protobuf.Timestamp
+	40,  // 50: eisenkan.v1.BoardSnapshot.revision:type_name -> eisenkan.v1.BoardRevision
+	2,   // 51: eisenkan.v1.BoardSnapshot.tasks:type_name -> eisenkan.v1.TaskResponse
+	3,   // 52: eisenkan.v1.BoardSnapshot.archived_tasks:type_name -> eisenkan.v1.ArchivedTask
+	40,  // 53: eisenkan.v1.TaskRevision.revision:type_name -> eisenkan.v1.BoardRevision
+	46,  // 54: eisenkan.v1.TaskRevision.changes:type_name -> eisenkan.v1.TaskFieldChange
+	47,  // 55: eisenkan.v1.TaskRevisionList.revisions:type_name -> eisenkan.v1.TaskRevision
+	0,   // 56: eisenkan.v1.BatchRequest.priority:type_name -> eisenkan.v1.Priority
+	2,   // 57: eisenkan.v1.BatchResponse.tasks:type_name -> eisenkan.v1.TaskResponse
+	83,  // 58: eisenkan.v1.RunScheduledRulesRequest.now:type_name -> google.protobuf.Timestamp
+	83,  // 59: eisenkan.v1.ScheduledRulesResponse.run_at:type_name -> google.protobuf.Timestamp
+	52,  // 60: eisenkan.v1.ScheduledRulesResponse.triggered:type_name -> eisenkan.v1.ScheduledTrigger
+	2,   // 61: eisenkan.v1.ScheduledRulesResponse.promoted:type_name -> eisenkan.v1.TaskResponse
+	73,  // 62: eisenkan.v1.BoardStatistics.tasks_by_column:type_name -> eisenkan.v1.BoardStatistics.TasksByColumnEntry
+	74,  // 63: eisenkan.v1.BoardStatistics.tasks_by_priority:type_name -> eisenkan.v1.BoardStatistics.TasksByPriorityEntry
+	83,  // 64: eisenkan.v1.BoardStatistics.last_activity:type_name -> google.protobuf.Timestamp
+	4,   // 65: eisenkan.v1.FlowMetricsRequest.date_range:type_name -> eisenkan.v1.DateRange
+	83,  // 66: eisenkan.v1.TaskFlow.created_at:type_name -> google.protobuf.Timestamp
+	83,  // 67: eisenkan.v1.TaskFlow.started_at:type_name -> google.protobuf.Timestamp
+	83,  // 68: eisenkan.v1.TaskFlow.completed_at:type_name -> google.protobuf.Timestamp
+	83,  // 69: eisenkan.v1.ThroughputPeriod.week_start:type_name -> google.protobuf.Timestamp
+	75,  // 70: eisenkan.v1.ThroughputPeriod.by_quadrant:type_name -> eisenkan.v1.ThroughputPeriod.ByQuadrantEntry
+	76,  // 71: eisenkan.v1.ColumnCounts.columns:type_name -> eisenkan.v1.ColumnCounts.ColumnsEntry
+	83,  // 72: eisenkan.v1.CumulativeFlowPoint.date:type_name -> google.protobuf.Timestamp
+	77,  // 73: eisenkan.v1.CumulativeFlowPoint.columns:type_name -> eisenkan.v1.CumulativeFlowPoint.ColumnsEntry
+	78,  // 74: eisenkan.v1.CumulativeFlowPoint.quadrants:type_name -> eisenkan.v1.CumulativeFlowPoint.QuadrantsEntry
+	79,  // 75: eisenkan.v1.CumulativeFlowPoint.wip_by_quadrant:type_name -> eisenkan.v1.CumulativeFlowPoint.WipByQuadrantEntry
+	83,  // 76: eisenkan.v1.FlowMetrics.from:type_name -> google.protobuf.Timestamp
+	83,  // 77: eisenkan.v1.FlowMetrics.to:type_name -> google.protobuf.Timestamp
+	58,  // 78: eisenkan.v1.FlowMetrics.tasks:type_name -> eisenkan.v1.TaskFlow
+	57,  // 79: eisenkan.v1.FlowMetrics.lead_time:type_name -> eisenkan.v1.FlowTimeStatistics
+	57,  // 80: eisenkan.v1.FlowMetrics.cycle_time:type_name -> eisenkan.v1.FlowTimeStatistics
+	80,  // 81: eisenkan.v1.FlowMetrics.lead_time_by_quadrant:type_name -> eisenkan.v1.FlowMetrics.LeadTimeByQuadrantEntry
+	81,  // 82: eisenkan.v1.FlowMetrics.cycle_time_by_quadrant:type_name -> eisenkan.v1.FlowMetrics.CycleTimeByQuadrantEntry
+	59,  // 83: eisenkan.v1.FlowMetrics.throughput:type_name -> eisenkan.v1.ThroughputPeriod
+	61,  // 84: eisenkan.v1.FlowMetrics.cumulative_flow:type_name -> eisenkan.v1.CumulativeFlowPoint
+	85,  // 85: eisenkan.v1.ContextData.data:type_name -> google.protobuf.Struct
+	82,  // 86: eisenkan.v1.ContextData.metadata:type_name -> eisenkan.v1.ContextData.MetadataEntry
+	2,   // 87: eisenkan.v1.TaskEvent.task:type_name -> eisenkan.v1.TaskResponse
+	83,  // 88: eisenkan.v1.TaskEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	37,  // 89: eisenkan.v1.TaskEvent.conflicts:type_name -> eisenkan.v1.TaskFieldConflict
+	66,  // 90: eisenkan.v1.TaskEvent.notification:type_name -> eisenkan.v1.RuleNotification
+	19,  // 91: eisenkan.v1.RuleSet.DependenciesEntry.value:type_name -> eisenkan.v1.RuleDependencies
+	60,  // 92: eisenkan.v1.CumulativeFlowPoint.QuadrantsEntry.value:type_name -> eisenkan.v1.ColumnCounts
+	57,  // 93: eisenkan.v1.FlowMetrics.LeadTimeByQuadrantEntry.value:type_name -> eisenkan.v1.FlowTimeStatistics
+	57,  // 94: eisenkan.v1.FlowMetrics.CycleTimeByQuadrantEntry.value:type_name -> eisenkan.v1.FlowTimeStatistics
+	1,   // 95: eisenkan.v1.TaskManagerService.CreateTask:input_type -> eisenkan.v1.TaskRequest
+	12,  // 96: eisenkan.v1.TaskManagerService.UpdateTask:input_type -> eisenkan.v1.UpdateTaskRequest
+	6,   // 97: eisenkan.v1.TaskManagerService.GetTask:input_type -> eisenkan.v1.TaskIdentifier
+	7,   // 98: eisenkan.v1.TaskManagerService.DeleteTask:input_type -> eisenkan.v1.DeleteTaskRequest
+	5,   // 99: eisenkan.v1.TaskManagerService.ListTasks:input_type -> eisenkan.v1.QueryCriteria
+	13,  // 100: eisenkan.v1.TaskManagerService.ChangeTaskStatus:input_type -> eisenkan.v1.ChangeTaskStatusRequest
+	1,   // 101: eisenkan.v1.TaskManagerService.ValidateTask:input_type -> eisenkan.v1.TaskRequest
+	21,  // 102: eisenkan.v1.TaskManagerService.SimulateRules:input_type -> eisenkan.v1.SimulateRulesRequest
+	86,  // 103: eisenkan.v1.TaskManagerService.ProcessPriorityPromotions:input_type -> google.protobuf.Empty
+	51,  // 104: eisenkan.v1.TaskManagerService.RunScheduledRules:input_type -> eisenkan.v1.RunScheduledRulesRequest
+	8,   // 105: eisenkan.v1.TaskManagerService.ArchiveTask:input_type -> eisenkan.v1.ArchiveTaskRequest
+	86,  // 106: eisenkan.v1.TaskManagerService.ListArchivedTasks:input_type -> google.protobuf.Empty
+	9,   // 107: eisenkan.v1.TaskManagerService.RestoreTask:input_type -> eisenkan.v1.CascadeTaskRequest
+	14,  // 108: eisenkan.v1.TaskManagerService.PurgeArchive:input_type -> eisenkan.v1.PurgeArchiveRequest
+	26,  // 109: eisenkan.v1.TaskManagerService.ValidateBoardDirectory:input_type -> eisenkan.v1.BoardPath
+	86,  // 110: eisenkan.v1.TaskManagerService.RepairBoard:input_type -> google.protobuf.Empty
+	26,  // 111: eisenkan.v1.TaskManagerService.GetBoardMetadata:input_type -> eisenkan.v1.BoardPath
+	26,  // 112: eisenkan.v1.TaskManagerService.GetBoardStatistics:input_type -> eisenkan.v1.BoardPath
+	56,  // 113: eisenkan.v1.TaskManagerService.GetFlowMetrics:input_type -> eisenkan.v1.FlowMetricsRequest
+	32,  // 114: eisenkan.v1.TaskManagerService.UpdateBoardMetadata:input_type -> eisenkan.v1.UpdateBoardMetadataRequest
+	86,  // 115: eisenkan.v1.TaskManagerService.ListBoardRemotes:input_type -> google.protobuf.Empty
+	33,  // 116: eisenkan.v1.TaskManagerService.AddBoardRemote:input_type -> eisenkan.v1.BoardRemote
+	33,  // 117: eisenkan.v1.TaskManagerService.RemoveBoardRemote:input_type -> eisenkan.v1.BoardRemote
+	35,  // 118: eisenkan.v1.TaskManagerService.SyncBoard:input_type -> eisenkan.v1.SyncBoardRequest
+	86,  // 119: eisenkan.v1.TaskManagerService.Undo:input_type -> google.protobuf.Empty
+	86,  // 120: eisenkan.v1.TaskManagerService.Redo:input_type -> google.protobuf.Empty
+	39,  // 121: eisenkan.v1.TaskManagerService.ListBoardRevisions:input_type -> eisenkan.v1.ListBoardRevisionsRequest
+	42,  // 122: eisenkan.v1.TaskManagerService.LoadBoardAt:input_type -> eisenkan.v1.LoadBoardAtRequest
+	44,  // 123: eisenkan.v1.TaskManagerService.RestoreTaskFrom:input_type -> eisenkan.v1.RestoreTaskFromRequest
+	45,  // 124: eisenkan.v1.TaskManagerService.GetTaskHistory:input_type -> eisenkan.v1.GetTaskHistoryRequest
+	49,  // 125: eisenkan.v1.TaskManagerService.ExecuteBatch:input_type -> eisenkan.v1.BatchRequest
+	63,  // 126: eisenkan.v1.TaskManagerService.LoadContext:input_type -> eisenkan.v1.LoadContextRequest
+	64,  // 127: eisenkan.v1.TaskManagerService.StoreContext:input_type -> eisenkan.v1.ContextData
+	86,  // 128: eisenkan.v1.TaskManagerService.SubscribeTaskEvents:input_type -> google.protobuf.Empty
+	2,   // 129: eisenkan.v1.TaskManagerService.CreateTask:output_type -> eisenkan.v1.TaskResponse
+	2,   // 130: eisenkan.v1.TaskManagerService.UpdateTask:output_type -> eisenkan.v1.TaskResponse
+	2,   // 131: eisenkan.v1.TaskManagerService.GetTask:output_type -> eisenkan.v1.TaskResponse
+	86,  // 132: eisenkan.v1.TaskManagerService.DeleteTask:output_type -> google.protobuf.Empty
+	10,  // 133: eisenkan.v1.TaskManagerService.ListTasks:output_type -> eisenkan.v1.TaskList
+	2,   // 134: eisenkan.v1.TaskManagerService.ChangeTaskStatus:output_type -> eisenkan.v1.TaskResponse
+	17,  // 135: eisenkan.v1.TaskManagerService.ValidateTask:output_type -> eisenkan.v1.ValidationResult
+	23,  // 136: eisenkan.v1.TaskManagerService.SimulateRules:output_type -> eisenkan.v1.SimulationReport
+	10,  // 137: eisenkan.v1.TaskManagerService.ProcessPriorityPromotions:output_type -> eisenkan.v1.TaskList
+	53,  // 138: eisenkan.v1.TaskManagerService.RunScheduledRules:output_type -> eisenkan.v1.ScheduledRulesResponse
+	2,   // 139: eisenkan.v1.TaskManagerService.ArchiveTask:output_type -> eisenkan.v1.TaskResponse
+	11,  // 140: eisenkan.v1.TaskManagerService.ListArchivedTasks:output_type -> eisenkan.v1.ArchivedTaskList
+	2,   // 141: eisenkan.v1.TaskManagerService.RestoreTask:output_type -> eisenkan.v1.TaskResponse
+	15,  // 142: eisenkan.v1.TaskManagerService.PurgeArchive:output_type -> eisenkan.v1.PurgeArchiveResponse
+	27,  // 143: eisenkan.v1.TaskManagerService.ValidateBoardDirectory:output_type -> eisenkan.v1.BoardValidationResponse
+	28,  // 144: eisenkan.v1.TaskManagerService.RepairBoard:output_type -> eisenkan.v1.BoardRepairResponse
+	29,  // 145: eisenkan.v1.TaskManagerService.GetBoardMetadata:output_type -> eisenkan.v1.BoardMetadataResponse
+	55,  // 146: eisenkan.v1.TaskManagerService.GetBoardStatistics:output_type -> eisenkan.v1.BoardStatistics
+	62,  // 147: eisenkan.v1.TaskManagerService.GetFlowMetrics:output_type -> eisenkan.v1.FlowMetrics
+	29,  // 148: eisenkan.v1.TaskManagerService.UpdateBoardMetadata:output_type -> eisenkan.v1.BoardMetadataResponse
+	34,  // 149: eisenkan.v1.TaskManagerService.ListBoardRemotes:output_type -> eisenkan.v1.BoardRemoteList
+	86,  // 150: eisenkan.v1.TaskManagerService.AddBoardRemote:output_type -> google.protobuf.Empty
+	86,  // 151: eisenkan.v1.TaskManagerService.RemoveBoardRemote:output_type -> google.protobuf.Empty
+	36,  // 152: eisenkan.v1.TaskManagerService.SyncBoard:output_type -> eisenkan.v1.SyncResponse
+	38,  // 153: eisenkan.v1.TaskManagerService.Undo:output_type -> eisenkan.v1.UndoResponse
+	38,  // 154: eisenkan.v1.TaskManagerService.Redo:output_type -> eisenkan.v1.UndoResponse
+	41,  // 155: eisenkan.v1.TaskManagerService.ListBoardRevisions:output_type -> eisenkan.v1.BoardRevisionList
+	43,  // 156: eisenkan.v1.TaskManagerService.LoadBoardAt:output_type -> eisenkan.v1.BoardSnapshot
+	2,   // 157: eisenkan.v1.TaskManagerService.RestoreTaskFrom:output_type -> eisenkan.v1.TaskResponse
+	48,  // 158: eisenkan.v1.TaskManagerService.GetTaskHistory:output_type -> eisenkan.v1.TaskRevisionList
+	50,  // 159: eisenkan.v1.TaskManagerService.ExecuteBatch:output_type -> eisenkan.v1.BatchResponse
+	64,  // 160: eisenkan.v1.TaskManagerService.LoadContext:output_type -> eisenkan.v1.ContextData
+	86,  // 161: eisenkan.v1.TaskManagerService.StoreContext:output_type -> google.protobuf.Empty
+	65,  // 162: eisenkan.v1.TaskManagerService.SubscribeTaskEvents:output_type -> eisenkan.v1.TaskEvent
+	129, // [129:163] is the sub-list for method output_type
+	95,  // [95:129] is the sub-list for method input_type
+	95,  // [95:95] is the sub-list for extension type_name
+	95,  // [95:95] is the sub-list for extension extendee
+	0,   // [0:95] is the sub-list for field type_name
+}
+
+func init() { file_task_manager_proto_init() }
+func file_task_manager_proto_init() {
+	if File_task_manager_proto != nil {
+		return
+	}
+	file_task_manager_proto_msgTypes[1].OneofWrappers = []any{}
+	file_task_manager_proto_msgTypes[2].OneofWrappers = []any{}
+	file_task_manager_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_task_manager_proto_rawDesc), len(file_task_manager_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   83,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_task_manager_proto_goTypes,
+		DependencyIndexes: file_task_manager_proto_depIdxs,
+		MessageInfos:      file_task_manager_proto_msgTypes,
+	}.Build()
+	File_task_manager_proto = out.File
+	file_task_manager_proto_goTypes = nil
+	file_task_manager_proto_depIdxs = nil
+}
@@ -0,0 +1,1432 @@
+// Protocol Buffer definitions for the EisenKan TaskManager service.
+// Messages mirror the Go types of internal/managers/task_manager so that a
+// client can drive a board served by another process.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: task_manager.proto
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TaskManagerService_CreateTask_FullMethodName                = "/eisenkan.v1.TaskManagerService/CreateTask"
+	TaskManagerService_UpdateTask_FullMethodName                = "/eisenkan.v1.TaskManagerService/UpdateTask"
+	TaskManagerService_GetTask_FullMethodName                   = "/eisenkan.v1.TaskManagerService/GetTask"
+	TaskManagerService_DeleteTask_FullMethodName                = "/eisenkan.v1.TaskManagerService/DeleteTask"
+	TaskManagerService_ListTasks_FullMethodName                 = "/eisenkan.v1.TaskManagerService/ListTasks"
+	TaskManagerService_ChangeTaskStatus_FullMethodName          = "/eisenkan.v1.TaskManagerService/ChangeTaskStatus"
+	TaskManagerService_ValidateTask_FullMethodName              = "/eisenkan.v1.TaskManagerService/ValidateTask"
+	TaskManagerService_SimulateRules_FullMethodName             = "/eisenkan.v1.TaskManagerService/SimulateRules"
+	TaskManagerService_ProcessPriorityPromotions_FullMethodName = "/eisenkan.v1.TaskManagerService/ProcessPriorityPromotions"
+	TaskManagerService_RunScheduledRules_FullMethodName         = "/eisenkan.v1.TaskManagerService/RunScheduledRules"
+	TaskManagerService_ArchiveTask_FullMethodName               = "/eisenkan.v1.TaskManagerService/ArchiveTask"
+	TaskManagerService_ListArchivedTasks_FullMethodName         = "/eisenkan.v1.TaskManagerService/ListArchivedTasks"
+	TaskManagerService_RestoreTask_FullMethodName               = "/eisenkan.v1.TaskManagerService/RestoreTask"
+	TaskManagerService_PurgeArchive_FullMethodName              = "/eisenkan.v1.TaskManagerService/PurgeArchive"
+	TaskManagerService_ValidateBoardDirectory_FullMethodName    = "/eisenkan.v1.TaskManagerService/ValidateBoardDirectory"
+	TaskManagerService_RepairBoard_FullMethodName               = "/eisenkan.v1.TaskManagerService/RepairBoard"
+	TaskManagerService_GetBoardMetadata_FullMethodName          = "/eisenkan.v1.TaskManagerService/GetBoardMetadata"
+	TaskManagerService_GetBoardStatistics_FullMethodName        = "/eisenkan.v1.TaskManagerService/GetBoardStatistics"
+	TaskManagerService_GetFlowMetrics_FullMethodName            = "/eisenkan.v1.TaskManagerService/GetFlowMetrics"
+	TaskManagerService_UpdateBoardMetadata_FullMethodName       = "/eisenkan.v1.TaskManagerService/UpdateBoardMetadata"
+	TaskManagerService_ListBoardRemotes_FullMethodName          = "/eisenkan.v1.TaskManagerService/ListBoardRemotes"
+	TaskManagerService_AddBoardRemote_FullMethodName            = "/eisenkan.v1.TaskManagerService/AddBoardRemote"
+	TaskManagerService_RemoveBoardRemote_FullMethodName         = "/eisenkan.v1.TaskManagerService/RemoveBoardRemote"
+	TaskManagerService_SyncBoard_FullMethodName                 = "/eisenkan.v1.TaskManagerService/SyncBoard"
+	TaskManagerService_Undo_FullMethodName                      = "/eisenkan.v1.TaskManagerService/Undo"
+	TaskManagerService_Redo_FullMethodName                      = "/eisenkan.v1.TaskManagerService/Redo"
+	TaskManagerService_ListBoardRevisions_FullMethodName        = "/eisenkan.v1.TaskManagerService/ListBoardRevisions"
+	TaskManagerService_LoadBoardAt_FullMethodName               = "/eisenkan.v1.TaskManagerService/LoadBoardAt"
+	TaskManagerService_RestoreTaskFrom_FullMethodName           = "/eisenkan.v1.TaskManagerService/RestoreTaskFrom"
+	TaskManagerService_GetTaskHistory_FullMethodName            = "/eisenkan.v1.TaskManagerService/GetTaskHistory"
+	TaskManagerService_ExecuteBatch_FullMethodName              = "/eisenkan.v1.TaskManagerService/ExecuteBatch"
+	TaskManagerService_LoadContext_FullMethodName               = "/eisenkan.v1.TaskManagerService/LoadContext"
+	TaskManagerService_StoreContext_FullMethodName              = "/eisenkan.v1.TaskManagerService/StoreContext"
+	TaskManagerService_SubscribeTaskEvents_FullMethodName       = "/eisenkan.v1.TaskManagerService/SubscribeTaskEvents"
+)
+
+// TaskManagerServiceClient is the client API for TaskManagerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TaskManagerService exposes task_manager.TaskManager.
+//
+// Errors use standard gRPC status codes: NOT_FOUND for unknown tasks,
+// FAILED_PRECONDITION with a RuleViolations detail when business rules reject
+// an operation, INVALID_ARGUMENT for malformed requests and UNKNOWN otherwise.
+// A failed batch carries a BatchFailure detail naming the task it failed on.
+// A modification of a task that no longer has the expected update time fails
+// with ABORTED and a TaskModified detail.
+type TaskManagerServiceClient interface {
+	// Task CRUD operations
+	CreateTask(ctx context.Context, in *TaskRequest, opts ...grpc.CallOption) (*TaskResponse, error)
+	UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...grpc.CallOption) (*TaskResponse, error)
+	GetTask(ctx context.Context, in *TaskIdentifier, opts ...grpc.CallOption) (*TaskResponse, error)
+	DeleteTask(ctx context.Context, in *DeleteTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Task query operations
+	ListTasks(ctx context.Context, in *QueryCriteria, opts ...grpc.CallOption) (*TaskList, error)
+	// Workflow operations
+	ChangeTaskStatus(ctx context.Context, in *ChangeTaskStatusRequest, opts ...grpc.CallOption) (*TaskResponse, error)
+	// Validation operations
+	ValidateTask(ctx context.Context, in *TaskRequest, opts ...grpc.CallOption) (*ValidationResult, error)
+	SimulateRules(ctx context.Context, in *SimulateRulesRequest, opts ...grpc.CallOption) (*SimulationReport, error)
+	// Priority promotion operations
+	ProcessPriorityPromotions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TaskList, error)
+	// Scheduled operations; time-triggered rules are evaluated at the given time
+	RunScheduledRules(ctx context.Context, in *RunScheduledRulesRequest, opts ...grpc.CallOption) (*ScheduledRulesResponse, error)
+	// Archive operations
+	ArchiveTask(ctx context.Context, in *ArchiveTaskRequest, opts ...grpc.CallOption) (*TaskResponse, error)
+	ListArchivedTasks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ArchivedTaskList, error)
+	RestoreTask(ctx context.Context, in *CascadeTaskRequest, opts ...grpc.CallOption) (*TaskResponse, error)
+	PurgeArchive(ctx context.Context, in *PurgeArchiveRequest, opts ...grpc.CallOption) (*PurgeArchiveResponse, error)
+	// Board management operations on the served board; board paths must be empty or name the served board.
+	// Boards are created and deleted locally only, the service cannot reach other directories of the server.
+	ValidateBoardDirectory(ctx context.Context, in *BoardPath, opts ...grpc.CallOption) (*BoardValidationResponse, error)
+	RepairBoard(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*BoardRepairResponse, error)
+	GetBoardMetadata(ctx context.Context, in *BoardPath, opts ...grpc.CallOption) (*BoardMetadataResponse, error)
+	GetBoardStatistics(ctx context.Context, in *BoardPath, opts ...grpc.CallOption) (*BoardStatistics, error)
+	GetFlowMetrics(ctx context.Context, in *FlowMetricsRequest, opts ...grpc.CallOption) (*FlowMetrics, error)
+	UpdateBoardMetadata(ctx context.Context, in *UpdateBoardMetadataRequest, opts ...grpc.CallOption) (*BoardMetadataResponse, error)
+	// Board synchronisation operations through the server board's git remotes
+	ListBoardRemotes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*BoardRemoteList, error)
+	AddBoardRemote(ctx context.Context, in *BoardRemote, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveBoardRemote(ctx context.Context, in *BoardRemote, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SyncBoard(ctx context.Context, in *SyncBoardRequest, opts ...grpc.CallOption) (*SyncResponse, error)
+	// Undo history operations; both add a revert commit to the server board
+	Undo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UndoResponse, error)
+	Redo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UndoResponse, error)
+	// Board history operations; snapshots are read-only, restoring a task adds a commit
+	ListBoardRevisions(ctx context.Context, in *ListBoardRevisionsRequest, opts ...grpc.CallOption) (*BoardRevisionList, error)
+	LoadBoardAt(ctx context.Context, in *LoadBoardAtRequest, opts ...grpc.CallOption) (*BoardSnapshot, error)
+	RestoreTaskFrom(ctx context.Context, in *RestoreTaskFromRequest, opts ...grpc.CallOption) (*TaskResponse, error)
+	GetTaskHistory(ctx context.Context, in *GetTaskHistoryRequest, opts ...grpc.CallOption) (*TaskRevisionList, error)
+	// Batch operations; a batch changes all of its tasks in a single commit or none of them
+	ExecuteBatch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	// UI context operations
+	LoadContext(ctx context.Context, in *LoadContextRequest, opts ...grpc.CallOption) (*ContextData, error)
+	StoreContext(ctx context.Context, in *ContextData, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Task event operations; the stream lasts until the client cancels it
+	SubscribeTaskEvents(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TaskEvent], error)
+}
+
+type taskManagerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTaskManagerServiceClient(cc grpc.ClientConnInterface) TaskManagerServiceClient {
+	return &taskManagerServiceClient{cc}
+}
+
+func (c *taskManagerServiceClient) CreateTask(ctx context.Context, in *TaskRequest, opts ...grpc.CallOption) (*TaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskResponse)
+	err := c.cc.Invoke(ctx, TaskManagerService_CreateTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...grpc.CallOption) (*TaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskResponse)
+	err := c.cc.Invoke(ctx, TaskManagerService_UpdateTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) GetTask(ctx context.Context, in *TaskIdentifier, opts ...grpc.CallOption) (*TaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskResponse)
+	err := c.cc.Invoke(ctx, TaskManagerService_GetTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) DeleteTask(ctx context.Context, in *DeleteTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TaskManagerService_DeleteTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) ListTasks(ctx context.Context, in *QueryCriteria, opts ...grpc.CallOption) (*TaskList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskList)
+	err := c.cc.Invoke(ctx, TaskManagerService_ListTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) ChangeTaskStatus(ctx context.Context, in *ChangeTaskStatusRequest, opts ...grpc.CallOption) (*TaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskResponse)
+	err := c.cc.Invoke(ctx, TaskManagerService_ChangeTaskStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) ValidateTask(ctx context.Context, in *TaskRequest, opts ...grpc.CallOption) (*ValidationResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidationResult)
+	err := c.cc.Invoke(ctx, TaskManagerService_ValidateTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) SimulateRules(ctx context.Context, in *SimulateRulesRequest, opts ...grpc.CallOption) (*SimulationReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulationReport)
+	err := c.cc.Invoke(ctx, TaskManagerService_SimulateRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) ProcessPriorityPromotions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TaskList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskList)
+	err := c.cc.Invoke(ctx, TaskManagerService_ProcessPriorityPromotions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) RunScheduledRules(ctx context.Context, in *RunScheduledRulesRequest, opts ...grpc.CallOption) (*ScheduledRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduledRulesResponse)
+	err := c.cc.Invoke(ctx, TaskManagerService_RunScheduledRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) ArchiveTask(ctx context.Context, in *ArchiveTaskRequest, opts ...grpc.CallOption) (*TaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskResponse)
+	err := c.cc.Invoke(ctx, TaskManagerService_ArchiveTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) ListArchivedTasks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ArchivedTaskList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchivedTaskList)
+	err := c.cc.Invoke(ctx, TaskManagerService_ListArchivedTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) RestoreTask(ctx context.Context, in *CascadeTaskRequest, opts ...grpc.CallOption) (*TaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskResponse)
+	err := c.cc.Invoke(ctx, TaskManagerService_RestoreTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) PurgeArchive(ctx context.Context, in *PurgeArchiveRequest, opts ...grpc.CallOption) (*PurgeArchiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeArchiveResponse)
+	err := c.cc.Invoke(ctx, TaskManagerService_PurgeArchive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) ValidateBoardDirectory(ctx context.Context, in *BoardPath, opts ...grpc.CallOption) (*BoardValidationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoardValidationResponse)
+	err := c.cc.Invoke(ctx, TaskManagerService_ValidateBoardDirectory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) RepairBoard(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*BoardRepairResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoardRepairResponse)
+	err := c.cc.Invoke(ctx, TaskManagerService_RepairBoard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) GetBoardMetadata(ctx context.Context, in *BoardPath, opts ...grpc.CallOption) (*BoardMetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoardMetadataResponse)
+	err := c.cc.Invoke(ctx, TaskManagerService_GetBoardMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) GetBoardStatistics(ctx context.Context, in *BoardPath, opts ...grpc.CallOption) (*BoardStatistics, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoardStatistics)
+	err := c.cc.Invoke(ctx, TaskManagerService_GetBoardStatistics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) GetFlowMetrics(ctx context.Context, in *FlowMetricsRequest, opts ...grpc.CallOption) (*FlowMetrics, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FlowMetrics)
+	err := c.cc.Invoke(ctx, TaskManagerService_GetFlowMetrics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) UpdateBoardMetadata(ctx context.Context, in *UpdateBoardMetadataRequest, opts ...grpc.CallOption) (*BoardMetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoardMetadataResponse)
+	err := c.cc.Invoke(ctx, TaskManagerService_UpdateBoardMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) ListBoardRemotes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*BoardRemoteList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoardRemoteList)
+	err := c.cc.Invoke(ctx, TaskManagerService_ListBoardRemotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) AddBoardRemote(ctx context.Context, in *BoardRemote, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TaskManagerService_AddBoardRemote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) RemoveBoardRemote(ctx context.Context, in *BoardRemote, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TaskManagerService_RemoveBoardRemote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) SyncBoard(ctx context.Context, in *SyncBoardRequest, opts ...grpc.CallOption) (*SyncResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncResponse)
+	err := c.cc.Invoke(ctx, TaskManagerService_SyncBoard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) Undo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UndoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UndoResponse)
+	err := c.cc.Invoke(ctx, TaskManagerService_Undo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) Redo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UndoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UndoResponse)
+	err := c.cc.Invoke(ctx, TaskManagerService_Redo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) ListBoardRevisions(ctx context.Context, in *ListBoardRevisionsRequest, opts ...grpc.CallOption) (*BoardRevisionList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoardRevisionList)
+	err := c.cc.Invoke(ctx, TaskManagerService_ListBoardRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) LoadBoardAt(ctx context.Context, in *LoadBoardAtRequest, opts ...grpc.CallOption) (*BoardSnapshot, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoardSnapshot)
+	err := c.cc.Invoke(ctx, TaskManagerService_LoadBoardAt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) RestoreTaskFrom(ctx context.Context, in *RestoreTaskFromRequest, opts ...grpc.CallOption) (*TaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskResponse)
+	err := c.cc.Invoke(ctx, TaskManagerService_RestoreTaskFrom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) GetTaskHistory(ctx context.Context, in *GetTaskHistoryRequest, opts ...grpc.CallOption) (*TaskRevisionList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskRevisionList)
+	err := c.cc.Invoke(ctx, TaskManagerService_GetTaskHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) ExecuteBatch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, TaskManagerService_ExecuteBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) LoadContext(ctx context.Context, in *LoadContextRequest, opts ...grpc.CallOption) (*ContextData, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContextData)
+	err := c.cc.Invoke(ctx, TaskManagerService_LoadContext_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) StoreContext(ctx context.Context, in *ContextData, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TaskManagerService_StoreContext_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagerServiceClient) SubscribeTaskEvents(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TaskEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TaskManagerService_ServiceDesc.Streams[0], TaskManagerService_SubscribeTaskEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[emptypb.Empty, TaskEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TaskManagerService_SubscribeTaskEventsClient = grpc.ServerStreamingClient[TaskEvent]
+
+// TaskManagerServiceServer is the server API for TaskManagerService service.
+// All implementations must embed UnimplementedTaskManagerServiceServer
+// for forward compatibility.
+//
+// TaskManagerService exposes task_manager.TaskManager.
+//
+// Errors use standard gRPC status codes: NOT_FOUND for unknown tasks,
+// FAILED_PRECONDITION with a RuleViolations detail when business rules reject
+// an operation, INVALID_ARGUMENT for malformed requests and UNKNOWN otherwise.
+// A failed batch carries a BatchFailure detail naming the task it failed on.
+// A modification of a task that no longer has the expected update time fails
+// with ABORTED and a TaskModified detail.
+type TaskManagerServiceServer interface {
+	// Task CRUD operations
+	CreateTask(context.Context, *TaskRequest) (*TaskResponse, error)
+	UpdateTask(context.Context, *UpdateTaskRequest) (*TaskResponse, error)
+	GetTask(context.Context, *TaskIdentifier) (*TaskResponse, error)
+	DeleteTask(context.Context, *DeleteTaskRequest) (*emptypb.Empty, error)
+	// Task query operations
+	ListTasks(context.Context, *QueryCriteria) (*TaskList, error)
+	// Workflow operations
+	ChangeTaskStatus(context.Context, *ChangeTaskStatusRequest) (*TaskResponse, error)
+	// Validation operations
+	ValidateTask(context.Context, *TaskRequest) (*ValidationResult, error)
+	SimulateRules(context.Context, *SimulateRulesRequest) (*SimulationReport, error)
+	// Priority promotion operations
+	ProcessPriorityPromotions(context.Context, *emptypb.Empty) (*TaskList, error)
+	// Scheduled operations; time-triggered rules are evaluated at the given time
+	RunScheduledRules(context.Context, *RunScheduledRulesRequest) (*ScheduledRulesResponse, error)
+	// Archive operations
+	ArchiveTask(context.Context, *ArchiveTaskRequest) (*TaskResponse, error)
+	ListArchivedTasks(context.Context, *emptypb.Empty) (*ArchivedTaskList, error)
+	RestoreTask(context.Context, *CascadeTaskRequest) (*TaskResponse, error)
+	PurgeArchive(context.Context, *PurgeArchiveRequest) (*PurgeArchiveResponse, error)
+	// Board management operations on the served board; board paths must be empty or name the served board.
+	// Boards are created and deleted locally only, the service cannot reach other directories of the server.
+	ValidateBoardDirectory(context.Context, *BoardPath) (*BoardValidationResponse, error)
+	RepairBoard(context.Context, *emptypb.Empty) (*BoardRepairResponse, error)
+	GetBoardMetadata(context.Context, *BoardPath) (*BoardMetadataResponse, error)
+	GetBoardStatistics(context.Context, *BoardPath) (*BoardStatistics, error)
+	GetFlowMetrics(context.Context, *FlowMetricsRequest) (*FlowMetrics, error)
+	UpdateBoardMetadata(context.Context, *UpdateBoardMetadataRequest) (*BoardMetadataResponse, error)
+	// Board synchronisation operations through the server board's git remotes
+	ListBoardRemotes(context.Context, *emptypb.Empty) (*BoardRemoteList, error)
+	AddBoardRemote(context.Context, *BoardRemote) (*emptypb.Empty, error)
+	RemoveBoardRemote(context.Context, *BoardRemote) (*emptypb.Empty, error)
+	SyncBoard(context.Context, *SyncBoardRequest) (*SyncResponse, error)
+	// Undo history operations; both add a revert commit to the server board
+	Undo(context.Context, *emptypb.Empty) (*UndoResponse, error)
+	Redo(context.Context, *emptypb.Empty) (*UndoResponse, error)
+	// Board history operations; snapshots are read-only, restoring a task adds a commit
+	ListBoardRevisions(context.Context, *ListBoardRevisionsRequest) (*BoardRevisionList, error)
+	LoadBoardAt(context.Context, *LoadBoardAtRequest) (*BoardSnapshot, error)
+	RestoreTaskFrom(context.Context, *RestoreTaskFromRequest) (*TaskResponse, error)
+	GetTaskHistory(context.Context, *GetTaskHistoryRequest) (*TaskRevisionList, error)
+	// Batch operations; a batch changes all of its tasks in a single commit or none of them
+	ExecuteBatch(context.Context, *BatchRequest) (*BatchResponse, error)
+	// UI context operations
+	LoadContext(context.Context, *LoadContextRequest) (*ContextData, error)
+	StoreContext(context.Context, *ContextData) (*emptypb.Empty, error)
+	// Task event operations; the stream lasts until the client cancels it
+	SubscribeTaskEvents(*emptypb.Empty, grpc.ServerStreamingServer[TaskEvent]) error
+	mustEmbedUnimplementedTaskManagerServiceServer()
+}
+
+// UnimplementedTaskManagerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTaskManagerServiceServer struct{}
+
+func (UnimplementedTaskManagerServiceServer) CreateTask(context.Context, *TaskRequest) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTask not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) UpdateTask(context.Context, *UpdateTaskRequest) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTask not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) GetTask(context.Context, *TaskIdentifier) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTask not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) DeleteTask(context.Context, *DeleteTaskRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTask not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) ListTasks(context.Context, *QueryCriteria) (*TaskList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTasks not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) ChangeTaskStatus(context.Context, *ChangeTaskStatusRequest) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeTaskStatus not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) ValidateTask(context.Context, *TaskRequest) (*ValidationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateTask not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) SimulateRules(context.Context, *SimulateRulesRequest) (*SimulationReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateRules not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) ProcessPriorityPromotions(context.Context, *emptypb.Empty) (*TaskList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessPriorityPromotions not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) RunScheduledRules(context.Context, *RunScheduledRulesRequest) (*ScheduledRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunScheduledRules not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) ArchiveTask(context.Context, *ArchiveTaskRequest) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveTask not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) ListArchivedTasks(context.Context, *emptypb.Empty) (*ArchivedTaskList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArchivedTasks not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) RestoreTask(context.Context, *CascadeTaskRequest) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTask not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) PurgeArchive(context.Context, *PurgeArchiveRequest) (*PurgeArchiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeArchive not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) ValidateBoardDirectory(context.Context, *BoardPath) (*BoardValidationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateBoardDirectory not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) RepairBoard(context.Context, *emptypb.Empty) (*BoardRepairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepairBoard not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) GetBoardMetadata(context.Context, *BoardPath) (*BoardMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBoardMetadata not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) GetBoardStatistics(context.Context, *BoardPath) (*BoardStatistics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBoardStatistics not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) GetFlowMetrics(context.Context, *FlowMetricsRequest) (*FlowMetrics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFlowMetrics not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) UpdateBoardMetadata(context.Context, *UpdateBoardMetadataRequest) (*BoardMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBoardMetadata not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) ListBoardRemotes(context.Context, *emptypb.Empty) (*BoardRemoteList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBoardRemotes not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) AddBoardRemote(context.Context, *BoardRemote) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBoardRemote not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) RemoveBoardRemote(context.Context, *BoardRemote) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBoardRemote not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) SyncBoard(context.Context, *SyncBoardRequest) (*SyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncBoard not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) Undo(context.Context, *emptypb.Empty) (*UndoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Undo not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) Redo(context.Context, *emptypb.Empty) (*UndoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Redo not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) ListBoardRevisions(context.Context, *ListBoardRevisionsRequest) (*BoardRevisionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBoardRevisions not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) LoadBoardAt(context.Context, *LoadBoardAtRequest) (*BoardSnapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadBoardAt not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) RestoreTaskFrom(context.Context, *RestoreTaskFromRequest) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTaskFrom not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) GetTaskHistory(context.Context, *GetTaskHistoryRequest) (*TaskRevisionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskHistory not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) ExecuteBatch(context.Context, *BatchRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteBatch not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) LoadContext(context.Context, *LoadContextRequest) (*ContextData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadContext not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) StoreContext(context.Context, *ContextData) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreContext not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) SubscribeTaskEvents(*emptypb.Empty, grpc.ServerStreamingServer[TaskEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeTaskEvents not implemented")
+}
+func (UnimplementedTaskManagerServiceServer) mustEmbedUnimplementedTaskManagerServiceServer() {}
+func (UnimplementedTaskManagerServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeTaskManagerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TaskManagerServiceServer will
+// result in compilation errors.
+type UnsafeTaskManagerServiceServer interface {
+	mustEmbedUnimplementedTaskManagerServiceServer()
+}
+
+func RegisterTaskManagerServiceServer(s grpc.ServiceRegistrar, srv TaskManagerServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTaskManagerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TaskManagerService_ServiceDesc, srv)
+}
+
+func _TaskManagerService_CreateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).CreateTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_CreateTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).CreateTask(ctx, req.(*TaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_UpdateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).UpdateTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_UpdateTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).UpdateTask(ctx, req.(*UpdateTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_GetTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).GetTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_GetTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).GetTask(ctx, req.(*TaskIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_DeleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).DeleteTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_DeleteTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).DeleteTask(ctx, req.(*DeleteTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCriteria)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).ListTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_ListTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).ListTasks(ctx, req.(*QueryCriteria))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_ChangeTaskStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeTaskStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).ChangeTaskStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_ChangeTaskStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).ChangeTaskStatus(ctx, req.(*ChangeTaskStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_ValidateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).ValidateTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_ValidateTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).ValidateTask(ctx, req.(*TaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_SimulateRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).SimulateRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_SimulateRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).SimulateRules(ctx, req.(*SimulateRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_ProcessPriorityPromotions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).ProcessPriorityPromotions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_ProcessPriorityPromotions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).ProcessPriorityPromotions(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_RunScheduledRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunScheduledRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).RunScheduledRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_RunScheduledRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).RunScheduledRules(ctx, req.(*RunScheduledRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_ArchiveTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).ArchiveTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_ArchiveTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).ArchiveTask(ctx, req.(*ArchiveTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_ListArchivedTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).ListArchivedTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_ListArchivedTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).ListArchivedTasks(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_RestoreTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CascadeTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).RestoreTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_RestoreTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).RestoreTask(ctx, req.(*CascadeTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_PurgeArchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeArchiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).PurgeArchive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_PurgeArchive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).PurgeArchive(ctx, req.(*PurgeArchiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_ValidateBoardDirectory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardPath)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).ValidateBoardDirectory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_ValidateBoardDirectory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).ValidateBoardDirectory(ctx, req.(*BoardPath))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_RepairBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).RepairBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_RepairBoard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).RepairBoard(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_GetBoardMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardPath)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).GetBoardMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_GetBoardMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).GetBoardMetadata(ctx, req.(*BoardPath))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_GetBoardStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardPath)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).GetBoardStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_GetBoardStatistics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).GetBoardStatistics(ctx, req.(*BoardPath))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_GetFlowMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlowMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).GetFlowMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_GetFlowMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).GetFlowMetrics(ctx, req.(*FlowMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_UpdateBoardMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBoardMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).UpdateBoardMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_UpdateBoardMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).UpdateBoardMetadata(ctx, req.(*UpdateBoardMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_ListBoardRemotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).ListBoardRemotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_ListBoardRemotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).ListBoardRemotes(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_AddBoardRemote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardRemote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).AddBoardRemote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_AddBoardRemote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).AddBoardRemote(ctx, req.(*BoardRemote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_RemoveBoardRemote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardRemote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).RemoveBoardRemote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_RemoveBoardRemote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).RemoveBoardRemote(ctx, req.(*BoardRemote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_SyncBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncBoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).SyncBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_SyncBoard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).SyncBoard(ctx, req.(*SyncBoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_Undo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).Undo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_Undo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).Undo(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_Redo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).Redo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_Redo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).Redo(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_ListBoardRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBoardRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).ListBoardRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_ListBoardRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).ListBoardRevisions(ctx, req.(*ListBoardRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_LoadBoardAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadBoardAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).LoadBoardAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_LoadBoardAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).LoadBoardAt(ctx, req.(*LoadBoardAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_RestoreTaskFrom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTaskFromRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).RestoreTaskFrom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_RestoreTaskFrom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).RestoreTaskFrom(ctx, req.(*RestoreTaskFromRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_GetTaskHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).GetTaskHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_GetTaskHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).GetTaskHistory(ctx, req.(*GetTaskHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_ExecuteBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).ExecuteBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_ExecuteBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).ExecuteBatch(ctx, req.(*BatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_LoadContext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadContextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).LoadContext(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_LoadContext_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).LoadContext(ctx, req.(*LoadContextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_StoreContext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContextData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagerServiceServer).StoreContext(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskManagerService_StoreContext_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagerServiceServer).StoreContext(ctx, req.(*ContextData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagerService_SubscribeTaskEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TaskManagerServiceServer).SubscribeTaskEvents(m, &grpc.GenericServerStream[emptypb.Empty, TaskEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TaskManagerService_SubscribeTaskEventsServer = grpc.ServerStreamingServer[TaskEvent]
+
+// TaskManagerService_ServiceDesc is the grpc.ServiceDesc for TaskManagerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TaskManagerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "eisenkan.v1.TaskManagerService",
+	HandlerType: (*TaskManagerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTask",
+			Handler:    _TaskManagerService_CreateTask_Handler,
+		},
+		{
+			MethodName: "UpdateTask",
+			Handler:    _TaskManagerService_UpdateTask_Handler,
+		},
+		{
+			MethodName: "GetTask",
+			Handler:    _TaskManagerService_GetTask_Handler,
+		},
+		{
+			MethodName: "DeleteTask",
+			Handler:    _TaskManagerService_DeleteTask_Handler,
+		},
+		{
+			MethodName: "ListTasks",
+			Handler:    _TaskManagerService_ListTasks_Handler,
+		},
+		{
+			MethodName: "ChangeTaskStatus",
+			Handler:    _TaskManagerService_ChangeTaskStatus_Handler,
+		},
+		{
+			MethodName: "ValidateTask",
+			Handler:    _TaskManagerService_ValidateTask_Handler,
+		},
+		{
+			MethodName: "SimulateRules",
+			Handler:    _TaskManagerService_SimulateRules_Handler,
+		},
+		{
+			MethodName: "ProcessPriorityPromotions",
+			Handler:    _TaskManagerService_ProcessPriorityPromotions_Handler,
+		},
+		{
+			MethodName: "RunScheduledRules",
+			Handler:    _TaskManagerService_RunScheduledRules_Handler,
+		},
+		{
+			MethodName: "ArchiveTask",
+			Handler:    _TaskManagerService_ArchiveTask_Handler,
+		},
+		{
+			MethodName: "ListArchivedTasks",
+			Handler:    _TaskManagerService_ListArchivedTasks_Handler,
+		},
+		{
+			MethodName: "RestoreTask",
+			Handler:    _TaskManagerService_RestoreTask_Handler,
+		},
+		{
+			MethodName: "PurgeArchive",
+			Handler:    _TaskManagerService_PurgeArchive_Handler,
+		},
+		{
+			MethodName: "ValidateBoardDirectory",
+			Handler:    _TaskManagerService_ValidateBoardDirectory_Handler,
+		},
+		{
+			MethodName: "RepairBoard",
+			Handler:    _TaskManagerService_RepairBoard_Handler,
+		},
+		{
+			MethodName: "GetBoardMetadata",
+			Handler:    _TaskManagerService_GetBoardMetadata_Handler,
+		},
+		{
+			MethodName: "GetBoardStatistics",
+			Handler:    _TaskManagerService_GetBoardStatistics_Handler,
+		},
+		{
+			MethodName: "GetFlowMetrics",
+			Handler:    _TaskManagerService_GetFlowMetrics_Handler,
+		},
+		{
+			MethodName: "UpdateBoardMetadata",
+			Handler:    _TaskManagerService_UpdateBoardMetadata_Handler,
+		},
+		{
+			MethodName: "ListBoardRemotes",
+			Handler:    _TaskManagerService_ListBoardRemotes_Handler,
+		},
+		{
+			MethodName: "AddBoardRemote",
+			Handler:    _TaskManagerService_AddBoardRemote_Handler,
+		},
+		{
+			MethodName: "RemoveBoardRemote",
+			Handler:    _TaskManagerService_RemoveBoardRemote_Handler,
+		},
+		{
+			MethodName: "SyncBoard",
+			Handler:    _TaskManagerService_SyncBoard_Handler,
+		},
+		{
+			MethodName: "Undo",
+			Handler:    _TaskManagerService_Undo_Handler,
+		},
+		{
+			MethodName: "Redo",
+			Handler:    _TaskManagerService_Redo_Handler,
+		},
+		{
+			MethodName: "ListBoardRevisions",
+			Handler:    _TaskManagerService_ListBoardRevisions_Handler,
+		},
+		{
+			MethodName: "LoadBoardAt",
+			Handler:    _TaskManagerService_LoadBoardAt_Handler,
+		},
+		{
+			MethodName: "RestoreTaskFrom",
+			Handler:    _TaskManagerService_RestoreTaskFrom_Handler,
+		},
+		{
+			MethodName: "GetTaskHistory",
+			Handler:    _TaskManagerService_GetTaskHistory_Handler,
+		},
+		{
+			MethodName: "ExecuteBatch",
+			Handler:    _TaskManagerService_ExecuteBatch_Handler,
+		},
+		{
+			MethodName: "LoadContext",
+			Handler:    _TaskManagerService_LoadContext_Handler,
+		},
+		{
+			MethodName: "StoreContext",
+			Handler:    _TaskManagerService_StoreContext_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeTaskEvents",
+			Handler:       _TaskManagerService_SubscribeTaskEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "task_manager.proto",
+}
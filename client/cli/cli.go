@@ -0,0 +1,353 @@
+// Package cli provides the headless EisenKan command-line interface.
+// Commands drive the TaskManager directly and never create a window, so boards
+// can be scripted from terminals, cron jobs and CI without a display.
+package cli
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rknuus/eisenkan/internal/engines"
+	"github.com/rknuus/eisenkan/internal/managers/task_manager"
+	"github.com/rknuus/eisenkan/internal/resource_access"
+	"github.com/rknuus/eisenkan/internal/resource_access/board_access"
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// Exit codes returned by Run
+const (
+	ExitOK            = 0 // Command succeeded
+	ExitError         = 1 // Command failed, or validation found problems
+	ExitUsage         = 2 // Invalid command line
+	ExitRuleViolation = 3 // Operation rejected by board rules
+)
+
+// boardEnvVariable names the environment variable used when --board is omitted
+const boardEnvVariable = "EISENKAN_BOARD"
+
+// command describes a CLI subcommand
+type command struct {
+	name    string
+	usage   string
+	summary string
+	run     func(env *environment, args []string) error
+}
+
+// commands lists all subcommands in help order
+var commands = []command{
+	{"list", "list [--status s1,s2] [--priority label] [--tags t1,t2]", "List tasks", runList},
+	{"add", "add --description text [--priority label] [--status s] [--tags t1,t2] [--deadline date] [--promotion-date date] [--parent id]", "Create a task", runAdd},
+	{"move", "move <task-id> --status s", "Change the workflow status of a task", runMove},
+	{"edit", "edit <task-id> [--description text] [--priority label] [--tags t1,t2] [--deadline date] [--promotion-date date]", "Change task data", runEdit},
+	{"archive", "archive <task-id>", "Archive a task and its subtasks", runArchive},
+	{"promote", "promote", "Promote tasks whose priority promotion date has been reached", runPromote},
+	{"validate", "validate [--description text [--priority label] [--status s] ...]", "Validate the board, or check a prospective task against the rules", runValidate},
+	{"simulate", "simulate [--rules file] [--since date]", "Report the violations a rule set would cause on the board and in its history", runSimulate},
+	{"repair", "repair", "Fix corrupt files, missing directories and task positions reported by validate", runRepair},
+	{"stats", "stats [--from date] [--to date] [--csv tasks|throughput|cfd]", "Show board statistics and flow metrics", runStats},
+	{"remote", "remote [add <name> <url> | remove <name>]", "List, add or remove the git remotes the board is shared through", runRemote},
+	{"sync", "sync [remote]", "Merge task changes from a git remote (default origin) and push the board back", runSync},
+	{"serve", "serve [--addr host:port] [--grpc-addr host:port]", "Serve the board as a REST/JSON and gRPC API until interrupted", runServe},
+}
+
+// environment carries the shared state of a single CLI invocation
+type environment struct {
+	stdout    io.Writer
+	stderr    io.Writer
+	boardPath string
+	format    string
+	open      func(boardPath string) (*session, error)
+}
+
+// usageError marks errors caused by an invalid command line
+type usageError struct {
+	message string
+}
+
+// Error implements the error interface
+func (e *usageError) Error() string {
+	return e.message
+}
+
+// newUsageError creates a usage error with a formatted message
+func newUsageError(format string, args ...interface{}) error {
+	return &usageError{message: fmt.Sprintf(format, args...)}
+}
+
+// IsCommand reports whether the argument names a CLI subcommand or help
+func IsCommand(arg string) bool {
+	if arg == "help" || arg == "-h" || arg == "--help" {
+		return true
+	}
+	_, found := findCommand(arg)
+	return found
+}
+
+// Run executes a command line such as "list --board ./board" and returns the process exit code
+func Run(args []string, stdout, stderr io.Writer) int {
+	// Keep stdout for command output; diagnostics only go to stderr unless configured otherwise
+	if os.Getenv("LOG_CONSOLE") == "" {
+		os.Setenv("LOG_CONSOLE", "stderr")
+	}
+	if os.Getenv("LOG_LEVEL") == "" {
+		os.Setenv("LOG_LEVEL", "ERROR")
+	}
+
+	return run(args, &environment{stdout: stdout, stderr: stderr, open: openSession})
+}
+
+// run dispatches a command line using the given environment
+func run(args []string, env *environment) int {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		printUsage(env.stderr)
+		if len(args) == 0 {
+			return ExitUsage
+		}
+		return ExitOK
+	}
+
+	cmd, found := findCommand(args[0])
+	if !found {
+		fmt.Fprintf(env.stderr, "eisenkan: unknown command %q\n\n", args[0])
+		printUsage(env.stderr)
+		return ExitUsage
+	}
+
+	err := cmd.run(env, args[1:])
+	if err == nil {
+		return ExitOK
+	}
+
+	var usageErr *usageError
+	var violationErr *task_manager.RuleViolationError
+	switch {
+	case errors.Is(err, flag.ErrHelp):
+		return ExitOK
+	case errors.As(err, &usageErr):
+		fmt.Fprintf(env.stderr, "eisenkan %s: %v\nusage: eisenkan %s --board <path> [--format table|json]\n", cmd.name, err, cmd.usage)
+		return ExitUsage
+	case errors.As(err, &violationErr):
+		fmt.Fprintf(env.stderr, "eisenkan %s: rejected by board rules (%s)\n", cmd.name, violationErr.Operation)
+		printViolations(env.stderr, violationErr.Violations)
+		return ExitRuleViolation
+	default:
+		fmt.Fprintf(env.stderr, "eisenkan %s: %v\n", cmd.name, err)
+		return ExitError
+	}
+}
+
+// findCommand looks up a subcommand by name
+func findCommand(name string) (command, bool) {
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd, true
+		}
+	}
+	return command{}, false
+}
+
+// printUsage writes the command overview
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: eisenkan <command> --board <path> [--format table|json] [options]")
+	fmt.Fprintln(w, "       eisenkan            (without a command, starts the desktop application)")
+	fmt.Fprintln(w, "       eisenkan-cli <command> ...  (the same commands, built without the desktop application)")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-10s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "The board defaults to $%s when --board is omitted.\n", boardEnvVariable)
+	fmt.Fprintln(w, "Priorities: urgent-important, urgent-not-important, not-urgent-important (default)")
+	fmt.Fprintln(w, "Exit codes: 0 success, 1 error or invalid board, 2 usage error, 3 rule violation")
+}
+
+// printViolations writes rule violations, one per line
+func printViolations(w io.Writer, violations []engines.RuleViolation) {
+	for _, violation := range violations {
+		fmt.Fprintf(w, "  - [%s] %s", violation.RuleID, violation.Message)
+		if violation.Details != "" {
+			fmt.Fprintf(w, " (%s)", violation.Details)
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+// newFlagSet creates a flag set with the options shared by all commands
+func newFlagSet(env *environment, name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(env.stderr)
+	fs.StringVar(&env.boardPath, "board", os.Getenv(boardEnvVariable), "path to the board directory")
+	fs.StringVar(&env.format, "format", "table", "output format: table or json")
+	return fs
+}
+
+// parseArgs parses flags that may appear before or after positional arguments
+func parseArgs(env *environment, fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+			return nil, &usageError{message: err.Error()}
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+
+	if env.boardPath == "" {
+		return nil, newUsageError("--board is required (or set %s)", boardEnvVariable)
+	}
+	if env.format != "table" && env.format != "json" {
+		return nil, newUsageError("unsupported format %q", env.format)
+	}
+	return positional, nil
+}
+
+// requireTaskID extracts the single task ID argument
+func requireTaskID(positional []string) (string, error) {
+	if len(positional) != 1 {
+		return "", newUsageError("expected exactly one task ID, got %d arguments", len(positional))
+	}
+	return positional[0], nil
+}
+
+// session holds the backend components for one board
+type session struct {
+	taskManager task_manager.TaskManager
+	logger      utilities.ILoggingUtility
+	closers     []func() error
+}
+
+// openSession wires the backend for a board the same way the desktop application does
+func openSession(boardPath string) (*session, error) {
+	info, err := os.Stat(boardPath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot access board directory %s: %w", boardPath, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("board path is not a directory: %s", boardPath)
+	}
+
+	s := &session{logger: utilities.NewLoggingUtility()}
+	fail := func(err error) (*session, error) {
+		s.close()
+		return nil, err
+	}
+
+	repository, err := utilities.InitializeRepositoryWithConfig(boardPath, &utilities.AuthorConfiguration{
+		User:  "EisenKan CLI",
+		Email: "cli@eisenkan.local",
+	})
+	if err != nil {
+		return fail(fmt.Errorf("failed to open board repository: %w", err))
+	}
+	s.closers = append(s.closers, repository.Close)
+
+	boardAccess, err := board_access.NewBoardAccess(boardPath)
+	if err != nil {
+		return fail(fmt.Errorf("failed to open board: %w", err))
+	}
+	s.closers = append(s.closers, boardAccess.Close)
+
+	rulesAccess, err := resource_access.NewRulesAccess(boardPath)
+	if err != nil {
+		return fail(fmt.Errorf("failed to open board rules: %w", err))
+	}
+	s.closers = append(s.closers, rulesAccess.Close)
+
+	ruleEngine, err := engines.NewRuleEngine(rulesAccess, boardAccess)
+	if err != nil {
+		return fail(fmt.Errorf("failed to create rule engine: %w", err))
+	}
+	s.closers = append(s.closers, ruleEngine.Close)
+
+	s.taskManager = task_manager.NewTaskManager(boardAccess, ruleEngine, s.logger, repository, boardPath)
+	return s, nil
+}
+
+// close releases the backend components in reverse order of creation
+func (s *session) close() {
+	for i := len(s.closers) - 1; i >= 0; i-- {
+		s.closers[i]()
+	}
+	s.closers = nil
+}
+
+// withSession opens the board, runs fn and closes the board again
+func withSession(env *environment, fn func(tm task_manager.TaskManager) error) error {
+	s, err := env.open(env.boardPath)
+	if err != nil {
+		return err
+	}
+	defer s.close()
+	return fn(s.taskManager)
+}
+
+// priorityLabels maps Eisenhower labels to priorities; the board does not store the
+// not-urgent-not-important quadrant
+var priorityLabels = map[string]board_access.Priority{
+	"urgent-important":     {Urgent: true, Important: true, Label: "urgent-important"},
+	"urgent-not-important": {Urgent: true, Important: false, Label: "urgent-not-important"},
+	"not-urgent-important": {Urgent: false, Important: true, Label: "not-urgent-important"},
+}
+
+// parsePriority converts an Eisenhower label into a priority
+func parsePriority(label string) (board_access.Priority, error) {
+	priority, found := priorityLabels[label]
+	if !found {
+		labels := make([]string, 0, len(priorityLabels))
+		for known := range priorityLabels {
+			labels = append(labels, known)
+		}
+		sort.Strings(labels)
+		return board_access.Priority{}, newUsageError("unknown priority %q (expected one of %s)", label, strings.Join(labels, ", "))
+	}
+	return priority, nil
+}
+
+// parseDate accepts a calendar date (2006-01-02) in local time or an RFC 3339 timestamp
+func parseDate(name, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		return &t, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return &t, nil
+	}
+	return nil, newUsageError("invalid %s %q (expected YYYY-MM-DD or RFC 3339)", name, value)
+}
+
+// parseList splits a comma-separated flag value, dropping empty items
+func parseList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// flagWasSet reports whether a flag was given on the command line
+func flagWasSet(fs *flag.FlagSet, name string) bool {
+	set := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
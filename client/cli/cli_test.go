@@ -0,0 +1,372 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/rknuus/eisenkan/internal/engines"
+	"github.com/rknuus/eisenkan/internal/managers/task_manager"
+)
+
+// runCLI executes a command line against the board and returns exit code, stdout and stderr
+func runCLI(t *testing.T, boardPath string, args ...string) (int, string, string) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	code := Run(append(args, "--board", boardPath), &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestUnit_CLI_UsageErrors(t *testing.T) {
+	boardPath := t.TempDir()
+
+	testCases := []struct {
+		name string
+		args []string
+	}{
+		{"no arguments", nil},
+		{"unknown command", []string{"frobnicate"}},
+		{"missing board", []string{"list"}},
+		{"unsupported format", []string{"list", "--board", boardPath, "--format", "xml"}},
+		{"missing description", []string{"add", "--board", boardPath}},
+		{"unknown priority", []string{"add", "--board", boardPath, "--description", "x", "--priority", "whenever"}},
+		{"invalid date", []string{"add", "--board", boardPath, "--description", "x", "--deadline", "tomorrow"}},
+		{"missing task ID", []string{"move", "--board", boardPath, "--status", "doing"}},
+		{"missing status", []string{"move", "abc", "--board", boardPath}},
+		{"unknown flag", []string{"list", "--board", boardPath, "--colour", "red"}},
+		{"unknown CSV report", []string{"stats", "--board", boardPath, "--csv", "burndown"}},
+		{"invalid since date", []string{"simulate", "--board", boardPath, "--since", "last week"}},
+	}
+
+	t.Setenv(boardEnvVariable, "")
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			opened := false
+			env := &environment{stdout: &stdout, stderr: &stderr, open: func(string) (*session, error) {
+				opened = true
+				return nil, os.ErrNotExist
+			}}
+
+			if code := run(tc.args, env); code != ExitUsage {
+				t.Errorf("Expected exit code %d, got %d (stderr: %s)", ExitUsage, code, stderr.String())
+			}
+			if opened {
+				t.Error("Board must not be opened for an invalid command line")
+			}
+			if stdout.Len() != 0 {
+				t.Errorf("Expected no output on stdout, got %q", stdout.String())
+			}
+		})
+	}
+}
+
+func TestUnit_CLI_BoardFromEnvironment(t *testing.T) {
+	t.Setenv(boardEnvVariable, "/boards/from-env")
+
+	var stdout, stderr bytes.Buffer
+	var openedPath string
+	env := &environment{stdout: &stdout, stderr: &stderr, open: func(boardPath string) (*session, error) {
+		openedPath = boardPath
+		return nil, os.ErrNotExist
+	}}
+
+	if code := run([]string{"list"}, env); code != ExitError {
+		t.Errorf("Expected exit code %d, got %d", ExitError, code)
+	}
+	if openedPath != "/boards/from-env" {
+		t.Errorf("Expected board from %s, got %q", boardEnvVariable, openedPath)
+	}
+}
+
+func TestUnit_CLI_IsCommand(t *testing.T) {
+	for _, arg := range []string{"list", "add", "move", "edit", "archive", "promote", "validate", "simulate", "repair", "stats", "serve", "help", "--help"} {
+		if !IsCommand(arg) {
+			t.Errorf("Expected %q to be a command", arg)
+		}
+	}
+	for _, arg := range []string{"", "-v", "/path/to/board", "serve-gui"} {
+		if IsCommand(arg) {
+			t.Errorf("Expected %q not to be a command", arg)
+		}
+	}
+}
+
+func TestIntegration_CLI_TaskLifecycle(t *testing.T) {
+	boardPath := t.TempDir()
+
+	// Create
+	code, stdout, stderr := runCLI(t, boardPath, "add", "--description", "Write report",
+		"--priority", "urgent-important", "--tags", "work,writing", "--deadline", "2030-01-15", "--format", "json")
+	if code != ExitOK {
+		t.Fatalf("add failed with %d: %s", code, stderr)
+	}
+	var created task_manager.TaskResponse
+	if err := json.Unmarshal([]byte(stdout), &created); err != nil {
+		t.Fatalf("add did not print JSON: %v\n%s", err, stdout)
+	}
+	if created.ID == "" || created.Description != "Write report" || created.WorkflowStatus != task_manager.Todo {
+		t.Fatalf("Unexpected created task: %+v", created)
+	}
+	if !created.Priority.Urgent || !created.Priority.Important || len(created.Tags) != 2 {
+		t.Errorf("Unexpected priority or tags: %+v", created)
+	}
+
+	if code, _, stderr := runCLI(t, boardPath, "add", "--description", "Tidy desk"); code != ExitOK {
+		t.Fatalf("second add failed with %d: %s", code, stderr)
+	}
+
+	// List with filters, as table and JSON
+	code, stdout, _ = runCLI(t, boardPath, "list")
+	if code != ExitOK || !strings.Contains(stdout, "Write report") || !strings.Contains(stdout, "Tidy desk") {
+		t.Errorf("Unexpected list output (%d):\n%s", code, stdout)
+	}
+	code, stdout, _ = runCLI(t, boardPath, "list", "--priority", "urgent-important", "--format", "json")
+	var listed []task_manager.TaskResponse
+	if err := json.Unmarshal([]byte(stdout), &listed); err != nil || code != ExitOK {
+		t.Fatalf("list did not print JSON (%d): %v\n%s", code, err, stdout)
+	}
+	if len(listed) != 1 || listed[0].ID != created.ID {
+		t.Errorf("Expected only the urgent-important task, got %+v", listed)
+	}
+
+	// Move and edit; the task ID may precede or follow the flags
+	if code, _, stderr := runCLI(t, boardPath, "move", created.ID, "--status", "doing"); code != ExitOK {
+		t.Fatalf("move failed with %d: %s", code, stderr)
+	}
+	code, stdout, stderr = runCLI(t, boardPath, "edit", "--description", "Write final report", created.ID, "--format", "json")
+	if code != ExitOK {
+		t.Fatalf("edit failed with %d: %s", code, stderr)
+	}
+	var edited task_manager.TaskResponse
+	if err := json.Unmarshal([]byte(stdout), &edited); err != nil {
+		t.Fatalf("edit did not print JSON: %v", err)
+	}
+	if edited.Description != "Write final report" || edited.WorkflowStatus != task_manager.InProgress || len(edited.Tags) != 2 || edited.Deadline == nil {
+		t.Errorf("Edit must only change the given fields, got %+v", edited)
+	}
+
+	// Statistics and CSV reports
+	code, stdout, stderr = runCLI(t, boardPath, "stats")
+	if code != ExitOK || !strings.Contains(stdout, "Total tasks") || !strings.Contains(stdout, "Cycle time") {
+		t.Errorf("Unexpected stats output (%d): %s\n%s", code, stdout, stderr)
+	}
+	code, stdout, _ = runCLI(t, boardPath, "stats", "--csv", "cfd")
+	if code != ExitOK || !strings.HasPrefix(stdout, "date,todo,doing,done,wip") {
+		t.Errorf("Unexpected cumulative flow CSV (%d):\n%s", code, stdout)
+	}
+
+	// Archive removes the task from the board
+	if code, _, stderr := runCLI(t, boardPath, "archive", created.ID); code != ExitOK {
+		t.Fatalf("archive failed with %d: %s", code, stderr)
+	}
+	code, stdout, _ = runCLI(t, boardPath, "list", "--format", "json")
+	listed = nil
+	if err := json.Unmarshal([]byte(stdout), &listed); err != nil || code != ExitOK || len(listed) != 1 {
+		t.Errorf("Expected one remaining task after archive, got %s", stdout)
+	}
+
+	// Unknown tasks are errors, not usage errors
+	if code, _, _ := runCLI(t, boardPath, "move", "no-such-task", "--status", "done"); code != ExitError {
+		t.Errorf("Expected exit code %d for unknown task, got %d", ExitError, code)
+	}
+
+	if code, stdout, stderr := runCLI(t, boardPath, "promote"); code != ExitOK {
+		t.Errorf("promote failed with %d: %s%s", code, stdout, stderr)
+	}
+}
+
+func TestIntegration_CLI_RuleViolations(t *testing.T) {
+	boardPath := t.TempDir()
+	rules := `{"version":"1.0","rules":[{"id":"wip-limit","name":"WIP limit","category":"validation","trigger_type":"all","conditions":{"max_wip_limit":1},"priority":1,"enabled":true}]}`
+	if err := os.WriteFile(filepath.Join(boardPath, "rules.json"), []byte(rules), 0644); err != nil {
+		t.Fatalf("Failed to write rules: %v", err)
+	}
+
+	if code, _, stderr := runCLI(t, boardPath, "add", "--description", "First"); code != ExitOK {
+		t.Fatalf("first add failed with %d: %s", code, stderr)
+	}
+
+	// A dry run reports the violation without creating a task
+	code, _, stderr := runCLI(t, boardPath, "validate", "--description", "Second")
+	if code != ExitRuleViolation || !strings.Contains(stderr, "wip-limit") {
+		t.Errorf("Expected rule violation from validate, got %d: %s", code, stderr)
+	}
+
+	code, _, stderr = runCLI(t, boardPath, "add", "--description", "Second")
+	if code != ExitRuleViolation || !strings.Contains(stderr, "WIP limit exceeded") {
+		t.Errorf("Expected rule violation from add, got %d: %s", code, stderr)
+	}
+
+	_, stdout, _ := runCLI(t, boardPath, "list", "--format", "json")
+	var listed []task_manager.TaskResponse
+	if err := json.Unmarshal([]byte(stdout), &listed); err != nil || len(listed) != 1 {
+		t.Errorf("Expected the rejected task not to be stored, got %s", stdout)
+	}
+
+	// Board validation reports on the board structure
+	code, stdout, stderr = runCLI(t, boardPath, "validate")
+	if code != ExitOK && code != ExitError {
+		t.Errorf("Unexpected exit code %d from board validation: %s", code, stderr)
+	}
+	if !strings.Contains(stdout, "Board is") {
+		t.Errorf("Expected board validation summary, got %q", stdout)
+	}
+}
+
+func TestIntegration_CLI_SimulateRules(t *testing.T) {
+	boardPath := t.TempDir()
+	for _, description := range []string{"First", "Second"} {
+		if code, _, stderr := runCLI(t, boardPath, "add", "--description", description); code != ExitOK {
+			t.Fatalf("add failed with %d: %s", code, stderr)
+		}
+	}
+	_, stdout, _ := runCLI(t, boardPath, "list", "--format", "json")
+	var listed []task_manager.TaskResponse
+	if err := json.Unmarshal([]byte(stdout), &listed); err != nil || len(listed) != 2 {
+		t.Fatalf("Expected two tasks, got %s", stdout)
+	}
+	if code, _, stderr := runCLI(t, boardPath, "move", listed[0].ID, "--status", "doing"); code != ExitOK {
+		t.Fatalf("move failed with %d: %s", code, stderr)
+	}
+
+	rulesPath := filepath.Join(t.TempDir(), "candidate.json")
+	rules := `{"version":"1.0","rules":[{"id":"wip-limit","name":"WIP limit","category":"validation","trigger_type":"all","conditions":{"max_wip_limit":1},"actions":{"block":true},"priority":1}]}`
+	if err := os.WriteFile(rulesPath, []byte(rules), 0644); err != nil {
+		t.Fatalf("Failed to write rules: %v", err)
+	}
+
+	// Adding the second task to todo would have exceeded the candidate WIP limit
+	code, stdout, stderr := runCLI(t, boardPath, "simulate", "--rules", rulesPath)
+	if code != ExitOK || !strings.Contains(stdout, "created in todo") || !strings.Contains(stdout, "wip-limit  1 violations") {
+		t.Errorf("Unexpected simulate output (%d): %s%s", code, stdout, stderr)
+	}
+	code, stdout, _ = runCLI(t, boardPath, "simulate", "--rules", rulesPath, "--since", time.Now().Add(time.Hour).Format(time.RFC3339), "--format", "json")
+	var report engines.SimulationReport
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil || code != ExitOK {
+		t.Fatalf("simulate did not print JSON (%d): %v\n%s", code, err, stdout)
+	}
+	if report.RulesSimulated != 1 || report.TasksEvaluated != 2 || report.ChangesReplayed != 0 || len(report.Violations) != 0 {
+		t.Errorf("Expected no violations without history, got %+v", report)
+	}
+
+	// The candidate rules are not stored
+	if code, _, stderr := runCLI(t, boardPath, "add", "--description", "Third"); code != ExitOK {
+		t.Errorf("Expected the board rules to be unchanged, add failed with %d: %s", code, stderr)
+	}
+	if code, _, _ := runCLI(t, boardPath, "simulate", "--rules", filepath.Join(boardPath, "missing.json")); code != ExitError {
+		t.Errorf("Expected exit code %d for a missing rules file, got %d", ExitError, code)
+	}
+}
+
+func TestIntegration_CLI_RepairBoard(t *testing.T) {
+	boardPath := t.TempDir()
+	if err := os.WriteFile(filepath.Join(boardPath, "board.json"), []byte("{ not json"), 0644); err != nil {
+		t.Fatalf("Failed to write board.json: %v", err)
+	}
+
+	if code, stdout, _ := runCLI(t, boardPath, "validate"); code != ExitError || !strings.Contains(stdout, "Invalid board.json format") {
+		t.Fatalf("Expected the corrupt board.json to be reported, got %d: %s", code, stdout)
+	}
+
+	code, stdout, stderr := runCLI(t, boardPath, "repair")
+	if code != ExitOK || !strings.Contains(stdout, "Recreated board.json") || !strings.Contains(stdout, "Board is valid") {
+		t.Fatalf("Expected the board to be repaired, got %d: %s%s", code, stdout, stderr)
+	}
+
+	if code, stdout, _ := runCLI(t, boardPath, "repair"); code != ExitOK || !strings.Contains(stdout, "Nothing to repair") {
+		t.Errorf("Expected nothing left to repair, got %d: %s", code, stdout)
+	}
+}
+
+func TestIntegration_CLI_RemoteSync(t *testing.T) {
+	root := t.TempDir()
+	remotePath := filepath.Join(root, "remote.git")
+	if _, err := git.PlainInit(remotePath, true); err != nil {
+		t.Fatalf("Failed to create bare repository: %v", err)
+	}
+	laptop, desktop := filepath.Join(root, "laptop"), filepath.Join(root, "desktop")
+	for _, boardPath := range []string{laptop, desktop} {
+		if err := os.MkdirAll(boardPath, 0755); err != nil {
+			t.Fatalf("Failed to create board directory: %v", err)
+		}
+		if code, _, stderr := runCLI(t, boardPath, "remote", "add", "origin", remotePath); code != ExitOK {
+			t.Fatalf("remote add failed with %d: %s", code, stderr)
+		}
+	}
+
+	code, stdout, _ := runCLI(t, laptop, "remote")
+	if code != ExitOK || !strings.Contains(stdout, "origin") || !strings.Contains(stdout, remotePath) {
+		t.Errorf("Unexpected remote output (%d):\n%s", code, stdout)
+	}
+	if code, _, _ := runCLI(t, laptop, "remote", "rename", "origin"); code != ExitUsage {
+		t.Errorf("Expected a usage error for an unknown remote subcommand, got %d", code)
+	}
+
+	if code, _, stderr := runCLI(t, laptop, "add", "--description", "Shared task"); code != ExitOK {
+		t.Fatalf("add failed with %d: %s", code, stderr)
+	}
+	if code, _, stderr := runCLI(t, laptop, "sync"); code != ExitOK {
+		t.Fatalf("sync failed with %d: %s", code, stderr)
+	}
+
+	code, stdout, stderr := runCLI(t, desktop, "sync", "origin", "--format", "json")
+	if code != ExitOK {
+		t.Fatalf("sync failed with %d: %s", code, stderr)
+	}
+	var response task_manager.SyncResponse
+	if err := json.Unmarshal([]byte(stdout), &response); err != nil || len(response.ChangedTaskIDs) != 1 {
+		t.Errorf("Expected the shared task to be pulled, got %s (%v)", stdout, err)
+	}
+	if _, stdout, _ := runCLI(t, desktop, "list"); !strings.Contains(stdout, "Shared task") {
+		t.Errorf("Expected the shared task on the other board, got:\n%s", stdout)
+	}
+
+	if code, stdout, _ := runCLI(t, desktop, "sync"); code != ExitOK || !strings.Contains(stdout, "up to date") {
+		t.Errorf("Expected the board to be up to date (%d):\n%s", code, stdout)
+	}
+}
+
+func TestUnit_CLI_ServeShutsDownOnCancel(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+
+	var stderr bytes.Buffer
+	env := &environment{stdout: &bytes.Buffer{}, stderr: &stderr, boardPath: "board"}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- serve(ctx, env, listener, handler)
+	}()
+
+	resp, err := http.Get("http://" + listener.Addr().String() + "/api/tasks")
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusTeapot {
+		t.Errorf("Expected the handler to serve the request, got %d", resp.StatusCode)
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("Expected clean shutdown, got %v", err)
+	}
+	if !strings.Contains(stderr.String(), listener.Addr().String()) {
+		t.Errorf("Expected the listen address to be reported, got %q", stderr.String())
+	}
+}
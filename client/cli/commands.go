@@ -0,0 +1,575 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/rknuus/eisenkan/api"
+	"github.com/rknuus/eisenkan/client/rest"
+	"github.com/rknuus/eisenkan/internal/managers/task_manager"
+	"github.com/rknuus/eisenkan/internal/resource_access"
+	"github.com/rknuus/eisenkan/internal/resource_access/board_access"
+	"github.com/rknuus/eisenkan/internal/rpc"
+)
+
+// taskFlags holds the task attribute flags shared by add, edit and validate
+type taskFlags struct {
+	description   string
+	priority      string
+	status        string
+	tags          string
+	deadline      string
+	promotionDate string
+	parent        string
+}
+
+// register adds the task attribute flags to a flag set
+func (tf *taskFlags) register(fs *flag.FlagSet, defaultPriority, defaultStatus string, withParent bool) {
+	fs.StringVar(&tf.description, "description", "", "task description")
+	fs.StringVar(&tf.priority, "priority", defaultPriority, "Eisenhower priority label")
+	fs.StringVar(&tf.status, "status", defaultStatus, "workflow status (todo, doing, done)")
+	fs.StringVar(&tf.tags, "tags", "", "comma-separated tags")
+	fs.StringVar(&tf.deadline, "deadline", "", "deadline (YYYY-MM-DD or RFC 3339)")
+	fs.StringVar(&tf.promotionDate, "promotion-date", "", "priority promotion date (YYYY-MM-DD or RFC 3339)")
+	if withParent {
+		fs.StringVar(&tf.parent, "parent", "", "parent task ID, creates a subtask")
+	}
+}
+
+// request builds a task request from the flags
+func (tf *taskFlags) request() (task_manager.TaskRequest, error) {
+	priority, err := parsePriority(tf.priority)
+	if err != nil {
+		return task_manager.TaskRequest{}, err
+	}
+	deadline, err := parseDate("deadline", tf.deadline)
+	if err != nil {
+		return task_manager.TaskRequest{}, err
+	}
+	promotionDate, err := parseDate("promotion date", tf.promotionDate)
+	if err != nil {
+		return task_manager.TaskRequest{}, err
+	}
+
+	request := task_manager.TaskRequest{
+		Description:           tf.description,
+		Priority:              priority,
+		WorkflowStatus:        task_manager.WorkflowStatus(tf.status),
+		Tags:                  parseList(tf.tags),
+		Deadline:              deadline,
+		PriorityPromotionDate: promotionDate,
+	}
+	if tf.parent != "" {
+		parent := tf.parent
+		request.ParentTaskID = &parent
+	}
+	return request, nil
+}
+
+// runList prints the tasks matching the filters
+func runList(env *environment, args []string) error {
+	fs := newFlagSet(env, "list")
+	statuses := fs.String("status", "", "comma-separated workflow statuses to include")
+	priorityLabel := fs.String("priority", "", "only tasks with this Eisenhower priority")
+	tags := fs.String("tags", "", "only tasks with these comma-separated tags")
+	positional, err := parseArgs(env, fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return newUsageError("unexpected arguments: %v", positional)
+	}
+
+	criteria := task_manager.QueryCriteria{
+		Columns: parseList(*statuses),
+		Tags:    parseList(*tags),
+	}
+	if *priorityLabel != "" {
+		priority, err := parsePriority(*priorityLabel)
+		if err != nil {
+			return err
+		}
+		criteria.Priority = &priority
+	}
+
+	return withSession(env, func(tm task_manager.TaskManager) error {
+		tasks, err := tm.ListTasks(criteria)
+		if err != nil {
+			return err
+		}
+		return writeTasks(env, tasks)
+	})
+}
+
+// runAdd creates a task
+func runAdd(env *environment, args []string) error {
+	fs := newFlagSet(env, "add")
+	var flags taskFlags
+	flags.register(fs, "not-urgent-important", string(task_manager.Todo), true)
+	positional, err := parseArgs(env, fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return newUsageError("unexpected arguments: %v", positional)
+	}
+	if flags.description == "" {
+		return newUsageError("--description is required")
+	}
+
+	request, err := flags.request()
+	if err != nil {
+		return err
+	}
+
+	return withSession(env, func(tm task_manager.TaskManager) error {
+		task, err := tm.CreateTask(request)
+		if err != nil {
+			return err
+		}
+		return writeTask(env, task)
+	})
+}
+
+// runMove changes the workflow status of a task
+func runMove(env *environment, args []string) error {
+	fs := newFlagSet(env, "move")
+	status := fs.String("status", "", "new workflow status (todo, doing, done)")
+	positional, err := parseArgs(env, fs, args)
+	if err != nil {
+		return err
+	}
+	taskID, err := requireTaskID(positional)
+	if err != nil {
+		return err
+	}
+	if *status == "" {
+		return newUsageError("--status is required")
+	}
+
+	return withSession(env, func(tm task_manager.TaskManager) error {
+		task, err := tm.ChangeTaskStatus(taskID, task_manager.WorkflowStatus(*status), nil)
+		if err != nil {
+			return err
+		}
+		return writeTask(env, task)
+	})
+}
+
+// runEdit changes the given attributes of a task and keeps all others
+func runEdit(env *environment, args []string) error {
+	fs := newFlagSet(env, "edit")
+	var flags taskFlags
+	flags.register(fs, "", "", false)
+	positional, err := parseArgs(env, fs, args)
+	if err != nil {
+		return err
+	}
+	taskID, err := requireTaskID(positional)
+	if err != nil {
+		return err
+	}
+	if flagWasSet(fs, "status") {
+		return newUsageError("use 'eisenkan move' to change the status")
+	}
+
+	return withSession(env, func(tm task_manager.TaskManager) error {
+		current, err := tm.GetTask(taskID)
+		if err != nil {
+			return err
+		}
+
+		request := task_manager.TaskRequest{
+			Description:           current.Description,
+			Priority:              current.Priority,
+			WorkflowStatus:        current.WorkflowStatus,
+			Tags:                  current.Tags,
+			Deadline:              current.Deadline,
+			PriorityPromotionDate: current.PriorityPromotionDate,
+			ParentTaskID:          current.ParentTaskID,
+		}
+		if flagWasSet(fs, "description") {
+			request.Description = flags.description
+		}
+		if flagWasSet(fs, "priority") {
+			if request.Priority, err = parsePriority(flags.priority); err != nil {
+				return err
+			}
+		}
+		if flagWasSet(fs, "tags") {
+			request.Tags = parseList(flags.tags)
+		}
+		if flagWasSet(fs, "deadline") {
+			if request.Deadline, err = parseDate("deadline", flags.deadline); err != nil {
+				return err
+			}
+		}
+		if flagWasSet(fs, "promotion-date") {
+			if request.PriorityPromotionDate, err = parseDate("promotion date", flags.promotionDate); err != nil {
+				return err
+			}
+		}
+
+		task, err := tm.UpdateTask(taskID, request, nil)
+		if err != nil {
+			return err
+		}
+		return writeTask(env, task)
+	})
+}
+
+// runArchive archives a task together with its subtasks
+func runArchive(env *environment, args []string) error {
+	fs := newFlagSet(env, "archive")
+	positional, err := parseArgs(env, fs, args)
+	if err != nil {
+		return err
+	}
+	taskID, err := requireTaskID(positional)
+	if err != nil {
+		return err
+	}
+
+	return withSession(env, func(tm task_manager.TaskManager) error {
+		task, err := tm.ArchiveTask(taskID, board_access.ArchiveSubtasks, nil)
+		if err != nil {
+			return err
+		}
+		return writeTask(env, task)
+	})
+}
+
+// runPromote promotes all tasks whose priority promotion date has been reached
+func runPromote(env *environment, args []string) error {
+	fs := newFlagSet(env, "promote")
+	positional, err := parseArgs(env, fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return newUsageError("unexpected arguments: %v", positional)
+	}
+
+	return withSession(env, func(tm task_manager.TaskManager) error {
+		promoted, err := tm.ProcessPriorityPromotions()
+		if err != nil {
+			return err
+		}
+		return writeTasks(env, promoted)
+	})
+}
+
+// runValidate checks the board structure, or a prospective task when task flags are given
+func runValidate(env *environment, args []string) error {
+	fs := newFlagSet(env, "validate")
+	var flags taskFlags
+	flags.register(fs, "not-urgent-important", string(task_manager.Todo), true)
+	positional, err := parseArgs(env, fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return newUsageError("unexpected arguments: %v", positional)
+	}
+
+	// Task validation is a dry run of "add" against the board rules
+	if flags.description != "" {
+		request, err := flags.request()
+		if err != nil {
+			return err
+		}
+		return withSession(env, func(tm task_manager.TaskManager) error {
+			result, err := tm.ValidateTask(request)
+			if err != nil {
+				return err
+			}
+			if err := writeValidation(env, result); err != nil {
+				return err
+			}
+			if !result.Valid {
+				return &task_manager.RuleViolationError{Operation: "task validation", Violations: result.Violations}
+			}
+			return nil
+		})
+	}
+
+	return withSession(env, func(tm task_manager.TaskManager) error {
+		result, err := tm.ValidateBoardDirectory(env.boardPath)
+		if err != nil {
+			return err
+		}
+		if err := writeBoardValidation(env, result); err != nil {
+			return err
+		}
+		if !result.IsValid {
+			return fmt.Errorf("board is invalid: %d issues", len(result.Issues))
+		}
+		return nil
+	})
+}
+
+// runSimulate reports the violations the rules of a file, or of the board, would cause without storing them
+func runSimulate(env *environment, args []string) error {
+	fs := newFlagSet(env, "simulate")
+	rulesFile := fs.String("rules", "", "rules.json file to simulate (default: the rules of the board)")
+	since := fs.String("since", "", "replay the board history from this date on (YYYY-MM-DD or RFC 3339; default: all)")
+	positional, err := parseArgs(env, fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return newUsageError("unexpected arguments: %v", positional)
+	}
+
+	from, err := parseDate("since date", *since)
+	if err != nil {
+		return err
+	}
+	var sinceTime time.Time
+	if from != nil {
+		sinceTime = *from
+	}
+
+	var ruleSet *resource_access.RuleSet
+	if *rulesFile != "" {
+		content, err := os.ReadFile(*rulesFile)
+		if err != nil {
+			return fmt.Errorf("failed to read rules: %w", err)
+		}
+		ruleSet = &resource_access.RuleSet{}
+		if err := json.Unmarshal(content, ruleSet); err != nil {
+			return fmt.Errorf("failed to parse rules %s: %w", *rulesFile, err)
+		}
+	}
+
+	return withSession(env, func(tm task_manager.TaskManager) error {
+		report, err := tm.SimulateRules(ruleSet, sinceTime)
+		if err != nil {
+			return err
+		}
+		return writeSimulation(env, report)
+	})
+}
+
+// runRepair fixes the repairable issues of board validation and reports the repaired board
+func runRepair(env *environment, args []string) error {
+	fs := newFlagSet(env, "repair")
+	positional, err := parseArgs(env, fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return newUsageError("unexpected arguments: %v", positional)
+	}
+
+	return withSession(env, func(tm task_manager.TaskManager) error {
+		response, err := tm.RepairBoard()
+		if err != nil {
+			return err
+		}
+		if err := writeBoardRepair(env, response); err != nil {
+			return err
+		}
+		if !response.Validation.IsValid {
+			return fmt.Errorf("board is still invalid: %d issues", len(response.Validation.Issues))
+		}
+		return nil
+	})
+}
+
+// runStats prints board statistics and flow metrics, or a flow metrics CSV report
+func runStats(env *environment, args []string) error {
+	fs := newFlagSet(env, "stats")
+	from := fs.String("from", "", "start of the reporting range (YYYY-MM-DD or RFC 3339)")
+	to := fs.String("to", "", "end of the reporting range (YYYY-MM-DD or RFC 3339)")
+	csvReport := fs.String("csv", "", "write a CSV report instead: tasks, throughput or cfd")
+	positional, err := parseArgs(env, fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return newUsageError("unexpected arguments: %v", positional)
+	}
+
+	dateRange := &board_access.DateRange{}
+	if dateRange.From, err = parseDate("from date", *from); err != nil {
+		return err
+	}
+	if dateRange.To, err = parseDate("to date", *to); err != nil {
+		return err
+	}
+	if dateRange.To != nil && *to != "" && len(*to) == len("2006-01-02") {
+		// A calendar end date includes the whole day
+		endOfDay := dateRange.To.AddDate(0, 0, 1).Add(-1)
+		dateRange.To = &endOfDay
+	}
+
+	switch *csvReport {
+	case "", "tasks", "throughput", "cfd":
+	default:
+		return newUsageError("unknown CSV report %q", *csvReport)
+	}
+
+	return withSession(env, func(tm task_manager.TaskManager) error {
+		metrics, err := tm.GetFlowMetrics(env.boardPath, dateRange)
+		if err != nil {
+			return err
+		}
+
+		switch *csvReport {
+		case "tasks":
+			return metrics.WriteTasksCSV(env.stdout)
+		case "throughput":
+			return metrics.WriteThroughputCSV(env.stdout)
+		case "cfd":
+			return metrics.WriteCumulativeFlowCSV(env.stdout)
+		}
+
+		stats, err := tm.GetBoardStatistics(env.boardPath)
+		if err != nil {
+			return err
+		}
+		return writeStats(env, stats, metrics)
+	})
+}
+
+// runRemote lists the board's git remotes, or adds or removes one
+func runRemote(env *environment, args []string) error {
+	fs := newFlagSet(env, "remote")
+	positional, err := parseArgs(env, fs, args)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case len(positional) == 0:
+		return withSession(env, func(tm task_manager.TaskManager) error {
+			remotes, err := tm.ListBoardRemotes()
+			if err != nil {
+				return err
+			}
+			return writeRemotes(env, remotes)
+		})
+	case positional[0] == "add" && len(positional) == 3:
+		return withSession(env, func(tm task_manager.TaskManager) error {
+			return tm.AddBoardRemote(positional[1], positional[2])
+		})
+	case positional[0] == "remove" && len(positional) == 2:
+		return withSession(env, func(tm task_manager.TaskManager) error {
+			return tm.RemoveBoardRemote(positional[1])
+		})
+	default:
+		return newUsageError("unexpected arguments: %v", positional)
+	}
+}
+
+// runSync merges the remote's task changes into the board and pushes the result
+func runSync(env *environment, args []string) error {
+	fs := newFlagSet(env, "sync")
+	positional, err := parseArgs(env, fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 1 {
+		return newUsageError("expected at most one remote, got %d arguments", len(positional))
+	}
+	remote := ""
+	if len(positional) == 1 {
+		remote = positional[0]
+	}
+
+	return withSession(env, func(tm task_manager.TaskManager) error {
+		response, err := tm.SyncBoard(remote)
+		if err != nil {
+			return err
+		}
+		return writeSync(env, response)
+	})
+}
+
+// runServe serves the board over HTTP, and optionally gRPC, until the process is interrupted
+func runServe(env *environment, args []string) error {
+	fs := newFlagSet(env, "serve")
+	addr := fs.String("addr", "127.0.0.1:8080", "listen address")
+	grpcAddr := fs.String("grpc-addr", "", "listen address of the gRPC TaskManager service (disabled if empty)")
+	positional, err := parseArgs(env, fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return newUsageError("unexpected arguments: %v", positional)
+	}
+
+	s, err := env.open(env.boardPath)
+	if err != nil {
+		return err
+	}
+	defer s.close()
+
+	listener, err := net.Listen("tcp", *addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", *addr, err)
+	}
+
+	if *grpcAddr != "" {
+		grpcListener, err := net.Listen("tcp", *grpcAddr)
+		if err != nil {
+			listener.Close()
+			return fmt.Errorf("failed to listen on %s: %w", *grpcAddr, err)
+		}
+		grpcServer := serveGRPC(env, grpcListener, s)
+		defer grpcServer.GracefulStop()
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	return serve(ctx, env, listener, rest.NewServer(s.taskManager, env.boardPath, s.logger))
+}
+
+// serveGRPC starts the gRPC TaskManager service on listener in the background
+func serveGRPC(env *environment, listener net.Listener, s *session) *grpc.Server {
+	server := grpc.NewServer()
+	api.RegisterTaskManagerServiceServer(server, rpc.NewServer(s.taskManager, env.boardPath, s.logger))
+
+	fmt.Fprintf(env.stderr, "Serving %s over gRPC on %s\n", env.boardPath, listener.Addr())
+	go func() {
+		if err := server.Serve(listener); err != nil {
+			fmt.Fprintf(env.stderr, "gRPC server stopped: %v\n", err)
+		}
+	}()
+	return server
+}
+
+// serve runs the HTTP server on listener until ctx is cancelled, then shuts it down gracefully
+func serve(ctx context.Context, env *environment, listener net.Listener, handler http.Handler) error {
+	server := &http.Server{Handler: handler, ReadHeaderTimeout: 10 * time.Second}
+
+	fmt.Fprintf(env.stderr, "Serving %s on http://%s/api\n", env.boardPath, listener.Addr())
+	errs := make(chan error, 1)
+	go func() {
+		errs <- server.Serve(listener)
+	}()
+
+	select {
+	case err := <-errs:
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("failed to shut down server: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,290 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/rknuus/eisenkan/internal/engines"
+	"github.com/rknuus/eisenkan/internal/managers/task_manager"
+	"github.com/rknuus/eisenkan/internal/resource_access/board_access"
+)
+
+// writeJSON writes a value as indented JSON
+func writeJSON(w io.Writer, value interface{}) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
+
+// writeTasks writes a task list as a table or JSON array
+func writeTasks(env *environment, tasks []task_manager.TaskResponse) error {
+	if env.format == "json" {
+		if tasks == nil {
+			tasks = []task_manager.TaskResponse{}
+		}
+		return writeJSON(env.stdout, tasks)
+	}
+
+	tw := tabwriter.NewWriter(env.stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tSTATUS\tPRIORITY\tDEADLINE\tTAGS\tDESCRIPTION")
+	for _, task := range tasks {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			task.ID, task.WorkflowStatus, priorityLabel(task.Priority), formatDate(task.Deadline),
+			strings.Join(task.Tags, ","), task.Description)
+	}
+	return tw.Flush()
+}
+
+// writeTask writes a single task as a key/value table or JSON object
+func writeTask(env *environment, task task_manager.TaskResponse) error {
+	if env.format == "json" {
+		return writeJSON(env.stdout, task)
+	}
+
+	tw := tabwriter.NewWriter(env.stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "ID\t%s\n", task.ID)
+	fmt.Fprintf(tw, "Description\t%s\n", task.Description)
+	fmt.Fprintf(tw, "Status\t%s\n", task.WorkflowStatus)
+	fmt.Fprintf(tw, "Priority\t%s\n", priorityLabel(task.Priority))
+	if len(task.Tags) > 0 {
+		fmt.Fprintf(tw, "Tags\t%s\n", strings.Join(task.Tags, ","))
+	}
+	if task.Deadline != nil {
+		fmt.Fprintf(tw, "Deadline\t%s\n", formatDate(task.Deadline))
+	}
+	if task.PriorityPromotionDate != nil {
+		fmt.Fprintf(tw, "Promotion date\t%s\n", formatDate(task.PriorityPromotionDate))
+	}
+	if task.ParentTaskID != nil {
+		fmt.Fprintf(tw, "Parent\t%s\n", *task.ParentTaskID)
+	}
+	if len(task.SubtaskIDs) > 0 {
+		fmt.Fprintf(tw, "Subtasks\t%s\n", strings.Join(task.SubtaskIDs, ","))
+	}
+	return tw.Flush()
+}
+
+// writeValidation writes the result of a task validation
+func writeValidation(env *environment, result task_manager.ValidationResult) error {
+	if env.format == "json" {
+		return writeJSON(env.stdout, result)
+	}
+	if result.Valid {
+		fmt.Fprintln(env.stdout, "Task is valid")
+	}
+	// Violations are reported on stderr by run
+	return nil
+}
+
+// writeBoardValidation writes the result of a board structure validation
+func writeBoardValidation(env *environment, result task_manager.BoardValidationResponse) error {
+	if env.format == "json" {
+		return writeJSON(env.stdout, result)
+	}
+
+	if result.IsValid {
+		fmt.Fprintln(env.stdout, "Board is valid")
+	} else {
+		fmt.Fprintln(env.stdout, "Board is invalid")
+	}
+	for _, issue := range result.Issues {
+		fmt.Fprintf(env.stdout, "  error: %s\n", issue)
+	}
+	for _, warning := range result.Warnings {
+		fmt.Fprintf(env.stdout, "  warning: %s\n", warning)
+	}
+	return nil
+}
+
+// writeBoardRepair writes the changes of a board repair followed by the validation of the repaired board
+func writeBoardRepair(env *environment, response task_manager.BoardRepairResponse) error {
+	if env.format == "json" {
+		return writeJSON(env.stdout, response)
+	}
+
+	switch {
+	case len(response.Actions) == 0:
+		fmt.Fprintln(env.stdout, "Nothing to repair")
+	case response.Commit != "":
+		fmt.Fprintf(env.stdout, "Repaired board in commit %s\n", response.Commit)
+	default:
+		fmt.Fprintln(env.stdout, "Repaired board")
+	}
+	for _, action := range response.Actions {
+		fmt.Fprintf(env.stdout, "  %s\n", action)
+	}
+	return writeBoardValidation(env, response.Validation)
+}
+
+// writeRemotes writes the git remotes of the board
+func writeRemotes(env *environment, remotes []task_manager.BoardRemote) error {
+	if env.format == "json" {
+		return writeJSON(env.stdout, remotes)
+	}
+
+	tw := tabwriter.NewWriter(env.stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tURL")
+	for _, remote := range remotes {
+		fmt.Fprintf(tw, "%s\t%s\n", remote.Name, remote.URL)
+	}
+	return tw.Flush()
+}
+
+// writeSync writes the outcome of a board synchronisation
+func writeSync(env *environment, response task_manager.SyncResponse) error {
+	if env.format == "json" {
+		return writeJSON(env.stdout, response)
+	}
+
+	switch {
+	case response.UpToDate:
+		fmt.Fprintf(env.stdout, "Board is up to date with %s\n", response.Remote)
+	case response.FastForward:
+		fmt.Fprintf(env.stdout, "Updated board from %s\n", response.Remote)
+	default:
+		fmt.Fprintf(env.stdout, "Merged changes from %s in commit %s\n", response.Remote, response.MergeCommit)
+	}
+	if len(response.ChangedTaskIDs) > 0 {
+		fmt.Fprintf(env.stdout, "  changed tasks: %s\n", strings.Join(response.ChangedTaskIDs, ", "))
+	}
+	if response.ConfigurationChanged {
+		fmt.Fprintln(env.stdout, "  board configuration changed")
+	}
+	for _, conflict := range response.Conflicts {
+		fmt.Fprintf(env.stdout, "  conflict in task %s %s: local %q, remote %q, %s (%s)\n",
+			conflict.TaskID, conflict.Field, conflict.LocalValue, conflict.RemoteValue, conflictResolutionText(conflict.Resolution), conflict.Reason)
+	}
+	return nil
+}
+
+// writeSimulation writes the violations a rule simulation found followed by their number per rule
+func writeSimulation(env *environment, report *engines.SimulationReport) error {
+	if env.format == "json" {
+		return writeJSON(env.stdout, report)
+	}
+
+	fmt.Fprintf(env.stdout, "Simulated %d rules on %d tasks and %d changes in %d revisions\n",
+		report.RulesSimulated, report.TasksEvaluated, report.ChangesReplayed, report.RevisionsReplayed)
+	if len(report.Violations) == 0 {
+		fmt.Fprintln(env.stdout, "No violations")
+		return nil
+	}
+
+	counts := make(map[string]int)
+	tw := tabwriter.NewWriter(env.stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "RULE\tWHEN\tCOMMIT\tTASK\tCHANGE\tMESSAGE")
+	for _, violation := range report.Violations {
+		counts[violation.RuleID]++
+		when, commit, change := "now", "-", "in "+violation.ToColumn
+		if violation.Commit != "" {
+			when = violation.OccurredAt.Local().Format("2006-01-02 15:04")
+			commit = violation.Commit
+			if len(commit) > 7 {
+				commit = commit[:7]
+			}
+			if violation.FromColumn != "" {
+				change = violation.FromColumn + " -> " + violation.ToColumn
+			} else {
+				change = "created in " + violation.ToColumn
+			}
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", violation.RuleID, when, commit, violation.TaskID, change, violation.Message)
+	}
+	fmt.Fprintln(tw)
+	for _, ruleID := range sortedKeys(counts) {
+		fmt.Fprintf(tw, "%s\t%d violations\n", ruleID, counts[ruleID])
+	}
+	return tw.Flush()
+}
+
+// conflictResolutionText describes which value a sync kept for a conflicting task field
+func conflictResolutionText(resolution string) string {
+	switch resolution {
+	case task_manager.ConflictKeptLocal:
+		return "kept local"
+	case task_manager.ConflictTookRemote:
+		return "took remote"
+	default:
+		return "needs review"
+	}
+}
+
+// writeStats writes board statistics together with the flow metrics summary
+func writeStats(env *environment, stats *board_access.BoardStatistics, metrics *board_access.FlowMetrics) error {
+	if env.format == "json" {
+		return writeJSON(env.stdout, struct {
+			Statistics *board_access.BoardStatistics `json:"statistics"`
+			Flow       *board_access.FlowMetrics     `json:"flow"`
+		}{stats, metrics})
+	}
+
+	tw := tabwriter.NewWriter(env.stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "Total tasks\t%d\n", stats.TotalTasks)
+	fmt.Fprintf(tw, "Active tasks\t%d\n", stats.ActiveTasks)
+	fmt.Fprintf(tw, "Completed tasks\t%d\n", stats.CompletedTasks)
+	for _, column := range sortedKeys(stats.TasksByColumn) {
+		fmt.Fprintf(tw, "  in %s\t%d\n", column, stats.TasksByColumn[column])
+	}
+	fmt.Fprintf(tw, "Average task age\t%.1f days\n", stats.AverageTaskAge)
+	fmt.Fprintf(tw, "Average time in column\t%.1f days\n", stats.AverageColumnAge)
+	fmt.Fprintf(tw, "Board health\t%.2f\n", stats.BoardHealthScore)
+	fmt.Fprintln(tw)
+
+	fmt.Fprintf(tw, "Flow %s to %s\n", metrics.From.Format("2006-01-02"), metrics.To.Format("2006-01-02"))
+	writeFlowTime(tw, "Lead time", metrics.LeadTime)
+	writeFlowTime(tw, "Cycle time", metrics.CycleTime)
+	completed := 0
+	for _, period := range metrics.Throughput {
+		completed += period.Completed
+	}
+	if weeks := len(metrics.Throughput); weeks > 0 {
+		fmt.Fprintf(tw, "Throughput\t%d completed, %.1f per week\n", completed, float64(completed)/float64(weeks))
+	}
+	if points := len(metrics.CumulativeFlow); points > 0 {
+		fmt.Fprintf(tw, "Current WIP\t%d\n", metrics.CumulativeFlow[points-1].WIP)
+	}
+	return tw.Flush()
+}
+
+// writeFlowTime writes one line summarizing a duration distribution
+func writeFlowTime(w io.Writer, name string, stats board_access.FlowTimeStatistics) {
+	if stats.Count == 0 {
+		fmt.Fprintf(w, "%s\tno completed tasks\n", name)
+		return
+	}
+	fmt.Fprintf(w, "%s\tavg %.1f, median %.1f, p85 %.1f, max %.1f days (%d tasks)\n",
+		name, stats.AverageDays, stats.MedianDays, stats.P85Days, stats.MaxDays, stats.Count)
+}
+
+// priorityLabel returns the Eisenhower label of a priority
+func priorityLabel(priority board_access.Priority) string {
+	for label, known := range priorityLabels {
+		if known.Urgent == priority.Urgent && known.Important == priority.Important {
+			return label
+		}
+	}
+	return priority.Label
+}
+
+// formatDate formats an optional date for table output
+func formatDate(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.Local().Format("2006-01-02")
+}
+
+// sortedKeys returns the keys of a count map in alphabetical order
+func sortedKeys(counts map[string]int) []string {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -2,7 +2,9 @@ package managers
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -20,6 +22,8 @@ type WorkflowManager interface {
 	Batch() IBatch
 	Search() ISearch
 	Subtask() ISubtask
+	History() IHistory
+	Rules() IRules
 }
 
 // ITask handles task-related workflows with validation
@@ -34,6 +38,10 @@ type ITask interface {
 	ChangeTaskStatusWorkflow(ctx context.Context, taskID string, status string) (map[string]any, error)
 	ChangeTaskPriorityWorkflow(ctx context.Context, taskID string, priority string) (map[string]any, error)
 	ArchiveTaskWorkflow(ctx context.Context, taskID string, options map[string]any) (map[string]any, error)
+	RestoreTaskWorkflow(ctx context.Context, taskID string, options map[string]any) (map[string]any, error)
+
+	// Change notifications for tasks modified outside this client
+	SubscribeTaskEvents(ctx context.Context) <-chan map[string]any
 }
 
 // IDrag handles drag-drop workflows with movement validation
@@ -61,6 +69,21 @@ type ISubtask interface {
 	MoveSubtaskWorkflow(ctx context.Context, subtaskID string, newParentID string, position map[string]any) (map[string]any, error)
 }
 
+// IHistory handles undo and redo of board operations and browsing past board states
+type IHistory interface {
+	UndoWorkflow(ctx context.Context) (map[string]any, error)
+	RedoWorkflow(ctx context.Context) (map[string]any, error)
+	ListRevisionsWorkflow(ctx context.Context, limit int) (map[string]any, error)
+	LoadBoardAtWorkflow(ctx context.Context, commitID string, at time.Time) (map[string]any, error)
+	RestoreTaskFromWorkflow(ctx context.Context, commitID string, taskID string) (map[string]any, error)
+	GetTaskHistoryWorkflow(ctx context.Context, taskID string, limit int) (map[string]any, error)
+}
+
+// IRules handles workflows around the board's rules
+type IRules interface {
+	SimulateRulesWorkflow(ctx context.Context, rulesFile string) (map[string]any, error)
+}
+
 // Data Types for workflow state management
 type WorkflowType string
 type WorkflowStatus string
@@ -77,6 +100,7 @@ const (
 	WorkflowTypeStatusChange   WorkflowType = "status_change"
 	WorkflowTypePriorityChange WorkflowType = "priority_change"
 	WorkflowTypeTaskArchive    WorkflowType = "task_archive"
+	WorkflowTypeTaskRestore    WorkflowType = "task_restore"
 	WorkflowTypeBatchStatus    WorkflowType = "batch_status"
 	WorkflowTypeBatchPriority  WorkflowType = "batch_priority"
 	WorkflowTypeBatchArchive   WorkflowType = "batch_archive"
@@ -85,6 +109,13 @@ const (
 	WorkflowTypeSubtaskCreate  WorkflowType = "subtask_create"
 	WorkflowTypeSubtaskComplete WorkflowType = "subtask_complete"
 	WorkflowTypeSubtaskMove    WorkflowType = "subtask_move"
+	WorkflowTypeUndo           WorkflowType = "undo"
+	WorkflowTypeRedo           WorkflowType = "redo"
+	WorkflowTypeRevisionList   WorkflowType = "revision_list"
+	WorkflowTypeBoardSnapshot  WorkflowType = "board_snapshot"
+	WorkflowTypeTaskRestoreFrom WorkflowType = "task_restore_from"
+	WorkflowTypeTaskHistory    WorkflowType = "task_history"
+	WorkflowTypeRuleSimulation WorkflowType = "rule_simulation"
 
 	WorkflowStatusPending    WorkflowStatus = "pending"
 	WorkflowStatusInProgress WorkflowStatus = "in_progress"
@@ -149,6 +180,14 @@ func (wm *workflowManager) Subtask() ISubtask {
 	return &subtaskWorkflows{manager: wm}
 }
 
+func (wm *workflowManager) History() IHistory {
+	return &historyWorkflows{manager: wm}
+}
+
+func (wm *workflowManager) Rules() IRules {
+	return &rulesWorkflows{manager: wm}
+}
+
 // Workflow state management
 func (wm *workflowManager) createWorkflow(workflowType WorkflowType) *WorkflowState {
 	wm.mu.Lock()
@@ -568,9 +607,18 @@ func (t *taskWorkflows) ArchiveTaskWorkflow(ctx context.Context, taskID string,
 		}, nil
 	}
 
-	// Archive task through TaskManagerAccess (using status change to archived)
-	archivedStatus := resource_access.UIWorkflowStatus("archived")
-	respCh, errCh := t.manager.backend.ChangeTaskStatusAsync(ctx, taskID, archivedStatus)
+	policy, err := cascadePolicyFromOptions(options)
+	if err != nil {
+		t.manager.failWorkflow(workflow.WorkflowID, err)
+		return map[string]any{
+			"success":     false,
+			"workflow_id": workflow.WorkflowID,
+			"error":       err.Error(),
+		}, nil
+	}
+
+	// Archive task through TaskManagerAccess
+	respCh, errCh := t.manager.backend.ArchiveTaskAsync(ctx, taskID, policy)
 
 	select {
 	case response := <-respCh:
@@ -588,7 +636,68 @@ func (t *taskWorkflows) ArchiveTaskWorkflow(ctx context.Context, taskID string,
 				"display_name": response.DisplayName,
 				"archived":    true,
 			},
-			"cascade_effects": options, // Return options as cascade info
+			"cascade_effects": options,
+			"cascade_policy":  string(policy),
+		}, nil
+	case err := <-errCh:
+		t.manager.failWorkflow(workflow.WorkflowID, err)
+		errMsg := "unknown error"
+		if err != nil {
+			errMsg = err.Error()
+		}
+		return map[string]any{
+			"success":     false,
+			"workflow_id": workflow.WorkflowID,
+			"error":       errMsg,
+		}, err
+	case <-ctx.Done():
+		t.manager.failWorkflow(workflow.WorkflowID, ctx.Err())
+		return nil, ctx.Err()
+	}
+}
+
+func (t *taskWorkflows) RestoreTaskWorkflow(ctx context.Context, taskID string, options map[string]any) (map[string]any, error) {
+	workflow := t.manager.createWorkflow(WorkflowTypeTaskRestore)
+	t.manager.updateWorkflowStatus(workflow.WorkflowID, WorkflowStatusInProgress)
+
+	if taskID == "" {
+		t.manager.failWorkflow(workflow.WorkflowID, fmt.Errorf("restore validation failed"))
+		return map[string]any{
+			"success":     false,
+			"workflow_id": workflow.WorkflowID,
+			"error":       "Task ID is required",
+		}, nil
+	}
+	policy, err := cascadePolicyFromOptions(options)
+	if err != nil {
+		t.manager.failWorkflow(workflow.WorkflowID, err)
+		return map[string]any{
+			"success":     false,
+			"workflow_id": workflow.WorkflowID,
+			"error":       err.Error(),
+		}, nil
+	}
+
+	// Restore task through TaskManagerAccess
+	respCh, errCh := t.manager.backend.RestoreTaskAsync(ctx, taskID, policy)
+
+	select {
+	case response := <-respCh:
+		t.manager.completeWorkflow(workflow.WorkflowID)
+
+		// Format response using FormattingEngine
+		formattedDesc, _ := t.manager.formatting.Text().FormatText(response.Description, engines.TextOptions{MaxLength: 50})
+
+		return map[string]any{
+			"success":     true,
+			"workflow_id": workflow.WorkflowID,
+			"task": map[string]any{
+				"id":           response.ID,
+				"description":  formattedDesc,
+				"display_name": response.DisplayName,
+				"status":       string(response.WorkflowStatus),
+				"archived":     false,
+			},
 		}, nil
 	case err := <-errCh:
 		t.manager.failWorkflow(workflow.WorkflowID, err)
@@ -607,6 +716,58 @@ func (t *taskWorkflows) ArchiveTaskWorkflow(ctx context.Context, taskID string,
 	}
 }
 
+func (t *taskWorkflows) SubscribeTaskEvents(ctx context.Context) <-chan map[string]any {
+	events := make(chan map[string]any, 64)
+
+	go func() {
+		defer close(events)
+
+		for event := range t.manager.backend.SubscribeTaskEvents(ctx) {
+			formatted := map[string]any{
+				"type":            string(event.Type),
+				"task_id":         event.TaskID,
+				"previous_status": string(event.PreviousStatus),
+				"occurred_at":     event.OccurredAt,
+			}
+			if event.Task != nil {
+				// Format like the other task workflows, with the fields the board needs to place the task
+				formattedDesc, _ := t.manager.formatting.Text().FormatText(event.Task.Description, engines.TextOptions{MaxLength: 50})
+				formatted["task"] = map[string]any{
+					"id":           event.Task.ID,
+					"title":        event.Task.Description,
+					"description":  formattedDesc,
+					"display_name": event.Task.DisplayName,
+					"priority":     event.Task.Priority.Label,
+					"status":       string(event.Task.WorkflowStatus),
+					"created_at":   event.Task.CreatedAt,
+					"updated_at":   event.Task.UpdatedAt,
+				}
+			}
+			if len(event.Conflicts) > 0 {
+				conflicts := make([]map[string]any, 0, len(event.Conflicts))
+				for _, conflict := range event.Conflicts {
+					conflicts = append(conflicts, map[string]any{
+						"field":        conflict.Field,
+						"local_value":  conflict.LocalValue,
+						"remote_value": conflict.RemoteValue,
+						"resolution":   conflict.Resolution,
+						"reason":       conflict.Reason,
+					})
+				}
+				formatted["conflicts"] = conflicts
+			}
+
+			select {
+			case events <- formatted:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events
+}
+
 // Drag workflow implementations
 type dragWorkflows struct {
 	manager *workflowManager
@@ -679,52 +840,18 @@ func (b *batchWorkflows) BatchStatusUpdateWorkflow(ctx context.Context, taskIDs
 		}, nil
 	}
 
-	// Process batch status updates
-	results := make([]map[string]any, len(taskIDs))
-	successCount := 0
-	failureCount := 0
-
-	for i, taskID := range taskIDs {
-		// Change status for each task
-		statusEnum := resource_access.UIWorkflowStatus(status)
-		respCh, errCh := b.manager.backend.ChangeTaskStatusAsync(ctx, taskID, statusEnum)
-
-		select {
-		case response := <-respCh:
-			formattedDesc, _ := b.manager.formatting.Text().FormatText(response.Description, engines.TextOptions{MaxLength: 30})
-			results[i] = map[string]any{
-				"task_id":     taskID,
-				"success":     true,
-				"description": formattedDesc,
-				"status":      status,
-			}
-			successCount++
-		case err := <-errCh:
-			errMsg := "unknown error"
-			if err != nil {
-				errMsg = err.Error()
-			}
-			results[i] = map[string]any{
-				"task_id": taskID,
-				"success": false,
-				"error":   errMsg,
-			}
-			failureCount++
-		case <-ctx.Done():
-			b.manager.failWorkflow(workflow.WorkflowID, ctx.Err())
-			return nil, ctx.Err()
-		}
+	// Apply all status changes as a single board transaction
+	batchStatus, ok := parseBatchWorkflowStatus(status)
+	if !ok {
+		b.manager.failWorkflow(workflow.WorkflowID, fmt.Errorf("unknown status %q", status))
+		return map[string]any{
+			"success":     false,
+			"workflow_id": workflow.WorkflowID,
+			"error":       fmt.Sprintf("Unknown status %q", status),
+		}, nil
 	}
-
-	b.manager.completeWorkflow(workflow.WorkflowID)
-	return map[string]any{
-		"success":       successCount > 0,
-		"workflow_id":   workflow.WorkflowID,
-		"results":       results,
-		"success_count": successCount,
-		"failure_count": failureCount,
-		"total_count":   len(taskIDs),
-	}, nil
+	request := resource_access.UIBatchRequest{Operation: resource_access.UIBatchChangeStatus, TaskIDs: taskIDs, WorkflowStatus: batchStatus}
+	return b.executeBatch(ctx, workflow, request, "status", status)
 }
 
 func (b *batchWorkflows) BatchPriorityUpdateWorkflow(ctx context.Context, taskIDs []string, priority string) (map[string]any, error) {
@@ -755,54 +882,18 @@ func (b *batchWorkflows) BatchPriorityUpdateWorkflow(ctx context.Context, taskID
 		}, nil
 	}
 
-	// Process batch priority updates
-	results := make([]map[string]any, len(taskIDs))
-	successCount := 0
-	failureCount := 0
-
-	for i, taskID := range taskIDs {
-		// Update priority for each task (using UpdateTaskAsync as priority-specific method may not exist)
-		updateRequest := resource_access.UITaskRequest{
-			Description: "", // Will be filled by backend from existing task
-		}
-		respCh, errCh := b.manager.backend.UpdateTaskAsync(ctx, taskID, updateRequest)
-
-		select {
-		case response := <-respCh:
-			formattedDesc, _ := b.manager.formatting.Text().FormatText(response.Description, engines.TextOptions{MaxLength: 30})
-			results[i] = map[string]any{
-				"task_id":     taskID,
-				"success":     true,
-				"description": formattedDesc,
-				"priority":    priority,
-			}
-			successCount++
-		case err := <-errCh:
-			errMsg := "unknown error"
-			if err != nil {
-				errMsg = err.Error()
-			}
-			results[i] = map[string]any{
-				"task_id": taskID,
-				"success": false,
-				"error":   errMsg,
-			}
-			failureCount++
-		case <-ctx.Done():
-			b.manager.failWorkflow(workflow.WorkflowID, ctx.Err())
-			return nil, ctx.Err()
-		}
+	// Apply all priority changes as a single board transaction
+	batchPriority, ok := parseBatchPriority(priority)
+	if !ok {
+		b.manager.failWorkflow(workflow.WorkflowID, fmt.Errorf("unknown priority %q", priority))
+		return map[string]any{
+			"success":     false,
+			"workflow_id": workflow.WorkflowID,
+			"error":       fmt.Sprintf("Unknown priority %q", priority),
+		}, nil
 	}
-
-	b.manager.completeWorkflow(workflow.WorkflowID)
-	return map[string]any{
-		"success":       successCount > 0,
-		"workflow_id":   workflow.WorkflowID,
-		"results":       results,
-		"success_count": successCount,
-		"failure_count": failureCount,
-		"total_count":   len(taskIDs),
-	}, nil
+	request := resource_access.UIBatchRequest{Operation: resource_access.UIBatchChangePriority, TaskIDs: taskIDs, Priority: batchPriority}
+	return b.executeBatch(ctx, workflow, request, "priority", priority)
 }
 
 func (b *batchWorkflows) BatchArchiveWorkflow(ctx context.Context, taskIDs []string, options map[string]any) (map[string]any, error) {
@@ -833,53 +924,147 @@ func (b *batchWorkflows) BatchArchiveWorkflow(ctx context.Context, taskIDs []str
 		}, nil
 	}
 
-	// Process batch archive operations
-	results := make([]map[string]any, len(taskIDs))
-	successCount := 0
-	failureCount := 0
+	policy, err := cascadePolicyFromOptions(options)
+	if err != nil {
+		b.manager.failWorkflow(workflow.WorkflowID, err)
+		return map[string]any{
+			"success":     false,
+			"workflow_id": workflow.WorkflowID,
+			"error":       err.Error(),
+		}, nil
+	}
+
+	// Archive all tasks as a single board transaction
+	request := resource_access.UIBatchRequest{Operation: resource_access.UIBatchArchive, TaskIDs: taskIDs, CascadePolicy: policy}
+	response, err := b.executeBatch(ctx, workflow, request, "archived", true)
+	if response != nil {
+		response["cascade_effects"] = options
+		response["cascade_policy"] = string(policy)
+	}
+	return response, err
+}
+
+// cascadePolicyFromOptions maps the "cascade" option of an archive or restore workflow to what happens to the
+// subtasks: "archive" or "restore" moves them along with the task (the default), "promote" or "orphan" turns them
+// into top-level tasks, "delete" deletes them and "keep" leaves them where they are
+func cascadePolicyFromOptions(options map[string]any) (resource_access.UICascadePolicy, error) {
+	cascade, ok := options["cascade"]
+	if !ok {
+		return resource_access.UIArchiveSubtasks, nil
+	}
+	switch cascade {
+	case "archive", "restore":
+		return resource_access.UIArchiveSubtasks, nil
+	case "promote", "orphan":
+		return resource_access.UIPromoteSubtasks, nil
+	case "delete":
+		return resource_access.UIDeleteSubtasks, nil
+	case "keep":
+		return resource_access.UIKeepSubtasks, nil
+	}
+	return "", fmt.Errorf("unknown cascade option %v", cascade)
+}
 
-	for i, taskID := range taskIDs {
-		// Archive each task (using status change to archived)
-		archivedStatus := resource_access.UIWorkflowStatus("archived")
-		respCh, errCh := b.manager.backend.ChangeTaskStatusAsync(ctx, taskID, archivedStatus)
+// executeBatch applies a batch through TaskManagerAccess in a single board commit; if any task fails,
+// no task is changed and every task is reported as failed
+func (b *batchWorkflows) executeBatch(ctx context.Context, workflow *WorkflowState, request resource_access.UIBatchRequest, resultKey string, resultValue any) (map[string]any, error) {
+	respCh, errCh := b.manager.backend.ExecuteBatchAsync(ctx, request)
 
-		select {
-		case response := <-respCh:
-			formattedDesc, _ := b.manager.formatting.Text().FormatText(response.Description, engines.TextOptions{MaxLength: 30})
+	results := make([]map[string]any, len(request.TaskIDs))
+	select {
+	case response := <-respCh:
+		for i, taskID := range request.TaskIDs {
 			results[i] = map[string]any{
-				"task_id":     taskID,
-				"success":     true,
-				"description": formattedDesc,
-				"archived":    true,
+				"task_id": taskID,
+				"success": true,
+				resultKey: resultValue,
 			}
-			successCount++
-		case err := <-errCh:
-			errMsg := "unknown error"
-			if err != nil {
-				errMsg = err.Error()
+			if i < len(response.Tasks) {
+				formattedDesc, _ := b.manager.formatting.Text().FormatText(response.Tasks[i].Description, engines.TextOptions{MaxLength: 30})
+				results[i]["description"] = formattedDesc
 			}
+		}
+
+		b.manager.completeWorkflow(workflow.WorkflowID)
+		return map[string]any{
+			"success":       true,
+			"workflow_id":   workflow.WorkflowID,
+			"results":       results,
+			"success_count": len(request.TaskIDs),
+			"failure_count": 0,
+			"total_count":   len(request.TaskIDs),
+			"commit":        response.Commit,
+		}, nil
+	case err := <-errCh:
+		errMsg := "unknown error"
+		if err != nil {
+			errMsg = err.Error()
+		}
+		failedTaskID := ""
+		var uiErr resource_access.UIErrorResponse
+		if errors.As(err, &uiErr) {
+			failedTaskID = uiErr.FailedTaskID
+		}
+		for i, taskID := range request.TaskIDs {
 			results[i] = map[string]any{
 				"task_id": taskID,
 				"success": false,
 				"error":   errMsg,
 			}
-			failureCount++
-		case <-ctx.Done():
-			b.manager.failWorkflow(workflow.WorkflowID, ctx.Err())
-			return nil, ctx.Err()
+			if failedTaskID != "" && taskID != failedTaskID {
+				results[i]["error"] = fmt.Sprintf("not changed, batch failed at task %s", failedTaskID)
+			}
+		}
+
+		b.manager.failWorkflow(workflow.WorkflowID, err)
+		response := map[string]any{
+			"success":       false,
+			"workflow_id":   workflow.WorkflowID,
+			"error":         errMsg,
+			"results":       results,
+			"success_count": 0,
+			"failure_count": len(request.TaskIDs),
+			"total_count":   len(request.TaskIDs),
 		}
+		if failedTaskID != "" {
+			response["failed_task_id"] = failedTaskID
+		}
+		return response, nil
+	case <-ctx.Done():
+		b.manager.failWorkflow(workflow.WorkflowID, ctx.Err())
+		return nil, ctx.Err()
 	}
+}
 
-	b.manager.completeWorkflow(workflow.WorkflowID)
-	return map[string]any{
-		"success":         successCount > 0,
-		"workflow_id":     workflow.WorkflowID,
-		"results":         results,
-		"success_count":   successCount,
-		"failure_count":   failureCount,
-		"total_count":     len(taskIDs),
-		"cascade_effects": options,
-	}, nil
+// parseBatchWorkflowStatus maps the status names used by workflows to board columns
+func parseBatchWorkflowStatus(status string) (resource_access.UIWorkflowStatus, bool) {
+	switch strings.ToLower(strings.TrimSpace(status)) {
+	case "todo", "to_do", "to-do":
+		return resource_access.UITodo, true
+	case "doing", "in_progress", "in-progress":
+		return resource_access.UIInProgress, true
+	case "done", "completed":
+		return resource_access.UIDone, true
+	default:
+		return "", false
+	}
+}
+
+// parseBatchPriority maps an Eisenhower label such as "urgent-important", or a bare "urgent" or "important", to a priority
+func parseBatchPriority(priority string) (resource_access.UIPriority, bool) {
+	label := strings.ToLower(strings.TrimSpace(priority))
+	switch label {
+	case "urgent-important":
+		return resource_access.UIPriority{Urgent: true, Important: true, Label: label}, true
+	case "urgent", "urgent-not-important":
+		return resource_access.UIPriority{Urgent: true, Label: "urgent-not-important"}, true
+	case "important", "not-urgent-important":
+		return resource_access.UIPriority{Important: true, Label: "not-urgent-important"}, true
+	case "not-urgent-not-important":
+		return resource_access.UIPriority{Label: label}, true
+	default:
+		return resource_access.UIPriority{}, false
+	}
 }
 
 // Search workflow implementations
@@ -1213,4 +1398,331 @@ func (st *subtaskWorkflows) MoveSubtaskWorkflow(ctx context.Context, subtaskID s
 		st.manager.failWorkflow(workflow.WorkflowID, ctx.Err())
 		return nil, ctx.Err()
 	}
+}
+
+// History workflow implementations
+type historyWorkflows struct {
+	manager *workflowManager
+}
+
+func (h *historyWorkflows) UndoWorkflow(ctx context.Context) (map[string]any, error) {
+	respCh, errCh := h.manager.backend.UndoAsync(ctx)
+	return h.revertWorkflow(ctx, WorkflowTypeUndo, respCh, errCh)
+}
+
+func (h *historyWorkflows) RedoWorkflow(ctx context.Context) (map[string]any, error) {
+	respCh, errCh := h.manager.backend.RedoAsync(ctx)
+	return h.revertWorkflow(ctx, WorkflowTypeRedo, respCh, errCh)
+}
+
+// revertWorkflow waits for an undo or redo; an empty history fails the workflow without an error, like a validation failure
+func (h *historyWorkflows) revertWorkflow(ctx context.Context, workflowType WorkflowType, respCh <-chan resource_access.UIUndoResult, errCh <-chan error) (map[string]any, error) {
+	workflow := h.manager.createWorkflow(workflowType)
+	h.manager.updateWorkflowStatus(workflow.WorkflowID, WorkflowStatusInProgress)
+
+	select {
+	case response := <-respCh:
+		h.manager.completeWorkflow(workflow.WorkflowID)
+
+		formattedDesc, _ := h.manager.formatting.Text().FormatText(response.Description, engines.TextOptions{MaxLength: 80})
+
+		return map[string]any{
+			"success":          true,
+			"workflow_id":      workflow.WorkflowID,
+			"description":      formattedDesc,
+			"changed_task_ids": response.ChangedTaskIDs,
+			"can_undo":         response.CanUndo,
+			"can_redo":         response.CanRedo,
+		}, nil
+	case err := <-errCh:
+		h.manager.failWorkflow(workflow.WorkflowID, err)
+		if uiErr, ok := err.(resource_access.UIErrorResponse); ok && uiErr.Category == "history" {
+			return map[string]any{
+				"success":     false,
+				"workflow_id": workflow.WorkflowID,
+				"error":       uiErr.Message,
+			}, nil
+		}
+		errMsg := "unknown error"
+		if err != nil {
+			errMsg = err.Error()
+		}
+		return map[string]any{
+			"success":     false,
+			"workflow_id": workflow.WorkflowID,
+			"error":       errMsg,
+		}, err
+	case <-ctx.Done():
+		h.manager.failWorkflow(workflow.WorkflowID, ctx.Err())
+		return nil, ctx.Err()
+	}
+}
+
+func (h *historyWorkflows) ListRevisionsWorkflow(ctx context.Context, limit int) (map[string]any, error) {
+	workflow := h.manager.createWorkflow(WorkflowTypeRevisionList)
+	h.manager.updateWorkflowStatus(workflow.WorkflowID, WorkflowStatusInProgress)
+
+	respCh, errCh := h.manager.backend.ListBoardRevisionsAsync(ctx, limit)
+
+	select {
+	case revisions := <-respCh:
+		h.manager.completeWorkflow(workflow.WorkflowID)
+
+		formattedRevisions := make([]map[string]any, len(revisions))
+		for i, revision := range revisions {
+			formattedRevisions[i] = h.formatRevision(revision)
+		}
+
+		return map[string]any{
+			"success":     true,
+			"workflow_id": workflow.WorkflowID,
+			"revisions":   formattedRevisions,
+			"count":       len(revisions),
+		}, nil
+	case err := <-errCh:
+		h.manager.failWorkflow(workflow.WorkflowID, err)
+		errMsg := "unknown error"
+		if err != nil {
+			errMsg = err.Error()
+		}
+		return map[string]any{
+			"success":     false,
+			"workflow_id": workflow.WorkflowID,
+			"error":       errMsg,
+		}, err
+	case <-ctx.Done():
+		h.manager.failWorkflow(workflow.WorkflowID, ctx.Err())
+		return nil, ctx.Err()
+	}
+}
+
+// LoadBoardAtWorkflow returns the read-only board of a past revision, with tasks formatted like the query workflow
+func (h *historyWorkflows) LoadBoardAtWorkflow(ctx context.Context, commitID string, at time.Time) (map[string]any, error) {
+	workflow := h.manager.createWorkflow(WorkflowTypeBoardSnapshot)
+	h.manager.updateWorkflowStatus(workflow.WorkflowID, WorkflowStatusInProgress)
+
+	respCh, errCh := h.manager.backend.LoadBoardAtAsync(ctx, commitID, at)
+
+	select {
+	case snapshot := <-respCh:
+		h.manager.completeWorkflow(workflow.WorkflowID)
+
+		tasks := make([]interface{}, len(snapshot.Tasks))
+		for i, task := range snapshot.Tasks {
+			tasks[i] = h.formatSnapshotTask(task)
+		}
+
+		return map[string]any{
+			"success":        true,
+			"workflow_id":    workflow.WorkflowID,
+			"revision":       h.formatRevision(snapshot.Revision),
+			"tasks":          tasks,
+			"archived_count": len(snapshot.ArchivedTasks),
+			"read_only":      true,
+		}, nil
+	case err := <-errCh:
+		h.manager.failWorkflow(workflow.WorkflowID, err)
+		errMsg := "unknown error"
+		if err != nil {
+			errMsg = err.Error()
+		}
+		return map[string]any{
+			"success":     false,
+			"workflow_id": workflow.WorkflowID,
+			"error":       errMsg,
+		}, err
+	case <-ctx.Done():
+		h.manager.failWorkflow(workflow.WorkflowID, ctx.Err())
+		return nil, ctx.Err()
+	}
+}
+
+func (h *historyWorkflows) RestoreTaskFromWorkflow(ctx context.Context, commitID string, taskID string) (map[string]any, error) {
+	workflow := h.manager.createWorkflow(WorkflowTypeTaskRestoreFrom)
+	h.manager.updateWorkflowStatus(workflow.WorkflowID, WorkflowStatusInProgress)
+
+	if commitID == "" || taskID == "" {
+		h.manager.failWorkflow(workflow.WorkflowID, fmt.Errorf("restore validation failed"))
+		return map[string]any{
+			"success":     false,
+			"workflow_id": workflow.WorkflowID,
+			"error":       "Revision and task ID are required",
+		}, nil
+	}
+
+	respCh, errCh := h.manager.backend.RestoreTaskFromAsync(ctx, commitID, taskID)
+
+	select {
+	case response := <-respCh:
+		h.manager.completeWorkflow(workflow.WorkflowID)
+
+		return map[string]any{
+			"success":     true,
+			"workflow_id": workflow.WorkflowID,
+			"commit":      commitID,
+			"task":        h.formatSnapshotTask(response),
+		}, nil
+	case err := <-errCh:
+		h.manager.failWorkflow(workflow.WorkflowID, err)
+		errMsg := "unknown error"
+		if err != nil {
+			errMsg = err.Error()
+		}
+		return map[string]any{
+			"success":     false,
+			"workflow_id": workflow.WorkflowID,
+			"error":       errMsg,
+		}, err
+	case <-ctx.Done():
+		h.manager.failWorkflow(workflow.WorkflowID, ctx.Err())
+		return nil, ctx.Err()
+	}
+}
+
+// GetTaskHistoryWorkflow returns the revisions of a task, newest first, with the fields each changed
+func (h *historyWorkflows) GetTaskHistoryWorkflow(ctx context.Context, taskID string, limit int) (map[string]any, error) {
+	workflow := h.manager.createWorkflow(WorkflowTypeTaskHistory)
+	h.manager.updateWorkflowStatus(workflow.WorkflowID, WorkflowStatusInProgress)
+
+	if taskID == "" {
+		h.manager.failWorkflow(workflow.WorkflowID, fmt.Errorf("task history validation failed"))
+		return map[string]any{
+			"success":     false,
+			"workflow_id": workflow.WorkflowID,
+			"error":       "Task ID is required",
+		}, nil
+	}
+
+	respCh, errCh := h.manager.backend.GetTaskHistoryAsync(ctx, taskID, limit)
+
+	select {
+	case revisions := <-respCh:
+		h.manager.completeWorkflow(workflow.WorkflowID)
+
+		formattedRevisions := make([]map[string]any, len(revisions))
+		for i, revision := range revisions {
+			changes := make([]map[string]any, len(revision.Changes))
+			for j, change := range revision.Changes {
+				changes[j] = map[string]any{
+					"field":  change.Field,
+					"label":  change.Label,
+					"before": change.Before,
+					"after":  change.After,
+				}
+			}
+			formatted := h.formatRevision(revision.Revision)
+			formatted["operation"] = revision.Operation
+			formatted["changes"] = changes
+			formattedRevisions[i] = formatted
+		}
+
+		return map[string]any{
+			"success":     true,
+			"workflow_id": workflow.WorkflowID,
+			"task_id":     taskID,
+			"revisions":   formattedRevisions,
+			"count":       len(revisions),
+		}, nil
+	case err := <-errCh:
+		h.manager.failWorkflow(workflow.WorkflowID, err)
+		errMsg := "unknown error"
+		if err != nil {
+			errMsg = err.Error()
+		}
+		return map[string]any{
+			"success":     false,
+			"workflow_id": workflow.WorkflowID,
+			"error":       errMsg,
+		}, err
+	case <-ctx.Done():
+		h.manager.failWorkflow(workflow.WorkflowID, ctx.Err())
+		return nil, ctx.Err()
+	}
+}
+
+// formatRevision formats a board revision for UI consumption
+func (h *historyWorkflows) formatRevision(revision resource_access.UIBoardRevision) map[string]any {
+	formattedMessage, _ := h.manager.formatting.Text().FormatText(revision.Message, engines.TextOptions{MaxLength: 80})
+	return map[string]any{
+		"commit":         revision.Commit,
+		"author":         revision.Author,
+		"message":        formattedMessage,
+		"timestamp":      revision.Timestamp,
+		"timestamp_text": revision.TimestampText,
+	}
+}
+
+// formatSnapshotTask formats a task with the fields the board needs to place it
+func (h *historyWorkflows) formatSnapshotTask(task resource_access.UITaskResponse) map[string]any {
+	formattedDesc, _ := h.manager.formatting.Text().FormatText(task.Description, engines.TextOptions{MaxLength: 50})
+	return map[string]any{
+		"id":           task.ID,
+		"title":        task.Description,
+		"description":  formattedDesc,
+		"display_name": task.DisplayName,
+		"priority":     task.Priority.Label,
+		"status":       string(task.WorkflowStatus),
+		"created_at":   task.CreatedAt,
+		"updated_at":   task.UpdatedAt,
+	}
+}
+
+// Rules workflow implementations
+type rulesWorkflows struct {
+	manager *workflowManager
+}
+
+// SimulateRulesWorkflow reports the violations the rules of a rules.json file, or of the board without a file,
+// would cause on the board and in its history
+func (r *rulesWorkflows) SimulateRulesWorkflow(ctx context.Context, rulesFile string) (map[string]any, error) {
+	workflow := r.manager.createWorkflow(WorkflowTypeRuleSimulation)
+	r.manager.updateWorkflowStatus(workflow.WorkflowID, WorkflowStatusInProgress)
+
+	respCh, errCh := r.manager.backend.SimulateRulesAsync(ctx, rulesFile)
+
+	select {
+	case simulation := <-respCh:
+		r.manager.completeWorkflow(workflow.WorkflowID)
+
+		violations := make([]map[string]any, len(simulation.Violations))
+		for i, violation := range simulation.Violations {
+			violations[i] = map[string]any{
+				"rule_id":       violation.RuleID,
+				"message":       violation.Message,
+				"task_id":       violation.TaskID,
+				"task_title":    violation.TaskTitle,
+				"from_column":   violation.FromColumn,
+				"to_column":     violation.ToColumn,
+				"commit":        violation.Commit,
+				"occurred_at":   violation.OccurredAt,
+				"occurred_text": violation.OccurredText,
+			}
+		}
+
+		return map[string]any{
+			"success":            true,
+			"workflow_id":        workflow.WorkflowID,
+			"rules_file":         rulesFile,
+			"rules_simulated":    simulation.RulesSimulated,
+			"tasks_evaluated":    simulation.TasksEvaluated,
+			"revisions_replayed": simulation.RevisionsReplayed,
+			"changes_replayed":   simulation.ChangesReplayed,
+			"violations":         violations,
+			"count":              len(violations),
+		}, nil
+	case err := <-errCh:
+		r.manager.failWorkflow(workflow.WorkflowID, err)
+		errMsg := "unknown error"
+		if err != nil {
+			errMsg = err.Error()
+		}
+		return map[string]any{
+			"success":     false,
+			"workflow_id": workflow.WorkflowID,
+			"error":       errMsg,
+		}, err
+	case <-ctx.Done():
+		r.manager.failWorkflow(workflow.WorkflowID, ctx.Err())
+		return nil, ctx.Err()
+	}
 }
\ No newline at end of file
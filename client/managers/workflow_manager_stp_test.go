@@ -122,6 +122,13 @@ func (m *failingMockTaskManagerAccess) ValidateTaskAsync(ctx context.Context, re
 	return respCh, errCh
 }
 
+func (m *failingMockTaskManagerAccess) SimulateRulesAsync(ctx context.Context, rulesFile string) (<-chan resource_access.UIRuleSimulation, <-chan error) {
+	respCh := make(chan resource_access.UIRuleSimulation, 1)
+	errCh := make(chan error, 1)
+	errCh <- fmt.Errorf("backend service unavailable")
+	return respCh, errCh
+}
+
 func (m *failingMockTaskManagerAccess) ProcessPriorityPromotionsAsync(ctx context.Context) (<-chan []resource_access.UITaskResponse, <-chan error) {
 	return m.QueryTasksAsync(ctx, resource_access.UIQueryCriteria{})
 }
@@ -138,6 +145,82 @@ func (m *failingMockTaskManagerAccess) SearchTasksAsync(ctx context.Context, que
 	return m.QueryTasksAsync(ctx, resource_access.UIQueryCriteria{})
 }
 
+func (m *failingMockTaskManagerAccess) ArchiveTaskAsync(ctx context.Context, taskID string, policy resource_access.UICascadePolicy) (<-chan resource_access.UITaskResponse, <-chan error) {
+	return m.CreateTaskAsync(ctx, resource_access.UITaskRequest{Description: "Archived"})
+}
+
+func (m *failingMockTaskManagerAccess) ListArchivedTasksAsync(ctx context.Context) (<-chan []resource_access.UIArchivedTask, <-chan error) {
+	respCh := make(chan []resource_access.UIArchivedTask, 1)
+	errCh := make(chan error, 1)
+	respCh <- []resource_access.UIArchivedTask{}
+	close(respCh)
+	return respCh, errCh
+}
+
+func (m *failingMockTaskManagerAccess) RestoreTaskAsync(ctx context.Context, taskID string, policy resource_access.UICascadePolicy) (<-chan resource_access.UITaskResponse, <-chan error) {
+	return m.CreateTaskAsync(ctx, resource_access.UITaskRequest{Description: "Restored"})
+}
+
+func (m *failingMockTaskManagerAccess) PurgeArchiveAsync(ctx context.Context, olderThan time.Duration) (<-chan []string, <-chan error) {
+	respCh := make(chan []string, 1)
+	errCh := make(chan error, 1)
+	respCh <- []string{}
+	close(respCh)
+	return respCh, errCh
+}
+
+func (m *failingMockTaskManagerAccess) UndoAsync(ctx context.Context) (<-chan resource_access.UIUndoResult, <-chan error) {
+	respCh := make(chan resource_access.UIUndoResult, 1)
+	errCh := make(chan error, 1)
+	errCh <- fmt.Errorf("backend service unavailable")
+	return respCh, errCh
+}
+
+func (m *failingMockTaskManagerAccess) RedoAsync(ctx context.Context) (<-chan resource_access.UIUndoResult, <-chan error) {
+	return m.UndoAsync(ctx)
+}
+
+func (m *failingMockTaskManagerAccess) ListBoardRevisionsAsync(ctx context.Context, limit int) (<-chan []resource_access.UIBoardRevision, <-chan error) {
+	respCh := make(chan []resource_access.UIBoardRevision, 1)
+	errCh := make(chan error, 1)
+	errCh <- fmt.Errorf("backend service unavailable")
+	return respCh, errCh
+}
+
+func (m *failingMockTaskManagerAccess) LoadBoardAtAsync(ctx context.Context, commitID string, at time.Time) (<-chan resource_access.UIBoardSnapshot, <-chan error) {
+	respCh := make(chan resource_access.UIBoardSnapshot, 1)
+	errCh := make(chan error, 1)
+	errCh <- fmt.Errorf("backend service unavailable")
+	return respCh, errCh
+}
+
+func (m *failingMockTaskManagerAccess) RestoreTaskFromAsync(ctx context.Context, commitID, taskID string) (<-chan resource_access.UITaskResponse, <-chan error) {
+	respCh := make(chan resource_access.UITaskResponse, 1)
+	errCh := make(chan error, 1)
+	errCh <- fmt.Errorf("backend service unavailable")
+	return respCh, errCh
+}
+
+func (m *failingMockTaskManagerAccess) ExecuteBatchAsync(ctx context.Context, request resource_access.UIBatchRequest) (<-chan resource_access.UIBatchResult, <-chan error) {
+	respCh := make(chan resource_access.UIBatchResult, 1)
+	errCh := make(chan error, 1)
+	errCh <- fmt.Errorf("backend service unavailable")
+	return respCh, errCh
+}
+
+func (m *failingMockTaskManagerAccess) GetTaskHistoryAsync(ctx context.Context, taskID string, limit int) (<-chan []resource_access.UITaskRevision, <-chan error) {
+	respCh := make(chan []resource_access.UITaskRevision, 1)
+	errCh := make(chan error, 1)
+	errCh <- fmt.Errorf("backend service unavailable")
+	return respCh, errCh
+}
+
+func (m *failingMockTaskManagerAccess) SubscribeTaskEvents(ctx context.Context) <-chan resource_access.UITaskEvent {
+	events := make(chan resource_access.UITaskEvent)
+	close(events)
+	return events
+}
+
 // STP Test Case DT-CREATE-001: Task Creation Workflow with Engine Coordination Failures
 func TestSTP_DT_CREATE_001_EngineCoordinationFailures(t *testing.T) {
 	validation := engines.NewFormValidationEngine()
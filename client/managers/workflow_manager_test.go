@@ -338,6 +338,26 @@ func (m *mockTaskManagerAccess) ValidateTaskAsync(ctx context.Context, request r
 	return respCh, errCh
 }
 
+func (m *mockTaskManagerAccess) SimulateRulesAsync(ctx context.Context, rulesFile string) (<-chan resource_access.UIRuleSimulation, <-chan error) {
+	respCh := make(chan resource_access.UIRuleSimulation, 1)
+	errCh := make(chan error, 1)
+
+	if rulesFile == "missing.json" {
+		errCh <- resource_access.UIErrorResponse{Category: "validation", Message: "Rules file could not be read"}
+		return respCh, errCh
+	}
+	respCh <- resource_access.UIRuleSimulation{
+		RulesSimulated: 1,
+		TasksEvaluated: 2,
+		Violations: []resource_access.UISimulatedViolation{
+			{RuleID: "wip-limit", Message: "WIP limit exceeded", TaskID: "task-123", TaskTitle: "Test Task", ToColumn: "doing"},
+		},
+	}
+	close(respCh)
+
+	return respCh, errCh
+}
+
 func (m *mockTaskManagerAccess) ProcessPriorityPromotionsAsync(ctx context.Context) (<-chan []resource_access.UITaskResponse, <-chan error) {
 	respCh := make(chan []resource_access.UITaskResponse, 1)
 	errCh := make(chan error, 1)
@@ -373,6 +393,187 @@ func (m *mockTaskManagerAccess) SearchTasksAsync(ctx context.Context, query stri
 	return respCh, errCh
 }
 
+func (m *mockTaskManagerAccess) ArchiveTaskAsync(ctx context.Context, taskID string, policy resource_access.UICascadePolicy) (<-chan resource_access.UITaskResponse, <-chan error) {
+	respCh := make(chan resource_access.UITaskResponse, 1)
+	errCh := make(chan error, 1)
+
+	respCh <- resource_access.UITaskResponse{
+		ID:          taskID,
+		Description: "Archived Task",
+		DisplayName: "Archived Task",
+	}
+	close(respCh)
+	// Don't close errCh immediately - let the select handle it
+
+	return respCh, errCh
+}
+
+func (m *mockTaskManagerAccess) ListArchivedTasksAsync(ctx context.Context) (<-chan []resource_access.UIArchivedTask, <-chan error) {
+	respCh := make(chan []resource_access.UIArchivedTask, 1)
+	errCh := make(chan error, 1)
+
+	respCh <- []resource_access.UIArchivedTask{
+		{UITaskResponse: resource_access.UITaskResponse{ID: "archived-1", Description: "Archived Task", DisplayName: "Archived Task"}, ArchivedAt: time.Now()},
+	}
+	close(respCh)
+	// Don't close errCh immediately - let the select handle it
+
+	return respCh, errCh
+}
+
+func (m *mockTaskManagerAccess) RestoreTaskAsync(ctx context.Context, taskID string, policy resource_access.UICascadePolicy) (<-chan resource_access.UITaskResponse, <-chan error) {
+	respCh := make(chan resource_access.UITaskResponse, 1)
+	errCh := make(chan error, 1)
+
+	respCh <- resource_access.UITaskResponse{
+		ID:             taskID,
+		Description:    "Restored Task",
+		DisplayName:    "Restored Task",
+		WorkflowStatus: resource_access.UIDone,
+	}
+	close(respCh)
+	// Don't close errCh immediately - let the select handle it
+
+	return respCh, errCh
+}
+
+func (m *mockTaskManagerAccess) PurgeArchiveAsync(ctx context.Context, olderThan time.Duration) (<-chan []string, <-chan error) {
+	respCh := make(chan []string, 1)
+	errCh := make(chan error, 1)
+
+	respCh <- []string{}
+	close(respCh)
+	// Don't close errCh immediately - let the select handle it
+
+	return respCh, errCh
+}
+
+func (m *mockTaskManagerAccess) UndoAsync(ctx context.Context) (<-chan resource_access.UIUndoResult, <-chan error) {
+	respCh := make(chan resource_access.UIUndoResult, 1)
+	errCh := make(chan error, 1)
+
+	respCh <- resource_access.UIUndoResult{
+		Description:    "move task \"Test Task\" to doing",
+		ChangedTaskIDs: []string{"task-123"},
+		CanRedo:        true,
+	}
+	close(respCh)
+
+	return respCh, errCh
+}
+
+func (m *mockTaskManagerAccess) RedoAsync(ctx context.Context) (<-chan resource_access.UIUndoResult, <-chan error) {
+	respCh := make(chan resource_access.UIUndoResult, 1)
+	errCh := make(chan error, 1)
+
+	// Nothing was undone before
+	errCh <- resource_access.UIErrorResponse{Category: "history", Message: "Nothing to redo"}
+
+	return respCh, errCh
+}
+
+func (m *mockTaskManagerAccess) ListBoardRevisionsAsync(ctx context.Context, limit int) (<-chan []resource_access.UIBoardRevision, <-chan error) {
+	respCh := make(chan []resource_access.UIBoardRevision, 1)
+	errCh := make(chan error, 1)
+
+	respCh <- []resource_access.UIBoardRevision{
+		{Commit: "def456", Message: "Update task task-123", Timestamp: time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC), TimestampText: "Mar 2, 2026 10:00"},
+		{Commit: "abc123", Message: "Create task task-123", Timestamp: time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC), TimestampText: "Mar 1, 2026 09:30"},
+	}
+	close(respCh)
+
+	return respCh, errCh
+}
+
+func (m *mockTaskManagerAccess) LoadBoardAtAsync(ctx context.Context, commitID string, at time.Time) (<-chan resource_access.UIBoardSnapshot, <-chan error) {
+	respCh := make(chan resource_access.UIBoardSnapshot, 1)
+	errCh := make(chan error, 1)
+
+	respCh <- resource_access.UIBoardSnapshot{
+		Revision: resource_access.UIBoardRevision{Commit: commitID, Message: "Create task task-123"},
+		Tasks: []resource_access.UITaskResponse{
+			{ID: "task-123", Description: "Past Task", WorkflowStatus: resource_access.UITodo, Priority: resource_access.UIPriority{Urgent: true, Important: true, Label: "urgent-important"}},
+		},
+	}
+	close(respCh)
+
+	return respCh, errCh
+}
+
+func (m *mockTaskManagerAccess) RestoreTaskFromAsync(ctx context.Context, commitID, taskID string) (<-chan resource_access.UITaskResponse, <-chan error) {
+	respCh := make(chan resource_access.UITaskResponse, 1)
+	errCh := make(chan error, 1)
+
+	respCh <- resource_access.UITaskResponse{ID: taskID, Description: "Past Task", WorkflowStatus: resource_access.UITodo}
+	close(respCh)
+
+	return respCh, errCh
+}
+
+func (m *mockTaskManagerAccess) ExecuteBatchAsync(ctx context.Context, request resource_access.UIBatchRequest) (<-chan resource_access.UIBatchResult, <-chan error) {
+	respCh := make(chan resource_access.UIBatchResult, 1)
+	errCh := make(chan error, 1)
+
+	result := resource_access.UIBatchResult{Commit: "batch123"}
+	for _, taskID := range request.TaskIDs {
+		if taskID == "missing-task" {
+			errCh <- resource_access.UIErrorResponse{Category: "service", Message: "Batch failed at task missing-task, no task was changed", FailedTaskID: taskID}
+			close(respCh)
+			return respCh, errCh
+		}
+		result.Tasks = append(result.Tasks, resource_access.UITaskResponse{
+			ID:             taskID,
+			Description:    "Batch Task",
+			WorkflowStatus: request.WorkflowStatus,
+			Priority:       request.Priority,
+			DisplayName:    "Batch Task",
+		})
+	}
+	respCh <- result
+	close(respCh)
+	// Don't close errCh immediately - let the select handle it
+
+	return respCh, errCh
+}
+
+func (m *mockTaskManagerAccess) GetTaskHistoryAsync(ctx context.Context, taskID string, limit int) (<-chan []resource_access.UITaskRevision, <-chan error) {
+	respCh := make(chan []resource_access.UITaskRevision, 1)
+	errCh := make(chan error, 1)
+
+	respCh <- []resource_access.UITaskRevision{
+		{
+			Revision:  resource_access.UIBoardRevision{Commit: "def456", Message: "Move task " + taskID},
+			Operation: "moved",
+			Changes:   []resource_access.UITaskFieldChange{{Field: "column", Label: "Column", Before: "todo", After: "doing"}},
+		},
+		{
+			Revision:  resource_access.UIBoardRevision{Commit: "abc123", Message: "Create task " + taskID},
+			Operation: "created",
+			Changes:   []resource_access.UITaskFieldChange{{Field: "title", Label: "Title", After: "Past Task"}},
+		},
+	}
+	close(respCh)
+
+	return respCh, errCh
+}
+
+func (m *mockTaskManagerAccess) SubscribeTaskEvents(ctx context.Context) <-chan resource_access.UITaskEvent {
+	events := make(chan resource_access.UITaskEvent, 1)
+	events <- resource_access.UITaskEvent{
+		Type:           resource_access.UITaskMoved,
+		TaskID:         "event-1",
+		PreviousStatus: resource_access.UITodo,
+		Task: &resource_access.UITaskResponse{
+			ID:             "event-1",
+			Description:    "Moved Task",
+			Priority:       resource_access.UIPriority{Urgent: true, Important: true, Label: "urgent-important"},
+			WorkflowStatus: resource_access.UIInProgress,
+		},
+	}
+	close(events)
+	return events
+}
+
 // Helper function to create test WorkflowManager
 func createTestWorkflowManager() WorkflowManager {
 	validation := engines.NewFormValidationEngine()
@@ -475,6 +676,27 @@ func TestUnit_WorkflowManager_Task_DeleteTaskWorkflow(t *testing.T) {
 	}
 }
 
+func TestUnit_WorkflowManager_Task_ArchiveTaskWorkflowCascade(t *testing.T) {
+	wm := createTestWorkflowManager()
+	ctx := context.Background()
+
+	// Subtasks follow the task unless the options say otherwise
+	response, err := wm.Task().ArchiveTaskWorkflow(ctx, "task-123", map[string]any{})
+	if err != nil || response["success"] != true || response["cascade_policy"] != "archive_subtasks" {
+		t.Errorf("ArchiveTaskWorkflow should archive subtasks by default, got %+v (%v)", response, err)
+	}
+
+	response, err = wm.Task().ArchiveTaskWorkflow(ctx, "task-123", map[string]any{"cascade": "promote"})
+	if err != nil || response["success"] != true || response["cascade_policy"] != "promote_subtasks" {
+		t.Errorf("ArchiveTaskWorkflow should promote subtasks, got %+v (%v)", response, err)
+	}
+
+	response, err = wm.Task().RestoreTaskWorkflow(ctx, "task-123", map[string]any{"cascade": "shred"})
+	if err != nil || response["success"] != false {
+		t.Errorf("RestoreTaskWorkflow should reject unknown cascade options, got %+v (%v)", response, err)
+	}
+}
+
 func TestUnit_WorkflowManager_Task_QueryTasksWorkflow(t *testing.T) {
 	wm := createTestWorkflowManager()
 	ctx := context.Background()
@@ -509,6 +731,180 @@ func TestUnit_WorkflowManager_Task_QueryTasksWorkflow(t *testing.T) {
 	}
 }
 
+func TestUnit_WorkflowManager_Task_SubscribeTaskEvents(t *testing.T) {
+	wm := createTestWorkflowManager()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var received []map[string]any
+	for event := range wm.Task().SubscribeTaskEvents(ctx) {
+		received = append(received, event)
+	}
+
+	if len(received) != 1 {
+		t.Fatalf("SubscribeTaskEvents should forward the backend event, got %d events", len(received))
+	}
+	event := received[0]
+	if event["type"] != "moved" || event["task_id"] != "event-1" || event["previous_status"] != "todo" {
+		t.Errorf("SubscribeTaskEvents should describe the change, got %v", event)
+	}
+
+	task, ok := event["task"].(map[string]any)
+	if !ok {
+		t.Fatal("SubscribeTaskEvents should include the changed task")
+	}
+	if task["status"] != "doing" || task["priority"] != "urgent-important" || task["title"] != "Moved Task" {
+		t.Errorf("SubscribeTaskEvents should carry the fields needed to place the task, got %v", task)
+	}
+}
+
+func TestUnit_WorkflowManager_History_UndoRedoWorkflow(t *testing.T) {
+	wm := createTestWorkflowManager()
+	ctx := context.Background()
+
+	response, err := wm.History().UndoWorkflow(ctx)
+	if err != nil {
+		t.Fatalf("UndoWorkflow should not return an error: %v", err)
+	}
+	if success, _ := response["success"].(bool); !success {
+		t.Errorf("UndoWorkflow should return success=true, got %+v", response)
+	}
+	if canRedo, _ := response["can_redo"].(bool); !canRedo {
+		t.Error("UndoWorkflow should report that the operation can be redone")
+	}
+	if changed, _ := response["changed_task_ids"].([]string); len(changed) != 1 || changed[0] != "task-123" {
+		t.Errorf("UndoWorkflow should return the changed tasks, got %v", response["changed_task_ids"])
+	}
+
+	// An empty redo history is not an error
+	response, err = wm.History().RedoWorkflow(ctx)
+	if err != nil {
+		t.Fatalf("RedoWorkflow should not return an error for an empty history: %v", err)
+	}
+	if success, _ := response["success"].(bool); success || response["error"] != "Nothing to redo" {
+		t.Errorf("RedoWorkflow should report that there is nothing to redo, got %+v", response)
+	}
+}
+
+func TestUnit_WorkflowManager_Rules_SimulateRulesWorkflow(t *testing.T) {
+	wm := createTestWorkflowManager()
+	ctx := context.Background()
+
+	response, err := wm.Rules().SimulateRulesWorkflow(ctx, "")
+	if err != nil {
+		t.Fatalf("SimulateRulesWorkflow should not return an error: %v", err)
+	}
+	if success, _ := response["success"].(bool); !success || response["rules_simulated"] != 1 || response["tasks_evaluated"] != 2 {
+		t.Errorf("SimulateRulesWorkflow should return the simulation counts, got %+v", response)
+	}
+	violations, _ := response["violations"].([]map[string]any)
+	if len(violations) != 1 || violations[0]["rule_id"] != "wip-limit" || violations[0]["task_title"] != "Test Task" {
+		t.Errorf("SimulateRulesWorkflow should return the violations, got %+v", response["violations"])
+	}
+
+	// A rules file that cannot be read fails the workflow
+	response, err = wm.Rules().SimulateRulesWorkflow(ctx, "missing.json")
+	if err == nil {
+		t.Fatal("SimulateRulesWorkflow should return an error for an unreadable rules file")
+	}
+	if success, _ := response["success"].(bool); success {
+		t.Errorf("SimulateRulesWorkflow should return success=false, got %+v", response)
+	}
+}
+
+func TestUnit_WorkflowManager_History_TimeTravelWorkflows(t *testing.T) {
+	wm := createTestWorkflowManager()
+	ctx := context.Background()
+
+	response, err := wm.History().ListRevisionsWorkflow(ctx, 0)
+	if err != nil {
+		t.Fatalf("ListRevisionsWorkflow should not return an error: %v", err)
+	}
+	revisions, _ := response["revisions"].([]map[string]any)
+	if len(revisions) != 2 || revisions[1]["commit"] != "abc123" {
+		t.Fatalf("ListRevisionsWorkflow should return the revisions newest first, got %+v", response)
+	}
+
+	response, err = wm.History().LoadBoardAtWorkflow(ctx, "abc123", time.Time{})
+	if err != nil {
+		t.Fatalf("LoadBoardAtWorkflow should not return an error: %v", err)
+	}
+	if readOnly, _ := response["read_only"].(bool); !readOnly {
+		t.Error("LoadBoardAtWorkflow should mark the board as read-only")
+	}
+	tasks, _ := response["tasks"].([]interface{})
+	if len(tasks) != 1 {
+		t.Fatalf("LoadBoardAtWorkflow should return the tasks of the revision, got %+v", response["tasks"])
+	}
+	if task, _ := tasks[0].(map[string]any); task["id"] != "task-123" || task["status"] != "todo" || task["priority"] != "urgent-important" {
+		t.Errorf("LoadBoardAtWorkflow should return the fields needed to place the task, got %+v", task)
+	}
+
+	response, err = wm.History().RestoreTaskFromWorkflow(ctx, "abc123", "task-123")
+	if err != nil {
+		t.Fatalf("RestoreTaskFromWorkflow should not return an error: %v", err)
+	}
+	if success, _ := response["success"].(bool); !success {
+		t.Errorf("RestoreTaskFromWorkflow should return success=true, got %+v", response)
+	}
+
+	if response, _ = wm.History().RestoreTaskFromWorkflow(ctx, "", "task-123"); response["success"] != false {
+		t.Errorf("RestoreTaskFromWorkflow should require a revision, got %+v", response)
+	}
+}
+
+func TestUnit_WorkflowManager_History_GetTaskHistoryWorkflow(t *testing.T) {
+	wm := createTestWorkflowManager()
+	ctx := context.Background()
+
+	response, err := wm.History().GetTaskHistoryWorkflow(ctx, "task-123", 0)
+	if err != nil {
+		t.Fatalf("GetTaskHistoryWorkflow should not return an error: %v", err)
+	}
+	revisions, _ := response["revisions"].([]map[string]any)
+	if len(revisions) != 2 || revisions[0]["operation"] != "moved" || revisions[0]["commit"] != "def456" {
+		t.Fatalf("GetTaskHistoryWorkflow should return the revisions newest first, got %+v", response)
+	}
+	changes, _ := revisions[0]["changes"].([]map[string]any)
+	if len(changes) != 1 || changes[0]["label"] != "Column" || changes[0]["before"] != "todo" || changes[0]["after"] != "doing" {
+		t.Errorf("GetTaskHistoryWorkflow should return the changed fields, got %+v", revisions[0]["changes"])
+	}
+
+	if response, _ = wm.History().GetTaskHistoryWorkflow(ctx, "", 0); response["success"] != false {
+		t.Errorf("GetTaskHistoryWorkflow should require a task, got %+v", response)
+	}
+}
+
+func TestUnit_WorkflowManager_Batch_ExecutesSingleTransaction(t *testing.T) {
+	wm := createTestWorkflowManager()
+	ctx := context.Background()
+
+	response, err := wm.Batch().BatchPriorityUpdateWorkflow(ctx, []string{"task-1", "task-2"}, "urgent-important")
+	if err != nil {
+		t.Fatalf("BatchPriorityUpdateWorkflow should not return an error: %v", err)
+	}
+	if response["success"] != true || response["success_count"] != 2 || response["commit"] != "batch123" {
+		t.Errorf("BatchPriorityUpdateWorkflow should change both tasks in one commit, got %+v", response)
+	}
+
+	// A failing task leaves every task of the batch unchanged
+	response, err = wm.Batch().BatchStatusUpdateWorkflow(ctx, []string{"task-1", "missing-task", "task-3"}, "done")
+	if err != nil {
+		t.Fatalf("BatchStatusUpdateWorkflow should not return an error: %v", err)
+	}
+	if response["success"] != false || response["failure_count"] != 3 || response["failed_task_id"] != "missing-task" {
+		t.Errorf("BatchStatusUpdateWorkflow should fail the whole batch, got %+v", response)
+	}
+	results, _ := response["results"].([]map[string]any)
+	if len(results) != 3 || results[0]["success"] != false || results[0]["error"] != "not changed, batch failed at task missing-task" {
+		t.Errorf("BatchStatusUpdateWorkflow should report every task as unchanged, got %+v", results)
+	}
+
+	if response, _ = wm.Batch().BatchStatusUpdateWorkflow(ctx, []string{"task-1"}, "blocked"); response["success"] != false {
+		t.Errorf("BatchStatusUpdateWorkflow should reject unknown statuses, got %+v", response)
+	}
+}
+
 func TestUnit_WorkflowManager_Drag_ProcessDragDropWorkflow(t *testing.T) {
 	wm := createTestWorkflowManager()
 	ctx := context.Background()
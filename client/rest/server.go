@@ -0,0 +1,413 @@
+// Package rest exposes a board's TaskManager as a local REST/JSON HTTP API.
+// Task representations carry an ETag derived from their last update time, and
+// modifying requests honour If-Match so concurrent clients cannot overwrite each
+// other's changes unnoticed.
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rknuus/eisenkan/internal/engines"
+	"github.com/rknuus/eisenkan/internal/managers/task_manager"
+	"github.com/rknuus/eisenkan/internal/resource_access/board_access"
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// maxRequestBodySize limits the size of JSON request bodies
+const maxRequestBodySize = 1 << 20
+
+// Server serves the REST API for a single board
+type Server struct {
+	taskManager task_manager.TaskManager
+	boardPath   string
+	logger      utilities.ILoggingUtility
+	mux         *http.ServeMux
+}
+
+// ErrorResponse is the body of every non-2xx response
+type ErrorResponse struct {
+	Error      string                  `json:"error"`
+	Violations []engines.RuleViolation `json:"violations,omitempty"`
+}
+
+// StatusChangeRequest is the body of a workflow status change
+type StatusChangeRequest struct {
+	Status task_manager.WorkflowStatus `json:"status"`
+}
+
+// NewServer creates the REST API handler for the board managed by taskManager
+func NewServer(taskManager task_manager.TaskManager, boardPath string, logger utilities.ILoggingUtility) *Server {
+	s := &Server{
+		taskManager: taskManager,
+		boardPath:   boardPath,
+		logger:      logger,
+		mux:         http.NewServeMux(),
+	}
+
+	s.mux.HandleFunc("GET /api/tasks", s.handleListTasks)
+	s.mux.HandleFunc("POST /api/tasks", s.handleCreateTask)
+	s.mux.HandleFunc("POST /api/tasks/validate", s.handleValidateTask)
+	s.mux.HandleFunc("GET /api/tasks/{id}", s.handleGetTask)
+	s.mux.HandleFunc("PUT /api/tasks/{id}", s.handleUpdateTask)
+	s.mux.HandleFunc("DELETE /api/tasks/{id}", s.handleDeleteTask)
+	s.mux.HandleFunc("PUT /api/tasks/{id}/status", s.handleChangeTaskStatus)
+	s.mux.HandleFunc("POST /api/tasks/{id}/archive", s.handleArchiveTask)
+	s.mux.HandleFunc("POST /api/promotions", s.handleProcessPriorityPromotions)
+	s.mux.HandleFunc("GET /api/board", s.handleGetBoardMetadata)
+	s.mux.HandleFunc("PUT /api/board", s.handleUpdateBoardMetadata)
+
+	return s
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// handleListTasks returns the tasks matching the query parameters status, section, priority, tags and parent
+func (s *Server) handleListTasks(w http.ResponseWriter, r *http.Request) {
+	criteria, err := parseQueryCriteria(r)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	tasks, err := s.taskManager.ListTasks(criteria)
+	if err != nil {
+		s.writeTaskManagerError(w, err)
+		return
+	}
+	if tasks == nil {
+		tasks = []task_manager.TaskResponse{}
+	}
+	s.writeJSON(w, http.StatusOK, tasks)
+}
+
+// handleCreateTask creates a task and returns it with its location
+func (s *Server) handleCreateTask(w http.ResponseWriter, r *http.Request) {
+	var request task_manager.TaskRequest
+	if err := decodeBody(w, r, &request); err != nil {
+		s.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	task, err := s.taskManager.CreateTask(request)
+	if err != nil {
+		s.writeTaskManagerError(w, err)
+		return
+	}
+	w.Header().Set("Location", "/api/tasks/"+task.ID)
+	s.writeTask(w, http.StatusCreated, task)
+}
+
+// handleValidateTask checks a prospective task against the board rules without storing it
+func (s *Server) handleValidateTask(w http.ResponseWriter, r *http.Request) {
+	var request task_manager.TaskRequest
+	if err := decodeBody(w, r, &request); err != nil {
+		s.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	result, err := s.taskManager.ValidateTask(request)
+	if err != nil {
+		s.writeTaskManagerError(w, err)
+		return
+	}
+	s.writeJSON(w, http.StatusOK, result)
+}
+
+// handleGetTask returns a single task, or 304 when If-None-Match matches its ETag
+func (s *Server) handleGetTask(w http.ResponseWriter, r *http.Request) {
+	task, err := s.taskManager.GetTask(r.PathValue("id"))
+	if err != nil {
+		s.writeTaskManagerError(w, err)
+		return
+	}
+
+	etag := taskETag(task)
+	if matchesETag(r.Header.Get("If-None-Match"), etag, true) {
+		w.Header().Set("ETag", etag)
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	s.writeTask(w, http.StatusOK, task)
+}
+
+// handleUpdateTask replaces the data of a task
+func (s *Server) handleUpdateTask(w http.ResponseWriter, r *http.Request) {
+	var request task_manager.TaskRequest
+	if err := decodeBody(w, r, &request); err != nil {
+		s.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	s.modifyTask(w, r, func(taskID string, expectedUpdatedAt *time.Time) (task_manager.TaskResponse, error) {
+		return s.taskManager.UpdateTask(taskID, request, expectedUpdatedAt)
+	})
+}
+
+// handleChangeTaskStatus moves a task to another workflow status
+func (s *Server) handleChangeTaskStatus(w http.ResponseWriter, r *http.Request) {
+	var request StatusChangeRequest
+	if err := decodeBody(w, r, &request); err != nil {
+		s.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	switch request.Status {
+	case task_manager.Todo, task_manager.InProgress, task_manager.Done:
+	default:
+		s.writeError(w, http.StatusBadRequest, fmt.Errorf("unknown workflow status %q", request.Status))
+		return
+	}
+
+	s.modifyTask(w, r, func(taskID string, expectedUpdatedAt *time.Time) (task_manager.TaskResponse, error) {
+		return s.taskManager.ChangeTaskStatus(taskID, request.Status, expectedUpdatedAt)
+	})
+}
+
+// handleArchiveTask archives a task together with its subtasks
+func (s *Server) handleArchiveTask(w http.ResponseWriter, r *http.Request) {
+	s.modifyTask(w, r, func(taskID string, expectedUpdatedAt *time.Time) (task_manager.TaskResponse, error) {
+		return s.taskManager.ArchiveTask(taskID, board_access.ArchiveSubtasks, expectedUpdatedAt)
+	})
+}
+
+// handleDeleteTask deletes a task together with its subtasks
+func (s *Server) handleDeleteTask(w http.ResponseWriter, r *http.Request) {
+	taskID := r.PathValue("id")
+	expectedUpdatedAt, ok := s.checkPrecondition(w, r, taskID)
+	if !ok {
+		return
+	}
+	if err := s.taskManager.DeleteTask(taskID, expectedUpdatedAt); err != nil {
+		s.writeModifyError(w, taskID, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleProcessPriorityPromotions promotes all tasks whose promotion date has been reached
+func (s *Server) handleProcessPriorityPromotions(w http.ResponseWriter, r *http.Request) {
+	promoted, err := s.taskManager.ProcessPriorityPromotions()
+	if err != nil {
+		s.writeTaskManagerError(w, err)
+		return
+	}
+	if promoted == nil {
+		promoted = []task_manager.TaskResponse{}
+	}
+	s.writeJSON(w, http.StatusOK, promoted)
+}
+
+// handleGetBoardMetadata returns the board metadata
+func (s *Server) handleGetBoardMetadata(w http.ResponseWriter, r *http.Request) {
+	metadata, err := s.taskManager.GetBoardMetadata(s.boardPath)
+	if err != nil {
+		s.writeTaskManagerError(w, err)
+		return
+	}
+	s.writeJSON(w, http.StatusOK, metadata)
+}
+
+// handleUpdateBoardMetadata changes the board title, description and metadata
+func (s *Server) handleUpdateBoardMetadata(w http.ResponseWriter, r *http.Request) {
+	var request task_manager.BoardMetadataRequest
+	if err := decodeBody(w, r, &request); err != nil {
+		s.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	metadata, err := s.taskManager.UpdateBoardMetadata(s.boardPath, request)
+	if err != nil {
+		s.writeTaskManagerError(w, err)
+		return
+	}
+	s.writeJSON(w, http.StatusOK, metadata)
+}
+
+// modifyTask applies a change to the task named in the path, which TaskManager only makes while the task still
+// matches If-Match
+func (s *Server) modifyTask(w http.ResponseWriter, r *http.Request, change func(taskID string, expectedUpdatedAt *time.Time) (task_manager.TaskResponse, error)) {
+	taskID := r.PathValue("id")
+	expectedUpdatedAt, ok := s.checkPrecondition(w, r, taskID)
+	if !ok {
+		return
+	}
+
+	task, err := change(taskID, expectedUpdatedAt)
+	if err != nil {
+		s.writeModifyError(w, taskID, err)
+		return
+	}
+	s.writeTask(w, http.StatusOK, task)
+}
+
+// checkPrecondition verifies that the task matches If-Match and returns the update time the change has to find the
+// task with, nil when any version may be changed; otherwise it writes the error response
+func (s *Server) checkPrecondition(w http.ResponseWriter, r *http.Request, taskID string) (*time.Time, bool) {
+	ifMatch := r.Header.Get("If-Match")
+	if ifMatch == "" {
+		return nil, true
+	}
+
+	current, err := s.taskManager.GetTask(taskID)
+	if err != nil {
+		s.writeTaskManagerError(w, err)
+		return nil, false
+	}
+	if !matchesETag(ifMatch, taskETag(current), false) {
+		s.writePreconditionFailed(w, taskID, current)
+		return nil, false
+	}
+	for _, candidate := range strings.Split(ifMatch, ",") {
+		if strings.TrimSpace(candidate) == "*" {
+			return nil, true
+		}
+	}
+	return &current.UpdatedAt, true
+}
+
+// writeModifyError writes the error response of a failed change, 412 with the current ETag when the task was
+// changed after If-Match was checked
+func (s *Server) writeModifyError(w http.ResponseWriter, taskID string, err error) {
+	if errors.Is(err, task_manager.ErrTaskModified) {
+		if current, getErr := s.taskManager.GetTask(taskID); getErr == nil {
+			s.writePreconditionFailed(w, taskID, current)
+			return
+		}
+	}
+	s.writeTaskManagerError(w, err)
+}
+
+// writePreconditionFailed writes a 412 response carrying the task's current ETag
+func (s *Server) writePreconditionFailed(w http.ResponseWriter, taskID string, current task_manager.TaskResponse) {
+	w.Header().Set("ETag", taskETag(current))
+	s.writeError(w, http.StatusPreconditionFailed, fmt.Errorf("task %s has been modified", taskID))
+}
+
+// taskETag derives a strong entity tag from the task's last update time
+func taskETag(task task_manager.TaskResponse) string {
+	return `"` + strconv.FormatInt(task.UpdatedAt.UnixNano(), 36) + `"`
+}
+
+// matchesETag reports whether an If-Match or If-None-Match header value matches etag. If-Match requires the strong
+// comparison of RFC 9110, under which weak tags never match; If-None-Match uses the weak comparison.
+func matchesETag(header, etag string, weak bool) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if weak {
+			candidate = strings.TrimPrefix(candidate, "W/")
+		}
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// parseQueryCriteria converts list query parameters into task query criteria
+func parseQueryCriteria(r *http.Request) (task_manager.QueryCriteria, error) {
+	query := r.URL.Query()
+	criteria := task_manager.QueryCriteria{
+		Columns:  splitList(query.Get("status")),
+		Sections: splitList(query.Get("section")),
+		Tags:     splitList(query.Get("tags")),
+	}
+
+	if label := query.Get("priority"); label != "" {
+		priority, err := parsePriorityLabel(label)
+		if err != nil {
+			return criteria, err
+		}
+		criteria.Priority = &priority
+	}
+	if parent := query.Get("parent"); parent != "" {
+		criteria.ParentTaskID = &parent
+	}
+	return criteria, nil
+}
+
+// parsePriorityLabel converts an Eisenhower label such as "urgent-important" into a priority
+func parsePriorityLabel(label string) (board_access.Priority, error) {
+	switch label {
+	case "urgent-important", "urgent-not-important", "not-urgent-important":
+		return board_access.Priority{
+			Urgent:    !strings.HasPrefix(label, "not-urgent"),
+			Important: !strings.HasSuffix(label, "not-important"),
+			Label:     label,
+		}, nil
+	default:
+		return board_access.Priority{}, fmt.Errorf("unknown priority %q", label)
+	}
+}
+
+// splitList splits a comma-separated query value, dropping empty items
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// decodeBody parses a JSON request body, rejecting unknown fields and trailing data
+func decodeBody(w http.ResponseWriter, r *http.Request, target interface{}) error {
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBodySize))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(target); err != nil {
+		return fmt.Errorf("invalid request body: %w", err)
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return errors.New("invalid request body: unexpected data after JSON value")
+	}
+	return nil
+}
+
+// writeTask writes a task representation together with its ETag
+func (s *Server) writeTask(w http.ResponseWriter, status int, task task_manager.TaskResponse) {
+	w.Header().Set("ETag", taskETag(task))
+	s.writeJSON(w, status, task)
+}
+
+// writeJSON writes a JSON response body
+func (s *Server) writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(value); err != nil {
+		s.logger.LogMessage(utilities.Warning, "RESTServer", fmt.Sprintf("Failed to write response: %v", err))
+	}
+}
+
+// writeError writes an error response
+func (s *Server) writeError(w http.ResponseWriter, status int, err error) {
+	s.writeJSON(w, status, ErrorResponse{Error: err.Error()})
+}
+
+// writeTaskManagerError maps TaskManager errors to HTTP status codes
+func (s *Server) writeTaskManagerError(w http.ResponseWriter, err error) {
+	var violationErr *task_manager.RuleViolationError
+	switch {
+	case errors.As(err, &violationErr):
+		s.writeJSON(w, http.StatusUnprocessableEntity, ErrorResponse{Error: err.Error(), Violations: violationErr.Violations})
+	case errors.Is(err, task_manager.ErrTaskNotFound):
+		s.writeError(w, http.StatusNotFound, err)
+	case errors.Is(err, task_manager.ErrTaskModified):
+		s.writeError(w, http.StatusPreconditionFailed, err)
+	case errors.Is(err, board_access.ErrModifiedExternally):
+		s.writeError(w, http.StatusConflict, err)
+	case errors.Is(err, board_access.ErrBoardReadOnly):
+		s.writeError(w, http.StatusLocked, err)
+	default:
+		s.logger.LogError("RESTServer", err, nil)
+		s.writeError(w, http.StatusInternalServerError, err)
+	}
+}
@@ -0,0 +1,407 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rknuus/eisenkan/internal/engines"
+	"github.com/rknuus/eisenkan/internal/managers/task_manager"
+	"github.com/rknuus/eisenkan/internal/resource_access"
+	"github.com/rknuus/eisenkan/internal/resource_access/board_access"
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// newTestServer serves a fresh board, optionally with the given rules.json content
+func newTestServer(t *testing.T, rules string) *httptest.Server {
+	t.Helper()
+	taskManager, boardPath := newTestTaskManager(t, rules)
+	server := httptest.NewServer(NewServer(taskManager, boardPath, utilities.NewLoggingUtility()))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// newTestTaskManager creates the TaskManager of a fresh board, optionally with the given rules.json content
+func newTestTaskManager(t *testing.T, rules string) (task_manager.TaskManager, string) {
+	t.Helper()
+	boardPath := t.TempDir()
+	if rules != "" {
+		if err := os.WriteFile(filepath.Join(boardPath, "rules.json"), []byte(rules), 0644); err != nil {
+			t.Fatalf("Failed to write rules: %v", err)
+		}
+	}
+
+	logger := utilities.NewLoggingUtility()
+	repository, err := utilities.InitializeRepositoryWithConfig(boardPath, &utilities.AuthorConfiguration{User: "Test", Email: "test@example.com"})
+	if err != nil {
+		t.Fatalf("Failed to initialize repository: %v", err)
+	}
+	boardAccess, err := board_access.NewBoardAccess(boardPath)
+	if err != nil {
+		t.Fatalf("Failed to create BoardAccess: %v", err)
+	}
+	rulesAccess, err := resource_access.NewRulesAccess(boardPath)
+	if err != nil {
+		t.Fatalf("Failed to create RulesAccess: %v", err)
+	}
+	ruleEngine, err := engines.NewRuleEngine(rulesAccess, boardAccess)
+	if err != nil {
+		t.Fatalf("Failed to create RuleEngine: %v", err)
+	}
+	t.Cleanup(func() {
+		ruleEngine.Close()
+		rulesAccess.Close()
+		boardAccess.Close()
+		repository.Close()
+	})
+	return task_manager.NewTaskManager(boardAccess, ruleEngine, logger, repository, boardPath), boardPath
+}
+
+// interferingTaskManager changes a task once right after it was read, like a concurrent request between
+// the If-Match check and the change
+type interferingTaskManager struct {
+	task_manager.TaskManager
+	interfere func(task task_manager.TaskResponse)
+}
+
+// GetTask returns the task and then lets the interference change it
+func (m *interferingTaskManager) GetTask(taskID string) (task_manager.TaskResponse, error) {
+	task, err := m.TaskManager.GetTask(taskID)
+	if err == nil && m.interfere != nil {
+		interfere := m.interfere
+		m.interfere = nil
+		interfere(task)
+	}
+	return task, err
+}
+
+// doRequest sends a JSON request and decodes the JSON response into result when given
+func doRequest(t *testing.T, method, url string, body interface{}, headers map[string]string, result interface{}) *http.Response {
+	t.Helper()
+	var reader *bytes.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			t.Fatalf("Failed to encode request: %v", err)
+		}
+		reader = bytes.NewReader(data)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+
+	req, err := http.NewRequest(method, url, reader)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("%s %s failed: %v", method, url, err)
+	}
+	defer resp.Body.Close()
+	if result != nil {
+		if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+			t.Fatalf("%s %s returned invalid JSON (status %d): %v", method, url, resp.StatusCode, err)
+		}
+	}
+	return resp
+}
+
+func urgentImportantRequest(description string) task_manager.TaskRequest {
+	return task_manager.TaskRequest{
+		Description:    description,
+		Priority:       board_access.Priority{Urgent: true, Important: true, Label: "urgent-important"},
+		WorkflowStatus: task_manager.Todo,
+	}
+}
+
+func TestUnit_RESTServer_ETags(t *testing.T) {
+	updatedAt := time.Date(2025, 3, 3, 9, 0, 0, 123, time.UTC)
+	etag := taskETag(task_manager.TaskResponse{UpdatedAt: updatedAt})
+
+	if etag != taskETag(task_manager.TaskResponse{UpdatedAt: updatedAt}) {
+		t.Error("Expected identical ETags for identical update times")
+	}
+	if etag == taskETag(task_manager.TaskResponse{UpdatedAt: updatedAt.Add(time.Nanosecond)}) {
+		t.Error("Expected different ETags for different update times")
+	}
+
+	// Weak tags only match under the weak comparison of If-None-Match
+	testCases := []struct {
+		header      string
+		strongMatch bool
+		weakMatch   bool
+	}{
+		{etag, true, true},
+		{"*", true, true},
+		{"W/" + etag, false, true},
+		{`"other", ` + etag, true, true},
+		{`"other"`, false, false},
+		{"", false, false},
+	}
+	for _, tc := range testCases {
+		if got := matchesETag(tc.header, etag, false); got != tc.strongMatch {
+			t.Errorf("matchesETag(%q) with strong comparison: expected %v, got %v", tc.header, tc.strongMatch, got)
+		}
+		if got := matchesETag(tc.header, etag, true); got != tc.weakMatch {
+			t.Errorf("matchesETag(%q) with weak comparison: expected %v, got %v", tc.header, tc.weakMatch, got)
+		}
+	}
+}
+
+func TestUnit_RESTServer_ParseQueryCriteria(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/api/tasks?status=todo,doing&priority=not-urgent-important&tags=a,,b&parent=p1", nil)
+	criteria, err := parseQueryCriteria(req)
+	if err != nil {
+		t.Fatalf("Failed to parse criteria: %v", err)
+	}
+	if len(criteria.Columns) != 2 || len(criteria.Tags) != 2 {
+		t.Errorf("Unexpected columns or tags: %+v", criteria)
+	}
+	if criteria.Priority == nil || criteria.Priority.Urgent || !criteria.Priority.Important {
+		t.Errorf("Unexpected priority: %+v", criteria.Priority)
+	}
+	if criteria.ParentTaskID == nil || *criteria.ParentTaskID != "p1" {
+		t.Errorf("Unexpected parent: %v", criteria.ParentTaskID)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/tasks?priority=someday", nil)
+	if _, err := parseQueryCriteria(req); err == nil {
+		t.Error("Expected error for unknown priority")
+	}
+}
+
+func TestIntegration_RESTServer_TaskLifecycle(t *testing.T) {
+	server := newTestServer(t, "")
+	tasksURL := server.URL + "/api/tasks"
+
+	// Create
+	var created task_manager.TaskResponse
+	resp := doRequest(t, http.MethodPost, tasksURL, urgentImportantRequest("Write report"), nil, &created)
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Expected 201, got %d", resp.StatusCode)
+	}
+	if resp.Header.Get("Location") != "/api/tasks/"+created.ID || resp.Header.Get("ETag") == "" {
+		t.Errorf("Expected Location and ETag headers, got %v", resp.Header)
+	}
+	etag := resp.Header.Get("ETag")
+
+	// Read, with and without a matching If-None-Match
+	var fetched task_manager.TaskResponse
+	resp = doRequest(t, http.MethodGet, tasksURL+"/"+created.ID, nil, nil, &fetched)
+	if resp.StatusCode != http.StatusOK || fetched.Description != "Write report" || resp.Header.Get("ETag") != etag {
+		t.Errorf("Unexpected GET result %d %+v (ETag %s)", resp.StatusCode, fetched, resp.Header.Get("ETag"))
+	}
+	resp = doRequest(t, http.MethodGet, tasksURL+"/"+created.ID, nil, map[string]string{"If-None-Match": etag}, nil)
+	if resp.StatusCode != http.StatusNotModified {
+		t.Errorf("Expected 304 for matching If-None-Match, got %d", resp.StatusCode)
+	}
+
+	// Update with the current ETag succeeds and yields a new ETag
+	update := urgentImportantRequest("Write final report")
+	var updated task_manager.TaskResponse
+	resp = doRequest(t, http.MethodPut, tasksURL+"/"+created.ID, update, map[string]string{"If-Match": etag}, &updated)
+	if resp.StatusCode != http.StatusOK || updated.Description != "Write final report" {
+		t.Fatalf("Expected successful update, got %d %+v", resp.StatusCode, updated)
+	}
+	newETag := resp.Header.Get("ETag")
+	if newETag == etag {
+		t.Error("Expected the ETag to change after an update")
+	}
+
+	// A stale ETag is rejected and the task keeps its data
+	var errResp ErrorResponse
+	resp = doRequest(t, http.MethodPut, tasksURL+"/"+created.ID, urgentImportantRequest("Lost update"), map[string]string{"If-Match": etag}, &errResp)
+	if resp.StatusCode != http.StatusPreconditionFailed || resp.Header.Get("ETag") != newETag {
+		t.Errorf("Expected 412 with current ETag, got %d (ETag %s)", resp.StatusCode, resp.Header.Get("ETag"))
+	}
+
+	// Status change
+	var moved task_manager.TaskResponse
+	resp = doRequest(t, http.MethodPut, tasksURL+"/"+created.ID+"/status", StatusChangeRequest{Status: task_manager.InProgress}, map[string]string{"If-Match": newETag}, &moved)
+	if resp.StatusCode != http.StatusOK || moved.WorkflowStatus != task_manager.InProgress || moved.Description != "Write final report" {
+		t.Errorf("Expected task in doing, got %d %+v", resp.StatusCode, moved)
+	}
+	resp = doRequest(t, http.MethodPut, tasksURL+"/"+created.ID+"/status", StatusChangeRequest{Status: "blocked"}, nil, &errResp)
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected 400 for unknown status, got %d", resp.StatusCode)
+	}
+
+	// List with filters
+	doRequest(t, http.MethodPost, tasksURL, urgentImportantRequest("Second task"), nil, nil)
+	var listed []task_manager.TaskResponse
+	resp = doRequest(t, http.MethodGet, tasksURL+"?status=doing", nil, nil, &listed)
+	if resp.StatusCode != http.StatusOK || len(listed) != 1 || listed[0].ID != created.ID {
+		t.Errorf("Expected only the task in doing, got %d %+v", resp.StatusCode, listed)
+	}
+	resp = doRequest(t, http.MethodGet, tasksURL+"?priority=someday", nil, nil, &errResp)
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected 400 for unknown priority, got %d", resp.StatusCode)
+	}
+
+	// Delete, then the task is gone
+	resp = doRequest(t, http.MethodDelete, tasksURL+"/"+created.ID, nil, map[string]string{"If-Match": etag}, nil)
+	if resp.StatusCode != http.StatusPreconditionFailed {
+		t.Errorf("Expected 412 for delete with stale ETag, got %d", resp.StatusCode)
+	}
+	resp = doRequest(t, http.MethodDelete, tasksURL+"/"+created.ID, nil, nil, nil)
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Expected 204 for delete, got %d", resp.StatusCode)
+	}
+	resp = doRequest(t, http.MethodGet, tasksURL+"/"+created.ID, nil, nil, &errResp)
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected 404 after delete, got %d", resp.StatusCode)
+	}
+	resp = doRequest(t, http.MethodPut, tasksURL+"/"+created.ID+"/status", StatusChangeRequest{Status: task_manager.Done}, nil, &errResp)
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected 404 for status change of deleted task, got %d", resp.StatusCode)
+	}
+
+	// Promotions
+	var promoted []task_manager.TaskResponse
+	resp = doRequest(t, http.MethodPost, server.URL+"/api/promotions", nil, nil, &promoted)
+	if resp.StatusCode != http.StatusOK || len(promoted) != 0 {
+		t.Errorf("Expected no promotions, got %d %+v", resp.StatusCode, promoted)
+	}
+}
+
+func TestIntegration_RESTServer_ChangeAfterPreconditionCheck(t *testing.T) {
+	taskManager, boardPath := newTestTaskManager(t, "")
+	interfering := &interferingTaskManager{TaskManager: taskManager}
+	server := httptest.NewServer(NewServer(interfering, boardPath, utilities.NewLoggingUtility()))
+	defer server.Close()
+	tasksURL := server.URL + "/api/tasks"
+
+	var created task_manager.TaskResponse
+	resp := doRequest(t, http.MethodPost, tasksURL, urgentImportantRequest("Write report"), nil, &created)
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Expected 201, got %d", resp.StatusCode)
+	}
+	etag := resp.Header.Get("ETag")
+
+	// Another client updates the task after its ETag was checked, so the change must not overwrite that update
+	interfering.interfere = func(task task_manager.TaskResponse) {
+		if _, err := taskManager.UpdateTask(task.ID, urgentImportantRequest("Concurrent update"), nil); err != nil {
+			t.Errorf("Concurrent update failed: %v", err)
+		}
+	}
+	var errResp ErrorResponse
+	resp = doRequest(t, http.MethodPut, tasksURL+"/"+created.ID, urgentImportantRequest("Lost update"), map[string]string{"If-Match": etag}, &errResp)
+	current, err := taskManager.GetTask(created.ID)
+	if err != nil {
+		t.Fatalf("Failed to get task: %v", err)
+	}
+	if resp.StatusCode != http.StatusPreconditionFailed || resp.Header.Get("ETag") != taskETag(current) {
+		t.Errorf("Expected 412 with the ETag of the concurrent update, got %d (ETag %s)", resp.StatusCode, resp.Header.Get("ETag"))
+	}
+	if current.Description != "Concurrent update" {
+		t.Errorf("Expected the concurrent update to be kept, got %+v", current)
+	}
+
+	// The same holds for deleting the task
+	etag = taskETag(current)
+	interfering.interfere = func(task task_manager.TaskResponse) {
+		if _, err := taskManager.ChangeTaskStatus(task.ID, task_manager.InProgress, nil); err != nil {
+			t.Errorf("Concurrent status change failed: %v", err)
+		}
+	}
+	resp = doRequest(t, http.MethodDelete, tasksURL+"/"+created.ID, nil, map[string]string{"If-Match": etag}, &errResp)
+	if resp.StatusCode != http.StatusPreconditionFailed {
+		t.Errorf("Expected 412 for a delete after a concurrent change, got %d", resp.StatusCode)
+	}
+	if _, err := taskManager.GetTask(created.ID); err != nil {
+		t.Errorf("Expected the task to be kept, got %v", err)
+	}
+}
+
+func TestIntegration_RESTServer_RuleViolations(t *testing.T) {
+	rules := `{"version":"1.0","rules":[{"id":"wip-limit","name":"WIP limit","category":"validation","trigger_type":"all","conditions":{"max_wip_limit":1},"priority":1,"enabled":true}]}`
+	server := newTestServer(t, rules)
+	tasksURL := server.URL + "/api/tasks"
+
+	if resp := doRequest(t, http.MethodPost, tasksURL, urgentImportantRequest("First"), nil, nil); resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Expected 201 for first task, got %d", resp.StatusCode)
+	}
+
+	var validation task_manager.ValidationResult
+	resp := doRequest(t, http.MethodPost, tasksURL+"/validate", urgentImportantRequest("Second"), nil, &validation)
+	if resp.StatusCode != http.StatusOK || validation.Valid || len(validation.Violations) == 0 {
+		t.Errorf("Expected invalid validation result, got %d %+v", resp.StatusCode, validation)
+	}
+
+	var errResp ErrorResponse
+	resp = doRequest(t, http.MethodPost, tasksURL, urgentImportantRequest("Second"), nil, &errResp)
+	if resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Fatalf("Expected 422 for WIP limit violation, got %d", resp.StatusCode)
+	}
+	if len(errResp.Violations) != 1 || errResp.Violations[0].RuleID != "wip-limit" {
+		t.Errorf("Expected the WIP rule violation in the body, got %+v", errResp)
+	}
+}
+
+func TestIntegration_RESTServer_InvalidRequests(t *testing.T) {
+	server := newTestServer(t, "")
+
+	testCases := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		status int
+	}{
+		{"malformed JSON", http.MethodPost, "/api/tasks", `{"description":`, http.StatusBadRequest},
+		{"unknown field", http.MethodPost, "/api/tasks", `{"title":"x"}`, http.StatusBadRequest},
+		{"trailing data", http.MethodPost, "/api/tasks", `{"description":"x"} {}`, http.StatusBadRequest},
+		{"unknown task", http.MethodGet, "/api/tasks/missing", ``, http.StatusNotFound},
+		{"unknown route", http.MethodGet, "/api/columns", ``, http.StatusNotFound},
+		{"wrong method", http.MethodPatch, "/api/tasks", ``, http.StatusMethodNotAllowed},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := http.NewRequest(tc.method, server.URL+tc.path, bytes.NewBufferString(tc.body))
+			if err != nil {
+				t.Fatalf("Failed to create request: %v", err)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("Request failed: %v", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tc.status {
+				t.Errorf("Expected %d, got %d", tc.status, resp.StatusCode)
+			}
+		})
+	}
+}
+
+func TestIntegration_RESTServer_BoardMetadata(t *testing.T) {
+	server := newTestServer(t, "")
+	boardURL := server.URL + "/api/board"
+
+	var metadata task_manager.BoardMetadataResponse
+	resp := doRequest(t, http.MethodGet, boardURL, nil, nil, &metadata)
+	if resp.StatusCode != http.StatusOK || metadata.SchemaVersion == "" {
+		t.Errorf("Expected board metadata, got %d %+v", resp.StatusCode, metadata)
+	}
+
+	resp = doRequest(t, http.MethodPut, boardURL, task_manager.BoardMetadataRequest{Title: "Team board"}, nil, &metadata)
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected successful metadata update, got %d", resp.StatusCode)
+	}
+
+	var errResp ErrorResponse
+	resp = doRequest(t, http.MethodPut, boardURL, map[string]string{"name": "Team board"}, nil, &errResp)
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected 400 for unknown metadata field, got %d", resp.StatusCode)
+	}
+}
@@ -4,16 +4,23 @@
 package ui
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
+	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/app"
 	"fyne.io/fyne/v2/container"
 	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/storage"
 	"fyne.io/fyne/v2/widget"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
 	clientEngines "github.com/rknuus/eisenkan/client/engines"
 	"github.com/rknuus/eisenkan/client/managers"
@@ -21,6 +28,7 @@ import (
 	"github.com/rknuus/eisenkan/internal/resource_access/board_access"
 	"github.com/rknuus/eisenkan/internal/resource_access"
 	"github.com/rknuus/eisenkan/internal/engines"
+	"github.com/rknuus/eisenkan/internal/rpc"
 	"github.com/rknuus/eisenkan/internal/utilities"
 	clientResourceAccess "github.com/rknuus/eisenkan/internal/client/resource_access"
 )
@@ -94,6 +102,7 @@ type ApplicationRoot struct {
 
 	// View Management
 	currentView        ViewType
+	boardPath          string // board shown in the board view
 	boardSelectionView BoardSelectionView
 	boardView          *BoardView
 
@@ -102,11 +111,19 @@ type ApplicationRoot struct {
 
 	// Dependencies
 	taskManager      task_manager.TaskManager
+	remoteConn       *grpc.ClientConn // connection to the TaskManager service, nil for a local board
 	workflowManager  managers.WorkflowManager
 	formattingEngine *clientEngines.FormattingEngine
 	layoutEngine     *clientEngines.LayoutEngine
 	validationEngine *clientEngines.FormValidationEngine
 
+	// Time-triggered rules, run while the application is open
+	ruleScheduler *task_manager.RuleScheduler
+
+	// Notifications of rules, delivered while the application is open
+	notifications     *utilities.NotificationDispatcher
+	stopNotifications context.CancelFunc
+
 	// Thread Safety
 	mutex sync.RWMutex
 }
@@ -140,6 +157,11 @@ func (ar *ApplicationRoot) initializeDependencies(customBoardDir string) error {
 	ar.layoutEngine = clientEngines.NewLayoutEngine()
 	ar.validationEngine = clientEngines.NewFormValidationEngine()
 
+	// Use a remote backend when a TaskManager service address is configured
+	if serverAddr := os.Getenv("EISENKAN_SERVER"); serverAddr != "" {
+		return ar.initializeRemoteDependencies(serverAddr)
+	}
+
 	// Determine board directory
 	var boardDir string
 	if customBoardDir != "" {
@@ -210,12 +232,34 @@ func (ar *ApplicationRoot) initializeDependencies(customBoardDir string) error {
 	// Initialize TaskManager
 	ar.taskManager = task_manager.NewTaskManager(boardAccess, ruleEngine, loggingUtility, repository, boardDir)
 
+	ar.initializeWorkflowManager(clientResourceAccess.NewTaskManagerAccess(ar.taskManager, cacheUtility, loggingUtility))
+
+	return nil
+}
+
+// initializeRemoteDependencies connects to a TaskManager service instead of opening a local board
+func (ar *ApplicationRoot) initializeRemoteDependencies(serverAddr string) error {
+	conn, err := grpc.NewClient(serverAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("failed to connect to task manager service %s: %w", serverAddr, err)
+	}
+
+	loggingUtility := utilities.NewLoggingUtility()
+	cacheUtility := utilities.NewCacheUtility()
+
+	// The board view talks to TaskManager directly, so it shares the remote client
+	ar.remoteConn = conn
+	ar.taskManager = rpc.NewTaskManagerClient(conn)
+	ar.initializeWorkflowManager(clientResourceAccess.NewTaskManagerAccess(ar.taskManager, cacheUtility, loggingUtility))
+
+	return nil
+}
+
+// initializeWorkflowManager creates the WorkflowManager on top of the given TaskManagerAccess
+func (ar *ApplicationRoot) initializeWorkflowManager(taskManagerAccess clientResourceAccess.ITaskManagerAccess) {
 	// Initialize DragDropEngine
 	dragDropEngine := clientEngines.NewDragDropEngine()
 
-	// Initialize TaskManagerAccess for WorkflowManager
-	taskManagerAccess := clientResourceAccess.NewTaskManagerAccess(ar.taskManager, cacheUtility, loggingUtility)
-
 	// Initialize WorkflowManager
 	ar.workflowManager = managers.NewWorkflowManager(
 		ar.validationEngine,
@@ -223,8 +267,6 @@ func (ar *ApplicationRoot) initializeDependencies(customBoardDir string) error {
 		dragDropEngine,
 		taskManagerAccess,
 	)
-
-	return nil
 }
 
 
@@ -252,6 +294,12 @@ func (ar *ApplicationRoot) StartApplication() error {
 	// Set up navigation event handlers
 	ar.setupNavigationHandlers()
 
+	// Set up undo and redo of board operations
+	ar.setupHistoryShortcuts()
+
+	// Set up the simulation of the board's rules
+	ar.setupRuleSimulationShortcut()
+
 	// Set up window close handler
 	ar.window.SetCloseIntercept(func() {
 		// Clean up and quit directly
@@ -265,11 +313,75 @@ func (ar *ApplicationRoot) StartApplication() error {
 		return fmt.Errorf("failed to show board selection view: %w", err)
 	}
 
+	// Deliver notifications and run time-triggered rules while the window is open
+	ar.startNotifications()
+	defer ar.stopNotificationDelivery()
+	ar.startRuleScheduler()
+	defer ar.stopRuleScheduler()
+
 	// Show window and run
 	ar.window.ShowAndRun()
 	return nil
 }
 
+// startRuleScheduler starts running the time-triggered rules of the board periodically
+func (ar *ApplicationRoot) startRuleScheduler() {
+	if ar.taskManager == nil {
+		return
+	}
+	ar.ruleScheduler = task_manager.NewRuleScheduler(ar.taskManager, utilities.NewSystemClock(), task_manager.DefaultScheduleInterval, utilities.NewLoggingUtility())
+	if err := ar.ruleScheduler.Start(); err != nil {
+		fmt.Printf("Warning: Failed to start rule scheduler: %v\n", err)
+	}
+}
+
+// stopRuleScheduler stops running the time-triggered rules and waits for a run in progress
+func (ar *ApplicationRoot) stopRuleScheduler() {
+	if ar.ruleScheduler != nil {
+		ar.ruleScheduler.Stop()
+	}
+}
+
+// startNotifications delivers the notifications of rules to the desktop, the notification centre of the board and
+// the log file and webhook named by EISENKAN_NOTIFICATION_LOG and EISENKAN_NOTIFICATION_WEBHOOK
+func (ar *ApplicationRoot) startNotifications() {
+	if ar.taskManager == nil {
+		return
+	}
+	logger := utilities.NewLoggingUtility()
+	ar.notifications = utilities.NewNotificationDispatcher(utilities.NewSystemClock(), utilities.DefaultNotificationWindow, logger)
+	if ar.app != nil {
+		ar.notifications.AddNotifier(NewDesktopNotifier(ar.app))
+	}
+	ar.notifications.AddNotifier(utilities.NotifierFunc(ar.notifyBoardView))
+	if logPath := os.Getenv("EISENKAN_NOTIFICATION_LOG"); logPath != "" {
+		ar.notifications.AddNotifier(utilities.NewLogFileNotifier(logPath))
+	}
+	if webhookURL := os.Getenv("EISENKAN_NOTIFICATION_WEBHOOK"); webhookURL != "" {
+		ar.notifications.AddNotifier(utilities.NewWebhookNotifier(webhookURL, nil))
+	}
+
+	// Subscribe before the scheduler's first run, so its notifications are not missed
+	ctx, cancel := context.WithCancel(context.Background())
+	ar.stopNotifications = cancel
+	go task_manager.DeliverRuleNotifications(ar.taskManager.SubscribeRuleNotifications(ctx), ar.notifications, logger)
+}
+
+// stopNotificationDelivery stops delivering the notifications of rules
+func (ar *ApplicationRoot) stopNotificationDelivery() {
+	if ar.stopNotifications != nil {
+		ar.stopNotifications()
+	}
+}
+
+// notifyBoardView adds a notification to the notification centre of the board shown, if any
+func (ar *ApplicationRoot) notifyBoardView(notification utilities.Notification) error {
+	if boardView := ar.boardView; boardView != nil {
+		return boardView.Notifications().Notify(notification)
+	}
+	return nil
+}
+
 // setupNavigationHandlers configures the navigation event handlers
 func (ar *ApplicationRoot) setupNavigationHandlers() {
 	// Handle navigation to board
@@ -372,6 +484,12 @@ func (ar *ApplicationRoot) showBoardView(boardPath string) error {
 			ar.validationEngine,
 			nil, // Use default configuration
 		)
+		ar.boardView.SetOnTaskConflict(ar.showTaskConflicts)
+	}
+
+	// Show the columns of board.json with their names, colours, WIP limits and policies
+	if config := ar.boardColumnConfiguration(boardPath); config != nil {
+		ar.boardView.SetBoardConfiguration(config)
 	}
 
 	// Load the specified board
@@ -389,10 +507,156 @@ func (ar *ApplicationRoot) showBoardView(boardPath string) error {
 		ar.window.SetTitle(fmt.Sprintf("EisenKan - %s", boardPath))
 	}
 	ar.currentView = ViewTypeBoardView
+	ar.boardPath = boardPath
 
 	return nil
 }
 
+// boardColumnConfiguration returns the board view configuration of the columns in board.json, nil without columns
+func (ar *ApplicationRoot) boardColumnConfiguration(boardPath string) *BoardConfiguration {
+	if ar.taskManager == nil {
+		return nil
+	}
+	metadata, err := ar.taskManager.GetBoardMetadata(boardPath)
+	if err != nil || len(metadata.Columns) == 0 {
+		return nil
+	}
+	return NewBoardConfigurationFromColumns(metadata.Title, metadata.Columns)
+}
+
+// setupHistoryShortcuts binds Ctrl+Z to undo and Ctrl+Shift+Z to redo the latest board operation
+func (ar *ApplicationRoot) setupHistoryShortcuts() {
+	canvas := ar.window.Canvas()
+	canvas.AddShortcut(&desktop.CustomShortcut{KeyName: fyne.KeyZ, Modifier: fyne.KeyModifierShortcutDefault}, func(fyne.Shortcut) {
+		if ar.workflowManager != nil && ar.currentView == ViewTypeBoardView {
+			go ar.runHistoryWorkflow(managers.IHistory.UndoWorkflow)
+		}
+	})
+	canvas.AddShortcut(&desktop.CustomShortcut{KeyName: fyne.KeyZ, Modifier: fyne.KeyModifierShortcutDefault | fyne.KeyModifierShift}, func(fyne.Shortcut) {
+		if ar.workflowManager != nil && ar.currentView == ViewTypeBoardView {
+			go ar.runHistoryWorkflow(managers.IHistory.RedoWorkflow)
+		}
+	})
+}
+
+// runHistoryWorkflow undoes or redoes a board operation; the board view follows the reverted tasks through task events
+func (ar *ApplicationRoot) runHistoryWorkflow(workflow func(managers.IHistory, context.Context) (map[string]any, error)) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// An empty history fails the workflow without an error and is not worth a dialog
+	if _, err := workflow(ar.workflowManager.History(), ctx); err != nil && ar.window != nil {
+		runOnMain(func() {
+			dialog.ShowError(err, ar.window)
+		})
+	}
+}
+
+// setupRuleSimulationShortcut binds Ctrl+Shift+R to simulating a rules file on the board
+func (ar *ApplicationRoot) setupRuleSimulationShortcut() {
+	ar.window.Canvas().AddShortcut(&desktop.CustomShortcut{KeyName: fyne.KeyR, Modifier: fyne.KeyModifierShortcutDefault | fyne.KeyModifierShift}, func(fyne.Shortcut) {
+		ar.chooseRulesToSimulate()
+	})
+}
+
+// chooseRulesToSimulate asks for the rules file to simulate, starting in the board directory that holds the board's own rules
+func (ar *ApplicationRoot) chooseRulesToSimulate() {
+	if ar.workflowManager == nil || ar.window == nil || ar.currentView != ViewTypeBoardView {
+		return
+	}
+
+	fileDialog := dialog.NewFileOpen(func(reader fyne.URIReadCloser, err error) {
+		if err != nil {
+			dialog.ShowError(err, ar.window)
+			return
+		}
+		if reader == nil {
+			return // User cancelled
+		}
+		rulesFile := reader.URI().Path()
+		reader.Close()
+		go ar.simulateRules(rulesFile)
+	}, ar.window)
+	fileDialog.SetFilter(storage.NewExtensionFileFilter([]string{".json"}))
+	if location, err := storage.ListerForURI(storage.NewFileURI(ar.boardPath)); err == nil {
+		fileDialog.SetLocation(location)
+	}
+	fileDialog.Show()
+}
+
+// simulateRules shows the violations the rules of rulesFile, including disabled ones, would cause on the board and in its history
+func (ar *ApplicationRoot) simulateRules(rulesFile string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	result, err := ar.workflowManager.Rules().SimulateRulesWorkflow(ctx, rulesFile)
+	runOnMain(func() {
+		if err != nil {
+			dialog.ShowError(err, ar.window)
+			return
+		}
+		content := container.NewVScroll(widget.NewLabel(describeRuleSimulation(result)))
+		content.SetMinSize(fyne.NewSize(640, 400))
+		dialog.ShowCustom("Rule Simulation", "Close", content, ar.window)
+	})
+}
+
+// describeRuleSimulation lists the violations of a rule simulation workflow result followed by their number per rule
+func describeRuleSimulation(result map[string]any) string {
+	var text strings.Builder
+	fmt.Fprintf(&text, "Simulated %v rules on %v tasks and %v changes in %v revisions\n",
+		result["rules_simulated"], result["tasks_evaluated"], result["changes_replayed"], result["revisions_replayed"])
+	violations, _ := result["violations"].([]map[string]any)
+	if len(violations) == 0 {
+		text.WriteString("No violations")
+		return text.String()
+	}
+
+	counts := make(map[string]int)
+	var ruleIDs []string
+	for _, violation := range violations {
+		ruleID, _ := violation["rule_id"].(string)
+		if counts[ruleID] == 0 {
+			ruleIDs = append(ruleIDs, ruleID)
+		}
+		counts[ruleID]++
+
+		fromColumn, _ := violation["from_column"].(string)
+		toColumn, _ := violation["to_column"].(string)
+		when, change := "now", "in "+toColumn
+		if commit, _ := violation["commit"].(string); commit != "" {
+			when, _ = violation["occurred_text"].(string)
+			if fromColumn != "" {
+				change = fromColumn + " -> " + toColumn
+			} else {
+				change = "created in " + toColumn
+			}
+		}
+		task, _ := violation["task_title"].(string)
+		if task == "" {
+			task, _ = violation["task_id"].(string)
+		}
+		fmt.Fprintf(&text, "\n%s, %s: '%s' %s: %v", ruleID, when, task, change, violation["message"])
+	}
+	text.WriteString("\n")
+	for _, ruleID := range ruleIDs {
+		fmt.Fprintf(&text, "\n%s: %d violations", ruleID, counts[ruleID])
+	}
+	return text.String()
+}
+
+// showTaskConflicts tells the user about task fields a sync merged provisionally
+func (ar *ApplicationRoot) showTaskConflicts(taskID string, conflicts []map[string]any) {
+	if ar.window == nil {
+		return
+	}
+	message := fmt.Sprintf("Task %s was changed differently on another copy of this board.\n\n%s\n\nReview the task and edit it if the kept values are wrong.",
+		taskID, describeFieldConflicts(conflicts, "\n"))
+	runOnMain(func() {
+		dialog.ShowInformation("Sync Conflicts", message, ar.window)
+	})
+}
+
 // showErrorAndExit displays an error dialog and exits the application
 func (ar *ApplicationRoot) showErrorAndExit(err error) {
 	if ar.window == nil {
@@ -414,11 +678,22 @@ func (ar *ApplicationRoot) showErrorAndExit(err error) {
 // shutdownApplication performs clean shutdown of the application
 func (ar *ApplicationRoot) shutdownApplication() {
 	// Simple and direct shutdown
+	ar.stopRuleScheduler()
+	ar.stopNotificationDelivery()
+	ar.closeRemoteConnection()
 	if ar.app != nil {
 		ar.app.Quit()
 	}
 }
 
+// closeRemoteConnection closes the connection to the TaskManager service, if any
+func (ar *ApplicationRoot) closeRemoteConnection() {
+	if ar.remoteConn != nil {
+		ar.remoteConn.Close()
+		ar.remoteConn = nil
+	}
+}
+
 // GetCurrentView returns the currently displayed view type
 func (ar *ApplicationRoot) GetCurrentView() ViewType {
 	ar.mutex.RLock()
@@ -367,4 +367,28 @@ func TestUnit_ApplicationRoot_StartApplication_MockEnvironment(t *testing.T) {
 
 	// Note: Full StartApplication testing is handled in integration tests
 	// Unit tests focus on individual component behavior
+}
+
+func TestUnit_ApplicationRoot_DescribeRuleSimulation(t *testing.T) {
+	result := map[string]any{"rules_simulated": 2, "tasks_evaluated": 3, "revisions_replayed": 2, "changes_replayed": 4}
+	if text := describeRuleSimulation(result); text != "Simulated 2 rules on 3 tasks and 4 changes in 2 revisions\nNo violations" {
+		t.Errorf("Unexpected description without violations: %q", text)
+	}
+
+	result["violations"] = []map[string]any{
+		{"rule_id": "wip-limit", "message": "WIP limit exceeded", "task_id": "task1", "task_title": "Draft", "to_column": "doing"},
+		{"rule_id": "transitions", "message": "Invalid column transition", "task_id": "task2", "to_column": "done",
+			"from_column": "todo", "commit": "c3", "occurred_text": "2026-03-10 09:00"},
+		{"rule_id": "wip-limit", "message": "WIP limit exceeded", "task_id": "task3", "task_title": "Review", "to_column": "doing",
+			"commit": "c2", "occurred_text": "2026-03-10 09:00"},
+	}
+	expected := "Simulated 2 rules on 3 tasks and 4 changes in 2 revisions\n" +
+		"\nwip-limit, now: 'Draft' in doing: WIP limit exceeded" +
+		"\ntransitions, 2026-03-10 09:00: 'task2' todo -> done: Invalid column transition" +
+		"\nwip-limit, 2026-03-10 09:00: 'Review' created in doing: WIP limit exceeded\n" +
+		"\nwip-limit: 2 violations" +
+		"\ntransitions: 1 violations"
+	if text := describeRuleSimulation(result); text != expected {
+		t.Errorf("Expected description %q, got %q", expected, text)
+	}
 }
\ No newline at end of file
@@ -24,6 +24,9 @@ import (
 	"github.com/rknuus/eisenkan/internal/managers/task_manager"
 )
 
+// boardLockedByKey is the board metadata entry naming the program holding a board's lock
+const boardLockedByKey = "locked_by"
+
 // BoardInfo represents board information for UI display
 type BoardInfo struct {
 	Path         string            `json:"path"`
@@ -229,6 +232,7 @@ func (bsv *boardSelectionView) initializeUI() {
 			if bsv.onBoardSelected != nil {
 				bsv.onBoardSelected(selectedBoard.Path)
 			}
+			go bsv.warnIfLocked(selectedBoard.Path)
 		}
 	}
 
@@ -351,12 +355,32 @@ func (bsv *boardSelectionView) validateAndAddBoard(directoryPath string) {
 		_ = bsv.RefreshBoards()
 
 		// Show success message
+		message := fmt.Sprintf("Board '%s' has been added to your recent boards.", metadata.Title)
+		if holder, locked := metadata.Metadata[boardLockedByKey]; locked {
+			message += "\n\n" + lockedBoardMessage(holder)
+		}
 		runOnMain(func() {
-			dialog.ShowInformation("Board Added", fmt.Sprintf("Board '%s' has been added to your recent boards.", metadata.Title), bsv.window)
+			dialog.ShowInformation("Board Added", message, bsv.window)
 		})
 	}()
 }
 
+// warnIfLocked tells the user when a selected board opens read-only because another program holds its lock
+func (bsv *boardSelectionView) warnIfLocked(boardPath string) {
+	metadata, err := bsv.taskManager.GetBoardMetadata(boardPath)
+	if err != nil {
+		return
+	}
+	if holder, locked := metadata.Metadata[boardLockedByKey]; locked {
+		runOnMain(func() { dialog.ShowInformation("Board Opened Read-Only", lockedBoardMessage(holder), bsv.window) })
+	}
+}
+
+// lockedBoardMessage explains the consequences of another program holding a board's lock
+func lockedBoardMessage(holder string) string {
+	return fmt.Sprintf("This board is in use by %s.\n\nIt opens read-only: changes cannot be saved until that program closes the board.", holder)
+}
+
 // CreateBoard creates a new board with the specified request
 func (bsv *boardSelectionView) CreateBoard(request BoardCreationRequest) error {
 	// Convert to TaskManager request format
@@ -1,13 +1,17 @@
 package ui
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
 
 	"fyne.io/fyne/v2/test"
 
+	"github.com/rknuus/eisenkan/internal/engines"
 	"github.com/rknuus/eisenkan/internal/managers/task_manager"
+	"github.com/rknuus/eisenkan/internal/resource_access"
+	"github.com/rknuus/eisenkan/internal/resource_access/board_access"
 )
 
 // MockTaskManager implements task_manager.TaskManager for testing
@@ -23,7 +27,7 @@ func (m *MockTaskManager) CreateTask(request task_manager.TaskRequest) (task_man
 	return task_manager.TaskResponse{}, nil
 }
 
-func (m *MockTaskManager) UpdateTask(taskID string, request task_manager.TaskRequest) (task_manager.TaskResponse, error) {
+func (m *MockTaskManager) UpdateTask(taskID string, request task_manager.TaskRequest, expectedUpdatedAt *time.Time) (task_manager.TaskResponse, error) {
 	return task_manager.TaskResponse{}, nil
 }
 
@@ -31,7 +35,7 @@ func (m *MockTaskManager) GetTask(taskID string) (task_manager.TaskResponse, err
 	return task_manager.TaskResponse{}, nil
 }
 
-func (m *MockTaskManager) DeleteTask(taskID string) error {
+func (m *MockTaskManager) DeleteTask(taskID string, expectedUpdatedAt *time.Time) error {
 	return nil
 }
 
@@ -39,7 +43,7 @@ func (m *MockTaskManager) ListTasks(criteria task_manager.QueryCriteria) ([]task
 	return []task_manager.TaskResponse{}, nil
 }
 
-func (m *MockTaskManager) ChangeTaskStatus(taskID string, status task_manager.WorkflowStatus) (task_manager.TaskResponse, error) {
+func (m *MockTaskManager) ChangeTaskStatus(taskID string, status task_manager.WorkflowStatus, expectedUpdatedAt *time.Time) (task_manager.TaskResponse, error) {
 	return task_manager.TaskResponse{}, nil
 }
 
@@ -47,10 +51,35 @@ func (m *MockTaskManager) ValidateTask(request task_manager.TaskRequest) (task_m
 	return task_manager.ValidationResult{Valid: true}, nil
 }
 
+func (m *MockTaskManager) SimulateRules(ruleSet *resource_access.RuleSet, since time.Time) (*engines.SimulationReport, error) {
+	return &engines.SimulationReport{Since: since}, nil
+}
+
 func (m *MockTaskManager) ProcessPriorityPromotions() ([]task_manager.TaskResponse, error) {
 	return []task_manager.TaskResponse{}, nil
 }
 
+func (m *MockTaskManager) RunScheduledRules(now time.Time) (task_manager.ScheduledRulesResponse, error) {
+	return task_manager.ScheduledRulesResponse{RunAt: now}, nil
+}
+
+// Archive operations
+func (m *MockTaskManager) ArchiveTask(taskID string, policy board_access.CascadePolicy, expectedUpdatedAt *time.Time) (task_manager.TaskResponse, error) {
+	return task_manager.TaskResponse{}, nil
+}
+
+func (m *MockTaskManager) ListArchivedTasks() ([]task_manager.ArchivedTaskResponse, error) {
+	return []task_manager.ArchivedTaskResponse{}, nil
+}
+
+func (m *MockTaskManager) RestoreTask(taskID string, policy board_access.CascadePolicy) (task_manager.TaskResponse, error) {
+	return task_manager.TaskResponse{}, nil
+}
+
+func (m *MockTaskManager) PurgeArchive(olderThan time.Duration) ([]string, error) {
+	return []string{}, nil
+}
+
 // Board operations
 func (m *MockTaskManager) ValidateBoardDirectory(directoryPath string) (task_manager.BoardValidationResponse, error) {
 	if m.validateBoardDirectoryFunc != nil {
@@ -59,6 +88,10 @@ func (m *MockTaskManager) ValidateBoardDirectory(directoryPath string) (task_man
 	return task_manager.BoardValidationResponse{IsValid: true}, nil
 }
 
+func (m *MockTaskManager) RepairBoard() (task_manager.BoardRepairResponse, error) {
+	return task_manager.BoardRepairResponse{Validation: task_manager.BoardValidationResponse{IsValid: true}}, nil
+}
+
 func (m *MockTaskManager) GetBoardMetadata(boardPath string) (task_manager.BoardMetadataResponse, error) {
 	if m.getBoardMetadataFunc != nil {
 		return m.getBoardMetadataFunc(boardPath)
@@ -71,6 +104,14 @@ func (m *MockTaskManager) GetBoardMetadata(boardPath string) (task_manager.Board
 	}, nil
 }
 
+func (m *MockTaskManager) GetBoardStatistics(boardPath string) (*board_access.BoardStatistics, error) {
+	return &board_access.BoardStatistics{}, nil
+}
+
+func (m *MockTaskManager) GetFlowMetrics(boardPath string, dateRange *board_access.DateRange) (*board_access.FlowMetrics, error) {
+	return &board_access.FlowMetrics{}, nil
+}
+
 func (m *MockTaskManager) CreateBoard(request task_manager.BoardCreationRequest) (task_manager.BoardCreationResponse, error) {
 	if m.createBoardFunc != nil {
 		return m.createBoardFunc(request)
@@ -96,6 +137,51 @@ func (m *MockTaskManager) DeleteBoard(request task_manager.BoardDeletionRequest)
 	return task_manager.BoardDeletionResponse{Success: true}, nil
 }
 
+func (m *MockTaskManager) ListBoardRemotes() ([]task_manager.BoardRemote, error) {
+	return []task_manager.BoardRemote{}, nil
+}
+
+func (m *MockTaskManager) AddBoardRemote(name, url string) error {
+	return nil
+}
+
+func (m *MockTaskManager) RemoveBoardRemote(name string) error {
+	return nil
+}
+
+func (m *MockTaskManager) SyncBoard(remote string) (task_manager.SyncResponse, error) {
+	return task_manager.SyncResponse{Remote: remote, UpToDate: true}, nil
+}
+
+func (m *MockTaskManager) Undo() (task_manager.UndoResponse, error) {
+	return task_manager.UndoResponse{}, task_manager.ErrNothingToUndo
+}
+
+func (m *MockTaskManager) Redo() (task_manager.UndoResponse, error) {
+	return task_manager.UndoResponse{}, task_manager.ErrNothingToRedo
+}
+
+func (m *MockTaskManager) ListBoardRevisions(limit int) ([]task_manager.BoardRevision, error) {
+	return []task_manager.BoardRevision{}, nil
+}
+
+func (m *MockTaskManager) LoadBoardAt(commitID string, at time.Time) (task_manager.BoardSnapshotResponse, error) {
+	return task_manager.BoardSnapshotResponse{}, nil
+}
+
+func (m *MockTaskManager) RestoreTaskFrom(commitID, taskID string) (task_manager.TaskResponse, error) {
+	return task_manager.TaskResponse{ID: taskID}, nil
+}
+
+func (m *MockTaskManager) GetTaskHistory(taskID string, limit int) ([]task_manager.TaskRevision, error) {
+	return []task_manager.TaskRevision{}, nil
+}
+
+// Batch operations
+func (m *MockTaskManager) ExecuteBatch(request task_manager.BatchRequest) (task_manager.BatchResponse, error) {
+	return task_manager.BatchResponse{}, nil
+}
+
 // Context operations (for IContext interface)
 func (m *MockTaskManager) Load(contextType string) (task_manager.ContextData, error) {
 	return task_manager.ContextData{}, nil
@@ -105,6 +191,16 @@ func (m *MockTaskManager) Store(contextType string, data task_manager.ContextDat
 	return nil
 }
 
+func (m *MockTaskManager) SubscribeTaskEvents(ctx context.Context) <-chan task_manager.TaskEvent {
+	events := make(chan task_manager.TaskEvent)
+	close(events)
+	return events
+}
+
+func (m *MockTaskManager) SubscribeRuleNotifications(ctx context.Context) <-chan task_manager.TaskEvent {
+	return m.SubscribeTaskEvents(ctx)
+}
+
 
 // TestUnit_BoardSelectionView_NewBoardSelectionView tests widget creation
 func TestUnit_BoardSelectionView_NewBoardSelectionView(t *testing.T) {
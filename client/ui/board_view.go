@@ -5,7 +5,9 @@ package ui
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -14,6 +16,7 @@ import (
 
 	"github.com/rknuus/eisenkan/client/engines"
 	"github.com/rknuus/eisenkan/client/managers"
+	"github.com/rknuus/eisenkan/internal/managers/task_manager"
 )
 
 // BoardConfiguration represents the configuration for the entire board
@@ -25,6 +28,41 @@ type BoardConfiguration struct {
 	Metadata      map[string]interface{}    `json:"metadata,omitempty"`
 }
 
+// NewBoardConfigurationFromColumns creates a kanban board configuration showing the columns of board.json,
+// the first column with the Eisenhower sections
+func NewBoardConfigurationFromColumns(title string, columns []task_manager.BoardColumn) *BoardConfiguration {
+	config := &BoardConfiguration{
+		Title:          title,
+		BoardType:      "kanban",
+		EnableDragDrop: true,
+		Columns:        make([]*ColumnConfiguration, 0, len(columns)),
+	}
+	for i, column := range columns {
+		columnConfig := &ColumnConfiguration{
+			ID:          column.ID,
+			Title:       column.Name,
+			Type:        DoingColumn,
+			WIPLimit:    column.WIPLimit,
+			Color:       column.Color,
+			Done:        column.Done,
+			EntryPolicy: column.EntryPolicy,
+			ExitPolicy:  column.ExitPolicy,
+		}
+		if columnConfig.Title == "" {
+			columnConfig.Title = column.ID
+		}
+		switch {
+		case column.Done:
+			columnConfig.Type = DoneColumn
+		case i == 0:
+			columnConfig.Type = TodoColumn
+			columnConfig.ShowSections = true
+		}
+		config.Columns = append(config.Columns, columnConfig)
+	}
+	return config
+}
+
 // BoardState represents the current state of the board widget
 type BoardState struct {
 	Configuration  *BoardConfiguration
@@ -35,8 +73,42 @@ type BoardState struct {
 	ErrorMessage   string
 	IsRefreshing   bool
 	LastRefresh    time.Time
+	History        *BoardHistory // non-nil while the board shows a past revision read-only
+}
+
+// BoardRevision describes a revision of the board the history slider can show
+type BoardRevision struct {
+	Commit        string
+	Author        string
+	Message       string
+	Timestamp     time.Time
+	TimestampText string
+}
+
+// BoardHistory is the history slider state; revisions are ordered oldest first
+type BoardHistory struct {
+	Revisions      []BoardRevision
+	Position       int
+	SelectedTaskID string
+}
+
+// Current returns the revision the board shows, or nil before any revision is shown
+func (h *BoardHistory) Current() *BoardRevision {
+	if h == nil || h.Position < 0 || h.Position >= len(h.Revisions) {
+		return nil
+	}
+	return &h.Revisions[h.Position]
 }
 
+// syncConflictKey is the task metadata entry describing fields a sync could not merge without review
+const syncConflictKey = "sync_conflict"
+
+// maxHistoryRevisions is the number of revisions the history slider scrubs through
+const maxHistoryRevisions = 100
+
+// errHistoryReadOnly reports an attempt to change the board while it shows a past revision
+var errHistoryReadOnly = errors.New("board shows a past revision and is read-only")
+
 // BoardView implements a Fyne widget for displaying a kanban board with configurable columns
 // following the Custom Widget + Renderer Pattern with Manager Integration
 type BoardView struct {
@@ -58,10 +130,15 @@ type BoardView struct {
 	onBoardRefreshed  func()
 	onError           func(error)
 	onConfigChanged   func(*BoardConfiguration)
+	onTaskConflict    func(taskID string, conflicts []map[string]any)
+
+	// Notifications of rules, shown above the columns
+	notifications *NotificationCentre
 
 	// Internal state
-	ctx    context.Context
-	cancel context.CancelFunc
+	ctx           context.Context
+	cancel        context.CancelFunc
+	subscribeOnce sync.Once
 }
 
 // NewBoardView creates a new BoardView with the specified dependencies and configuration
@@ -119,9 +196,10 @@ func NewBoardView(
 			Columns:       make([]*ColumnWidget, 0),
 			AllTasks:      make([]*TaskData, 0),
 		},
-		stateChannel: make(chan *BoardState, 10),
-		ctx:          ctx,
-		cancel:       cancel,
+		stateChannel:  make(chan *BoardState, 10),
+		notifications: NewNotificationCentre(),
+		ctx:           ctx,
+		cancel:        cancel,
 	}
 
 	board.ExtendBaseWidget(board)
@@ -149,6 +227,11 @@ func (bv *BoardView) LoadBoard() {
 		return
 	}
 
+	// Follow changes made elsewhere once the board shows tasks
+	bv.subscribeOnce.Do(func() {
+		go bv.processTaskEvents()
+	})
+
 	go bv.processLoadBoardWorkflow()
 }
 
@@ -173,6 +256,7 @@ func (bv *BoardView) GetBoardState() *BoardState {
 		ErrorMessage:  bv.currentState.ErrorMessage,
 		IsRefreshing:  bv.currentState.IsRefreshing,
 		LastRefresh:   bv.currentState.LastRefresh,
+		History:       bv.currentState.History,
 	}
 }
 
@@ -204,6 +288,10 @@ func (bv *BoardView) GetColumnTasks(columnIndex int) []*TaskData {
 
 // MoveTask programmatically moves a task between columns with validation
 func (bv *BoardView) MoveTask(taskID string, fromColumnIndex, toColumnIndex int) error {
+	if bv.InHistoryMode() {
+		return errHistoryReadOnly
+	}
+
 	// Validate indices
 	if fromColumnIndex < 0 || fromColumnIndex >= len(bv.currentState.Columns) {
 		return fmt.Errorf("invalid from column index: %d", fromColumnIndex)
@@ -255,6 +343,7 @@ func (bv *BoardView) SelectTask(taskID string) {
 		for _, task := range column.GetTasks() {
 			if task.ID == taskID {
 				column.SetSelected(true)
+				bv.selectHistoryTask(taskID)
 				if bv.onTaskSelected != nil {
 					bv.onTaskSelected(taskID)
 				}
@@ -278,6 +367,11 @@ func (bv *BoardView) RefreshTask(taskID string) {
 	}
 }
 
+// Notifications returns the notification centre of the board
+func (bv *BoardView) Notifications() *NotificationCentre {
+	return bv.notifications
+}
+
 // SetLoading sets the loading state of the board
 func (bv *BoardView) SetLoading(loading bool) {
 	newState := bv.copyCurrentState()
@@ -304,6 +398,140 @@ func (bv *BoardView) SetError(err error) {
 	}
 }
 
+// History Mode
+
+// InHistoryMode reports whether the board shows a past revision
+func (bv *BoardView) InHistoryMode() bool {
+	bv.stateMu.RLock()
+	defer bv.stateMu.RUnlock()
+	return bv.currentState.History != nil
+}
+
+// EnterHistoryMode switches the board to a read-only view of its revisions, starting at the latest one
+func (bv *BoardView) EnterHistoryMode() error {
+	if bv.workflowManager == nil {
+		return fmt.Errorf("workflow manager unavailable")
+	}
+
+	ctx, cancel := context.WithTimeout(bv.ctx, 10*time.Second)
+	defer cancel()
+
+	response, err := bv.workflowManager.History().ListRevisionsWorkflow(ctx, maxHistoryRevisions)
+	if err != nil {
+		return fmt.Errorf("revision listing failed: %w", err)
+	}
+
+	// The workflow lists the latest revision first, the slider runs from the oldest
+	revisionData, _ := response["revisions"].([]map[string]any)
+	if len(revisionData) == 0 {
+		return fmt.Errorf("board has no revisions yet")
+	}
+	revisions := make([]BoardRevision, len(revisionData))
+	for i, data := range revisionData {
+		revisions[len(revisionData)-1-i] = mapResponseToBoardRevision(data)
+	}
+
+	newState := bv.copyCurrentState()
+	newState.History = &BoardHistory{Revisions: revisions, Position: -1}
+	bv.updateState(newState)
+
+	return bv.ShowRevision(len(revisions) - 1)
+}
+
+// ShowRevision shows the board as it was at the revision at the given slider position
+func (bv *BoardView) ShowRevision(position int) error {
+	bv.stateMu.RLock()
+	history := bv.currentState.History
+	bv.stateMu.RUnlock()
+
+	if history == nil {
+		return fmt.Errorf("board is not in history mode")
+	}
+	if position < 0 || position >= len(history.Revisions) {
+		return fmt.Errorf("invalid revision position: %d", position)
+	}
+
+	ctx, cancel := context.WithTimeout(bv.ctx, 10*time.Second)
+	defer cancel()
+
+	revision := history.Revisions[position]
+	response, err := bv.workflowManager.History().LoadBoardAtWorkflow(ctx, revision.Commit, time.Time{})
+	if err != nil {
+		return fmt.Errorf("loading revision %s failed: %w", revision.Commit, err)
+	}
+
+	// Leaving history mode while the revision loaded keeps the live board
+	if !bv.InHistoryMode() {
+		return nil
+	}
+
+	if err := bv.organizeTasksIntoColumns(response); err != nil {
+		return fmt.Errorf("task organization failed: %w", err)
+	}
+
+	newState := bv.copyCurrentState()
+	if newState.History == nil {
+		return nil
+	}
+	newState.History = &BoardHistory{Revisions: history.Revisions, Position: position, SelectedTaskID: newState.History.SelectedTaskID}
+	bv.updateState(newState)
+	return nil
+}
+
+// RestoreTaskFromRevision restores a task as it was at the shown revision and returns to the live board
+func (bv *BoardView) RestoreTaskFromRevision(taskID string) error {
+	bv.stateMu.RLock()
+	revision := bv.currentState.History.Current()
+	bv.stateMu.RUnlock()
+
+	if revision == nil {
+		return fmt.Errorf("board does not show a past revision")
+	}
+	if taskID == "" {
+		return fmt.Errorf("no task selected to restore")
+	}
+
+	ctx, cancel := context.WithTimeout(bv.ctx, 10*time.Second)
+	defer cancel()
+
+	response, err := bv.workflowManager.History().RestoreTaskFromWorkflow(ctx, revision.Commit, taskID)
+	if err != nil {
+		return fmt.Errorf("task restore failed: %w", err)
+	}
+	if success, _ := response["success"].(bool); !success {
+		message, _ := response["error"].(string)
+		return fmt.Errorf("task restore failed: %s", message)
+	}
+
+	bv.ExitHistoryMode()
+	return nil
+}
+
+// ExitHistoryMode returns to the live board
+func (bv *BoardView) ExitHistoryMode() {
+	if !bv.InHistoryMode() {
+		return
+	}
+
+	newState := bv.copyCurrentState()
+	newState.History = nil
+	bv.updateState(newState)
+
+	bv.RefreshBoard()
+}
+
+// selectHistoryTask remembers the task a restore applies to while the board shows a past revision
+func (bv *BoardView) selectHistoryTask(taskID string) {
+	newState := bv.copyCurrentState()
+	if newState.History == nil {
+		return
+	}
+	history := *newState.History
+	history.SelectedTaskID = taskID
+	newState.History = &history
+	bv.updateState(newState)
+}
+
 // Event handler setters
 
 // SetOnTaskMoved sets the task moved event handler
@@ -331,6 +559,11 @@ func (bv *BoardView) SetOnConfigChanged(handler func(*BoardConfiguration)) {
 	bv.onConfigChanged = handler
 }
 
+// SetOnTaskConflict sets the handler for task fields a sync could not merge without review
+func (bv *BoardView) SetOnTaskConflict(handler func(taskID string, conflicts []map[string]any)) {
+	bv.onTaskConflict = handler
+}
+
 // Lifecycle Management
 
 // Destroy cleans up the board widget resources
@@ -387,6 +620,7 @@ func (bv *BoardView) copyCurrentState() *BoardState {
 		ErrorMessage:  bv.currentState.ErrorMessage,
 		IsRefreshing:  bv.currentState.IsRefreshing,
 		LastRefresh:   bv.currentState.LastRefresh,
+		History:       bv.currentState.History,
 	}
 
 	copy(newState.Columns, bv.currentState.Columns)
@@ -560,6 +794,107 @@ func (bv *BoardView) processTaskMovement(taskID string, fromColumnIndex, toColum
 	return nil
 }
 
+// processTaskEvents applies task events from WorkflowManager until the board is destroyed
+func (bv *BoardView) processTaskEvents() {
+	events := bv.workflowManager.Task().SubscribeTaskEvents(bv.ctx)
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			bv.applyTaskEvent(event)
+		case <-bv.ctx.Done():
+			return
+		}
+	}
+}
+
+// applyTaskEvent updates the columns holding a changed task; only board-wide changes reload the board
+func (bv *BoardView) applyTaskEvent(event map[string]any) {
+	// Leaving history mode reloads the board, which catches up on the changes
+	if bv.InHistoryMode() {
+		return
+	}
+
+	// The board was changed outside the application in a way individual tasks cannot describe
+	if eventType, _ := event["type"].(string); eventType == "reloaded" {
+		bv.RefreshBoard()
+		return
+	}
+
+	// Notifications change no task; ApplicationRoot delivers them to the notification centre
+	if eventType, _ := event["type"].(string); eventType == "notified" {
+		return
+	}
+
+	taskID, _ := event["task_id"].(string)
+	if taskID == "" {
+		return
+	}
+
+	bv.stateMu.RLock()
+	columns := make([]*ColumnWidget, len(bv.currentState.Columns))
+	copy(columns, bv.currentState.Columns)
+	columnConfigs := bv.currentState.Configuration.Columns
+	bv.stateMu.RUnlock()
+
+	// Drop the stale copy; the column callbacks keep AllTasks in sync
+	for _, column := range columns {
+		for _, task := range column.GetTasks() {
+			if task.ID == taskID {
+				column.RemoveTask(taskID)
+				break
+			}
+		}
+	}
+	bv.removeTaskFromBoardState(taskID)
+
+	eventType, _ := event["type"].(string)
+	if eventType == "deleted" || eventType == "archived" {
+		return
+	}
+
+	conflicts, _ := event["conflicts"].([]map[string]any)
+	if eventType == "conflicted" && bv.onTaskConflict != nil {
+		bv.onTaskConflict(taskID, conflicts)
+	}
+
+	taskMap, ok := event["task"].(map[string]any)
+	if !ok {
+		return
+	}
+	task := bv.mapResponseToTaskData(taskMap)
+	if len(conflicts) > 0 {
+		// Shown with the task until the board is reloaded
+		task.Metadata[syncConflictKey] = describeFieldConflicts(conflicts, "; ")
+	}
+
+	placed := false
+	for i, column := range columns {
+		if i < len(columnConfigs) && bv.taskBelongsToColumn(task, columnConfigs[i]) {
+			column.AddTask(task)
+			placed = true
+		}
+	}
+	if !placed {
+		bv.addTaskToBoardState(task)
+	}
+}
+
+// describeFieldConflicts summarises conflicting task fields for display
+func describeFieldConflicts(conflicts []map[string]any, separator string) string {
+	descriptions := make([]string, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		description := fmt.Sprintf("%v: local %q, remote %q", conflict["field"], fmt.Sprint(conflict["local_value"]), fmt.Sprint(conflict["remote_value"]))
+		if reason, _ := conflict["reason"].(string); reason != "" {
+			description += " (" + reason + ")"
+		}
+		descriptions = append(descriptions, description)
+	}
+	return strings.Join(descriptions, separator)
+}
+
 // Helper Methods
 
 // organizeTasksIntoColumns distributes tasks across columns based on their properties
@@ -642,8 +977,12 @@ func (bv *BoardView) taskMatchesEisenhowerColumn(task *TaskData, columnConfig *C
 	}
 }
 
-// taskMatchesKanbanColumn matches tasks to standard Kanban columns
+// taskMatchesKanbanColumn matches tasks to standard Kanban columns, or to the board column of the configuration
 func (bv *BoardView) taskMatchesKanbanColumn(task *TaskData, columnConfig *ColumnConfiguration) bool {
+	if columnConfig.ID != "" {
+		return task.Status == columnConfig.ID
+	}
+
 	switch columnConfig.Type {
 	case TodoColumn:
 		return task.Status == "todo" || task.Status == "backlog"
@@ -709,6 +1048,17 @@ func (bv *BoardView) mapResponseToTaskData(data map[string]interface{}) *TaskDat
 	return task
 }
 
+// mapResponseToBoardRevision converts a WorkflowManager revision to BoardRevision
+func mapResponseToBoardRevision(data map[string]any) BoardRevision {
+	revision := BoardRevision{}
+	revision.Commit, _ = data["commit"].(string)
+	revision.Author, _ = data["author"].(string)
+	revision.Message, _ = data["message"].(string)
+	revision.Timestamp, _ = data["timestamp"].(time.Time)
+	revision.TimestampText, _ = data["timestamp_text"].(string)
+	return revision
+}
+
 // updateColumnsAfterTaskMovement updates column states after successful task movement
 func (bv *BoardView) updateColumnsAfterTaskMovement(taskID string, fromColumnIndex, toColumnIndex int, response map[string]any) error {
 	// Find the task to move
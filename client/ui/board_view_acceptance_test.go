@@ -53,6 +53,14 @@ func (m *BoardViewAcceptanceMockWorkflowManager) Subtask() managers.ISubtask {
 	return &acceptanceSubtaskWorkflows{manager: m}
 }
 
+func (m *BoardViewAcceptanceMockWorkflowManager) History() managers.IHistory {
+	return &acceptanceHistoryWorkflows{manager: m}
+}
+
+func (m *BoardViewAcceptanceMockWorkflowManager) Rules() managers.IRules {
+	return &acceptanceRulesWorkflows{manager: m}
+}
+
 // Acceptance test implementations
 type acceptanceTaskWorkflows struct {
 	manager *BoardViewAcceptanceMockWorkflowManager
@@ -166,6 +174,21 @@ func (m *acceptanceTaskWorkflows) ArchiveTaskWorkflow(ctx context.Context, taskI
 	return map[string]any{}, nil
 }
 
+func (m *acceptanceTaskWorkflows) RestoreTaskWorkflow(ctx context.Context, taskID string, options map[string]any) (map[string]any, error) {
+	m.manager.callLog = append(m.manager.callLog, "RestoreTaskWorkflow")
+	if m.manager.shouldFail {
+		return nil, fmt.Errorf("mock workflow failure")
+	}
+	time.Sleep(m.manager.responseDelay)
+	return map[string]any{}, nil
+}
+
+func (m *acceptanceTaskWorkflows) SubscribeTaskEvents(ctx context.Context) <-chan map[string]any {
+	events := make(chan map[string]any)
+	close(events)
+	return events
+}
+
 type acceptanceDragWorkflows struct {
 	manager *BoardViewAcceptanceMockWorkflowManager
 }
@@ -261,6 +284,63 @@ func (m *acceptanceSubtaskWorkflows) MoveSubtaskWorkflow(ctx context.Context, su
 	return map[string]any{}, nil
 }
 
+type acceptanceHistoryWorkflows struct {
+	manager *BoardViewAcceptanceMockWorkflowManager
+}
+
+func (m *acceptanceHistoryWorkflows) UndoWorkflow(ctx context.Context) (map[string]any, error) {
+	if m.manager.shouldFail {
+		return nil, fmt.Errorf("mock workflow failure")
+	}
+	return map[string]any{}, nil
+}
+
+func (m *acceptanceHistoryWorkflows) RedoWorkflow(ctx context.Context) (map[string]any, error) {
+	if m.manager.shouldFail {
+		return nil, fmt.Errorf("mock workflow failure")
+	}
+	return map[string]any{}, nil
+}
+
+func (m *acceptanceHistoryWorkflows) ListRevisionsWorkflow(ctx context.Context, limit int) (map[string]any, error) {
+	if m.manager.shouldFail {
+		return nil, fmt.Errorf("mock workflow failure")
+	}
+	return map[string]any{"revisions": []map[string]any{}}, nil
+}
+
+func (m *acceptanceHistoryWorkflows) LoadBoardAtWorkflow(ctx context.Context, commitID string, at time.Time) (map[string]any, error) {
+	if m.manager.shouldFail {
+		return nil, fmt.Errorf("mock workflow failure")
+	}
+	return map[string]any{"tasks": []interface{}{}, "read_only": true}, nil
+}
+
+func (m *acceptanceHistoryWorkflows) RestoreTaskFromWorkflow(ctx context.Context, commitID string, taskID string) (map[string]any, error) {
+	if m.manager.shouldFail {
+		return nil, fmt.Errorf("mock workflow failure")
+	}
+	return map[string]any{}, nil
+}
+
+func (m *acceptanceHistoryWorkflows) GetTaskHistoryWorkflow(ctx context.Context, taskID string, limit int) (map[string]any, error) {
+	if m.manager.shouldFail {
+		return nil, fmt.Errorf("mock workflow failure")
+	}
+	return map[string]any{"revisions": []map[string]any{}}, nil
+}
+
+type acceptanceRulesWorkflows struct {
+	manager *BoardViewAcceptanceMockWorkflowManager
+}
+
+func (m *acceptanceRulesWorkflows) SimulateRulesWorkflow(ctx context.Context, rulesFile string) (map[string]any, error) {
+	if m.manager.shouldFail {
+		return nil, fmt.Errorf("mock workflow failure")
+	}
+	return map[string]any{"violations": []map[string]any{}}, nil
+}
+
 // STP Acceptance Tests - Based on BoardView_STP.md destructive test scenarios
 
 // TestAcceptance_DT_BOARD_001_BoardLifecycleStress validates board lifecycle under stress
@@ -41,6 +41,14 @@ func (m *SimpleMockWorkflowManager) Subtask() managers.ISubtask {
 	return &simpleSubtaskWorkflows{manager: m}
 }
 
+func (m *SimpleMockWorkflowManager) History() managers.IHistory {
+	return &simpleHistoryWorkflows{manager: m}
+}
+
+func (m *SimpleMockWorkflowManager) Rules() managers.IRules {
+	return &simpleRulesWorkflows{manager: m}
+}
+
 // Simple implementations that don't trigger UI
 type simpleTaskWorkflows struct {
 	manager *SimpleMockWorkflowManager
@@ -93,6 +101,17 @@ func (m *simpleTaskWorkflows) ArchiveTaskWorkflow(ctx context.Context, taskID st
 	return map[string]any{}, nil
 }
 
+func (m *simpleTaskWorkflows) RestoreTaskWorkflow(ctx context.Context, taskID string, options map[string]any) (map[string]any, error) {
+	m.manager.callLog = append(m.manager.callLog, "RestoreTaskWorkflow")
+	return map[string]any{}, nil
+}
+
+func (m *simpleTaskWorkflows) SubscribeTaskEvents(ctx context.Context) <-chan map[string]any {
+	events := make(chan map[string]any)
+	close(events)
+	return events
+}
+
 type simpleDragWorkflows struct {
 	manager *SimpleMockWorkflowManager
 }
@@ -151,6 +170,42 @@ func (m *simpleSubtaskWorkflows) MoveSubtaskWorkflow(ctx context.Context, subtas
 	return map[string]any{}, nil
 }
 
+type simpleHistoryWorkflows struct {
+	manager *SimpleMockWorkflowManager
+}
+
+func (m *simpleHistoryWorkflows) UndoWorkflow(ctx context.Context) (map[string]any, error) {
+	return map[string]any{}, nil
+}
+
+func (m *simpleHistoryWorkflows) RedoWorkflow(ctx context.Context) (map[string]any, error) {
+	return map[string]any{}, nil
+}
+
+func (m *simpleHistoryWorkflows) ListRevisionsWorkflow(ctx context.Context, limit int) (map[string]any, error) {
+	return map[string]any{}, nil
+}
+
+func (m *simpleHistoryWorkflows) LoadBoardAtWorkflow(ctx context.Context, commitID string, at time.Time) (map[string]any, error) {
+	return map[string]any{}, nil
+}
+
+func (m *simpleHistoryWorkflows) RestoreTaskFromWorkflow(ctx context.Context, commitID string, taskID string) (map[string]any, error) {
+	return map[string]any{}, nil
+}
+
+func (m *simpleHistoryWorkflows) GetTaskHistoryWorkflow(ctx context.Context, taskID string, limit int) (map[string]any, error) {
+	return map[string]any{}, nil
+}
+
+type simpleRulesWorkflows struct {
+	manager *SimpleMockWorkflowManager
+}
+
+func (m *simpleRulesWorkflows) SimulateRulesWorkflow(ctx context.Context, rulesFile string) (map[string]any, error) {
+	return map[string]any{}, nil
+}
+
 // Simple Integration Tests (Avoiding UI race conditions)
 
 // TestSimpleIntegration_BoardView_BasicWorkflowIntegration verifies basic workflow integration
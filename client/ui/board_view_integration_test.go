@@ -14,6 +14,7 @@ type BoardViewMockWorkflowManager struct {
 	taskResponses map[string]any
 	dragResponses map[string]any
 	callLog       []string
+	events        chan map[string]any
 }
 
 func NewBoardViewMockWorkflowManager() *BoardViewMockWorkflowManager {
@@ -21,6 +22,7 @@ func NewBoardViewMockWorkflowManager() *BoardViewMockWorkflowManager {
 		taskResponses: make(map[string]any),
 		dragResponses: make(map[string]any),
 		callLog:       make([]string, 0),
+		events:        make(chan map[string]any, 10),
 	}
 }
 
@@ -44,6 +46,14 @@ func (m *BoardViewMockWorkflowManager) Subtask() managers.ISubtask {
 	return &mockSubtaskWorkflows{manager: m}
 }
 
+func (m *BoardViewMockWorkflowManager) History() managers.IHistory {
+	return &mockHistoryWorkflows{manager: m}
+}
+
+func (m *BoardViewMockWorkflowManager) Rules() managers.IRules {
+	return &mockRulesWorkflows{manager: m}
+}
+
 // Mock task workflows
 type mockTaskWorkflows struct {
 	manager *BoardViewMockWorkflowManager
@@ -121,6 +131,15 @@ func (m *mockTaskWorkflows) ArchiveTaskWorkflow(ctx context.Context, taskID stri
 	return m.manager.taskResponses, nil
 }
 
+func (m *mockTaskWorkflows) RestoreTaskWorkflow(ctx context.Context, taskID string, options map[string]any) (map[string]any, error) {
+	m.manager.callLog = append(m.manager.callLog, "RestoreTaskWorkflow")
+	return m.manager.taskResponses, nil
+}
+
+func (m *mockTaskWorkflows) SubscribeTaskEvents(ctx context.Context) <-chan map[string]any {
+	return m.manager.events
+}
+
 // Mock drag workflows
 type mockDragWorkflows struct {
 	manager *BoardViewMockWorkflowManager
@@ -191,6 +210,48 @@ func (m *mockSubtaskWorkflows) MoveSubtaskWorkflow(ctx context.Context, subtaskI
 	return m.manager.taskResponses, nil
 }
 
+type mockHistoryWorkflows struct {
+	manager *BoardViewMockWorkflowManager
+}
+
+func (m *mockHistoryWorkflows) UndoWorkflow(ctx context.Context) (map[string]any, error) {
+	return m.manager.taskResponses, nil
+}
+
+func (m *mockHistoryWorkflows) RedoWorkflow(ctx context.Context) (map[string]any, error) {
+	return m.manager.taskResponses, nil
+}
+
+func (m *mockHistoryWorkflows) ListRevisionsWorkflow(ctx context.Context, limit int) (map[string]any, error) {
+	m.manager.callLog = append(m.manager.callLog, "ListRevisionsWorkflow")
+	return m.manager.taskResponses, nil
+}
+
+func (m *mockHistoryWorkflows) LoadBoardAtWorkflow(ctx context.Context, commitID string, at time.Time) (map[string]any, error) {
+	m.manager.callLog = append(m.manager.callLog, "LoadBoardAtWorkflow")
+	return m.manager.taskResponses, nil
+}
+
+func (m *mockHistoryWorkflows) RestoreTaskFromWorkflow(ctx context.Context, commitID string, taskID string) (map[string]any, error) {
+	m.manager.callLog = append(m.manager.callLog, "RestoreTaskFromWorkflow")
+	return m.manager.taskResponses, nil
+}
+
+func (m *mockHistoryWorkflows) GetTaskHistoryWorkflow(ctx context.Context, taskID string, limit int) (map[string]any, error) {
+	m.manager.callLog = append(m.manager.callLog, "GetTaskHistoryWorkflow")
+	return m.manager.taskResponses, nil
+}
+
+// Mock rules workflows
+type mockRulesWorkflows struct {
+	manager *BoardViewMockWorkflowManager
+}
+
+func (m *mockRulesWorkflows) SimulateRulesWorkflow(ctx context.Context, rulesFile string) (map[string]any, error) {
+	m.manager.callLog = append(m.manager.callLog, "SimulateRulesWorkflow")
+	return map[string]any{"violations": []map[string]any{}}, nil
+}
+
 // Integration Tests
 
 
@@ -317,4 +378,144 @@ func TestIntegration_BoardView_StateConsistency(t *testing.T) {
 	if clearedState.ErrorMessage != "" {
 		t.Error("Expected error message to be cleared")
 	}
-}
\ No newline at end of file
+}
+
+// TestIntegration_BoardView_AppliesTaskEvents verifies that task events update the columns without a reload
+func TestIntegration_BoardView_AppliesTaskEvents(t *testing.T) {
+	mockWM := NewBoardViewMockWorkflowManager()
+	board := NewBoardView(mockWM, nil, &BoardConfiguration{
+		Title:     "Kanban",
+		BoardType: "kanban",
+		Columns: []*ColumnConfiguration{
+			{Title: "Todo", Type: TodoColumn},
+			{Title: "Doing", Type: DoingColumn},
+			{Title: "Done", Type: DoneColumn},
+		},
+	})
+	defer board.Destroy()
+
+	board.LoadBoard()
+
+	columnHolds := func(columnIndex int, taskID string) bool {
+		for _, task := range board.GetColumnTasks(columnIndex) {
+			if task.ID == taskID {
+				return true
+			}
+		}
+		return false
+	}
+	waitFor := func(description string, condition func() bool) {
+		t.Helper()
+		for i := 0; i < 40; i++ {
+			if condition() {
+				return
+			}
+			time.Sleep(25 * time.Millisecond)
+		}
+		t.Fatalf("Timed out waiting until %s", description)
+	}
+
+	mockWM.events <- map[string]any{
+		"type":    "created",
+		"task_id": "remote-1",
+		"task":    map[string]any{"id": "remote-1", "title": "Remote", "status": "todo"},
+	}
+	waitFor("the created task is in todo", func() bool { return columnHolds(0, "remote-1") })
+
+	mockWM.events <- map[string]any{
+		"type":            "moved",
+		"task_id":         "remote-1",
+		"previous_status": "todo",
+		"task":            map[string]any{"id": "remote-1", "title": "Remote", "status": "doing"},
+	}
+	waitFor("the moved task is in doing", func() bool { return columnHolds(1, "remote-1") && !columnHolds(0, "remote-1") })
+
+	mockWM.events <- map[string]any{"type": "deleted", "task_id": "remote-1"}
+	waitFor("the deleted task is gone", func() bool { return !columnHolds(1, "remote-1") })
+
+	for _, task := range board.GetBoardState().AllTasks {
+		if task.ID == "remote-1" {
+			t.Error("Expected the deleted task to be removed from the board state")
+		}
+	}
+}
+
+// TestIntegration_BoardView_HistoryMode verifies scrubbing through past revisions and restoring a task
+func TestIntegration_BoardView_HistoryMode(t *testing.T) {
+	mockWM := NewBoardViewMockWorkflowManager()
+	mockWM.taskResponses = map[string]any{
+		"success": true,
+		"revisions": []map[string]any{
+			{"commit": "c2", "message": "Move task t1"},
+			{"commit": "c1", "message": "Create task t1"},
+		},
+		"tasks": []interface{}{
+			map[string]interface{}{"id": "t1", "title": "Old", "status": "doing"},
+		},
+	}
+	board := NewBoardView(mockWM, nil, &BoardConfiguration{
+		Title:     "Kanban",
+		BoardType: "kanban",
+		Columns: []*ColumnConfiguration{
+			{Title: "Todo", Type: TodoColumn},
+			{Title: "Doing", Type: DoingColumn},
+			{Title: "Done", Type: DoneColumn},
+		},
+	})
+	defer board.Destroy()
+
+	if err := board.EnterHistoryMode(); err != nil {
+		t.Fatalf("EnterHistoryMode failed: %v", err)
+	}
+	history := board.GetBoardState().History
+	if history == nil || len(history.Revisions) != 2 || history.Current() == nil || history.Current().Commit != "c2" {
+		t.Fatalf("Expected the latest revision to be shown first, got %+v", history)
+	}
+	if history.Revisions[0].Commit != "c1" {
+		t.Errorf("Expected the slider to start at the oldest revision, got %+v", history.Revisions)
+	}
+	if tasks := board.GetColumnTasks(1); len(tasks) != 1 || tasks[0].ID != "t1" {
+		t.Errorf("Expected the revision's task in doing, got %v", tasks)
+	}
+
+	if err := board.ShowRevision(0); err != nil {
+		t.Fatalf("ShowRevision failed: %v", err)
+	}
+	if current := board.GetBoardState().History.Current(); current == nil || current.Commit != "c1" {
+		t.Errorf("Expected the oldest revision to be shown, got %+v", current)
+	}
+
+	// The past board is read-only and does not follow live changes
+	if err := board.MoveTask("t1", 1, 2); err == nil {
+		t.Error("Expected moving a task of a past revision to be rejected")
+	}
+	board.applyTaskEvent(map[string]any{
+		"type":    "created",
+		"task_id": "live-1",
+		"task":    map[string]any{"id": "live-1", "status": "todo"},
+	})
+	if tasks := board.GetColumnTasks(0); len(tasks) != 0 {
+		t.Errorf("Expected live events to be ignored in history mode, got %v", tasks)
+	}
+
+	board.SelectTask("t1")
+	if selected := board.GetBoardState().History.SelectedTaskID; selected != "t1" {
+		t.Errorf("Expected t1 to be selected for restore, got %q", selected)
+	}
+	if err := board.RestoreTaskFromRevision("t1"); err != nil {
+		t.Fatalf("RestoreTaskFromRevision failed: %v", err)
+	}
+	if board.InHistoryMode() {
+		t.Error("Expected the restore to return to the live board")
+	}
+
+	restored := false
+	for _, call := range mockWM.callLog {
+		if call == "RestoreTaskFromWorkflow" {
+			restored = true
+		}
+	}
+	if !restored {
+		t.Errorf("Expected the restore workflow to be called, got %v", mockWM.callLog)
+	}
+}
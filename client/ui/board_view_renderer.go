@@ -4,6 +4,7 @@
 package ui
 
 import (
+	"fmt"
 	"image/color"
 
 	"fyne.io/fyne/v2"
@@ -22,6 +23,13 @@ type BoardViewRenderer struct {
 	titleLabel   *widget.Label
 	background   *canvas.Rectangle
 	objects      []fyne.CanvasObject
+
+	// History slider
+	historyButton *widget.Button
+	historyLabel  *widget.Label
+	historySlider *widget.Slider
+	restoreButton *widget.Button
+	liveButton    *widget.Button
 }
 
 // newBoardViewRenderer creates a new renderer for BoardView
@@ -49,6 +57,8 @@ func newBoardViewRenderer(board *BoardView) *BoardViewRenderer {
 	r.errorLabel.Alignment = fyne.TextAlignCenter
 	r.errorLabel.Hide()
 
+	r.createHistoryControls()
+
 	// Create main container with dynamic layout
 	r.container = container.NewVBox()
 
@@ -124,6 +134,8 @@ func (r *BoardViewRenderer) refreshLayout() {
 
 	// Add title
 	r.container.Add(r.titleLabel)
+	r.container.Add(r.historyBar(state.History))
+	r.container.Add(r.widget.notifications)
 
 	// Handle different states
 	switch {
@@ -219,6 +231,81 @@ func (r *BoardViewRenderer) selectOptimalLayout(state *BoardState, columnObjects
 	}
 }
 
+// createHistoryControls creates the controls to scrub through past revisions of the board
+func (r *BoardViewRenderer) createHistoryControls() {
+	board := r.widget
+
+	r.historyButton = widget.NewButton("History", func() {
+		go func() {
+			if err := board.EnterHistoryMode(); err != nil {
+				board.SetError(err)
+			}
+		}()
+	})
+
+	r.historyLabel = widget.NewLabel("")
+	r.historyLabel.Truncation = fyne.TextTruncateEllipsis
+
+	r.historySlider = widget.NewSlider(0, 1)
+	r.historySlider.Step = 1
+	r.historySlider.OnChangeEnded = func(value float64) {
+		go func() {
+			if err := board.ShowRevision(int(value)); err != nil {
+				board.SetError(err)
+			}
+		}()
+	}
+
+	r.restoreButton = widget.NewButton("Restore selected task", func() {
+		history := board.GetBoardState().History
+		if history == nil {
+			return
+		}
+		go func() {
+			if err := board.RestoreTaskFromRevision(history.SelectedTaskID); err != nil {
+				board.SetError(err)
+			}
+		}()
+	})
+
+	r.liveButton = widget.NewButton("Back to live board", board.ExitHistoryMode)
+}
+
+// historyBar returns the history button of the live board or the slider over past revisions
+func (r *BoardViewRenderer) historyBar(history *BoardHistory) fyne.CanvasObject {
+	if history == nil {
+		return container.NewHBox(r.historyButton)
+	}
+
+	revision := history.Current()
+	if revision == nil {
+		r.historyLabel.SetText("Loading revision...")
+	} else {
+		r.historyLabel.SetText(fmt.Sprintf("Revision %d of %d, %s: %s", history.Position+1, len(history.Revisions), revision.TimestampText, revision.Message))
+	}
+
+	// A single revision leaves nothing to scrub through
+	if len(history.Revisions) > 1 {
+		r.historySlider.Max = float64(len(history.Revisions) - 1)
+		r.historySlider.Value = float64(history.Position)
+		r.historySlider.Show()
+	} else {
+		r.historySlider.Hide()
+	}
+
+	if history.SelectedTaskID != "" && revision != nil {
+		r.restoreButton.Enable()
+	} else {
+		r.restoreButton.Disable()
+	}
+
+	return container.NewVBox(
+		r.historyLabel,
+		r.historySlider,
+		container.NewHBox(r.restoreButton, r.liveButton),
+	)
+}
+
 // updateColors updates colors based on current state
 func (r *BoardViewRenderer) updateColors() {
 	state := r.widget.GetBoardState()
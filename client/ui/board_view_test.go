@@ -6,6 +6,8 @@ import (
 
 	"github.com/rknuus/eisenkan/client/engines"
 	"github.com/rknuus/eisenkan/client/managers"
+	"github.com/rknuus/eisenkan/internal/managers/task_manager"
+	"github.com/rknuus/eisenkan/internal/utilities"
 )
 
 // TestNewBoardView verifies BoardView creation with default Eisenhower Matrix configuration
@@ -299,4 +301,67 @@ func TestBoardViewTaskMatching(t *testing.T) {
 	if matches {
 		t.Error("Task should not match wrong column")
 	}
+}
+
+// TestBoardViewColumnsFromBoardConfiguration verifies the board view shows the columns of board.json
+func TestBoardViewColumnsFromBoardConfiguration(t *testing.T) {
+	config := NewBoardConfigurationFromColumns("Kanban", []task_manager.BoardColumn{
+		{ID: "todo", Name: "To Do"},
+		{ID: "doing", Name: "In Progress", Color: "orange", WIPLimit: 3, EntryPolicy: "Assigned", ExitPolicy: "Reviewed"},
+		{ID: "done", Done: true},
+	})
+	if config.BoardType != "kanban" || len(config.Columns) != 3 {
+		t.Fatalf("Expected a kanban board with 3 columns, got %+v", config)
+	}
+
+	todo, doing, done := config.Columns[0], config.Columns[1], config.Columns[2]
+	if todo.Type != TodoColumn || !todo.ShowSections || todo.Title != "To Do" {
+		t.Errorf("Expected the first column to show the Eisenhower sections, got %+v", todo)
+	}
+	if doing.Type != DoingColumn || doing.WIPLimit != 3 || doing.Color != "orange" || doing.EntryPolicy != "Assigned" || doing.ExitPolicy != "Reviewed" {
+		t.Errorf("Expected the column settings, got %+v", doing)
+	}
+	if done.Type != DoneColumn || !done.Done || done.Title != "done" {
+		t.Errorf("Expected a done column titled by its ID, got %+v", done)
+	}
+
+	validationEngine := engines.NewFormValidationEngine()
+	board := NewBoardView(nil, validationEngine, config)
+	defer board.Destroy()
+
+	// Tasks are matched by their board column
+	task := &TaskData{ID: "task1", Status: "doing"}
+	if board.taskBelongsToColumn(task, todo) || !board.taskBelongsToColumn(task, doing) {
+		t.Error("Task should match only the column it is in")
+	}
+}
+
+// TestBoardViewNotificationCentre verifies the notification centre counts unread notifications and keeps the newest
+func TestBoardViewNotificationCentre(t *testing.T) {
+	board := NewBoardView(nil, engines.NewFormValidationEngine(), nil)
+	defer board.Destroy()
+
+	centre := board.Notifications()
+	for i := 0; i < maxNotificationCentreEntries+2; i++ {
+		centre.Notify(utilities.Notification{Title: "Due Soon", Message: fmt.Sprintf("Reminder %d", i)})
+	}
+	notifications := centre.Notifications()
+	if len(notifications) != maxNotificationCentreEntries || notifications[0].Message != fmt.Sprintf("Reminder %d", maxNotificationCentreEntries+1) {
+		t.Errorf("Expected the newest %d notifications first, got %d starting with %+v", maxNotificationCentreEntries, len(notifications), notifications[0])
+	}
+	if centre.UnreadCount() != maxNotificationCentreEntries+2 {
+		t.Errorf("Expected %d unread notifications, got %d", maxNotificationCentreEntries+2, centre.UnreadCount())
+	}
+
+	// Expanding the centre marks its notifications read
+	centre.SetExpanded(true)
+	centre.Notify(utilities.Notification{Title: "Blocked", Message: "Task 'Fix login' is blocked"})
+	if centre.UnreadCount() != 0 || !centre.IsExpanded() {
+		t.Errorf("Expected no unread notifications while expanded, got %d", centre.UnreadCount())
+	}
+
+	centre.Clear()
+	if len(centre.Notifications()) != 0 {
+		t.Errorf("Expected no notifications after clearing, got %d", len(centre.Notifications()))
+	}
 }
\ No newline at end of file
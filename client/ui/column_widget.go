@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"image/color"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -41,15 +42,56 @@ const (
 
 // ColumnConfiguration represents column settings and behavior
 type ColumnConfiguration struct {
+	ID          string                   `json:"id,omitempty"` // board column the tasks are in, e.g. "doing"
 	Title       string                   `json:"title"`
 	Type        ColumnType               `json:"type"`
 	WIPLimit    int                      `json:"wip_limit,omitempty"`
-	Color       string                   `json:"color,omitempty"`
+	Color       string                   `json:"color,omitempty"` // colour name like "red" or "#rrggbb"
+	Done        bool                     `json:"done,omitempty"`  // tasks in the column are finished
+	EntryPolicy string                   `json:"entry_policy,omitempty"`
+	ExitPolicy  string                   `json:"exit_policy,omitempty"`
 	ShowSections bool                    `json:"show_sections"`
 	SortOrder   string                   `json:"sort_order,omitempty"`
 	Metadata    map[string]interface{}   `json:"metadata,omitempty"`
 }
 
+// columnColors maps the colour names of column configurations to colours
+var columnColors = map[string]color.Color{
+	"red":    color.NRGBA{R: 0xd9, G: 0x53, B: 0x4f, A: 0xff},
+	"orange": color.NRGBA{R: 0xf0, G: 0xad, B: 0x4e, A: 0xff},
+	"yellow": color.NRGBA{R: 0xf7, G: 0xd7, B: 0x4a, A: 0xff},
+	"green":  color.NRGBA{R: 0x5c, G: 0xb8, B: 0x5c, A: 0xff},
+	"blue":   color.NRGBA{R: 0x42, G: 0x8b, B: 0xca, A: 0xff},
+	"purple": color.NRGBA{R: 0x8e, G: 0x6c, B: 0xc0, A: 0xff},
+	"gray":   color.NRGBA{R: 0x99, G: 0x99, B: 0x99, A: 0xff},
+}
+
+// parseColumnColor returns the colour of a colour name or "#rrggbb" value, false if it is not one
+func parseColumnColor(value string) (color.Color, bool) {
+	if c, ok := columnColors[strings.ToLower(value)]; ok {
+		return c, true
+	}
+	var r, g, b uint8
+	if len(value) == 7 {
+		if _, err := fmt.Sscanf(value, "#%02x%02x%02x", &r, &g, &b); err == nil {
+			return color.NRGBA{R: r, G: g, B: b, A: 0xff}, true
+		}
+	}
+	return nil, false
+}
+
+// columnPolicyText describes the entry and exit policies of a column, "" without policies
+func columnPolicyText(config *ColumnConfiguration) string {
+	var policies []string
+	if config.EntryPolicy != "" {
+		policies = append(policies, "Entry: "+config.EntryPolicy)
+	}
+	if config.ExitPolicy != "" {
+		policies = append(policies, "Exit: "+config.ExitPolicy)
+	}
+	return strings.Join(policies, "\n")
+}
+
 // ColumnState represents the current state of the column widget
 type ColumnState struct {
 	Configuration  *ColumnConfiguration
@@ -575,6 +617,12 @@ func (cw *ColumnWidget) processCreateTaskWorkflow(title, description string) {
 		taskRequest["status"] = "done"
 	}
 
+	// Columns of the board configuration create tasks in their board column
+	if id := cw.currentState.Configuration.ID; id != "" {
+		taskRequest["column_id"] = id
+		taskRequest["status"] = id
+	}
+
 	ctx, cancel := context.WithTimeout(cw.ctx, 30*time.Second)
 	defer cancel()
 
@@ -633,10 +681,10 @@ func (cw *ColumnWidget) mapResponseToTaskData(data map[string]any) *TaskData {
 
 // Helper Methods
 
-// checkWIPLimit checks if WIP limit is reached and updates state
+// checkWIPLimit checks if WIP limit is reached and updates state; done columns hold finished work and are not limited
 func (cw *ColumnWidget) checkWIPLimit() {
 	config := cw.currentState.Configuration
-	if config.WIPLimit <= 0 {
+	if config.WIPLimit <= 0 || config.Done {
 		return
 	}
 
@@ -656,9 +704,12 @@ func (cw *ColumnWidget) handleTaskSelection(taskID string, selected bool) {
 
 // getStateColors returns colors based on current column state
 func (cw *ColumnWidget) getStateColors() (background, border color.Color) {
-	// Default colors
+	// Default colors, the border in the column colour
 	background = theme.Color(theme.ColorNameBackground)
 	border = theme.Color(theme.ColorNameForeground)
+	if columnColor, ok := parseColumnColor(cw.currentState.Configuration.Color); ok {
+		border = columnColor
+	}
 
 	// State-specific colors
 	switch {
@@ -21,6 +21,7 @@ type columnWidgetRenderer struct {
 	headerContainer *fyne.Container
 	titleLabel      *widget.Label
 	taskCountLabel  *widget.Label
+	policyLabel     *widget.Label
 	addTaskButton   *widget.Button
 	settingsButton  *widget.Button
 	loadingIcon     *widget.Icon
@@ -74,6 +75,10 @@ func (r *columnWidgetRenderer) createComponents() {
 	r.taskCountLabel = widget.NewLabel("0 tasks")
 	r.taskCountLabel.TextStyle = fyne.TextStyle{Italic: true}
 
+	r.policyLabel = widget.NewLabel("")
+	r.policyLabel.Wrapping = fyne.TextWrapWord
+	r.policyLabel.Hide()
+
 	r.addTaskButton = widget.NewButton("+", func() {
 		r.handleAddTask()
 	})
@@ -132,7 +137,7 @@ func (r *columnWidgetRenderer) setupLayout() {
 	// Header container with title, count, and controls
 	r.headerContainer = container.NewBorder(
 		nil, nil,
-		container.NewVBox(r.titleLabel, r.taskCountLabel),
+		container.NewVBox(r.titleLabel, r.taskCountLabel, r.policyLabel),
 		container.NewHBox(r.loadingIcon, r.errorIcon, r.wipWarningIcon, r.addTaskButton, r.settingsButton),
 	)
 
@@ -202,11 +207,19 @@ func (r *columnWidgetRenderer) updateHeader() {
 	r.titleLabel.SetText(config.Title)
 
 	// Update task count with WIP limit if applicable
-	if config.WIPLimit > 0 {
+	if config.WIPLimit > 0 && !config.Done {
 		r.taskCountLabel.SetText(fmt.Sprintf("%d/%d tasks", taskCount, config.WIPLimit))
 	} else {
 		r.taskCountLabel.SetText(fmt.Sprintf("%d tasks", taskCount))
 	}
+
+	// Show the entry and exit policies of the column
+	if policies := columnPolicyText(config); policies != "" {
+		r.policyLabel.SetText(policies)
+		r.policyLabel.Show()
+	} else {
+		r.policyLabel.Hide()
+	}
 }
 
 // updateIcons shows/hides status icons based on current state
@@ -335,6 +348,7 @@ func (r *columnWidgetRenderer) updateSectionVisibility() {
 func (r *columnWidgetRenderer) updateEmptyState() {
 	r.titleLabel.SetText("No Configuration")
 	r.taskCountLabel.SetText("0 tasks")
+	r.policyLabel.Hide()
 
 	r.background.FillColor = theme.Color(theme.ColorNameDisabled)
 	r.border.StrokeColor = theme.Color(theme.ColorNameDisabled)
@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"image/color"
 	"testing"
 	"time"
 
@@ -616,4 +617,48 @@ func TestUnit_ColumnWidget_Lifecycle_Destroy(t *testing.T) {
 
 	// Verify mocks were called
 	mockDDE.AssertExpectations(t)
+}
+
+func TestUnit_ColumnWidget_ColumnSettings(t *testing.T) {
+	// Colours are names or hex values
+	if _, ok := parseColumnColor("Orange"); !ok {
+		t.Error("Expected a colour name to be parsed")
+	}
+	if c, ok := parseColumnColor("#336699"); !ok || c != (color.NRGBA{R: 0x33, G: 0x66, B: 0x99, A: 0xff}) {
+		t.Errorf("Expected a hex colour to be parsed, got %v", c)
+	}
+	if _, ok := parseColumnColor("chartreuse-ish"); ok {
+		t.Error("Expected an unknown colour to be rejected")
+	}
+
+	config := &ColumnConfiguration{EntryPolicy: "Assigned", ExitPolicy: "Reviewed"}
+	assert.Equal(t, "Entry: Assigned\nExit: Reviewed", columnPolicyText(config))
+	assert.Equal(t, "", columnPolicyText(&ColumnConfiguration{}))
+
+	// Done columns are not WIP limited
+	test.NewApp()
+	mockWM := &MockWorkflowManager{}
+	mockDDE := &MockDragDropEngine{}
+	layoutEngine := engines.NewLayoutEngine()
+	config = createTestColumnConfiguration(DoneColumn)
+	config.WIPLimit = 1
+	config.Done = true
+	config.Color = "green"
+
+	mockDDE.On("RegisterDropZone", mock.AnythingOfType("engines.DropZoneSpec")).Return(engines.ZoneID("test-zone"), nil)
+	mockDDE.On("UnregisterDropZone", engines.ZoneID("test-zone")).Return(nil)
+
+	widget := NewColumnWidget(mockWM, mockDDE, layoutEngine, config)
+	widget.SetTasks(createTestTasksCollection())
+	time.Sleep(50 * time.Millisecond)
+
+	widget.stateMu.RLock()
+	wipReached := widget.currentState.WIPLimitReached
+	widget.stateMu.RUnlock()
+	assert.False(t, wipReached)
+
+	_, border := widget.getStateColors()
+	assert.Equal(t, columnColors["green"], border)
+
+	widget.Destroy()
 }
\ No newline at end of file
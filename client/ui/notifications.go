@@ -0,0 +1,169 @@
+// Package ui provides Client UI layer components for the EisenKan system following iDesign methodology.
+// This package contains UI components that integrate with Manager and Engine layers.
+// Following iDesign namespace: eisenkan.Client.UI
+package ui
+
+import (
+	"fmt"
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// maxNotificationCentreEntries is the number of notifications the notification centre keeps
+const maxNotificationCentreEntries = 50
+
+// desktopNotifier shows notifications as notifications of the desktop
+type desktopNotifier struct {
+	app fyne.App
+}
+
+// NewDesktopNotifier creates a notifier sending notifications to the desktop through the application
+func NewDesktopNotifier(app fyne.App) utilities.INotifier {
+	return &desktopNotifier{app: app}
+}
+
+// Notify sends the notification to the desktop
+func (n *desktopNotifier) Notify(notification utilities.Notification) error {
+	n.app.SendNotification(fyne.NewNotification(notification.Title, notification.Message))
+	return nil
+}
+
+// NotificationCentre is the panel of a board listing the latest notifications, newest first; it shows the
+// number of unread notifications until it is expanded
+type NotificationCentre struct {
+	widget.BaseWidget
+
+	mutex         sync.RWMutex
+	notifications []utilities.Notification
+	unread        int
+	expanded      bool
+
+	toggleButton *widget.Button
+	clearButton  *widget.Button
+	entries      *fyne.Container
+	content      *fyne.Container
+}
+
+// NewNotificationCentre creates a collapsed notification centre without notifications
+func NewNotificationCentre() *NotificationCentre {
+	nc := &NotificationCentre{}
+	nc.toggleButton = widget.NewButton("", func() {
+		nc.SetExpanded(!nc.IsExpanded())
+	})
+	nc.clearButton = widget.NewButton("Clear", nc.Clear)
+	nc.entries = container.NewVBox()
+	nc.content = container.NewVBox(container.NewHBox(nc.toggleButton, nc.clearButton), nc.entries)
+	nc.ExtendBaseWidget(nc)
+	nc.update()
+	return nc
+}
+
+// CreateRenderer implements fyne.Widget interface
+func (nc *NotificationCentre) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(nc.content)
+}
+
+// Notify implements utilities.INotifier by adding the notification to the centre
+func (nc *NotificationCentre) Notify(notification utilities.Notification) error {
+	nc.mutex.Lock()
+	nc.notifications = append([]utilities.Notification{notification}, nc.notifications...)
+	if len(nc.notifications) > maxNotificationCentreEntries {
+		nc.notifications = nc.notifications[:maxNotificationCentreEntries]
+	}
+	if !nc.expanded {
+		nc.unread++
+	}
+	nc.mutex.Unlock()
+
+	nc.Refresh()
+	return nil
+}
+
+// Notifications returns the notifications in the centre, newest first
+func (nc *NotificationCentre) Notifications() []utilities.Notification {
+	nc.mutex.RLock()
+	defer nc.mutex.RUnlock()
+	notifications := make([]utilities.Notification, len(nc.notifications))
+	copy(notifications, nc.notifications)
+	return notifications
+}
+
+// UnreadCount returns the number of notifications received since the centre was last expanded
+func (nc *NotificationCentre) UnreadCount() int {
+	nc.mutex.RLock()
+	defer nc.mutex.RUnlock()
+	return nc.unread
+}
+
+// IsExpanded reports whether the centre lists its notifications
+func (nc *NotificationCentre) IsExpanded() bool {
+	nc.mutex.RLock()
+	defer nc.mutex.RUnlock()
+	return nc.expanded
+}
+
+// SetExpanded shows or hides the list of notifications; showing it marks all notifications read
+func (nc *NotificationCentre) SetExpanded(expanded bool) {
+	nc.mutex.Lock()
+	nc.expanded = expanded
+	if expanded {
+		nc.unread = 0
+	}
+	nc.mutex.Unlock()
+
+	nc.Refresh()
+}
+
+// Clear removes all notifications from the centre
+func (nc *NotificationCentre) Clear() {
+	nc.mutex.Lock()
+	nc.notifications = nil
+	nc.unread = 0
+	nc.mutex.Unlock()
+
+	nc.Refresh()
+}
+
+// Refresh updates the button and the list to the notifications
+func (nc *NotificationCentre) Refresh() {
+	nc.update()
+	nc.BaseWidget.Refresh()
+}
+
+// update rebuilds the button text and the list of notifications
+func (nc *NotificationCentre) update() {
+	nc.mutex.RLock()
+	defer nc.mutex.RUnlock()
+
+	if nc.unread > 0 {
+		nc.toggleButton.SetText(fmt.Sprintf("Notifications (%d)", nc.unread))
+	} else {
+		nc.toggleButton.SetText("Notifications")
+	}
+	if len(nc.notifications) == 0 {
+		nc.clearButton.Disable()
+	} else {
+		nc.clearButton.Enable()
+	}
+
+	nc.entries.Objects = nil
+	if !nc.expanded {
+		nc.entries.Hide()
+		return
+	}
+	if len(nc.notifications) == 0 {
+		nc.entries.Add(widget.NewLabel("No notifications"))
+	}
+	for _, notification := range nc.notifications {
+		label := widget.NewLabel(fmt.Sprintf("%s  %s: %s", notification.OccurredAt.Format("2006-01-02 15:04"), notification.Title, notification.Message))
+		label.Wrapping = fyne.TextWrapWord
+		nc.entries.Add(label)
+	}
+	nc.entries.Show()
+	nc.entries.Refresh()
+}
@@ -32,6 +32,25 @@ type TaskData struct {
 	UpdatedAt   time.Time              `json:"updated_at"`
 }
 
+// TaskFieldChange is a task field changed by a past revision
+type TaskFieldChange struct {
+	Label  string `json:"label"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// TaskHistoryEntry is a past revision of a task shown in the history pane
+type TaskHistoryEntry struct {
+	Commit        string            `json:"commit"`
+	Operation     string            `json:"operation"`
+	Message       string            `json:"message"`
+	TimestampText string            `json:"timestamp_text"`
+	Changes       []TaskFieldChange `json:"changes"`
+}
+
+// taskHistoryLimit is the number of revisions the history pane shows
+const taskHistoryLimit = 20
+
 // WidgetMode represents the current mode of the TaskWidget
 type WidgetMode int
 
@@ -57,6 +76,10 @@ type TaskWidgetState struct {
 	FormData       map[string]interface{}
 	IsFormDirty    bool
 	CanSave        bool
+
+	// Change history of the task, loaded when the history pane is shown
+	ShowHistory    bool
+	History        []TaskHistoryEntry
 }
 
 // TaskWidget implements a Fyne widget for displaying individual task information
@@ -172,6 +195,11 @@ func (tw *TaskWidget) CreateRenderer() fyne.WidgetRenderer {
 	renderer.saveButton = widget.NewButton("Save", renderer.onSaveClicked)
 	renderer.cancelButton = widget.NewButton("Cancel", renderer.onCancelClicked)
 
+	// Initialize history pane components
+	renderer.historyButton = widget.NewButton("History", renderer.onHistoryClicked)
+	renderer.historyLabel = widget.NewLabel("")
+	renderer.historyLabel.Wrapping = fyne.TextWrapWord
+
 	// Create container with initial layout
 	renderer.container = container.NewVBox()
 	renderer.refreshLayout()
@@ -322,6 +350,39 @@ func (tw *TaskWidget) SetShowMetadata(show bool) {
 	tw.Refresh()
 }
 
+// ShowHistory opens the history pane and loads the revisions of the task through WorkflowManager
+func (tw *TaskWidget) ShowHistory() {
+	tw.stateMu.RLock()
+	data := tw.currentState.Data
+	tw.stateMu.RUnlock()
+
+	if tw.workflowManager == nil || data == nil {
+		tw.SetError(fmt.Errorf("workflow manager unavailable"))
+		return
+	}
+
+	newState := tw.copyCurrentState()
+	newState.ShowHistory = true
+	newState.IsLoading = true
+	tw.updateState(newState)
+
+	go tw.processHistoryWorkflow(data.ID)
+}
+
+// HideHistory closes the history pane
+func (tw *TaskWidget) HideHistory() {
+	newState := tw.copyCurrentState()
+	newState.ShowHistory = false
+	tw.updateState(newState)
+}
+
+// GetHistory returns the revisions shown in the history pane, newest first
+func (tw *TaskWidget) GetHistory() []TaskHistoryEntry {
+	tw.stateMu.RLock()
+	defer tw.stateMu.RUnlock()
+	return tw.currentState.History
+}
+
 // Event handler setters
 
 // SetOnTapped sets the tap event handler
@@ -528,6 +589,8 @@ func (tw *TaskWidget) copyCurrentState() *TaskWidgetState {
 		FormData:       tw.currentState.FormData,
 		IsFormDirty:    tw.currentState.IsFormDirty,
 		CanSave:        tw.currentState.CanSave,
+		ShowHistory:    tw.currentState.ShowHistory,
+		History:        tw.currentState.History,
 	}
 
 	for k, v := range tw.currentState.ValidationErrs {
@@ -689,6 +752,72 @@ func (tw *TaskWidget) processDragWorkflow() {
 	tw.handleWorkflowResponse(response)
 }
 
+// processHistoryWorkflow loads the revisions of a task for the history pane
+func (tw *TaskWidget) processHistoryWorkflow(taskID string) {
+	ctx, cancel := context.WithTimeout(tw.ctx, 10*time.Second)
+	defer cancel()
+
+	response, err := tw.workflowManager.History().GetTaskHistoryWorkflow(ctx, taskID, taskHistoryLimit)
+	if err != nil {
+		tw.SetError(fmt.Errorf("history workflow failed: %w", err))
+		return
+	}
+	if success, _ := response["success"].(bool); !success {
+		workflowErr, _ := response["error"].(string)
+		tw.SetError(fmt.Errorf("workflow error: %s", workflowErr))
+		return
+	}
+
+	revisions, _ := response["revisions"].([]map[string]any)
+	history := make([]TaskHistoryEntry, 0, len(revisions))
+	for _, revision := range revisions {
+		entry := TaskHistoryEntry{}
+		entry.Commit, _ = revision["commit"].(string)
+		entry.Operation, _ = revision["operation"].(string)
+		entry.Message, _ = revision["message"].(string)
+		entry.TimestampText, _ = revision["timestamp_text"].(string)
+		changes, _ := revision["changes"].([]map[string]any)
+		for _, change := range changes {
+			fieldChange := TaskFieldChange{}
+			fieldChange.Label, _ = change["label"].(string)
+			fieldChange.Before, _ = change["before"].(string)
+			fieldChange.After, _ = change["after"].(string)
+			entry.Changes = append(entry.Changes, fieldChange)
+		}
+		history = append(history, entry)
+	}
+
+	// Set everything at once, the state updates of ShowHistory may not have been applied yet
+	newState := tw.copyCurrentState()
+	newState.ShowHistory = true
+	newState.IsLoading = false
+	newState.History = history
+	tw.updateState(newState)
+}
+
+// formatTaskHistory formats the revisions of a task for the history pane
+func formatTaskHistory(history []TaskHistoryEntry) string {
+	if len(history) == 0 {
+		return "No changes recorded"
+	}
+
+	valueOrNone := func(value string) string {
+		if value == "" {
+			return "(none)"
+		}
+		return value
+	}
+
+	lines := make([]string, 0, len(history)*3)
+	for _, entry := range history {
+		lines = append(lines, strings.TrimSpace(fmt.Sprintf("%s %s", entry.TimestampText, entry.Operation)))
+		for _, change := range entry.Changes {
+			lines = append(lines, fmt.Sprintf("  %s: %s → %s", change.Label, valueOrNone(change.Before), valueOrNone(change.After)))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
 // handleWorkflowResponse processes responses from WorkflowManager operations
 func (tw *TaskWidget) handleWorkflowResponse(response map[string]any) {
 	if response == nil {
@@ -1091,6 +1220,10 @@ type TaskWidgetRenderer struct {
 	saveButton       *widget.Button
 	cancelButton     *widget.Button
 
+	// History pane components
+	historyButton    *widget.Button
+	historyLabel     *widget.Label
+
 	// Layout container
 	container        *fyne.Container
 }
@@ -1174,12 +1307,26 @@ func (r *TaskWidgetRenderer) refreshContent() {
 	if !state.CanSave || state.IsLoading {
 		r.saveButton.Disable()
 	}
+
+	// Update history pane
+	if state.ShowHistory {
+		r.historyButton.SetText("Hide history")
+		if state.IsLoading {
+			r.historyLabel.SetText("Loading history...")
+		} else {
+			r.historyLabel.SetText(formatTaskHistory(state.History))
+		}
+	} else {
+		r.historyButton.SetText("History")
+	}
 }
 
 // refreshLayout updates the layout based on current widget mode
 func (r *TaskWidgetRenderer) refreshLayout() {
 	r.widget.stateMu.RLock()
 	mode := r.widget.currentState.Mode
+	showHistory := r.widget.currentState.ShowHistory
+	hasData := r.widget.currentState.Data != nil
 	r.widget.stateMu.RUnlock()
 
 	// Clear current layout
@@ -1194,6 +1341,14 @@ func (r *TaskWidgetRenderer) refreshLayout() {
 			r.metadataLabel,
 		}
 
+		// History pane for existing tasks
+		if hasData && r.widget.workflowManager != nil {
+			r.container.Objects = append(r.container.Objects, r.historyButton)
+			if showHistory {
+				r.container.Objects = append(r.container.Objects, r.historyLabel)
+			}
+		}
+
 	case EditMode, CreateMode:
 		// Edit/Create mode: show form components
 		r.container.Objects = []fyne.CanvasObject{
@@ -1244,6 +1399,18 @@ func (r *TaskWidgetRenderer) onSaveClicked() {
 	}
 }
 
+func (r *TaskWidgetRenderer) onHistoryClicked() {
+	r.widget.stateMu.RLock()
+	showHistory := r.widget.currentState.ShowHistory
+	r.widget.stateMu.RUnlock()
+
+	if showHistory {
+		r.widget.HideHistory()
+	} else {
+		r.widget.ShowHistory()
+	}
+}
+
 func (r *TaskWidgetRenderer) onCancelClicked() {
 	if err := r.widget.CancelEdit(); err != nil {
 		r.widget.SetError(fmt.Errorf("cancel failed: %w", err))
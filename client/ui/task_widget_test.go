@@ -38,6 +38,14 @@ func (m *MockWorkflowManager) Subtask() managers.ISubtask {
 	return MockISubtask{mock: &m.Mock}
 }
 
+func (m *MockWorkflowManager) History() managers.IHistory {
+	return MockIHistory{mock: &m.Mock}
+}
+
+func (m *MockWorkflowManager) Rules() managers.IRules {
+	return MockIRules{mock: &m.Mock}
+}
+
 type MockITask struct {
 	mock *mock.Mock
 }
@@ -77,6 +85,18 @@ func (m MockITask) ArchiveTaskWorkflow(ctx context.Context, taskID string, optio
 	return args.Get(0).(map[string]any), args.Error(1)
 }
 
+func (m MockITask) RestoreTaskWorkflow(ctx context.Context, taskID string, options map[string]any) (map[string]any, error) {
+	args := m.mock.Called(ctx, taskID)
+	return args.Get(0).(map[string]any), args.Error(1)
+}
+
+func (m MockITask) SubscribeTaskEvents(ctx context.Context) <-chan map[string]any {
+	// Not recorded: widgets subscribe in the background independent of the expectations under test
+	events := make(chan map[string]any)
+	close(events)
+	return events
+}
+
 type MockIDrag struct {
 	mock *mock.Mock
 }
@@ -138,6 +158,49 @@ func (m MockISubtask) MoveSubtaskWorkflow(ctx context.Context, subtaskID string,
 	return args.Get(0).(map[string]any), args.Error(1)
 }
 
+type MockIHistory struct {
+	mock *mock.Mock
+}
+
+func (m MockIHistory) UndoWorkflow(ctx context.Context) (map[string]any, error) {
+	args := m.mock.Called(ctx)
+	return args.Get(0).(map[string]any), args.Error(1)
+}
+
+func (m MockIHistory) RedoWorkflow(ctx context.Context) (map[string]any, error) {
+	args := m.mock.Called(ctx)
+	return args.Get(0).(map[string]any), args.Error(1)
+}
+
+func (m MockIHistory) ListRevisionsWorkflow(ctx context.Context, limit int) (map[string]any, error) {
+	args := m.mock.Called(ctx, limit)
+	return args.Get(0).(map[string]any), args.Error(1)
+}
+
+func (m MockIHistory) LoadBoardAtWorkflow(ctx context.Context, commitID string, at time.Time) (map[string]any, error) {
+	args := m.mock.Called(ctx, commitID, at)
+	return args.Get(0).(map[string]any), args.Error(1)
+}
+
+func (m MockIHistory) RestoreTaskFromWorkflow(ctx context.Context, commitID string, taskID string) (map[string]any, error) {
+	args := m.mock.Called(ctx, commitID, taskID)
+	return args.Get(0).(map[string]any), args.Error(1)
+}
+
+func (m MockIHistory) GetTaskHistoryWorkflow(ctx context.Context, taskID string, limit int) (map[string]any, error) {
+	args := m.mock.Called(ctx, taskID, limit)
+	return args.Get(0).(map[string]any), args.Error(1)
+}
+
+type MockIRules struct {
+	mock *mock.Mock
+}
+
+func (m MockIRules) SimulateRulesWorkflow(ctx context.Context, rulesFile string) (map[string]any, error) {
+	args := m.mock.Called(ctx, rulesFile)
+	return args.Get(0).(map[string]any), args.Error(1)
+}
+
 // Test Data Helper
 func createTestTaskData() *TaskData {
 	return &TaskData{
@@ -763,4 +826,48 @@ func TestUnit_TaskWidget_EventHandlers(t *testing.T) {
 
 	// Cleanup
 	widget.Destroy()
+}
+
+func TestUnit_TaskWidget_HistoryPane(t *testing.T) {
+	// Setup
+	setupTestApp()
+	mockWM := &MockWorkflowManager{}
+	mockWM.On("GetTaskHistoryWorkflow", mock.Anything, "test-task-123", taskHistoryLimit).Return(map[string]any{
+		"success": true,
+		"revisions": []map[string]any{
+			{
+				"commit":         "def456",
+				"operation":      "moved",
+				"timestamp_text": "Mar 3, 2025 09:30",
+				"changes":        []map[string]any{{"label": "Column", "before": "todo", "after": "doing"}},
+			},
+			{
+				"commit":         "abc123",
+				"operation":      "created",
+				"timestamp_text": "Mar 1, 2025 08:00",
+				"changes":        []map[string]any{{"label": "Title", "before": "", "after": "Test Task"}},
+			},
+		},
+	}, nil)
+	widget := NewTaskWidget(mockWM, engines.NewFormattingEngine(), engines.NewFormValidationEngine(), createTestTaskData(), DisplayMode)
+	defer widget.Destroy()
+
+	// Execute
+	widget.ShowHistory()
+	time.Sleep(200 * time.Millisecond)
+
+	// Verify
+	history := widget.GetHistory()
+	assert.Len(t, history, 2)
+	assert.Equal(t, "moved", history[0].Operation)
+	assert.Equal(t, []TaskFieldChange{{Label: "Column", Before: "todo", After: "doing"}}, history[0].Changes)
+	assert.Equal(t, "Mar 3, 2025 09:30 moved\n  Column: todo → doing\nMar 1, 2025 08:00 created\n  Title: (none) → Test Task", formatTaskHistory(history))
+
+	widget.HideHistory()
+	time.Sleep(200 * time.Millisecond)
+	widget.stateMu.RLock()
+	assert.False(t, widget.currentState.ShowHistory)
+	widget.stateMu.RUnlock()
+
+	mockWM.AssertExpectations(t)
 }
\ No newline at end of file
@@ -0,0 +1,12 @@
+package main
+
+import (
+	"os"
+
+	"github.com/rknuus/eisenkan/client/cli"
+)
+
+func main() {
+	// Only the headless subcommands; without client/ui the binary builds without cgo
+	os.Exit(cli.Run(os.Args[1:], os.Stdout, os.Stderr))
+}
@@ -2,11 +2,18 @@ package main
 
 import (
 	"log"
+	"os"
 
+	"github.com/rknuus/eisenkan/client/cli"
 	"github.com/rknuus/eisenkan/client/ui"
 )
 
 func main() {
+	// Subcommands run headless and never open a window
+	if len(os.Args) > 1 && cli.IsCommand(os.Args[1]) {
+		os.Exit(cli.Run(os.Args[1:], os.Stdout, os.Stderr))
+	}
+
 	// Create and start the application
 	app := ui.NewApplicationRoot()
 	if app == nil {
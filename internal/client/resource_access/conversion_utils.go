@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/rknuus/eisenkan/internal/engines"
 	"github.com/rknuus/eisenkan/internal/managers/task_manager"
 	"github.com/rknuus/eisenkan/internal/resource_access/board_access"
 )
@@ -189,6 +190,90 @@ func (t *taskManagerAccess) convertValidationResultToUI(validation task_manager.
 	}
 }
 
+// convertBoardRevisionToUI converts a TaskManager board revision to UI format
+func (t *taskManagerAccess) convertBoardRevisionToUI(revision task_manager.BoardRevision) UIBoardRevision {
+	return UIBoardRevision{
+		Commit:        revision.Commit,
+		Author:        revision.Author,
+		Timestamp:     revision.Timestamp,
+		Message:       revision.Message,
+		TimestampText: revision.Timestamp.Format("Jan 2, 2006 15:04"),
+	}
+}
+
+// convertSimulationReportToUI converts a rule simulation report to UI format
+func (t *taskManagerAccess) convertSimulationReportToUI(report *engines.SimulationReport) UIRuleSimulation {
+	simulation := UIRuleSimulation{
+		RulesSimulated:    report.RulesSimulated,
+		TasksEvaluated:    report.TasksEvaluated,
+		RevisionsReplayed: report.RevisionsReplayed,
+		ChangesReplayed:   report.ChangesReplayed,
+	}
+	for _, violation := range report.Violations {
+		uiViolation := UISimulatedViolation{
+			RuleID:     violation.RuleID,
+			Message:    violation.Message,
+			TaskID:     violation.TaskID,
+			TaskTitle:  violation.TaskTitle,
+			FromColumn: violation.FromColumn,
+			ToColumn:   violation.ToColumn,
+			Commit:     violation.Commit,
+			OccurredAt: violation.OccurredAt,
+		}
+		if violation.Commit != "" {
+			uiViolation.OccurredText = violation.OccurredAt.Local().Format("2006-01-02 15:04")
+		}
+		simulation.Violations = append(simulation.Violations, uiViolation)
+	}
+	return simulation
+}
+
+// taskFieldLabels holds the display names of the task fields a revision can change
+var taskFieldLabels = map[string]string{
+	"title":                   "Title",
+	"description":             "Description",
+	"priority":                "Priority",
+	"parent":                  "Parent task",
+	"column":                  "Column",
+	"section":                 "Section",
+	"position":                "Position",
+	"tags":                    "Tags",
+	"due_date":                "Due date",
+	"priority_promotion_date": "Priority promotion",
+}
+
+// convertTaskRevisionToUI converts a TaskManager task revision to UI format, formatting dates for display
+func (t *taskManagerAccess) convertTaskRevisionToUI(revision task_manager.TaskRevision) UITaskRevision {
+	formatValue := func(field, value string) string {
+		if field != "due_date" && field != "priority_promotion_date" {
+			return value
+		}
+		if date, err := time.Parse(time.RFC3339, value); err == nil {
+			return date.Format("Jan 2, 2006")
+		}
+		return value
+	}
+
+	uiRevision := UITaskRevision{
+		Revision:  t.convertBoardRevisionToUI(revision.Revision),
+		Operation: revision.Operation,
+		Changes:   make([]UITaskFieldChange, len(revision.Changes)),
+	}
+	for i, change := range revision.Changes {
+		label, ok := taskFieldLabels[change.Field]
+		if !ok {
+			label = change.Field
+		}
+		uiRevision.Changes[i] = UITaskFieldChange{
+			Field:  change.Field,
+			Label:  label,
+			Before: formatValue(change.Field, change.Before),
+			After:  formatValue(change.Field, change.After),
+		}
+	}
+	return uiRevision
+}
+
 // calculatePrioritySortOrder determines sort order for UI priority display
 func (t *taskManagerAccess) calculatePrioritySortOrder(priority board_access.Priority) int {
 	if priority.Urgent && priority.Important {
@@ -0,0 +1,13 @@
+package resource_access
+
+import (
+	"google.golang.org/grpc"
+
+	"github.com/rknuus/eisenkan/internal/rpc"
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// NewRemoteTaskManagerAccess creates a TaskManagerAccess backed by a TaskManagerService reachable over conn
+func NewRemoteTaskManagerAccess(conn grpc.ClientConnInterface, cache ICacheUtility, logger utilities.ILoggingUtility) ITaskManagerAccess {
+	return NewTaskManagerAccess(rpc.NewTaskManagerClient(conn), cache, logger)
+}
@@ -0,0 +1,66 @@
+package resource_access
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/rknuus/eisenkan/api"
+	"github.com/rknuus/eisenkan/internal/managers/task_manager"
+	"github.com/rknuus/eisenkan/internal/rpc"
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// TestIntegration_TaskManagerAccess_RemoteBackend tests TaskManagerAccess against a TaskManager served over gRPC
+func TestIntegration_TaskManagerAccess_RemoteBackend(t *testing.T) {
+	mockTaskManager := &MockTaskManager{}
+	mockTaskManager.On("CreateTask", mock.AnythingOfType("task_manager.TaskRequest")).Return(createValidTaskResponse(), nil)
+	mockTaskManager.On("GetTask", "missing").Return(task_manager.TaskResponse{}, task_manager.ErrTaskNotFound)
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	api.RegisterTaskManagerServiceServer(server, rpc.NewServer(mockTaskManager, t.TempDir(), utilities.NewLoggingUtility()))
+	go server.Serve(listener)
+	defer server.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err, "Client should be created")
+	defer conn.Close()
+
+	access := NewRemoteTaskManagerAccess(conn, utilities.NewCacheUtility(), utilities.NewLoggingUtility())
+
+	resultChan, errorChan := access.CreateTaskAsync(context.Background(), createValidUITaskRequest())
+	select {
+	case result := <-resultChan:
+		assert.Equal(t, "task-123", result.ID, "Task ID should match")
+		assert.Equal(t, "Test task", result.Description, "Description should match")
+		assert.Equal(t, UITodo, result.WorkflowStatus, "Workflow status should match")
+	case err := <-errorChan:
+		t.Fatalf("Expected success but got error: %v", err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Operation timed out")
+	}
+
+	resultChan, errorChan = access.GetTaskAsync(context.Background(), "missing")
+	select {
+	case result := <-resultChan:
+		t.Fatalf("Expected an error but got %+v", result)
+	case err := <-errorChan:
+		assert.Error(t, err, "Unknown task should fail")
+	case <-time.After(5 * time.Second):
+		t.Fatal("Operation timed out")
+	}
+
+	mockTaskManager.AssertExpectations(t)
+}
@@ -5,10 +5,15 @@ package resource_access
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/rknuus/eisenkan/internal/managers/task_manager"
+	rules "github.com/rknuus/eisenkan/internal/resource_access"
+	"github.com/rknuus/eisenkan/internal/resource_access/board_access"
 	"github.com/rknuus/eisenkan/internal/utilities"
 )
 
@@ -24,12 +29,33 @@ type ITaskManagerAccess interface {
 	// Workflow Operations
 	ChangeTaskStatusAsync(ctx context.Context, taskID string, status UIWorkflowStatus) (<-chan UITaskResponse, <-chan error)
 	ValidateTaskAsync(ctx context.Context, request UITaskRequest) (<-chan UIValidationResult, <-chan error)
+	SimulateRulesAsync(ctx context.Context, rulesFile string) (<-chan UIRuleSimulation, <-chan error)
 	ProcessPriorityPromotionsAsync(ctx context.Context) (<-chan []UITaskResponse, <-chan error)
 
+	// Archive Operations
+	ArchiveTaskAsync(ctx context.Context, taskID string, policy UICascadePolicy) (<-chan UITaskResponse, <-chan error)
+	ListArchivedTasksAsync(ctx context.Context) (<-chan []UIArchivedTask, <-chan error)
+	RestoreTaskAsync(ctx context.Context, taskID string, policy UICascadePolicy) (<-chan UITaskResponse, <-chan error)
+	PurgeArchiveAsync(ctx context.Context, olderThan time.Duration) (<-chan []string, <-chan error)
+
+	// Batch Operations
+	ExecuteBatchAsync(ctx context.Context, request UIBatchRequest) (<-chan UIBatchResult, <-chan error)
+
+	// History Operations
+	UndoAsync(ctx context.Context) (<-chan UIUndoResult, <-chan error)
+	RedoAsync(ctx context.Context) (<-chan UIUndoResult, <-chan error)
+	ListBoardRevisionsAsync(ctx context.Context, limit int) (<-chan []UIBoardRevision, <-chan error)
+	LoadBoardAtAsync(ctx context.Context, commitID string, at time.Time) (<-chan UIBoardSnapshot, <-chan error)
+	RestoreTaskFromAsync(ctx context.Context, commitID, taskID string) (<-chan UITaskResponse, <-chan error)
+	GetTaskHistoryAsync(ctx context.Context, taskID string, limit int) (<-chan []UITaskRevision, <-chan error)
+
 	// Query Operations
 	QueryTasksAsync(ctx context.Context, criteria UIQueryCriteria) (<-chan []UITaskResponse, <-chan error)
 	GetBoardSummaryAsync(ctx context.Context) (<-chan UIBoardSummary, <-chan error)
 	SearchTasksAsync(ctx context.Context, query string) (<-chan []UITaskResponse, <-chan error)
+
+	// Event Operations
+	SubscribeTaskEvents(ctx context.Context) <-chan UITaskEvent
 }
 
 // ICacheUtility defines the interface for UI caching operations
@@ -131,7 +157,7 @@ func (t *taskManagerAccess) UpdateTaskAsync(ctx context.Context, taskID string,
 		}
 
 		// Call TaskManager service
-		response, err := t.taskManager.UpdateTask(taskID, taskRequest)
+		response, err := t.taskManager.UpdateTask(taskID, taskRequest, nil)
 		if err != nil {
 			errorChan <- t.translateServiceError("UpdateTask", err)
 			return
@@ -215,7 +241,7 @@ func (t *taskManagerAccess) DeleteTaskAsync(ctx context.Context, taskID string)
 		}
 
 		// Call TaskManager service
-		err := t.taskManager.DeleteTask(taskID)
+		err := t.taskManager.DeleteTask(taskID, nil)
 		if err != nil {
 			errorChan <- t.translateServiceError("DeleteTask", err)
 			return
@@ -299,7 +325,7 @@ func (t *taskManagerAccess) ChangeTaskStatusAsync(ctx context.Context, taskID st
 		taskStatus := t.convertUIWorkflowStatusToTaskStatus(status)
 
 		// Call TaskManager service
-		response, err := t.taskManager.ChangeTaskStatus(taskID, taskStatus)
+		response, err := t.taskManager.ChangeTaskStatus(taskID, taskStatus, nil)
 		if err != nil {
 			errorChan <- t.translateServiceError("ChangeTaskStatus", err)
 			return
@@ -366,6 +392,44 @@ func (t *taskManagerAccess) ValidateTaskAsync(ctx context.Context, request UITas
 	return resultChan, errorChan
 }
 
+// SimulateRulesAsync reports the violations the rules of a rules.json file, or of the board without a file, would
+// cause on the board and in its whole history asynchronously
+func (t *taskManagerAccess) SimulateRulesAsync(ctx context.Context, rulesFile string) (<-chan UIRuleSimulation, <-chan error) {
+	resultChan := make(chan UIRuleSimulation, 1)
+	errorChan := make(chan error, 1)
+
+	go func() {
+		defer close(resultChan)
+		defer close(errorChan)
+
+		// Read the candidate rules
+		var ruleSet *rules.RuleSet
+		if rulesFile != "" {
+			content, err := os.ReadFile(rulesFile)
+			if err != nil {
+				errorChan <- t.createUIError("validation", "Rules file could not be read", err.Error(), []string{"Choose an existing rules file"}, false)
+				return
+			}
+			ruleSet = &rules.RuleSet{}
+			if err := json.Unmarshal(content, ruleSet); err != nil {
+				errorChan <- t.createUIError("validation", "Rules file is not valid", err.Error(), []string{"Choose a rules.json file"}, false)
+				return
+			}
+		}
+
+		// Call TaskManager service
+		report, err := t.taskManager.SimulateRules(ruleSet, time.Time{})
+		if err != nil {
+			errorChan <- t.translateServiceError("SimulateRules", err)
+			return
+		}
+
+		resultChan <- t.convertSimulationReportToUI(report)
+	}()
+
+	return resultChan, errorChan
+}
+
 // ProcessPriorityPromotionsAsync processes priority promotions asynchronously
 func (t *taskManagerAccess) ProcessPriorityPromotionsAsync(ctx context.Context) (<-chan []UITaskResponse, <-chan error) {
 	resultChan := make(chan []UITaskResponse, 1)
@@ -405,6 +469,343 @@ func (t *taskManagerAccess) ProcessPriorityPromotionsAsync(ctx context.Context)
 	return resultChan, errorChan
 }
 
+// ArchiveTaskAsync moves a task into the board archive and applies the cascade policy to its subtasks asynchronously
+func (t *taskManagerAccess) ArchiveTaskAsync(ctx context.Context, taskID string, policy UICascadePolicy) (<-chan UITaskResponse, <-chan error) {
+	resultChan := make(chan UITaskResponse, 1)
+	errorChan := make(chan error, 1)
+
+	go func() {
+		defer close(resultChan)
+		defer close(errorChan)
+
+		// Validate input
+		if taskID == "" {
+			errorChan <- t.createUIError("validation", "Task ID is required", "Empty task ID provided", []string{"Provide a valid task ID"}, false)
+			return
+		}
+
+		// Call TaskManager service
+		response, err := t.taskManager.ArchiveTask(taskID, board_access.CascadePolicy(policy), nil)
+		if err != nil {
+			errorChan <- t.translateServiceError("ArchiveTask", err)
+			return
+		}
+
+		// Invalidate relevant cache entries
+		t.cache.Invalidate(fmt.Sprintf("task_%s", taskID))
+		t.cache.InvalidatePattern("tasks_*")
+		t.cache.InvalidatePattern("archived_tasks")
+		t.cache.InvalidatePattern("board_summary")
+
+		// Log operation
+		t.logger.Log(utilities.Info, "TaskManagerAccess", "Task archived successfully", map[string]interface{}{
+			"task_id": taskID,
+		})
+
+		resultChan <- t.convertTaskResponseToUI(response)
+	}()
+
+	return resultChan, errorChan
+}
+
+// ListArchivedTasksAsync lists archived tasks asynchronously
+func (t *taskManagerAccess) ListArchivedTasksAsync(ctx context.Context) (<-chan []UIArchivedTask, <-chan error) {
+	resultChan := make(chan []UIArchivedTask, 1)
+	errorChan := make(chan error, 1)
+
+	go func() {
+		defer close(resultChan)
+		defer close(errorChan)
+
+		// Check cache
+		cacheKey := "archived_tasks"
+		if cached, found := t.cache.Get(cacheKey); found {
+			if archived, ok := cached.([]UIArchivedTask); ok {
+				resultChan <- archived
+				return
+			}
+		}
+
+		// Call TaskManager service
+		responses, err := t.taskManager.ListArchivedTasks()
+		if err != nil {
+			errorChan <- t.translateServiceError("ListArchivedTasks", err)
+			return
+		}
+
+		// Convert responses to UI format
+		archived := make([]UIArchivedTask, len(responses))
+		for i, response := range responses {
+			archived[i] = UIArchivedTask{
+				UITaskResponse: t.convertTaskResponseToUI(response.TaskResponse),
+				ArchivedAt:     response.ArchivedAt,
+				ArchivedText:   response.ArchivedAt.Format("Jan 2, 2006"),
+			}
+		}
+
+		// Cache the result
+		t.cache.Set(cacheKey, archived, 2*time.Minute)
+
+		resultChan <- archived
+	}()
+
+	return resultChan, errorChan
+}
+
+// RestoreTaskAsync moves an archived task back onto the board and applies the cascade policy to its archived subtasks asynchronously
+func (t *taskManagerAccess) RestoreTaskAsync(ctx context.Context, taskID string, policy UICascadePolicy) (<-chan UITaskResponse, <-chan error) {
+	resultChan := make(chan UITaskResponse, 1)
+	errorChan := make(chan error, 1)
+
+	go func() {
+		defer close(resultChan)
+		defer close(errorChan)
+
+		// Validate input
+		if taskID == "" {
+			errorChan <- t.createUIError("validation", "Task ID is required", "Empty task ID provided", []string{"Provide a valid task ID"}, false)
+			return
+		}
+
+		// Call TaskManager service
+		response, err := t.taskManager.RestoreTask(taskID, board_access.CascadePolicy(policy))
+		if err != nil {
+			errorChan <- t.translateServiceError("RestoreTask", err)
+			return
+		}
+
+		// Invalidate relevant cache entries
+		t.cache.InvalidatePattern("tasks_*")
+		t.cache.InvalidatePattern("archived_tasks")
+		t.cache.InvalidatePattern("board_summary")
+
+		// Log operation
+		t.logger.Log(utilities.Info, "TaskManagerAccess", "Task restored successfully", map[string]interface{}{
+			"task_id": taskID,
+		})
+
+		resultChan <- t.convertTaskResponseToUI(response)
+	}()
+
+	return resultChan, errorChan
+}
+
+// PurgeArchiveAsync permanently deletes tasks archived longer ago than olderThan asynchronously
+func (t *taskManagerAccess) PurgeArchiveAsync(ctx context.Context, olderThan time.Duration) (<-chan []string, <-chan error) {
+	resultChan := make(chan []string, 1)
+	errorChan := make(chan error, 1)
+
+	go func() {
+		defer close(resultChan)
+		defer close(errorChan)
+
+		// Validate input
+		if olderThan < 0 {
+			errorChan <- t.createUIError("validation", "Invalid archive age", "Negative purge age provided", []string{"Provide a positive age"}, false)
+			return
+		}
+
+		// Call TaskManager service
+		purgedIDs, err := t.taskManager.PurgeArchive(olderThan)
+		if err != nil {
+			errorChan <- t.translateServiceError("PurgeArchive", err)
+			return
+		}
+
+		// Invalidate cache if tasks were purged
+		if len(purgedIDs) > 0 {
+			t.cache.InvalidatePattern("archived_tasks")
+
+			// Log operation
+			t.logger.Log(utilities.Info, "TaskManagerAccess", "Archive purged", map[string]interface{}{
+				"purged_count": len(purgedIDs),
+			})
+		}
+
+		resultChan <- purgedIDs
+	}()
+
+	return resultChan, errorChan
+}
+
+// UndoAsync reverts the latest board operation asynchronously
+func (t *taskManagerAccess) UndoAsync(ctx context.Context) (<-chan UIUndoResult, <-chan error) {
+	return t.revertOperationAsync("Undo", t.taskManager.Undo, task_manager.ErrNothingToUndo, "Nothing to undo")
+}
+
+// RedoAsync reapplies the latest undone board operation asynchronously
+func (t *taskManagerAccess) RedoAsync(ctx context.Context) (<-chan UIUndoResult, <-chan error) {
+	return t.revertOperationAsync("Redo", t.taskManager.Redo, task_manager.ErrNothingToRedo, "Nothing to redo")
+}
+
+// revertOperationAsync runs an undo or redo; an empty history is reported as a "history" error rather than a service failure
+func (t *taskManagerAccess) revertOperationAsync(operation string, revert func() (task_manager.UndoResponse, error), empty error, emptyMessage string) (<-chan UIUndoResult, <-chan error) {
+	resultChan := make(chan UIUndoResult, 1)
+	errorChan := make(chan error, 1)
+
+	go func() {
+		defer close(resultChan)
+		defer close(errorChan)
+
+		// Call TaskManager service
+		response, err := revert()
+		if errors.Is(err, empty) {
+			errorChan <- t.createUIError("history", emptyMessage, err.Error(), nil, false)
+			return
+		}
+		if err != nil {
+			errorChan <- t.translateServiceError(operation, err)
+			return
+		}
+
+		// Invalidate relevant cache entries
+		for _, taskID := range response.ChangedTaskIDs {
+			t.cache.Invalidate(fmt.Sprintf("task_%s", taskID))
+		}
+		t.cache.InvalidatePattern("tasks_*")
+		t.cache.InvalidatePattern("archived_tasks")
+		t.cache.InvalidatePattern("board_summary")
+
+		// Log operation
+		t.logger.Log(utilities.Info, "TaskManagerAccess", operation+" completed", map[string]interface{}{
+			"description":   response.Description,
+			"changed_tasks": len(response.ChangedTaskIDs),
+		})
+
+		resultChan <- UIUndoResult{
+			Description:    response.Description,
+			ChangedTaskIDs: response.ChangedTaskIDs,
+			CanUndo:        response.CanUndo,
+			CanRedo:        response.CanRedo,
+		}
+	}()
+
+	return resultChan, errorChan
+}
+
+// ListBoardRevisionsAsync lists the latest board revisions, newest first, asynchronously
+func (t *taskManagerAccess) ListBoardRevisionsAsync(ctx context.Context, limit int) (<-chan []UIBoardRevision, <-chan error) {
+	resultChan := make(chan []UIBoardRevision, 1)
+	errorChan := make(chan error, 1)
+
+	go func() {
+		defer close(resultChan)
+		defer close(errorChan)
+
+		// Validate input
+		if limit < 0 {
+			errorChan <- t.createUIError("validation", "Invalid revision limit", "Negative revision limit provided", []string{"Provide a positive limit, or 0 for all revisions"}, false)
+			return
+		}
+
+		// Call TaskManager service
+		responses, err := t.taskManager.ListBoardRevisions(limit)
+		if err != nil {
+			errorChan <- t.translateServiceError("ListBoardRevisions", err)
+			return
+		}
+
+		revisions := make([]UIBoardRevision, len(responses))
+		for i, response := range responses {
+			revisions[i] = t.convertBoardRevisionToUI(response)
+		}
+
+		resultChan <- revisions
+	}()
+
+	return resultChan, errorChan
+}
+
+// LoadBoardAtAsync loads the read-only board as of a commit or, without a commit, as of a time asynchronously
+func (t *taskManagerAccess) LoadBoardAtAsync(ctx context.Context, commitID string, at time.Time) (<-chan UIBoardSnapshot, <-chan error) {
+	resultChan := make(chan UIBoardSnapshot, 1)
+	errorChan := make(chan error, 1)
+
+	go func() {
+		defer close(resultChan)
+		defer close(errorChan)
+
+		// Past revisions never change, so snapshots of a commit can be cached for scrubbing back and forth
+		cacheKey := fmt.Sprintf("board_at_%s", commitID)
+		if commitID != "" {
+			if cached, found := t.cache.Get(cacheKey); found {
+				if snapshot, ok := cached.(UIBoardSnapshot); ok {
+					resultChan <- snapshot
+					return
+				}
+			}
+		}
+
+		// Call TaskManager service
+		response, err := t.taskManager.LoadBoardAt(commitID, at)
+		if err != nil {
+			errorChan <- t.translateServiceError("LoadBoardAt", err)
+			return
+		}
+
+		snapshot := UIBoardSnapshot{
+			Revision: t.convertBoardRevisionToUI(response.Revision),
+			Tasks:    make([]UITaskResponse, len(response.Tasks)),
+		}
+		for i, task := range response.Tasks {
+			snapshot.Tasks[i] = t.convertTaskResponseToUI(task)
+		}
+		for _, archived := range response.ArchivedTasks {
+			snapshot.ArchivedTasks = append(snapshot.ArchivedTasks, UIArchivedTask{
+				UITaskResponse: t.convertTaskResponseToUI(archived.TaskResponse),
+				ArchivedAt:     archived.ArchivedAt,
+				ArchivedText:   archived.ArchivedAt.Format("Jan 2, 2006"),
+			})
+		}
+
+		t.cache.Set(fmt.Sprintf("board_at_%s", snapshot.Revision.Commit), snapshot, 10*time.Minute)
+
+		resultChan <- snapshot
+	}()
+
+	return resultChan, errorChan
+}
+
+// RestoreTaskFromAsync brings a task back to its state at a past revision asynchronously
+func (t *taskManagerAccess) RestoreTaskFromAsync(ctx context.Context, commitID, taskID string) (<-chan UITaskResponse, <-chan error) {
+	resultChan := make(chan UITaskResponse, 1)
+	errorChan := make(chan error, 1)
+
+	go func() {
+		defer close(resultChan)
+		defer close(errorChan)
+
+		// Validate input
+		if commitID == "" || taskID == "" {
+			errorChan <- t.createUIError("validation", "Revision and task are required", "Empty revision or task ID provided", []string{"Select a revision and a task to restore"}, false)
+			return
+		}
+
+		// Call TaskManager service
+		response, err := t.taskManager.RestoreTaskFrom(commitID, taskID)
+		if err != nil {
+			errorChan <- t.translateServiceError("RestoreTaskFrom", err)
+			return
+		}
+
+		// Invalidate relevant cache entries
+		t.cache.Invalidate(fmt.Sprintf("task_%s", taskID))
+		t.cache.InvalidatePattern("tasks_*")
+		t.cache.InvalidatePattern("archived_tasks")
+		t.cache.InvalidatePattern("board_summary")
+
+		// Log operation
+		t.logger.Log(utilities.Info, "TaskManagerAccess", "Task restored from revision", map[string]interface{}{
+			"task_id": taskID,
+			"commit":  commitID,
+		})
+
+		resultChan <- t.convertTaskResponseToUI(response)
+	}()
+
+	return resultChan, errorChan
+}
+
 // QueryTasksAsync performs advanced task queries asynchronously
 func (t *taskManagerAccess) QueryTasksAsync(ctx context.Context, criteria UIQueryCriteria) (<-chan []UITaskResponse, <-chan error) {
 	// QueryTasksAsync is essentially the same as ListTasksAsync for this implementation
@@ -501,4 +902,163 @@ func (t *taskManagerAccess) SearchTasksAsync(ctx context.Context, query string)
 	}()
 
 	return resultChan, errorChan
-}
\ No newline at end of file
+}
+// SubscribeTaskEvents streams task changes, keeping the cache consistent, until ctx is done
+func (t *taskManagerAccess) SubscribeTaskEvents(ctx context.Context) <-chan UITaskEvent {
+	uiEvents := make(chan UITaskEvent, 64)
+
+	go func() {
+		defer close(uiEvents)
+
+		for event := range t.taskManager.SubscribeTaskEvents(ctx) {
+			// Changes made elsewhere make cached queries stale; notifications change nothing
+			if event.Type != task_manager.RuleNotified {
+				t.cache.Invalidate(fmt.Sprintf("task_%s", event.TaskID))
+				t.cache.InvalidatePattern("tasks_*")
+				t.cache.InvalidatePattern("board_summary")
+				if event.Type == task_manager.TaskArchived {
+					t.cache.InvalidatePattern("archived_tasks")
+				}
+				if event.Type == task_manager.BoardReloaded {
+					t.cache.InvalidatePattern("task_*")
+					t.cache.InvalidatePattern("archived_tasks")
+				}
+			}
+
+			uiEvent := UITaskEvent{
+				Type:       UITaskEventType(event.Type),
+				TaskID:     event.TaskID,
+				OccurredAt: event.OccurredAt,
+			}
+			if event.PreviousStatus != "" {
+				uiEvent.PreviousStatus = t.convertWorkflowStatusToUI(event.PreviousStatus)
+			}
+			if event.Task != nil {
+				uiTask := t.convertTaskResponseToUI(*event.Task)
+				uiEvent.Task = &uiTask
+			}
+			for _, conflict := range event.Conflicts {
+				uiEvent.Conflicts = append(uiEvent.Conflicts, UITaskFieldConflict{
+					Field:       conflict.Field,
+					LocalValue:  conflict.LocalValue,
+					RemoteValue: conflict.RemoteValue,
+					Resolution:  conflict.Resolution,
+					Reason:      conflict.Reason,
+				})
+			}
+
+			select {
+			case uiEvents <- uiEvent:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return uiEvents
+}
+
+// GetTaskHistoryAsync lists the latest revisions of a task with the fields each changed, newest first, asynchronously
+func (t *taskManagerAccess) GetTaskHistoryAsync(ctx context.Context, taskID string, limit int) (<-chan []UITaskRevision, <-chan error) {
+	resultChan := make(chan []UITaskRevision, 1)
+	errorChan := make(chan error, 1)
+
+	go func() {
+		defer close(resultChan)
+		defer close(errorChan)
+
+		// Validate input
+		if taskID == "" {
+			errorChan <- t.createUIError("validation", "Task ID is required", "Empty task ID provided", []string{"Select a task to show its history"}, false)
+			return
+		}
+		if limit < 0 {
+			errorChan <- t.createUIError("validation", "Invalid revision limit", "Negative revision limit provided", []string{"Provide a positive limit, or 0 for the latest revisions"}, false)
+			return
+		}
+
+		// Call TaskManager service
+		responses, err := t.taskManager.GetTaskHistory(taskID, limit)
+		if err != nil {
+			errorChan <- t.translateServiceError("GetTaskHistory", err)
+			return
+		}
+
+		revisions := make([]UITaskRevision, len(responses))
+		for i, response := range responses {
+			revisions[i] = t.convertTaskRevisionToUI(response)
+		}
+
+		resultChan <- revisions
+	}()
+
+	return resultChan, errorChan
+}
+
+// ExecuteBatchAsync applies one change to several tasks in a single board commit asynchronously;
+// a failed batch changes no task and reports the task it failed on
+func (t *taskManagerAccess) ExecuteBatchAsync(ctx context.Context, request UIBatchRequest) (<-chan UIBatchResult, <-chan error) {
+	resultChan := make(chan UIBatchResult, 1)
+	errorChan := make(chan error, 1)
+
+	go func() {
+		defer close(resultChan)
+		defer close(errorChan)
+
+		// Validate input
+		if len(request.TaskIDs) == 0 {
+			errorChan <- t.createUIError("validation", "No tasks selected", "Empty batch provided", []string{"Select the tasks to change"}, false)
+			return
+		}
+		if request.Operation == UIBatchChangeStatus && request.WorkflowStatus == "" {
+			errorChan <- t.createUIError("validation", "Workflow status is required", "Empty workflow status provided", []string{"Select the column to move the tasks to"}, false)
+			return
+		}
+
+		// Call TaskManager service
+		response, err := t.taskManager.ExecuteBatch(task_manager.BatchRequest{
+			Operation:      task_manager.BatchOperation(request.Operation),
+			TaskIDs:        request.TaskIDs,
+			WorkflowStatus: t.convertUIWorkflowStatusToTaskStatus(request.WorkflowStatus),
+			Priority:       board_access.Priority{Urgent: request.Priority.Urgent, Important: request.Priority.Important},
+			CascadePolicy:  board_access.CascadePolicy(request.CascadePolicy),
+		})
+		var batchErr *task_manager.BatchError
+		if errors.As(err, &batchErr) {
+			uiErr := t.translateServiceError("ExecuteBatch", batchErr.Err).(UIErrorResponse)
+			uiErr.Message = fmt.Sprintf("Batch failed at task %s, no task was changed", batchErr.TaskID)
+			uiErr.FailedTaskID = batchErr.TaskID
+			errorChan <- uiErr
+			return
+		}
+		if err != nil {
+			errorChan <- t.translateServiceError("ExecuteBatch", err)
+			return
+		}
+
+		// Invalidate relevant cache entries
+		for _, taskID := range request.TaskIDs {
+			t.cache.Invalidate(fmt.Sprintf("task_%s", taskID))
+		}
+		t.cache.InvalidatePattern("tasks_*")
+		t.cache.InvalidatePattern("board_summary")
+		if request.Operation == UIBatchArchive {
+			t.cache.InvalidatePattern("archived_tasks")
+		}
+
+		// Log operation
+		t.logger.Log(utilities.Info, "TaskManagerAccess", "Batch executed successfully", map[string]interface{}{
+			"operation":  string(request.Operation),
+			"task_count": len(response.Tasks),
+			"commit":     response.Commit,
+		})
+
+		result := UIBatchResult{Tasks: make([]UITaskResponse, len(response.Tasks)), Commit: response.Commit}
+		for i, task := range response.Tasks {
+			result.Tasks[i] = t.convertTaskResponseToUI(task)
+		}
+		resultChan <- result
+	}()
+
+	return resultChan, errorChan
+}
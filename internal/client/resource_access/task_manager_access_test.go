@@ -3,6 +3,8 @@ package resource_access
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -11,6 +13,7 @@ import (
 
 	"github.com/rknuus/eisenkan/internal/engines"
 	"github.com/rknuus/eisenkan/internal/managers/task_manager"
+	rules "github.com/rknuus/eisenkan/internal/resource_access"
 	"github.com/rknuus/eisenkan/internal/resource_access/board_access"
 	"github.com/rknuus/eisenkan/internal/utilities"
 )
@@ -27,8 +30,8 @@ func (m *MockTaskManager) CreateTask(request task_manager.TaskRequest) (task_man
 	return args.Get(0).(task_manager.TaskResponse), args.Error(1)
 }
 
-func (m *MockTaskManager) UpdateTask(taskID string, request task_manager.TaskRequest) (task_manager.TaskResponse, error) {
-	args := m.Called(taskID, request)
+func (m *MockTaskManager) UpdateTask(taskID string, request task_manager.TaskRequest, expectedUpdatedAt *time.Time) (task_manager.TaskResponse, error) {
+	args := m.Called(taskID, request, expectedUpdatedAt)
 	return args.Get(0).(task_manager.TaskResponse), args.Error(1)
 }
 
@@ -37,8 +40,8 @@ func (m *MockTaskManager) GetTask(taskID string) (task_manager.TaskResponse, err
 	return args.Get(0).(task_manager.TaskResponse), args.Error(1)
 }
 
-func (m *MockTaskManager) DeleteTask(taskID string) error {
-	args := m.Called(taskID)
+func (m *MockTaskManager) DeleteTask(taskID string, expectedUpdatedAt *time.Time) error {
+	args := m.Called(taskID, expectedUpdatedAt)
 	return args.Error(0)
 }
 
@@ -47,8 +50,8 @@ func (m *MockTaskManager) ListTasks(criteria task_manager.QueryCriteria) ([]task
 	return args.Get(0).([]task_manager.TaskResponse), args.Error(1)
 }
 
-func (m *MockTaskManager) ChangeTaskStatus(taskID string, status task_manager.WorkflowStatus) (task_manager.TaskResponse, error) {
-	args := m.Called(taskID, status)
+func (m *MockTaskManager) ChangeTaskStatus(taskID string, status task_manager.WorkflowStatus, expectedUpdatedAt *time.Time) (task_manager.TaskResponse, error) {
+	args := m.Called(taskID, status, expectedUpdatedAt)
 	return args.Get(0).(task_manager.TaskResponse), args.Error(1)
 }
 
@@ -57,11 +60,41 @@ func (m *MockTaskManager) ValidateTask(request task_manager.TaskRequest) (task_m
 	return args.Get(0).(task_manager.ValidationResult), args.Error(1)
 }
 
+func (m *MockTaskManager) SimulateRules(ruleSet *rules.RuleSet, since time.Time) (*engines.SimulationReport, error) {
+	args := m.Called(ruleSet, since)
+	return args.Get(0).(*engines.SimulationReport), args.Error(1)
+}
+
 func (m *MockTaskManager) ProcessPriorityPromotions() ([]task_manager.TaskResponse, error) {
 	args := m.Called()
 	return args.Get(0).([]task_manager.TaskResponse), args.Error(1)
 }
 
+func (m *MockTaskManager) RunScheduledRules(now time.Time) (task_manager.ScheduledRulesResponse, error) {
+	args := m.Called(now)
+	return args.Get(0).(task_manager.ScheduledRulesResponse), args.Error(1)
+}
+
+func (m *MockTaskManager) ArchiveTask(taskID string, policy board_access.CascadePolicy, expectedUpdatedAt *time.Time) (task_manager.TaskResponse, error) {
+	args := m.Called(taskID, policy, expectedUpdatedAt)
+	return args.Get(0).(task_manager.TaskResponse), args.Error(1)
+}
+
+func (m *MockTaskManager) ListArchivedTasks() ([]task_manager.ArchivedTaskResponse, error) {
+	args := m.Called()
+	return args.Get(0).([]task_manager.ArchivedTaskResponse), args.Error(1)
+}
+
+func (m *MockTaskManager) RestoreTask(taskID string, policy board_access.CascadePolicy) (task_manager.TaskResponse, error) {
+	args := m.Called(taskID, policy)
+	return args.Get(0).(task_manager.TaskResponse), args.Error(1)
+}
+
+func (m *MockTaskManager) PurgeArchive(olderThan time.Duration) ([]string, error) {
+	args := m.Called(olderThan)
+	return args.Get(0).([]string), args.Error(1)
+}
+
 // IContext facet mock methods
 func (m *MockTaskManager) Load(contextType string) (task_manager.ContextData, error) {
 	args := m.Called(contextType)
@@ -73,17 +106,48 @@ func (m *MockTaskManager) Store(contextType string, data task_manager.ContextDat
 	return args.Error(0)
 }
 
+func (m *MockTaskManager) SubscribeTaskEvents(ctx context.Context) <-chan task_manager.TaskEvent {
+	args := m.Called(ctx)
+	return args.Get(0).(<-chan task_manager.TaskEvent)
+}
+
+func (m *MockTaskManager) SubscribeRuleNotifications(ctx context.Context) <-chan task_manager.TaskEvent {
+	args := m.Called(ctx)
+	return args.Get(0).(<-chan task_manager.TaskEvent)
+}
+
 // Board Management Operations mock methods
 func (m *MockTaskManager) ValidateBoardDirectory(directoryPath string) (task_manager.BoardValidationResponse, error) {
 	args := m.Called(directoryPath)
 	return args.Get(0).(task_manager.BoardValidationResponse), args.Error(1)
 }
 
+func (m *MockTaskManager) RepairBoard() (task_manager.BoardRepairResponse, error) {
+	args := m.Called()
+	return args.Get(0).(task_manager.BoardRepairResponse), args.Error(1)
+}
+
 func (m *MockTaskManager) GetBoardMetadata(boardPath string) (task_manager.BoardMetadataResponse, error) {
 	args := m.Called(boardPath)
 	return args.Get(0).(task_manager.BoardMetadataResponse), args.Error(1)
 }
 
+func (m *MockTaskManager) GetBoardStatistics(boardPath string) (*board_access.BoardStatistics, error) {
+	args := m.Called(boardPath)
+	if stats, ok := args.Get(0).(*board_access.BoardStatistics); ok {
+		return stats, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTaskManager) GetFlowMetrics(boardPath string, dateRange *board_access.DateRange) (*board_access.FlowMetrics, error) {
+	args := m.Called(boardPath, dateRange)
+	if metrics, ok := args.Get(0).(*board_access.FlowMetrics); ok {
+		return metrics, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
 func (m *MockTaskManager) CreateBoard(request task_manager.BoardCreationRequest) (task_manager.BoardCreationResponse, error) {
 	args := m.Called(request)
 	return args.Get(0).(task_manager.BoardCreationResponse), args.Error(1)
@@ -99,6 +163,61 @@ func (m *MockTaskManager) DeleteBoard(request task_manager.BoardDeletionRequest)
 	return args.Get(0).(task_manager.BoardDeletionResponse), args.Error(1)
 }
 
+func (m *MockTaskManager) ListBoardRemotes() ([]task_manager.BoardRemote, error) {
+	args := m.Called()
+	return args.Get(0).([]task_manager.BoardRemote), args.Error(1)
+}
+
+func (m *MockTaskManager) AddBoardRemote(name, url string) error {
+	args := m.Called(name, url)
+	return args.Error(0)
+}
+
+func (m *MockTaskManager) RemoveBoardRemote(name string) error {
+	args := m.Called(name)
+	return args.Error(0)
+}
+
+func (m *MockTaskManager) SyncBoard(remote string) (task_manager.SyncResponse, error) {
+	args := m.Called(remote)
+	return args.Get(0).(task_manager.SyncResponse), args.Error(1)
+}
+
+func (m *MockTaskManager) Undo() (task_manager.UndoResponse, error) {
+	args := m.Called()
+	return args.Get(0).(task_manager.UndoResponse), args.Error(1)
+}
+
+func (m *MockTaskManager) Redo() (task_manager.UndoResponse, error) {
+	args := m.Called()
+	return args.Get(0).(task_manager.UndoResponse), args.Error(1)
+}
+
+func (m *MockTaskManager) ListBoardRevisions(limit int) ([]task_manager.BoardRevision, error) {
+	args := m.Called(limit)
+	return args.Get(0).([]task_manager.BoardRevision), args.Error(1)
+}
+
+func (m *MockTaskManager) LoadBoardAt(commitID string, at time.Time) (task_manager.BoardSnapshotResponse, error) {
+	args := m.Called(commitID, at)
+	return args.Get(0).(task_manager.BoardSnapshotResponse), args.Error(1)
+}
+
+func (m *MockTaskManager) RestoreTaskFrom(commitID, taskID string) (task_manager.TaskResponse, error) {
+	args := m.Called(commitID, taskID)
+	return args.Get(0).(task_manager.TaskResponse), args.Error(1)
+}
+
+func (m *MockTaskManager) GetTaskHistory(taskID string, limit int) ([]task_manager.TaskRevision, error) {
+	args := m.Called(taskID, limit)
+	return args.Get(0).([]task_manager.TaskRevision), args.Error(1)
+}
+
+func (m *MockTaskManager) ExecuteBatch(request task_manager.BatchRequest) (task_manager.BatchResponse, error) {
+	args := m.Called(request)
+	return args.Get(0).(task_manager.BatchResponse), args.Error(1)
+}
+
 // MockCacheUtility is a mock implementation of ICacheUtility
 type MockCacheUtility struct {
 	mock.Mock
@@ -299,7 +418,7 @@ func TestUnit_TaskManagerAccess_UpdateTaskAsync_Success(t *testing.T) {
 	expectedResponse := createValidTaskResponse()
 	
 	// Setup mocks
-	mockTaskManager.On("UpdateTask", taskID, mock.AnythingOfType("task_manager.TaskRequest")).Return(expectedResponse, nil)
+	mockTaskManager.On("UpdateTask", taskID, mock.AnythingOfType("task_manager.TaskRequest"), (*time.Time)(nil)).Return(expectedResponse, nil)
 	mockCache.On("Invalidate", "task_task-123").Return()
 	mockCache.On("InvalidatePattern", "tasks_*").Return()
 	mockCache.On("InvalidatePattern", "board_summary").Return()
@@ -445,7 +564,7 @@ func TestUnit_TaskManagerAccess_DeleteTaskAsync_Success(t *testing.T) {
 	taskID := "task-123"
 	
 	// Setup mocks
-	mockTaskManager.On("DeleteTask", taskID).Return(nil)
+	mockTaskManager.On("DeleteTask", taskID, (*time.Time)(nil)).Return(nil)
 	mockCache.On("Invalidate", "task_task-123").Return()
 	mockCache.On("InvalidatePattern", "tasks_*").Return()
 	mockCache.On("InvalidatePattern", "board_summary").Return()
@@ -478,7 +597,7 @@ func TestUnit_TaskManagerAccess_DeleteTaskAsync_ServiceError(t *testing.T) {
 	serviceError := fmt.Errorf("task not found")
 	
 	// Setup mocks
-	mockTaskManager.On("DeleteTask", taskID).Return(serviceError)
+	mockTaskManager.On("DeleteTask", taskID, (*time.Time)(nil)).Return(serviceError)
 	mockLogger.On("LogError", "TaskManagerAccess", serviceError, mock.Anything).Return()
 	
 	// Execute
@@ -502,6 +621,409 @@ func TestUnit_TaskManagerAccess_DeleteTaskAsync_ServiceError(t *testing.T) {
 	mockLogger.AssertExpectations(t)
 }
 
+// TestUnit_TaskManagerAccess_ArchiveTaskAsync_Success tests successful task archival
+func TestUnit_TaskManagerAccess_ArchiveTaskAsync_Success(t *testing.T) {
+	access, mockTaskManager, mockCache, mockLogger := createTestTaskManagerAccess()
+
+	taskID := "task-123"
+	archivedTask := createValidTaskResponse()
+
+	// Setup mocks
+	mockTaskManager.On("ArchiveTask", taskID, board_access.PromoteSubtasks, (*time.Time)(nil)).Return(archivedTask, nil)
+	mockCache.On("Invalidate", "task_task-123").Return()
+	mockCache.On("InvalidatePattern", "tasks_*").Return()
+	mockCache.On("InvalidatePattern", "archived_tasks").Return()
+	mockCache.On("InvalidatePattern", "board_summary").Return()
+	mockLogger.On("Log", utilities.Info, "TaskManagerAccess", "Task archived successfully", mock.Anything).Return()
+
+	// Execute
+	ctx := context.Background()
+	resultChan, errorChan := access.ArchiveTaskAsync(ctx, taskID, UIPromoteSubtasks)
+
+	// Wait for result
+	select {
+	case result := <-resultChan:
+		assert.Equal(t, archivedTask.ID, result.ID, "Archived task should be returned")
+	case err := <-errorChan:
+		t.Fatalf("Expected success but got error: %v", err)
+	case <-time.After(1 * time.Second):
+		t.Fatal("Operation timed out")
+	}
+
+	mockTaskManager.AssertExpectations(t)
+	mockCache.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+// TestUnit_TaskManagerAccess_SimulateRulesAsync tests simulating the rules of a chosen file
+func TestUnit_TaskManagerAccess_SimulateRulesAsync(t *testing.T) {
+	access, mockTaskManager, _, _ := createTestTaskManagerAccess()
+
+	rulesFile := filepath.Join(t.TempDir(), "rules.json")
+	if err := os.WriteFile(rulesFile, []byte(`{"version": "1.0", "rules": [{"id": "wip-limit", "name": "WIP limit", "category": "workflow", "trigger_type": "task_transition", "enabled": false}]}`), 0644); err != nil {
+		t.Fatalf("Failed to write rules: %v", err)
+	}
+	occurredAt := time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)
+	report := &engines.SimulationReport{RulesSimulated: 1, TasksEvaluated: 3, RevisionsReplayed: 2, ChangesReplayed: 4, Violations: []engines.SimulatedViolation{
+		{RuleViolation: engines.RuleViolation{RuleID: "wip-limit", Message: "WIP limit exceeded"}, TaskID: "task-123", TaskTitle: "Test Task", FromColumn: "todo", ToColumn: "doing", Commit: "abc123", OccurredAt: occurredAt},
+	}}
+	mockTaskManager.On("SimulateRules", mock.MatchedBy(func(ruleSet *rules.RuleSet) bool {
+		return ruleSet != nil && len(ruleSet.Rules) == 1 && ruleSet.Rules[0].ID == "wip-limit"
+	}), time.Time{}).Return(report, nil)
+
+	resultChan, errorChan := access.SimulateRulesAsync(context.Background(), rulesFile)
+	select {
+	case result := <-resultChan:
+		assert.Equal(t, 1, result.RulesSimulated)
+		assert.Equal(t, 4, result.ChangesReplayed)
+		if assert.Len(t, result.Violations, 1) {
+			assert.Equal(t, "wip-limit", result.Violations[0].RuleID)
+			assert.Equal(t, "todo", result.Violations[0].FromColumn)
+			assert.Equal(t, occurredAt.Local().Format("2006-01-02 15:04"), result.Violations[0].OccurredText)
+		}
+	case err := <-errorChan:
+		t.Fatalf("Expected success but got error: %v", err)
+	case <-time.After(1 * time.Second):
+		t.Fatal("Operation timed out")
+	}
+	mockTaskManager.AssertExpectations(t)
+
+	// A rules file that cannot be read is reported without calling the service
+	_, errorChan = access.SimulateRulesAsync(context.Background(), filepath.Join(t.TempDir(), "missing.json"))
+	select {
+	case err := <-errorChan:
+		uiErr, ok := err.(UIErrorResponse)
+		assert.True(t, ok, "Error should be a UIErrorResponse")
+		assert.Equal(t, "validation", uiErr.Category)
+	case <-time.After(1 * time.Second):
+		t.Fatal("Operation timed out")
+	}
+}
+
+// TestUnit_TaskManagerAccess_ListArchivedTasksAsync_Success tests listing archived tasks
+func TestUnit_TaskManagerAccess_ListArchivedTasksAsync_Success(t *testing.T) {
+	access, mockTaskManager, mockCache, _ := createTestTaskManagerAccess()
+
+	archivedAt := time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)
+	archivedTasks := []task_manager.ArchivedTaskResponse{
+		{TaskResponse: createValidTaskResponse(), ArchivedAt: archivedAt},
+	}
+
+	// Setup mocks - cache miss, then service call
+	mockCache.On("Get", "archived_tasks").Return(nil, false)
+	mockTaskManager.On("ListArchivedTasks").Return(archivedTasks, nil)
+	mockCache.On("Set", "archived_tasks", mock.Anything, 2*time.Minute).Return()
+
+	// Execute
+	ctx := context.Background()
+	resultChan, errorChan := access.ListArchivedTasksAsync(ctx)
+
+	// Wait for result
+	select {
+	case result := <-resultChan:
+		assert.Len(t, result, 1, "Should return one archived task")
+		assert.Equal(t, archivedAt, result[0].ArchivedAt, "Archival time should be preserved")
+		assert.Equal(t, "Sep 1, 2025", result[0].ArchivedText, "Archival time should be formatted")
+	case err := <-errorChan:
+		t.Fatalf("Expected success but got error: %v", err)
+	case <-time.After(1 * time.Second):
+		t.Fatal("Operation timed out")
+	}
+
+	mockTaskManager.AssertExpectations(t)
+	mockCache.AssertExpectations(t)
+}
+
+// TestUnit_TaskManagerAccess_RestoreTaskAsync_ServiceError tests restore of a task that is not archived
+func TestUnit_TaskManagerAccess_RestoreTaskAsync_ServiceError(t *testing.T) {
+	access, mockTaskManager, _, mockLogger := createTestTaskManagerAccess()
+
+	taskID := "task-123"
+	serviceError := fmt.Errorf("archived task not found: task-123")
+
+	// Setup mocks
+	mockTaskManager.On("RestoreTask", taskID, board_access.ArchiveSubtasks).Return(task_manager.TaskResponse{}, serviceError)
+	mockLogger.On("LogError", "TaskManagerAccess", serviceError, mock.Anything).Return()
+
+	// Execute
+	ctx := context.Background()
+	resultChan, errorChan := access.RestoreTaskAsync(ctx, taskID, UIArchiveSubtasks)
+
+	// Wait for error
+	select {
+	case <-resultChan:
+		t.Fatal("Expected service error but got success")
+	case err := <-errorChan:
+		uiError, ok := err.(UIErrorResponse)
+		assert.True(t, ok, "Error should be UIErrorResponse")
+		assert.Contains(t, uiError.Details, "archived task not found", "Error details should contain original error")
+	case <-time.After(1 * time.Second):
+		t.Fatal("Operation timed out")
+	}
+
+	mockTaskManager.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+// TestUnit_TaskManagerAccess_PurgeArchiveAsync_Success tests purging old archived tasks
+func TestUnit_TaskManagerAccess_PurgeArchiveAsync_Success(t *testing.T) {
+	access, mockTaskManager, mockCache, mockLogger := createTestTaskManagerAccess()
+
+	olderThan := 30 * 24 * time.Hour
+
+	// Setup mocks
+	mockTaskManager.On("PurgeArchive", olderThan).Return([]string{"task-1", "task-2"}, nil)
+	mockCache.On("InvalidatePattern", "archived_tasks").Return()
+	mockLogger.On("Log", utilities.Info, "TaskManagerAccess", "Archive purged", mock.Anything).Return()
+
+	// Execute
+	ctx := context.Background()
+	resultChan, errorChan := access.PurgeArchiveAsync(ctx, olderThan)
+
+	// Wait for result
+	select {
+	case result := <-resultChan:
+		assert.Equal(t, []string{"task-1", "task-2"}, result, "Purged task IDs should be returned")
+	case err := <-errorChan:
+		t.Fatalf("Expected success but got error: %v", err)
+	case <-time.After(1 * time.Second):
+		t.Fatal("Operation timed out")
+	}
+
+	mockTaskManager.AssertExpectations(t)
+	mockCache.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+// TestUnit_TaskManagerAccess_UndoAsync_Success tests undoing the latest board operation
+func TestUnit_TaskManagerAccess_UndoAsync_Success(t *testing.T) {
+	access, mockTaskManager, mockCache, mockLogger := createTestTaskManagerAccess()
+
+	// Setup mocks
+	mockTaskManager.On("Undo").Return(task_manager.UndoResponse{Description: "move task", ChangedTaskIDs: []string{"task-123"}, CanRedo: true}, nil)
+	mockCache.On("Invalidate", "task_task-123").Return()
+	mockCache.On("InvalidatePattern", "tasks_*").Return()
+	mockCache.On("InvalidatePattern", "archived_tasks").Return()
+	mockCache.On("InvalidatePattern", "board_summary").Return()
+	mockLogger.On("Log", utilities.Info, "TaskManagerAccess", "Undo completed", mock.Anything).Return()
+
+	// Execute
+	ctx := context.Background()
+	resultChan, errorChan := access.UndoAsync(ctx)
+
+	// Wait for result
+	select {
+	case result := <-resultChan:
+		assert.Equal(t, "move task", result.Description, "Undone operation should be described")
+		assert.Equal(t, []string{"task-123"}, result.ChangedTaskIDs, "Changed tasks should be returned")
+		assert.True(t, result.CanRedo, "Undone operation should be redoable")
+	case err := <-errorChan:
+		t.Fatalf("Expected success but got error: %v", err)
+	case <-time.After(1 * time.Second):
+		t.Fatal("Operation timed out")
+	}
+
+	mockTaskManager.AssertExpectations(t)
+	mockCache.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+// TestUnit_TaskManagerAccess_RedoAsync_NothingToRedo tests redo with an empty redo history
+func TestUnit_TaskManagerAccess_RedoAsync_NothingToRedo(t *testing.T) {
+	access, mockTaskManager, _, _ := createTestTaskManagerAccess()
+
+	// Setup mocks
+	mockTaskManager.On("Redo").Return(task_manager.UndoResponse{}, task_manager.ErrNothingToRedo)
+
+	// Execute
+	ctx := context.Background()
+	resultChan, errorChan := access.RedoAsync(ctx)
+
+	// Wait for error
+	select {
+	case <-resultChan:
+		t.Fatal("Expected an error but got success")
+	case err := <-errorChan:
+		uiError, ok := err.(UIErrorResponse)
+		assert.True(t, ok, "Error should be UIErrorResponse")
+		assert.Equal(t, "history", uiError.Category, "Empty history should not be reported as a service error")
+		assert.Equal(t, "Nothing to redo", uiError.Message)
+	case <-time.After(1 * time.Second):
+		t.Fatal("Operation timed out")
+	}
+
+	mockTaskManager.AssertExpectations(t)
+}
+
+// TestUnit_TaskManagerAccess_LoadBoardAtAsync_CachesRevision tests loading a past board state
+func TestUnit_TaskManagerAccess_LoadBoardAtAsync_CachesRevision(t *testing.T) {
+	access, mockTaskManager, mockCache, _ := createTestTaskManagerAccess()
+
+	committedAt := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+	snapshot := task_manager.BoardSnapshotResponse{
+		Revision: task_manager.BoardRevision{Commit: "abc123", Timestamp: committedAt, Message: "Create task"},
+		Tasks:    []task_manager.TaskResponse{{ID: "task-123", Description: "Past task", WorkflowStatus: task_manager.Todo}},
+	}
+
+	// Setup mocks
+	mockCache.On("Get", "board_at_abc123").Return(nil, false)
+	mockTaskManager.On("LoadBoardAt", "abc123", time.Time{}).Return(snapshot, nil)
+	mockCache.On("Set", "board_at_abc123", mock.AnythingOfType("UIBoardSnapshot"), 10*time.Minute).Return()
+
+	// Execute
+	ctx := context.Background()
+	resultChan, errorChan := access.LoadBoardAtAsync(ctx, "abc123", time.Time{})
+
+	// Wait for result
+	select {
+	case result := <-resultChan:
+		assert.Equal(t, "abc123", result.Revision.Commit)
+		assert.Equal(t, "Mar 1, 2026 09:30", result.Revision.TimestampText)
+		assert.Len(t, result.Tasks, 1)
+		assert.Equal(t, "Past task", result.Tasks[0].Description)
+	case err := <-errorChan:
+		t.Fatalf("Expected success but got error: %v", err)
+	case <-time.After(1 * time.Second):
+		t.Fatal("Operation timed out")
+	}
+
+	mockTaskManager.AssertExpectations(t)
+	mockCache.AssertExpectations(t)
+}
+
+// TestUnit_TaskManagerAccess_RestoreTaskFromAsync_Success tests restoring a task from a past revision
+func TestUnit_TaskManagerAccess_RestoreTaskFromAsync_Success(t *testing.T) {
+	access, mockTaskManager, mockCache, mockLogger := createTestTaskManagerAccess()
+
+	// Setup mocks
+	mockTaskManager.On("RestoreTaskFrom", "abc123", "task-123").Return(task_manager.TaskResponse{ID: "task-123", Description: "Past task", WorkflowStatus: task_manager.Todo}, nil)
+	mockCache.On("Invalidate", "task_task-123").Return()
+	mockCache.On("InvalidatePattern", "tasks_*").Return()
+	mockCache.On("InvalidatePattern", "archived_tasks").Return()
+	mockCache.On("InvalidatePattern", "board_summary").Return()
+	mockLogger.On("Log", utilities.Info, "TaskManagerAccess", "Task restored from revision", mock.Anything).Return()
+
+	// Execute
+	ctx := context.Background()
+	resultChan, errorChan := access.RestoreTaskFromAsync(ctx, "abc123", "task-123")
+
+	// Wait for result
+	select {
+	case result := <-resultChan:
+		assert.Equal(t, "Past task", result.Description)
+	case err := <-errorChan:
+		t.Fatalf("Expected success but got error: %v", err)
+	case <-time.After(1 * time.Second):
+		t.Fatal("Operation timed out")
+	}
+
+	mockTaskManager.AssertExpectations(t)
+	mockCache.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+// TestUnit_TaskManagerAccess_GetTaskHistoryAsync_FormatsChanges tests the field changes of a task's revisions
+func TestUnit_TaskManagerAccess_GetTaskHistoryAsync_FormatsChanges(t *testing.T) {
+	access, mockTaskManager, _, _ := createTestTaskManagerAccess()
+
+	// Setup mocks
+	mockTaskManager.On("GetTaskHistory", "task-123", 20).Return([]task_manager.TaskRevision{{
+		Revision:  task_manager.BoardRevision{Commit: "abc123", Timestamp: time.Date(2025, 3, 3, 9, 30, 0, 0, time.UTC)},
+		Operation: "updated",
+		Changes: []task_manager.TaskFieldChange{
+			{Field: "title", Before: "Draft", After: "Report"},
+			{Field: "due_date", After: "2025-03-10T00:00:00Z"},
+		},
+	}}, nil)
+
+	// Execute
+	ctx := context.Background()
+	resultChan, errorChan := access.GetTaskHistoryAsync(ctx, "task-123", 20)
+
+	// Wait for result
+	select {
+	case revisions := <-resultChan:
+		assert.Len(t, revisions, 1)
+		assert.Equal(t, "Mar 3, 2025 09:30", revisions[0].Revision.TimestampText)
+		assert.Equal(t, []UITaskFieldChange{
+			{Field: "title", Label: "Title", Before: "Draft", After: "Report"},
+			{Field: "due_date", Label: "Due date", After: "Mar 10, 2025"},
+		}, revisions[0].Changes)
+	case err := <-errorChan:
+		t.Fatalf("Expected success but got error: %v", err)
+	case <-time.After(1 * time.Second):
+		t.Fatal("Operation timed out")
+	}
+
+	mockTaskManager.AssertExpectations(t)
+}
+
+// TestUnit_TaskManagerAccess_ExecuteBatchAsync_Success tests a batch archive
+func TestUnit_TaskManagerAccess_ExecuteBatchAsync_Success(t *testing.T) {
+	access, mockTaskManager, mockCache, mockLogger := createTestTaskManagerAccess()
+
+	// Setup mocks
+	mockTaskManager.On("ExecuteBatch", task_manager.BatchRequest{
+		Operation:      task_manager.BatchArchive,
+		TaskIDs:        []string{"task-1", "task-2"},
+		WorkflowStatus: task_manager.Todo,
+	}).Return(task_manager.BatchResponse{Tasks: []task_manager.TaskResponse{createValidTaskResponse(), createValidTaskResponse()}, Commit: "abc123"}, nil)
+	mockCache.On("Invalidate", "task_task-1").Return()
+	mockCache.On("Invalidate", "task_task-2").Return()
+	mockCache.On("InvalidatePattern", "tasks_*").Return()
+	mockCache.On("InvalidatePattern", "board_summary").Return()
+	mockCache.On("InvalidatePattern", "archived_tasks").Return()
+	mockLogger.On("Log", utilities.Info, "TaskManagerAccess", "Batch executed successfully", mock.Anything).Return()
+
+	// Execute
+	ctx := context.Background()
+	resultChan, errorChan := access.ExecuteBatchAsync(ctx, UIBatchRequest{Operation: UIBatchArchive, TaskIDs: []string{"task-1", "task-2"}})
+
+	// Wait for result
+	select {
+	case result := <-resultChan:
+		assert.Len(t, result.Tasks, 2, "Changed tasks should be returned")
+		assert.Equal(t, "abc123", result.Commit, "Batch commit should be returned")
+	case err := <-errorChan:
+		t.Fatalf("Expected success but got error: %v", err)
+	case <-time.After(1 * time.Second):
+		t.Fatal("Operation timed out")
+	}
+
+	mockTaskManager.AssertExpectations(t)
+	mockCache.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+// TestUnit_TaskManagerAccess_ExecuteBatchAsync_Failure tests that a failed batch names the task it failed on
+func TestUnit_TaskManagerAccess_ExecuteBatchAsync_Failure(t *testing.T) {
+	access, mockTaskManager, _, mockLogger := createTestTaskManagerAccess()
+
+	// Setup mocks
+	batchErr := &task_manager.BatchError{TaskID: "task-2", Err: fmt.Errorf("failed to get current task state: %w", task_manager.ErrTaskNotFound)}
+	mockTaskManager.On("ExecuteBatch", mock.AnythingOfType("task_manager.BatchRequest")).Return(task_manager.BatchResponse{}, batchErr)
+	mockLogger.On("LogError", "TaskManagerAccess", mock.Anything, mock.Anything).Return()
+
+	// Execute
+	ctx := context.Background()
+	resultChan, errorChan := access.ExecuteBatchAsync(ctx, UIBatchRequest{Operation: UIBatchChangeStatus, TaskIDs: []string{"task-1", "task-2"}, WorkflowStatus: UIDone})
+
+	// Wait for result
+	select {
+	case <-resultChan:
+		t.Fatal("Expected the batch to fail")
+	case err := <-errorChan:
+		uiErr, ok := err.(UIErrorResponse)
+		assert.True(t, ok, "Error should be a UIErrorResponse")
+		assert.Equal(t, "task-2", uiErr.FailedTaskID, "Failed task should be reported")
+		assert.Contains(t, uiErr.Message, "no task was changed")
+	case <-time.After(1 * time.Second):
+		t.Fatal("Operation timed out")
+	}
+
+	mockTaskManager.AssertExpectations(t)
+}
+
 // TestUnit_TaskManagerAccess_ListTasksAsync_Success tests successful task listing
 func TestUnit_TaskManagerAccess_ListTasksAsync_Success(t *testing.T) {
 	access, mockTaskManager, mockCache, _ := createTestTaskManagerAccess()
@@ -545,7 +1067,7 @@ func TestUnit_TaskManagerAccess_ChangeTaskStatusAsync_Success(t *testing.T) {
 	expectedResponse.WorkflowStatus = task_manager.InProgress
 	
 	// Setup mocks
-	mockTaskManager.On("ChangeTaskStatus", taskID, task_manager.InProgress).Return(expectedResponse, nil)
+	mockTaskManager.On("ChangeTaskStatus", taskID, task_manager.InProgress, (*time.Time)(nil)).Return(expectedResponse, nil)
 	mockCache.On("Invalidate", "task_task-123").Return()
 	mockCache.On("InvalidatePattern", "tasks_*").Return()
 	mockCache.On("InvalidatePattern", "board_summary").Return()
@@ -942,4 +1464,38 @@ func TestUnit_TaskManagerAccess_ErrorHandling_CategoryMapping(t *testing.T) {
 	}
 	
 	mockTaskManager.AssertExpectations(t)
-}
\ No newline at end of file
+}
+// TestUnit_TaskManagerAccess_SubscribeTaskEvents tests event conversion and cache invalidation
+func TestUnit_TaskManagerAccess_SubscribeTaskEvents(t *testing.T) {
+	access, mockTaskManager, mockCache, _ := createTestTaskManagerAccess()
+
+	task := createValidTaskResponse()
+	task.WorkflowStatus = task_manager.InProgress
+	source := make(chan task_manager.TaskEvent, 1)
+	source <- task_manager.TaskEvent{Type: task_manager.TaskMoved, TaskID: task.ID, Task: &task, PreviousStatus: task_manager.Todo, OccurredAt: time.Now()}
+	close(source)
+
+	// Setup mocks
+	mockTaskManager.On("SubscribeTaskEvents", mock.Anything).Return((<-chan task_manager.TaskEvent)(source))
+	mockCache.On("Invalidate", "task_task-123").Return()
+	mockCache.On("InvalidatePattern", "tasks_*").Return()
+	mockCache.On("InvalidatePattern", "board_summary").Return()
+
+	// Execute
+	var received []UITaskEvent
+	for event := range access.SubscribeTaskEvents(context.Background()) {
+		received = append(received, event)
+	}
+
+	assert.Len(t, received, 1, "Event should be forwarded")
+	assert.Equal(t, UITaskMoved, received[0].Type, "Event type should match")
+	assert.Equal(t, UITodo, received[0].PreviousStatus, "Previous status should be converted")
+	if assert.NotNil(t, received[0].Task, "Task should be converted") {
+		assert.Equal(t, UIInProgress, received[0].Task.WorkflowStatus, "Task status should be converted")
+		assert.NotEmpty(t, received[0].Task.DisplayName, "Display fields should be filled")
+	}
+
+	// Verify mocks
+	mockTaskManager.AssertExpectations(t)
+	mockCache.AssertExpectations(t)
+}
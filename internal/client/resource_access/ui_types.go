@@ -38,6 +38,108 @@ type UITaskResponse struct {
 	IsOverdue             bool                 `json:"is_overdue"`             // Deadline status
 }
 
+// UIArchivedTask represents an archived task optimized for UI display
+type UIArchivedTask struct {
+	UITaskResponse
+	ArchivedAt   time.Time `json:"archived_at"`
+	ArchivedText string    `json:"archived_text"` // Formatted archival date
+}
+
+// UIUndoResult represents an undone or redone board operation
+type UIUndoResult struct {
+	Description    string   `json:"description"` // operation that was undone or redone
+	ChangedTaskIDs []string `json:"changed_task_ids,omitempty"`
+	CanUndo        bool     `json:"can_undo"`
+	CanRedo        bool     `json:"can_redo"`
+}
+
+// UIBoardRevision represents a past board state optimized for UI display
+type UIBoardRevision struct {
+	Commit        string    `json:"commit"`
+	Author        string    `json:"author"`
+	Timestamp     time.Time `json:"timestamp"`
+	Message       string    `json:"message"`
+	TimestampText string    `json:"timestamp_text"` // Formatted commit time
+}
+
+// UIBoardSnapshot represents the read-only board as of a past revision
+type UIBoardSnapshot struct {
+	Revision      UIBoardRevision  `json:"revision"`
+	Tasks         []UITaskResponse `json:"tasks"`
+	ArchivedTasks []UIArchivedTask `json:"archived_tasks,omitempty"`
+}
+
+// UITaskFieldChange represents a task field changed by a revision optimized for UI display
+type UITaskFieldChange struct {
+	Field  string `json:"field"`
+	Label  string `json:"label"` // Display name of the field
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// UITaskRevision represents a past change of a task optimized for UI display
+type UITaskRevision struct {
+	Revision  UIBoardRevision     `json:"revision"`
+	Operation string              `json:"operation"` // created, updated, moved, archived, restored or removed
+	Changes   []UITaskFieldChange `json:"changes"`
+}
+
+// UISimulatedViolation represents a rule violation found by a simulation optimized for UI display
+type UISimulatedViolation struct {
+	RuleID       string    `json:"rule_id"`
+	Message      string    `json:"message"`
+	TaskID       string    `json:"task_id"`
+	TaskTitle    string    `json:"task_title"`
+	FromColumn   string    `json:"from_column,omitempty"` // column a replayed transition left
+	ToColumn     string    `json:"to_column"`
+	Commit       string    `json:"commit,omitempty"` // revision of a replayed change; empty for the current board
+	OccurredAt   time.Time `json:"occurred_at,omitempty"`
+	OccurredText string    `json:"occurred_text,omitempty"` // Formatted commit time
+}
+
+// UIRuleSimulation represents the violations a rule set would cause on the board and in its history
+type UIRuleSimulation struct {
+	RulesSimulated    int                    `json:"rules_simulated"`
+	TasksEvaluated    int                    `json:"tasks_evaluated"`
+	RevisionsReplayed int                    `json:"revisions_replayed"`
+	ChangesReplayed   int                    `json:"changes_replayed"`
+	Violations        []UISimulatedViolation `json:"violations,omitempty"` // current board first, then history oldest first
+}
+
+// UIBatchOperation names the change a batch applies to each of its tasks
+type UIBatchOperation string
+
+const (
+	UIBatchChangeStatus   UIBatchOperation = "change_status"
+	UIBatchChangePriority UIBatchOperation = "change_priority"
+	UIBatchArchive        UIBatchOperation = "archive"
+)
+
+// UIBatchRequest represents one change applied to several tasks; either all tasks are changed or none
+type UIBatchRequest struct {
+	Operation      UIBatchOperation `json:"operation"`
+	TaskIDs        []string         `json:"task_ids"`
+	WorkflowStatus UIWorkflowStatus `json:"workflow_status,omitempty"` // target of UIBatchChangeStatus
+	Priority       UIPriority       `json:"priority"`                  // target of UIBatchChangePriority
+	CascadePolicy  UICascadePolicy  `json:"cascade_policy,omitempty"`  // subtasks of UIBatchArchive
+}
+
+// UICascadePolicy names what archiving or restoring a task does to its subtasks
+type UICascadePolicy string
+
+const (
+	UIKeepSubtasks    UICascadePolicy = "no_action"
+	UIArchiveSubtasks UICascadePolicy = "archive_subtasks"
+	UIDeleteSubtasks  UICascadePolicy = "delete_subtasks"
+	UIPromoteSubtasks UICascadePolicy = "promote_subtasks"
+)
+
+// UIBatchResult represents an applied batch optimized for UI display
+type UIBatchResult struct {
+	Tasks  []UITaskResponse `json:"tasks"`            // changed tasks in request order
+	Commit string           `json:"commit,omitempty"` // board commit holding all changes of the batch
+}
+
 // UIPriority represents priority settings optimized for UI interaction
 type UIPriority struct {
 	Urgent     bool   `json:"urgent"`
@@ -100,6 +202,7 @@ type UIErrorResponse struct {
 	Details     string   `json:"details"`     // Technical details for debugging
 	Suggestions []string `json:"suggestions"` // Recovery actions for user
 	Retryable   bool     `json:"retryable"`   // Whether operation can be retried
+	FailedTaskID string  `json:"failed_task_id,omitempty"` // Task a batch failed on
 }
 
 // UIBoardSummary represents board statistics optimized for UI display
@@ -121,6 +224,46 @@ type UISubtaskSummary struct {
 	CompletedSubtasks int `json:"completed_subtasks"` // Completed subtasks
 }
 
+// UITaskEventType names the kind of change a UITaskEvent reports
+type UITaskEventType string
+
+const (
+	UITaskCreated  UITaskEventType = "created"
+	UITaskUpdated  UITaskEventType = "updated"
+	UITaskMoved    UITaskEventType = "moved"
+	UITaskArchived UITaskEventType = "archived"
+	UITaskDeleted  UITaskEventType = "deleted"
+
+	// UITaskConflicted reports task fields a sync merged provisionally, listed in Conflicts for review
+	UITaskConflicted UITaskEventType = "conflicted"
+
+	// UIBoardReloaded carries no task; everything shown for the board should be reloaded
+	UIBoardReloaded UITaskEventType = "reloaded"
+
+	// UITaskNotified reports a notification rule that triggered for the task; the task is unchanged
+	UITaskNotified UITaskEventType = "notified"
+)
+
+// UITaskEvent represents a task change optimized for incremental UI updates
+type UITaskEvent struct {
+	Type           UITaskEventType  `json:"type"`
+	TaskID         string           `json:"task_id"`
+	Task           *UITaskResponse  `json:"task,omitempty"` // nil for deleted tasks, reloads and archivals made outside the application
+	PreviousStatus UIWorkflowStatus `json:"previous_status,omitempty"`
+	OccurredAt     time.Time        `json:"occurred_at"`
+
+	Conflicts []UITaskFieldConflict `json:"conflicts,omitempty"` // set for conflicted tasks
+}
+
+// UITaskFieldConflict represents a task field changed differently on two clones of the board
+type UITaskFieldConflict struct {
+	Field       string `json:"field"`
+	LocalValue  string `json:"local_value"`
+	RemoteValue string `json:"remote_value"`
+	Resolution  string `json:"resolution"` // "local", "remote" or "unresolved"
+	Reason      string `json:"reason,omitempty"`
+}
+
 // Error implements the error interface for UIErrorResponse
 func (e UIErrorResponse) Error() string {
 	return e.Message
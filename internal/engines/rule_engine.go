@@ -58,8 +58,20 @@ type RuleViolation struct {
 
 // RuleEvaluationResult contains the outcome of rule evaluation
 type RuleEvaluationResult struct {
-	Allowed    bool            `json:"allowed"`
-	Violations []RuleViolation `json:"violations,omitempty"`
+	Allowed    bool              `json:"allowed"`
+	Violations []RuleViolation   `json:"violations,omitempty"`
+	Actions    []TriggeredAction `json:"actions,omitempty"` // automation to apply and notifications to send once the change is made
+}
+
+// TriggeredAction is an automation or notification rule whose condition expression holds for a task change,
+// with the actions to apply to the task or the message to notify the user with
+type TriggeredAction struct {
+	RuleID   string                 `json:"rule_id"`
+	RuleName string                 `json:"rule_name"`
+	Category string                 `json:"category"` // "automation" or "notification"
+	Priority int                    `json:"priority"`
+	Actions  map[string]interface{} `json:"actions"`
+	Message  string                 `json:"message,omitempty"` // set for notification rules
 }
 
 // EnrichedContext contains all context needed for rule evaluation
@@ -71,10 +83,17 @@ type EnrichedContext struct {
 	Subtasks         []*board_access.TaskWithTimestamps            `json:"subtasks"`           // for dependency rules
 	ColumnTasks      map[string][]*board_access.TaskWithTimestamps `json:"column_tasks"`       // for priority comparisons
 	ColumnEnterTimes map[string]time.Time                          `json:"column_enter_times"` // column -> enter timestamp
+	ColumnSettings   map[string]board_access.ColumnSettings        `json:"column_settings"`    // column -> WIP limit and done
 	BoardMetadata    map[string]string                             `json:"board_metadata"`     // for custom rules
 	HierarchyMap     map[string][]string                           `json:"hierarchy_map"`      // parent -> subtasks mapping
 }
 
+// columnWIPLimitRuleID identifies violations of the WIP limit configured for a column in board.json
+const columnWIPLimitRuleID = "column_wip_limit"
+
+// columnWIPLimitPriority is the priority of column WIP limit violations
+const columnWIPLimitPriority = 100
+
 // IRuleEngine defines the interface for rule evaluation operations
 type IRuleEngine interface {
 	// EvaluateTaskChange evaluates whether a task change can be applied
@@ -83,6 +102,12 @@ type IRuleEngine interface {
 	// EvaluateBoardConfigurationChange evaluates whether a board configuration change can be applied
 	EvaluateBoardConfigurationChange(ctx context.Context, event BoardConfigurationEvent) (*RuleEvaluationResult, error)
 
+	// EvaluateTimeTriggers evaluates the time-triggered rules against all tasks of the board at the given time
+	EvaluateTimeTriggers(ctx context.Context, boardPath string, now time.Time) ([]TimeTrigger, error)
+
+	// SimulateRules reports the violations a rule set would cause on the current board and in its history since the given time
+	SimulateRules(ctx context.Context, ruleSet *resource_access.RuleSet, boardPath string, since time.Time) (*SimulationReport, error)
+
 	// Close releases any resources held by the engine
 	Close() error
 }
@@ -129,8 +154,7 @@ func (re *RuleEngine) EvaluateTaskChange(ctx context.Context, event TaskEvent, b
 	// Filter rules based on event type and enabled status
 	applicableRules := re.filterApplicableRules(ruleSet.Rules, event.EventType)
 	if len(applicableRules) == 0 {
-		re.logger.LogMessage(utilities.Debug, "RuleEngine", "No applicable rules found, allowing task change")
-		return &RuleEvaluationResult{Allowed: true}, nil
+		return re.evaluateColumnWIPLimitOnly(event)
 	}
 
 	// Enrich context with board data
@@ -141,6 +165,9 @@ func (re *RuleEngine) EvaluateTaskChange(ctx context.Context, event TaskEvent, b
 
 	// Evaluate all applicable rules using complete sequential processor
 	violations := re.evaluateRules(applicableRules, enrichedContext)
+	if violation := re.evaluateColumnWIPLimit(enrichedContext); violation != nil {
+		violations = append(violations, *violation)
+	}
 
 	// Sort violations by priority (higher priority first)
 	sort.Slice(violations, func(i, j int) bool {
@@ -151,9 +178,12 @@ func (re *RuleEngine) EvaluateTaskChange(ctx context.Context, event TaskEvent, b
 		Allowed:    len(violations) == 0,
 		Violations: violations,
 	}
+	if result.Allowed {
+		result.Actions = re.triggeredActions(applicableRules, enrichedContext)
+	}
 
 	re.logger.LogMessage(utilities.Info, "RuleEngine",
-		fmt.Sprintf("Rule evaluation completed: allowed=%t, violations=%d", result.Allowed, len(violations)))
+		fmt.Sprintf("Rule evaluation completed: allowed=%t, violations=%d, actions=%d", result.Allowed, len(violations), len(result.Actions)))
 
 	return result, nil
 }
@@ -287,6 +317,7 @@ func (re *RuleEngine) enrichContext(ctx context.Context, event TaskEvent, boardP
 		Subtasks:         subtasks,
 		ColumnTasks:      rulesData.ColumnTasks,
 		ColumnEnterTimes: rulesData.ColumnEnterTimes,
+		ColumnSettings:   rulesData.ColumnSettings,
 		BoardMetadata:    rulesData.BoardMetadata,
 		HierarchyMap:     rulesData.HierarchyMap,
 	}
@@ -333,6 +364,11 @@ func (re *RuleEngine) evaluateBoardRules(rules []resource_access.Rule, event Boa
 func (re *RuleEngine) evaluateRule(rule resource_access.Rule, context *EnrichedContext) *RuleViolation {
 	re.logger.LogMessage(utilities.Debug, "RuleEngine", fmt.Sprintf("Evaluating rule: %s (%s)", rule.ID, rule.Name))
 
+	// Condition expression, evaluated alongside the legacy condition keys
+	if violation := re.evaluateExpressionRule(rule, context); violation != nil {
+		return violation
+	}
+
 	// Evaluate rule based on category and conditions
 	switch rule.Category {
 	case "validation":
@@ -342,7 +378,8 @@ func (re *RuleEngine) evaluateRule(rule resource_access.Rule, context *EnrichedC
 	case "automation":
 		return re.evaluateAutomationRule(rule, context)
 	case "notification":
-		return re.evaluateNotificationRule(rule, context)
+		// Notification rules never block a change; triggeredActions collects the ones to send
+		return nil
 	case "board_configuration":
 		// Board configuration rules should not be evaluated in task context
 		re.logger.LogMessage(utilities.Warning, "RuleEngine", "Board configuration rule encountered in task evaluation context")
@@ -358,6 +395,150 @@ func (re *RuleEngine) evaluateRule(rule resource_access.Rule, context *EnrichedC
 	}
 }
 
+// evaluateExpressionRule reports a violation when the condition expression of a validation or workflow rule
+// holds; automation rules trigger actions instead and notification rules don't block the change
+func (re *RuleEngine) evaluateExpressionRule(rule resource_access.Rule, context *EnrichedContext) *RuleViolation {
+	expression, exists := rule.Conditions[resource_access.ConditionExpressionKey]
+	if !exists || (rule.Category != "validation" && rule.Category != "workflow") {
+		return nil
+	}
+
+	source, ok := expression.(string)
+	if !ok {
+		return &RuleViolation{
+			RuleID:   rule.ID,
+			Priority: rule.Priority,
+			Message:  fmt.Sprintf("Invalid condition expression: %v", expression),
+			Category: rule.Category,
+		}
+	}
+
+	matched, err := evaluateConditionExpression(source, context)
+	if err != nil {
+		return &RuleViolation{
+			RuleID:   rule.ID,
+			Priority: rule.Priority,
+			Message:  fmt.Sprintf("Invalid condition expression: %v", err),
+			Category: rule.Category,
+			Details:  source,
+		}
+	}
+	if !matched {
+		return nil
+	}
+
+	message, _ := rule.Actions["message"].(string)
+	if message == "" {
+		message = fmt.Sprintf("Rule '%s' condition is met", rule.Name)
+	}
+	return &RuleViolation{
+		RuleID:   rule.ID,
+		Priority: rule.Priority,
+		Message:  message,
+		Category: rule.Category,
+		Details:  fmt.Sprintf("Condition: %s", source),
+	}
+}
+
+// triggeredActions returns the automation rules with actions and the notification rules whose condition
+// expression holds, higher priority first; rules whose expression fails to evaluate are skipped
+func (re *RuleEngine) triggeredActions(rules []resource_access.Rule, context *EnrichedContext) []TriggeredAction {
+	var triggered []TriggeredAction
+	for _, rule := range rules {
+		source, ok := rule.Conditions[resource_access.ConditionExpressionKey].(string)
+		if !ok {
+			continue
+		}
+		if rule.Category != "notification" && (rule.Category != "automation" || !resource_access.HasRuleActions(rule.Actions)) {
+			continue
+		}
+
+		matched, err := evaluateConditionExpression(source, context)
+		if err != nil {
+			re.logger.LogMessage(utilities.Warning, "RuleEngine", fmt.Sprintf("Skipping %s rule %s, invalid condition expression: %v", rule.Category, rule.ID, err))
+			continue
+		}
+		if !matched {
+			continue
+		}
+		if rule.Category == "notification" {
+			triggered = append(triggered, re.evaluateNotificationRule(rule, context))
+			continue
+		}
+		triggered = append(triggered, TriggeredAction{RuleID: rule.ID, RuleName: rule.Name, Category: rule.Category, Priority: rule.Priority, Actions: rule.Actions})
+	}
+
+	sort.SliceStable(triggered, func(i, j int) bool {
+		return triggered[i].Priority > triggered[j].Priority
+	})
+	return triggered
+}
+
+// entersColumn reports whether a task change creates a task or moves it to another column
+func entersColumn(event TaskEvent) bool {
+	if event.FutureState == nil {
+		return false
+	}
+	return event.CurrentState == nil || event.CurrentState.Status.Column != event.FutureState.Status.Column
+}
+
+// evaluateColumnWIPLimitOnly evaluates a task change no rule applies to, reading only the WIP limit of the column
+// the task enters instead of the full board context
+func (re *RuleEngine) evaluateColumnWIPLimitOnly(event TaskEvent) (*RuleEvaluationResult, error) {
+	if !entersColumn(event) {
+		re.logger.LogMessage(utilities.Debug, "RuleEngine", "No applicable rules found, allowing task change")
+		return &RuleEvaluationResult{Allowed: true}, nil
+	}
+
+	column := event.FutureState.Status.Column
+	wipData, err := re.boardAccess.GetColumnWIPData(column)
+	if err != nil {
+		return nil, fmt.Errorf("RuleEngine.EvaluateTaskChange failed to get WIP data of column %s: %w", column, err)
+	}
+
+	context := &EnrichedContext{
+		Event:          event,
+		WIPCounts:      map[string]int{column: wipData.WIPCount},
+		ColumnSettings: map[string]board_access.ColumnSettings{column: wipData.Settings},
+	}
+	violation := re.evaluateColumnWIPLimit(context)
+	if violation == nil {
+		re.logger.LogMessage(utilities.Debug, "RuleEngine", "No applicable rules found, allowing task change")
+		return &RuleEvaluationResult{Allowed: true}, nil
+	}
+	return &RuleEvaluationResult{Allowed: false, Violations: []RuleViolation{*violation}}, nil
+}
+
+// evaluateColumnWIPLimit checks the WIP limit configured in board.json for the column a top-level task enters;
+// done columns hold finished work and are not limited
+func (re *RuleEngine) evaluateColumnWIPLimit(context *EnrichedContext) *RuleViolation {
+	if !entersColumn(context.Event) {
+		return nil
+	}
+	future := context.Event.FutureState
+	if future.Task != nil && future.Task.ParentTaskID != nil {
+		return nil
+	}
+
+	column := future.Status.Column
+	settings := context.ColumnSettings[column]
+	if settings.WIPLimit <= 0 || settings.Done {
+		return nil
+	}
+
+	currentWIP := context.WIPCounts[column]
+	if currentWIP < settings.WIPLimit {
+		return nil
+	}
+	return &RuleViolation{
+		RuleID:   columnWIPLimitRuleID,
+		Priority: columnWIPLimitPriority,
+		Message:  fmt.Sprintf("WIP limit exceeded: column '%s' has %d tasks, limit is %d", column, currentWIP, settings.WIPLimit),
+		Category: "validation",
+		Details:  fmt.Sprintf("Current WIP: %d, Limit: %d", currentWIP, settings.WIPLimit),
+	}
+}
+
 // evaluateValidationRule evaluates validation rules (e.g., required fields, WIP limits)
 func (re *RuleEngine) evaluateValidationRule(rule resource_access.Rule, context *EnrichedContext) *RuleViolation {
 	// WIP Limit Rule for top-level tasks
@@ -519,11 +700,20 @@ func (re *RuleEngine) evaluateAutomationRule(rule resource_access.Rule, context
 	return nil // No violation
 }
 
-// evaluateNotificationRule evaluates notification rules (currently placeholder)
-func (re *RuleEngine) evaluateNotificationRule(rule resource_access.Rule, context *EnrichedContext) *RuleViolation {
-	// Notification rules don't typically block actions, they trigger notifications
-	// For now, we'll treat them as non-blocking
-	return nil
+// evaluateNotificationRule returns the notification of a rule whose condition holds for a task change, with
+// the message of the rule or a message naming the rule and the task
+func (re *RuleEngine) evaluateNotificationRule(rule resource_access.Rule, context *EnrichedContext) TriggeredAction {
+	message, _ := rule.Actions["message"].(string)
+	if message == "" {
+		title := ""
+		if context.Event.FutureState != nil && context.Event.FutureState.Task != nil {
+			title = context.Event.FutureState.Task.Title
+		} else if context.Event.CurrentState != nil && context.Event.CurrentState.Task != nil {
+			title = context.Event.CurrentState.Task.Title
+		}
+		message = fmt.Sprintf("Rule '%s' triggered for task '%s'", rule.Name, title)
+	}
+	return TriggeredAction{RuleID: rule.ID, RuleName: rule.Name, Category: rule.Category, Priority: rule.Priority, Actions: rule.Actions, Message: message}
 }
 
 // Helper methods
@@ -41,7 +41,9 @@ type mockBoardAccess struct {
 	tasks       []*board_access.TaskWithTimestamps
 	history     []utilities.CommitInfo
 	config      *board_access.BoardConfiguration
+	snapshots   map[string]*board_access.BoardSnapshot // commit -> board, for history replays
 	err         error
+	rulesData   int // number of GetRulesData calls
 }
 
 func (m *mockBoardAccess) CreateTask(task *board_access.Task, priority board_access.Priority, status board_access.WorkflowStatus, parentTaskID *string) (string, error) {
@@ -72,6 +74,18 @@ func (m *mockBoardAccess) RemoveTask(taskID string, cascadePolicy board_access.C
 	return nil
 }
 
+func (m *mockBoardAccess) ListArchivedTasks() ([]*board_access.ArchivedTask, error) {
+	return []*board_access.ArchivedTask{}, nil
+}
+
+func (m *mockBoardAccess) RestoreTask(taskID string, cascadePolicy board_access.CascadePolicy) error {
+	return nil
+}
+
+func (m *mockBoardAccess) PurgeArchive(olderThan time.Duration) ([]string, error) {
+	return []string{}, nil
+}
+
 func (m *mockBoardAccess) FindTasks(criteria *board_access.QueryCriteria) ([]*board_access.TaskWithTimestamps, error) {
 	if m.err != nil {
 		return nil, m.err
@@ -79,11 +93,19 @@ func (m *mockBoardAccess) FindTasks(criteria *board_access.QueryCriteria) ([]*bo
 	return m.tasks, nil
 }
 
-func (m *mockBoardAccess) GetTaskHistory(taskID string, limit int) ([]utilities.CommitInfo, error) {
+func (m *mockBoardAccess) GetTaskHistory(taskID string, limit int) ([]board_access.TaskRevision, error) {
 	if m.err != nil {
 		return nil, m.err
 	}
-	return m.history, nil
+	revisions := make([]board_access.TaskRevision, len(m.history))
+	for i, commit := range m.history {
+		revisions[i] = board_access.TaskRevision{CommitInfo: commit, Operation: "updated"}
+	}
+	return revisions, nil
+}
+
+func (m *mockBoardAccess) GetTaskTimeline(taskID string) ([]board_access.TaskTimelineEntry, error) {
+	return []board_access.TaskTimelineEntry{}, m.err
 }
 
 func (m *mockBoardAccess) GetBoardConfiguration() (*board_access.BoardConfiguration, error) {
@@ -104,6 +126,7 @@ func (m *mockBoardAccess) GetRulesData(taskID string, targetColumns []string) (*
 	if m.err != nil {
 		return nil, m.err
 	}
+	m.rulesData++
 	
 	rulesData := &board_access.RulesData{
 		WIPCounts:        make(map[string]int),
@@ -146,6 +169,11 @@ func (m *mockBoardAccess) GetRulesData(taskID string, targetColumns []string) (*
 		}
 	}
 	
+	// Column settings from the mocked board.json
+	if m.config != nil {
+		rulesData.ColumnSettings = m.config.ColumnSettings
+	}
+
 	// Mock board metadata
 	if m.config != nil {
 		rulesData.BoardMetadata["board_name"] = m.config.Name
@@ -156,6 +184,22 @@ func (m *mockBoardAccess) GetRulesData(taskID string, targetColumns []string) (*
 	return rulesData, nil
 }
 
+func (m *mockBoardAccess) GetColumnWIPData(column string) (*board_access.ColumnWIPData, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	data := &board_access.ColumnWIPData{Column: column}
+	if m.config != nil {
+		data.Settings = m.config.ColumnSettings[column]
+	}
+	for _, task := range m.tasks {
+		if task.Status.Column == column && task.Task.ParentTaskID == nil {
+			data.WIPCount++
+		}
+	}
+	return data, nil
+}
+
 // Helper function for mock
 func containsString(slice []string, item string) bool {
 	for _, s := range slice {
@@ -170,6 +214,71 @@ func (m *mockBoardAccess) Close() error {
 	return nil
 }
 
+func (m *mockBoardAccess) WatchBoard(ctx context.Context) (<-chan board_access.BoardChange, error) {
+	changes := make(chan board_access.BoardChange)
+	close(changes)
+	return changes, nil
+}
+
+func (m *mockBoardAccess) LockStatus() board_access.BoardLockStatus {
+	return board_access.BoardLockStatus{}
+}
+
+func (m *mockBoardAccess) ListRemotes() ([]utilities.RemoteInfo, error) {
+	return []utilities.RemoteInfo{}, nil
+}
+
+func (m *mockBoardAccess) AddRemote(name, url string) error {
+	return nil
+}
+
+func (m *mockBoardAccess) RemoveRemote(name string) error {
+	return nil
+}
+
+func (m *mockBoardAccess) SyncBoard(remote string) (*board_access.SyncResult, error) {
+	return &board_access.SyncResult{Remote: remote, UpToDate: true}, nil
+}
+
+func (m *mockBoardAccess) CurrentRevision() (string, error) {
+	return "", nil
+}
+
+func (m *mockBoardAccess) RevertChanges(from, to, message string) (*board_access.RevertResult, error) {
+	return &board_access.RevertResult{Previous: to}, nil
+}
+
+func (m *mockBoardAccess) ListRevisions(limit int) ([]utilities.CommitInfo, error) {
+	return append([]utilities.CommitInfo{}, m.history...), m.err
+}
+
+func (m *mockBoardAccess) LoadBoardAt(point board_access.HistoryPoint) (*board_access.BoardSnapshot, error) {
+	if snapshot, found := m.snapshots[point.Commit]; found {
+		return snapshot, nil
+	}
+	return &board_access.BoardSnapshot{Revision: utilities.CommitInfo{ID: point.Commit}, Tasks: []*board_access.TaskWithTimestamps{}}, nil
+}
+
+func (m *mockBoardAccess) RestoreTaskFrom(commit, taskID string) (*board_access.RestoreResult, error) {
+	return &board_access.RestoreResult{Task: &board_access.TaskWithTimestamps{Task: &board_access.Task{ID: taskID}}}, nil
+}
+
+func (m *mockBoardAccess) Begin(description string) error {
+	return nil
+}
+
+func (m *mockBoardAccess) Commit() (string, error) {
+	return "", nil
+}
+
+func (m *mockBoardAccess) Rollback() error {
+	return nil
+}
+
+func (m *mockBoardAccess) Annotate(note string, trailers ...utilities.CommitTrailer) error {
+	return nil
+}
+
 // GetSubtasks retrieves all subtasks for a given parent task
 func (m *mockBoardAccess) GetSubtasks(parentTaskID string) ([]*board_access.TaskWithTimestamps, error) {
 	if m.err != nil {
@@ -250,6 +359,10 @@ func (m *mockBoardAccess) ExtractMetadata(ctx context.Context, boardPath string)
 	}, nil
 }
 
+func (m *mockBoardAccess) GetFlowMetrics(ctx context.Context, boardPath string, dateRange *board_access.DateRange) (*board_access.FlowMetrics, error) {
+	return &board_access.FlowMetrics{}, nil
+}
+
 func (m *mockBoardAccess) GetStatistics(ctx context.Context, boardPath string) (*board_access.BoardStatistics, error) {
 	return &board_access.BoardStatistics{
 		TotalTasks:    len(m.tasks),
@@ -267,6 +380,11 @@ func (m *mockBoardAccess) ValidateStructure(ctx context.Context, boardPath strin
 	}, nil
 }
 
+func (m *mockBoardAccess) RepairBoard(ctx context.Context) (*board_access.BoardRepairResult, error) {
+	validation, _ := m.ValidateStructure(ctx, "")
+	return &board_access.BoardRepairResult{Validation: validation}, nil
+}
+
 func (m *mockBoardAccess) LoadConfiguration(ctx context.Context, boardPath string, configType string) (map[string]interface{}, error) {
 	return map[string]interface{}{
 		"name":    "Mock Board",
@@ -464,6 +582,78 @@ func TestEvaluateTaskChange_WIPLimit(t *testing.T) {
 	}
 }
 
+func TestUnit_RuleEngine_EvaluateTaskChange_ColumnWIPLimit(t *testing.T) {
+	// Column WIP limits from board.json apply without rules
+	rulesAccess := &mockRulesAccess{
+		ruleSet: &resource_access.RuleSet{Version: "1.0"},
+	}
+	boardAccess := &mockBoardAccess{
+		tasks: []*board_access.TaskWithTimestamps{
+			createMockTask("task1", "Existing Task 1", "doing"),
+			createMockTask("task2", "Existing Task 2", "doing"),
+			createMockTask("task3", "Finished Task", "done"),
+		},
+		config: &board_access.BoardConfiguration{
+			Name:    "Test Board",
+			Columns: []string{"todo", "doing", "done"},
+			ColumnSettings: map[string]board_access.ColumnSettings{
+				"doing": {WIPLimit: 2},
+				"done":  {WIPLimit: 1, Done: true},
+			},
+		},
+	}
+
+	engine, err := NewRuleEngine(rulesAccess, boardAccess)
+	if err != nil {
+		t.Fatalf("NewRuleEngine() error = %v", err)
+	}
+
+	move := func(taskID, from, to string, parentTaskID *string) *RuleEvaluationResult {
+		event := TaskEvent{
+			EventType: "task_transition",
+			FutureState: &TaskState{
+				Task:   &board_access.Task{ID: taskID, Title: "Moving Task", ParentTaskID: parentTaskID},
+				Status: board_access.WorkflowStatus{Column: to},
+			},
+			Timestamp: time.Now(),
+		}
+		if from != "" {
+			event.CurrentState = createMockTask(taskID, "Moving Task", from)
+		}
+		result, err := engine.EvaluateTaskChange(context.Background(), event, "/test/board")
+		if err != nil {
+			t.Fatalf("EvaluateTaskChange() error = %v", err)
+		}
+		return result
+	}
+
+	result := move("task4", "todo", "doing", nil)
+	if result.Allowed || len(result.Violations) != 1 || result.Violations[0].RuleID != columnWIPLimitRuleID {
+		t.Errorf("Expected the column WIP limit to block the move, got %+v", result)
+	}
+	if result := move("task5", "", "doing", nil); result.Allowed {
+		t.Error("Expected the column WIP limit to block creating a task in the column")
+	}
+
+	// Tasks already in the column, subtasks and done columns are not limited
+	parentID := "task1"
+	for name, result := range map[string]*RuleEvaluationResult{
+		"update in column": move("task1", "doing", "doing", nil),
+		"subtask":          move("task6", "todo", "doing", &parentID),
+		"done column":      move("task2", "doing", "done", nil),
+		"unlimited column": move("task1", "doing", "todo", nil),
+	} {
+		if !result.Allowed {
+			t.Errorf("Expected %s to be allowed, got %+v", name, result.Violations)
+		}
+	}
+
+	// Without rules the full context is never gathered
+	if boardAccess.rulesData != 0 {
+		t.Errorf("Expected no rules data to be gathered without rules, got %d calls", boardAccess.rulesData)
+	}
+}
+
 func TestEvaluateTaskChange_RequiredFields(t *testing.T) {
 	rulesAccess := &mockRulesAccess{
 		ruleSet: &resource_access.RuleSet{
@@ -1167,4 +1357,80 @@ func TestUnit_RuleEngine_EvaluateBoardConfigurationChange_MultipleViolations(t *
 	if len(result.Violations) < 2 {
 		t.Errorf("EvaluateBoardConfigurationChange() violations = %d, want at least 2", len(result.Violations))
 	}
+}
+
+func TestUnit_RuleEngine_EvaluateTaskChange_AutomationActions(t *testing.T) {
+	automation := func(id string, priority int, expression string, actions map[string]interface{}) resource_access.Rule {
+		return resource_access.Rule{
+			ID:          id,
+			Name:        "Rule " + id,
+			Category:    "automation",
+			TriggerType: "task_transition",
+			Conditions:  map[string]interface{}{"expression": expression},
+			Actions:     actions,
+			Priority:    priority,
+			Enabled:     true,
+		}
+	}
+	rulesAccess := &mockRulesAccess{
+		ruleSet: &resource_access.RuleSet{
+			Version: "1.0",
+			Rules: []resource_access.Rule{
+				automation("tag-done", 10, `task.column == "done"`, map[string]interface{}{"add_tags": []interface{}{"finished"}}),
+				automation("follow-up", 50, `task.column == "done" && "review" in task.tags`, map[string]interface{}{"create_subtasks": []interface{}{"Write retrospective"}}),
+				automation("not-matching", 90, `task.column == "todo"`, map[string]interface{}{"move_to": "doing"}),
+				automation("invalid", 90, `task.title > 3`, map[string]interface{}{"move_to": "doing"}),
+				automation("no-actions", 90, `true`, map[string]interface{}{"message": "Nothing to apply"}),
+			},
+		},
+	}
+	boardAccess := &mockBoardAccess{}
+
+	engine, err := NewRuleEngine(rulesAccess, boardAccess)
+	if err != nil {
+		t.Fatalf("NewRuleEngine() error = %v", err)
+	}
+
+	event := TaskEvent{
+		EventType:    "task_transition",
+		CurrentState: createMockTask("task1", "Release", "doing"),
+		FutureState: &TaskState{
+			Task:   &board_access.Task{ID: "task1", Title: "Release", Tags: []string{"review"}},
+			Status: board_access.WorkflowStatus{Column: "done"},
+		},
+		Timestamp: time.Now(),
+	}
+	result, err := engine.EvaluateTaskChange(context.Background(), event, "/test/board")
+	if err != nil {
+		t.Fatalf("EvaluateTaskChange() error = %v", err)
+	}
+
+	// Automation rules don't block, matching rules are returned by priority
+	if !result.Allowed || len(result.Violations) != 0 {
+		t.Fatalf("Expected automation rules not to block, got %+v", result.Violations)
+	}
+	if len(result.Actions) != 2 || result.Actions[0].RuleID != "follow-up" || result.Actions[1].RuleID != "tag-done" {
+		t.Fatalf("Expected follow-up and tag-done to trigger, got %+v", result.Actions)
+	}
+	if result.Actions[1].RuleName != "Rule tag-done" || result.Actions[1].Actions["add_tags"] == nil {
+		t.Errorf("Unexpected triggered action %+v", result.Actions[1])
+	}
+
+	// Rejected changes trigger nothing
+	rulesAccess.ruleSet.Rules = append(rulesAccess.ruleSet.Rules, resource_access.Rule{
+		ID:          "no-done",
+		Name:        "No Done",
+		Category:    "validation",
+		TriggerType: "task_transition",
+		Conditions:  map[string]interface{}{"expression": `task.column == "done"`},
+		Actions:     map[string]interface{}{"message": "Not yet"},
+		Enabled:     true,
+	})
+	result, err = engine.EvaluateTaskChange(context.Background(), event, "/test/board")
+	if err != nil {
+		t.Fatalf("EvaluateTaskChange() error = %v", err)
+	}
+	if result.Allowed || len(result.Actions) != 0 {
+		t.Errorf("Expected a rejected change without actions, got %+v", result)
+	}
 }
\ No newline at end of file
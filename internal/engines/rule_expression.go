@@ -0,0 +1,546 @@
+// Package engines provides Engine layer components implementing the iDesign methodology.
+// This file implements the evaluation of rule condition expressions against the enriched context.
+package engines
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/rknuus/eisenkan/internal/resource_access"
+	"github.com/rknuus/eisenkan/internal/resource_access/board_access"
+)
+
+// expressionObject is a value with a fixed set of fields; accessing another field is an error, unlike
+// accessing a missing key of free-form data such as the board metadata, which yields null
+type expressionObject map[string]interface{}
+
+// expressionEvaluator evaluates a parsed condition expression; values are float64, string, bool, nil,
+// time.Time, []interface{}, map[string]interface{} and expressionObject
+type expressionEvaluator struct {
+	source    string
+	context   *EnrichedContext
+	now       time.Time
+	variables map[string]interface{}
+}
+
+// evaluateConditionExpression parses and evaluates a condition expression and reports whether it holds
+func evaluateConditionExpression(source string, context *EnrichedContext) (bool, error) {
+	expression, err := resource_access.ParseRuleExpression(source)
+	if err != nil {
+		return false, err
+	}
+
+	evaluator := newExpressionEvaluator(source, context)
+	value, err := evaluator.evaluate(expression.Root)
+	if err != nil {
+		return false, err
+	}
+	return evaluator.truth(expression.Root, value)
+}
+
+// newExpressionEvaluator binds the expression variables to the enriched context
+func newExpressionEvaluator(source string, context *EnrichedContext) *expressionEvaluator {
+	event := context.Event
+	now := event.Timestamp
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	var task, previous interface{}
+	if event.FutureState != nil {
+		task = taskValue(event.FutureState.Task, event.FutureState.Priority, event.FutureState.Status, nil)
+	}
+	if event.CurrentState != nil {
+		previous = taskValue(event.CurrentState.Task, event.CurrentState.Priority, event.CurrentState.Status, event.CurrentState)
+	}
+
+	board := make(map[string]interface{}, len(context.BoardMetadata))
+	for key, value := range context.BoardMetadata {
+		board[key] = value
+	}
+
+	return &expressionEvaluator{
+		source:  source,
+		context: context,
+		now:     now,
+		variables: map[string]interface{}{
+			"task":     task,
+			"previous": previous,
+			"event":    expressionObject{"type": event.EventType, "timestamp": event.Timestamp},
+			"subtasks": taskListValue(context.Subtasks),
+			"board":    board,
+			"now":      now,
+		},
+	}
+}
+
+// taskValue converts a task into an expression object; timestamps are only known for stored tasks
+func taskValue(task *board_access.Task, priority board_access.Priority, status board_access.WorkflowStatus, stored *board_access.TaskWithTimestamps) expressionObject {
+	value := expressionObject{
+		"id":          "",
+		"title":       "",
+		"description": "",
+		"tags":        []interface{}{},
+		"metadata":    map[string]interface{}{},
+		"due_date":    nil,
+		"parent_id":   nil,
+		"is_subtask":  false,
+		"priority":    priorityLabel(priority),
+		"urgent":      priority.Urgent,
+		"important":   priority.Important,
+		"column":      status.Column,
+		"section":     status.Section,
+		"position":    float64(status.Position),
+		"created_at":  nil,
+		"updated_at":  nil,
+	}
+	if task != nil {
+		value["id"] = task.ID
+		value["title"] = task.Title
+		value["description"] = task.Description
+		tags := make([]interface{}, 0, len(task.Tags))
+		for _, tag := range task.Tags {
+			tags = append(tags, tag)
+		}
+		value["tags"] = tags
+		metadata := make(map[string]interface{}, len(task.Metadata))
+		for key, entry := range task.Metadata {
+			metadata[key] = entry
+		}
+		value["metadata"] = metadata
+		if task.DueDate != nil {
+			value["due_date"] = *task.DueDate
+		}
+		if task.ParentTaskID != nil {
+			value["parent_id"] = *task.ParentTaskID
+			value["is_subtask"] = true
+		}
+	}
+	if stored != nil {
+		value["created_at"] = stored.CreatedAt
+		value["updated_at"] = stored.UpdatedAt
+	}
+	return value
+}
+
+// taskListValue converts stored tasks into a list of expression objects
+func taskListValue(tasks []*board_access.TaskWithTimestamps) []interface{} {
+	list := make([]interface{}, 0, len(tasks))
+	for _, task := range tasks {
+		if task != nil {
+			list = append(list, taskValue(task.Task, task.Priority, task.Status, task))
+		}
+	}
+	return list
+}
+
+// priorityLabel returns the label of a priority, deriving it from the flags when it is not set
+func priorityLabel(priority board_access.Priority) string {
+	if priority.Label != "" {
+		return priority.Label
+	}
+	urgent, important := "not-urgent", "not-important"
+	if priority.Urgent {
+		urgent = "urgent"
+	}
+	if priority.Important {
+		important = "important"
+	}
+	return urgent + "-" + important
+}
+
+// errorAt reports an evaluation error at the position of a node
+func (e *expressionEvaluator) errorAt(node resource_access.ExpressionNode, format string, args ...interface{}) error {
+	return resource_access.NewRuleExpressionError(e.source, node.Offset(), format, args...)
+}
+
+// truth converts a value used as a condition; null is false
+func (e *expressionEvaluator) truth(node resource_access.ExpressionNode, value interface{}) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case nil:
+		return false, nil
+	}
+	return false, e.errorAt(node, "expected a boolean but got %s", describeValue(value))
+}
+
+func (e *expressionEvaluator) evaluate(node resource_access.ExpressionNode) (interface{}, error) {
+	switch n := node.(type) {
+	case *resource_access.LiteralNode:
+		return n.Value, nil
+
+	case *resource_access.IdentifierNode:
+		return e.variables[n.Name], nil
+
+	case *resource_access.ListNode:
+		list := make([]interface{}, 0, len(n.Elements))
+		for _, element := range n.Elements {
+			value, err := e.evaluate(element)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, value)
+		}
+		return list, nil
+
+	case *resource_access.MemberNode:
+		object, err := e.evaluate(n.Object)
+		if err != nil {
+			return nil, err
+		}
+		return e.member(n, object, n.Name)
+
+	case *resource_access.IndexNode:
+		return e.index(n)
+
+	case *resource_access.CallNode:
+		return e.call(n)
+
+	case *resource_access.UnaryNode:
+		operand, err := e.evaluate(n.Operand)
+		if err != nil {
+			return nil, err
+		}
+		if n.Operator == "!" {
+			truth, err := e.truth(n.Operand, operand)
+			return !truth, err
+		}
+		switch v := operand.(type) {
+		case float64:
+			return -v, nil
+		case nil:
+			return nil, nil
+		}
+		return nil, e.errorAt(n, "cannot negate %s", describeValue(operand))
+
+	case *resource_access.BinaryNode:
+		return e.binary(n)
+	}
+	return nil, e.errorAt(node, "unsupported expression")
+}
+
+// member accesses a field; fields of null are null
+func (e *expressionEvaluator) member(node resource_access.ExpressionNode, object interface{}, name string) (interface{}, error) {
+	switch v := object.(type) {
+	case nil:
+		return nil, nil
+	case expressionObject:
+		value, exists := v[name]
+		if !exists {
+			return nil, e.errorAt(node, "unknown field %q", name)
+		}
+		return value, nil
+	case map[string]interface{}:
+		return v[name], nil
+	}
+	return nil, e.errorAt(node, "cannot access field %q of %s", name, describeValue(object))
+}
+
+// index accesses a list element, null when out of range, or a field by name
+func (e *expressionEvaluator) index(node *resource_access.IndexNode) (interface{}, error) {
+	object, err := e.evaluate(node.Object)
+	if err != nil {
+		return nil, err
+	}
+	index, err := e.evaluate(node.Index)
+	if err != nil {
+		return nil, err
+	}
+
+	if list, ok := object.([]interface{}); ok {
+		position, ok := index.(float64)
+		if !ok || position != math.Trunc(position) {
+			return nil, e.errorAt(node.Index, "expected a whole number as list index but got %s", describeValue(index))
+		}
+		if position < 0 || int(position) >= len(list) {
+			return nil, nil
+		}
+		return list[int(position)], nil
+	}
+	if name, ok := index.(string); ok {
+		return e.member(node, object, name)
+	}
+	if object == nil {
+		return nil, nil
+	}
+	return nil, e.errorAt(node, "cannot index %s with %s", describeValue(object), describeValue(index))
+}
+
+// call evaluates a function call; the parser has checked the number of arguments
+func (e *expressionEvaluator) call(node *resource_access.CallNode) (interface{}, error) {
+	argument, err := e.evaluate(node.Arguments[0])
+	if err != nil {
+		return nil, err
+	}
+
+	switch node.Function {
+	case "column":
+		column, ok := argument.(string)
+		if !ok {
+			return nil, e.errorAt(node.Arguments[0], "expected a column ID but got %s", describeValue(argument))
+		}
+		settings := e.context.ColumnSettings[column]
+		return expressionObject{
+			"wip":         float64(e.context.WIPCounts[column]),
+			"subtask_wip": float64(e.context.SubtaskWIPCounts[column]),
+			"wip_limit":   float64(settings.WIPLimit),
+			"done":        settings.Done,
+			"tasks":       taskListValue(e.context.ColumnTasks[column]),
+		}, nil
+
+	case "days_in":
+		column, ok := argument.(string)
+		if !ok {
+			return nil, e.errorAt(node.Arguments[0], "expected a column ID but got %s", describeValue(argument))
+		}
+		entered, exists := e.context.ColumnEnterTimes[column]
+		if !exists || entered.IsZero() {
+			return nil, nil
+		}
+		return e.now.Sub(entered).Hours() / 24, nil
+
+	case "days_since", "days_until":
+		if argument == nil {
+			return nil, nil
+		}
+		moment, err := e.time(node.Arguments[0], argument)
+		if err != nil {
+			return nil, err
+		}
+		days := e.now.Sub(moment).Hours() / 24
+		if node.Function == "days_until" {
+			days = -days
+		}
+		return days, nil
+
+	case "len":
+		switch v := argument.(type) {
+		case nil:
+			return float64(0), nil
+		case string:
+			return float64(utf8.RuneCountInString(v)), nil
+		case []interface{}:
+			return float64(len(v)), nil
+		case map[string]interface{}:
+			return float64(len(v)), nil
+		}
+		return nil, e.errorAt(node.Arguments[0], "cannot take the length of %s", describeValue(argument))
+	}
+	return nil, e.errorAt(node, "unknown function %q", node.Function)
+}
+
+// time converts a time or an RFC 3339 string argument
+func (e *expressionEvaluator) time(node resource_access.ExpressionNode, value interface{}) (time.Time, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, nil
+	case string:
+		if moment, err := time.Parse(time.RFC3339, v); err == nil {
+			return moment, nil
+		}
+		if moment, err := time.Parse("2006-01-02", v); err == nil {
+			return moment, nil
+		}
+	}
+	return time.Time{}, e.errorAt(node, "expected a time but got %s", describeValue(value))
+}
+
+func (e *expressionEvaluator) binary(node *resource_access.BinaryNode) (interface{}, error) {
+	left, err := e.evaluate(node.Left)
+	if err != nil {
+		return nil, err
+	}
+
+	// && and || only evaluate their right operand when it decides the result
+	if node.Operator == "&&" || node.Operator == "||" {
+		truth, err := e.truth(node.Left, left)
+		if err != nil {
+			return nil, err
+		}
+		if truth == (node.Operator == "||") {
+			return truth, nil
+		}
+		right, err := e.evaluate(node.Right)
+		if err != nil {
+			return nil, err
+		}
+		return e.truth(node.Right, right)
+	}
+
+	right, err := e.evaluate(node.Right)
+	if err != nil {
+		return nil, err
+	}
+
+	switch node.Operator {
+	case "==":
+		return expressionValuesEqual(left, right), nil
+	case "!=":
+		return !expressionValuesEqual(left, right), nil
+	case "<", "<=", ">", ">=":
+		return e.compare(node, left, right)
+	case "in":
+		return e.contains(node, left, right)
+	}
+	return e.arithmetic(node, left, right)
+}
+
+// compare orders numbers, strings and times; comparisons with null are false
+func (e *expressionEvaluator) compare(node *resource_access.BinaryNode, left, right interface{}) (interface{}, error) {
+	if left == nil || right == nil {
+		return false, nil
+	}
+
+	var order int
+	switch l := left.(type) {
+	case float64:
+		r, ok := right.(float64)
+		if !ok {
+			return nil, e.errorAt(node, "cannot compare %s with %s", describeValue(left), describeValue(right))
+		}
+		order = compareOrdered(l, r)
+	case string:
+		r, ok := right.(string)
+		if !ok {
+			if _, isTime := right.(time.Time); !isTime {
+				return nil, e.errorAt(node, "cannot compare %s with %s", describeValue(left), describeValue(right))
+			}
+			moment, err := e.time(node.Left, left)
+			if err != nil {
+				return nil, err
+			}
+			order = compareTimes(moment, right.(time.Time))
+		} else {
+			order = strings.Compare(l, r)
+		}
+	case time.Time:
+		r, err := e.time(node.Right, right)
+		if err != nil {
+			return nil, err
+		}
+		order = compareTimes(l, r)
+	default:
+		return nil, e.errorAt(node, "cannot compare %s with %s", describeValue(left), describeValue(right))
+	}
+
+	switch node.Operator {
+	case "<":
+		return order < 0, nil
+	case "<=":
+		return order <= 0, nil
+	case ">":
+		return order > 0, nil
+	}
+	return order >= 0, nil
+}
+
+// contains implements "in" for list elements, substrings and object fields; nothing is in null
+func (e *expressionEvaluator) contains(node *resource_access.BinaryNode, left, right interface{}) (interface{}, error) {
+	switch r := right.(type) {
+	case nil:
+		return false, nil
+	case []interface{}:
+		for _, element := range r {
+			if expressionValuesEqual(left, element) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case string:
+		if l, ok := left.(string); ok {
+			return strings.Contains(r, l), nil
+		}
+	case map[string]interface{}:
+		if l, ok := left.(string); ok {
+			_, exists := r[l]
+			return exists, nil
+		}
+	}
+	return nil, e.errorAt(node, "cannot look for %s in %s", describeValue(left), describeValue(right))
+}
+
+// arithmetic applies + - * / % to numbers, + also joins strings; null operands give null
+func (e *expressionEvaluator) arithmetic(node *resource_access.BinaryNode, left, right interface{}) (interface{}, error) {
+	if left == nil || right == nil {
+		return nil, nil
+	}
+	if l, ok := left.(string); ok && node.Operator == "+" {
+		if r, ok := right.(string); ok {
+			return l + r, nil
+		}
+	}
+
+	l, leftOK := left.(float64)
+	r, rightOK := right.(float64)
+	if !leftOK || !rightOK {
+		return nil, e.errorAt(node, "cannot apply %q to %s and %s", node.Operator, describeValue(left), describeValue(right))
+	}
+	switch node.Operator {
+	case "+":
+		return l + r, nil
+	case "-":
+		return l - r, nil
+	case "*":
+		return l * r, nil
+	}
+	if r == 0 {
+		return nil, e.errorAt(node, "division by zero")
+	}
+	if node.Operator == "/" {
+		return l / r, nil
+	}
+	return math.Mod(l, r), nil
+}
+
+// expressionValuesEqual compares values; times are equal when they denote the same instant
+func expressionValuesEqual(left, right interface{}) bool {
+	if l, ok := left.(time.Time); ok {
+		r, ok := right.(time.Time)
+		return ok && l.Equal(r)
+	}
+	return reflect.DeepEqual(left, right)
+}
+
+func compareOrdered(left, right float64) int {
+	switch {
+	case left < right:
+		return -1
+	case left > right:
+		return 1
+	}
+	return 0
+}
+
+func compareTimes(left, right time.Time) int {
+	switch {
+	case left.Before(right):
+		return -1
+	case left.After(right):
+		return 1
+	}
+	return 0
+}
+
+// describeValue names the type of a value for error messages
+func describeValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return fmt.Sprintf("boolean %t", v)
+	case float64:
+		return fmt.Sprintf("number %v", v)
+	case string:
+		return fmt.Sprintf("string %q", v)
+	case time.Time:
+		return fmt.Sprintf("time %s", v.Format(time.RFC3339))
+	case []interface{}:
+		return "a list"
+	}
+	return "an object"
+}
@@ -0,0 +1,180 @@
+package engines
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rknuus/eisenkan/internal/resource_access"
+	"github.com/rknuus/eisenkan/internal/resource_access/board_access"
+)
+
+func TestUnit_RuleEngine_EvaluateConditionExpression(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	due := now.Add(36 * time.Hour)
+	parentID := "task0"
+	stored := createMockTask("task1", "Write report", "todo")
+	stored.CreatedAt = now.Add(-72 * time.Hour)
+
+	enriched := &EnrichedContext{
+		Event: TaskEvent{
+			EventType:    "task_transition",
+			CurrentState: stored,
+			FutureState: &TaskState{
+				Task:     &board_access.Task{ID: "task1", Title: "Write report", Tags: []string{"blocked", "team"}, DueDate: &due, ParentTaskID: &parentID},
+				Priority: board_access.Priority{Urgent: true, Important: true},
+				Status:   board_access.WorkflowStatus{Column: "doing"},
+			},
+			Timestamp: now,
+		},
+		WIPCounts:        map[string]int{"doing": 3},
+		SubtaskWIPCounts: map[string]int{"doing": 1},
+		ColumnSettings:   map[string]board_access.ColumnSettings{"doing": {WIPLimit: 3}, "done": {Done: true}},
+		ColumnEnterTimes: map[string]time.Time{"todo": now.Add(-48 * time.Hour)},
+		ColumnTasks:      map[string][]*board_access.TaskWithTimestamps{"doing": {createMockTask("task2", "Review", "doing")}},
+		Subtasks:         []*board_access.TaskWithTimestamps{createMockTask("task3", "Draft", "done")},
+		BoardMetadata:    map[string]string{"team": "core"},
+	}
+
+	testCases := []struct {
+		expression string
+		expected   bool
+	}{
+		{`task.priority == "urgent-important" && column("doing").wip >= 3`, true},
+		{`column("doing").wip < column("doing").wip_limit`, false},
+		{`column("done").done && !column("doing").done && column("doing").subtask_wip == 1`, true},
+		{`len(column("doing").tasks) == 1 && column("doing").tasks[0].title == "Review"`, true},
+		{`previous.column == "todo" && task.column != previous.column && event.type == "task_transition"`, true},
+		{`"blocked" in task.tags && task.tags[1] == "team" && task.tags[5] == null`, true},
+		{`task.column in ["todo", "done"]`, false},
+		{`task.is_subtask && task.parent_id == "task0"`, true},
+		{`days_in("todo") >= 2 && days_in("doing") == null`, true},
+		{`days_until(task.due_date) == 1.5 && days_since(previous.created_at) == 3`, true},
+		{`days_until("2026-03-12") == 1.5`, true},
+		{`task.due_date < "2026-04-01T00:00:00Z" && task.due_date > now`, true},
+		{`board.team == "core" && board.missing == null && "team" in board`, true},
+		{`len(subtasks) == 1 && subtasks[0].column == "done" && len(task.title) == 12`, true},
+		{`(1 + 2) * 3 - 4 / 2 == 7 && 7 % 4 == 3 && -task.position == 0`, true},
+		{`task.title + "!" == "Write report!" && "report" in task.title`, true},
+		{`task.metadata.owner == null && (null < 3) == false`, true},
+		{`task.urgent || 1 / 0 == 1`, true},
+	}
+
+	for _, tc := range testCases {
+		matched, err := evaluateConditionExpression(tc.expression, enriched)
+		if err != nil {
+			t.Errorf("Failed to evaluate %q: %v", tc.expression, err)
+			continue
+		}
+		if matched != tc.expected {
+			t.Errorf("Expected %q to be %t", tc.expression, tc.expected)
+		}
+	}
+
+	// Without a stored task previous is null
+	created := *enriched
+	created.Event.CurrentState = nil
+	if matched, err := evaluateConditionExpression(`previous == null && previous.column == null`, &created); err != nil || !matched {
+		t.Errorf("Expected previous to be null on create, got %t (%v)", matched, err)
+	}
+
+	// Type errors are reported at the position of the operator
+	errorCases := map[string]string{
+		`task.title > 3`:              `line 1, column 12: cannot compare string "Write report" with number 3`,
+		`task.priorty == "x"`:         `line 1, column 5: unknown field "priorty"`,
+		`task.urgent && 1 / 0 == 1`:   `line 1, column 18: division by zero`,
+		`column("doing").wip`:         `line 1, column 16: expected a boolean but got number 3`,
+		`days_since("yesterday") > 1`: `line 1, column 12: expected a time but got string "yesterday"`,
+	}
+	for expression, message := range errorCases {
+		_, err := evaluateConditionExpression(expression, enriched)
+		var expressionErr *resource_access.RuleExpressionError
+		if !errors.As(err, &expressionErr) || err.Error() != message {
+			t.Errorf("Expected %q for %q, got %v", message, expression, err)
+		}
+	}
+}
+
+func TestUnit_RuleEngine_EvaluateTaskChange_ConditionExpression(t *testing.T) {
+	rulesAccess := &mockRulesAccess{
+		ruleSet: &resource_access.RuleSet{
+			Version: "1.0",
+			Rules: []resource_access.Rule{
+				{
+					ID:          "urgent-doing-limit",
+					Name:        "Urgent Work Limit",
+					Category:    "validation",
+					TriggerType: "task_transition",
+					Conditions:  map[string]interface{}{"expression": `task.priority == "urgent-important" && column("doing").wip >= 2`},
+					Actions:     map[string]interface{}{"message": "Finish urgent work first"},
+					Priority:    90,
+					Enabled:     true,
+				},
+				{
+					ID:          "notify-blocked",
+					Name:        "Blocked Task",
+					Category:    "notification",
+					TriggerType: "task_transition",
+					Conditions:  map[string]interface{}{"expression": `"blocked" in task.tags`},
+					Actions:     map[string]interface{}{"notify": true},
+					Enabled:     true,
+				},
+			},
+		},
+	}
+	boardAccess := &mockBoardAccess{
+		tasks: []*board_access.TaskWithTimestamps{
+			createMockTask("task1", "Existing Task 1", "doing"),
+			createMockTask("task2", "Existing Task 2", "doing"),
+		},
+	}
+
+	engine, err := NewRuleEngine(rulesAccess, boardAccess)
+	if err != nil {
+		t.Fatalf("NewRuleEngine() error = %v", err)
+	}
+
+	move := func(priority board_access.Priority) *RuleEvaluationResult {
+		event := TaskEvent{
+			EventType:    "task_transition",
+			CurrentState: createMockTask("task3", "Moving Task", "todo"),
+			FutureState: &TaskState{
+				Task:     &board_access.Task{ID: "task3", Title: "Moving Task", Tags: []string{"blocked"}},
+				Priority: priority,
+				Status:   board_access.WorkflowStatus{Column: "doing"},
+			},
+			Timestamp: time.Now(),
+		}
+		result, err := engine.EvaluateTaskChange(context.Background(), event, "/test/board")
+		if err != nil {
+			t.Fatalf("EvaluateTaskChange() error = %v", err)
+		}
+		return result
+	}
+
+	result := move(board_access.Priority{Urgent: true, Important: true, Label: "urgent-important"})
+	if result.Allowed || len(result.Violations) != 1 {
+		t.Fatalf("Expected the condition expression to block the move, got %+v", result)
+	}
+	if violation := result.Violations[0]; violation.RuleID != "urgent-doing-limit" || violation.Message != "Finish urgent work first" || violation.Priority != 90 {
+		t.Errorf("Unexpected violation %+v", violation)
+	}
+
+	// Notification rules don't block but are triggered, and a false expression is no violation
+	result = move(board_access.Priority{Important: true, Label: "not-urgent-important"})
+	if !result.Allowed {
+		t.Errorf("Expected the move to be allowed, got %+v", result.Violations)
+	}
+	if len(result.Actions) != 1 || result.Actions[0].RuleID != "notify-blocked" || result.Actions[0].Category != "notification" ||
+		result.Actions[0].Message != "Rule 'Blocked Task' triggered for task 'Moving Task'" {
+		t.Errorf("Expected the notification of the blocked task, got %+v", result.Actions)
+	}
+
+	// Expressions that fail to evaluate are reported as violations
+	rulesAccess.ruleSet.Rules[0].Conditions["expression"] = `task.title > 3`
+	result = move(board_access.Priority{Urgent: true, Important: true})
+	if result.Allowed || len(result.Violations) != 1 || result.Violations[0].Message != `Invalid condition expression: line 1, column 12: cannot compare string "Moving Task" with number 3` {
+		t.Errorf("Expected an invalid expression violation, got %+v", result.Violations)
+	}
+}
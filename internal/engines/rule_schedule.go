@@ -0,0 +1,178 @@
+// Package engines provides Engine layer components implementing the iDesign methodology.
+// This file implements the evaluation of time-triggered rules across all tasks of a board.
+package engines
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/rknuus/eisenkan/internal/resource_access"
+	"github.com/rknuus/eisenkan/internal/resource_access/board_access"
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// TimeTrigger is a time-triggered automation or notification rule that holds for a task at the time of
+// a scheduled evaluation
+type TimeTrigger struct {
+	RuleID      string                 `json:"rule_id"`
+	RuleName    string                 `json:"rule_name"`
+	Category    string                 `json:"category"`
+	TriggerType string                 `json:"trigger_type"`
+	Priority    int                    `json:"priority"`
+	TaskID      string                 `json:"task_id"`
+	Message     string                 `json:"message"`
+	Actions     map[string]interface{} `json:"actions,omitempty"`
+}
+
+// EvaluateTimeTriggers evaluates the enabled time-triggered rules against every active task at the given time.
+// Tasks in done columns are skipped unless a column age rule lists them, and a condition expression of a rule
+// has to hold as well; triggers are ordered by task, higher priority first.
+func (re *RuleEngine) EvaluateTimeTriggers(ctx context.Context, boardPath string, now time.Time) ([]TimeTrigger, error) {
+	ruleSet, err := re.rulesAccess.ReadRules(boardPath)
+	if err != nil {
+		return nil, fmt.Errorf("RuleEngine.EvaluateTimeTriggers failed to read rules: %w", err)
+	}
+
+	var rules []resource_access.Rule
+	for _, rule := range ruleSet.Rules {
+		if rule.Enabled && resource_access.IsTimeTrigger(rule.TriggerType) && (rule.Category == "automation" || rule.Category == "notification") {
+			rules = append(rules, rule)
+		}
+	}
+	if len(rules) == 0 {
+		re.logger.LogMessage(utilities.Debug, "RuleEngine", "No time-triggered rules found")
+		return nil, nil
+	}
+
+	config, err := re.boardAccess.GetBoardConfiguration()
+	if err != nil {
+		return nil, fmt.Errorf("RuleEngine.EvaluateTimeTriggers failed to read board configuration: %w", err)
+	}
+	tasks, err := re.boardAccess.FindTasks(&board_access.QueryCriteria{})
+	if err != nil {
+		return nil, fmt.Errorf("RuleEngine.EvaluateTimeTriggers failed to find tasks: %w", err)
+	}
+	sort.SliceStable(tasks, func(i, j int) bool {
+		return tasks[i].Task.ID < tasks[j].Task.ID
+	})
+
+	var triggers []TimeTrigger
+	for _, task := range tasks {
+		var enriched *EnrichedContext
+		var taskTriggers []TimeTrigger
+		for _, rule := range rules {
+			if !watchesColumn(rule, task.Status.Column, config.IsDoneColumn(task.Status.Column)) {
+				continue
+			}
+
+			// The column enter times of the task history are only read for rules that need them
+			if enriched == nil && (rule.TriggerType == resource_access.TriggerColumnAge || rule.Conditions[resource_access.ConditionExpressionKey] != nil) {
+				event := TaskEvent{
+					CurrentState: task,
+					FutureState:  &TaskState{Task: task.Task, Priority: task.Priority, Status: task.Status},
+					Timestamp:    now,
+				}
+				if enriched, err = re.enrichContext(ctx, event, boardPath); err != nil {
+					return nil, fmt.Errorf("RuleEngine.EvaluateTimeTriggers failed to enrich context of task %s: %w", task.Task.ID, err)
+				}
+			}
+
+			message, holds := re.evaluateTimeCondition(rule, task, enriched, now)
+			if !holds {
+				continue
+			}
+			if source, ok := rule.Conditions[resource_access.ConditionExpressionKey].(string); ok {
+				ruleContext := *enriched
+				ruleContext.Event.EventType = rule.TriggerType
+				matched, err := evaluateConditionExpression(source, &ruleContext)
+				if err != nil {
+					re.logger.LogMessage(utilities.Warning, "RuleEngine", fmt.Sprintf("Skipping time-triggered rule %s, invalid condition expression: %v", rule.ID, err))
+					continue
+				}
+				if !matched {
+					continue
+				}
+			}
+			if custom, ok := rule.Actions["message"].(string); ok && custom != "" {
+				message = custom
+			}
+
+			taskTriggers = append(taskTriggers, TimeTrigger{
+				RuleID:      rule.ID,
+				RuleName:    rule.Name,
+				Category:    rule.Category,
+				TriggerType: rule.TriggerType,
+				Priority:    rule.Priority,
+				TaskID:      task.Task.ID,
+				Message:     message,
+				Actions:     rule.Actions,
+			})
+		}
+		sort.SliceStable(taskTriggers, func(i, j int) bool {
+			return taskTriggers[i].Priority > taskTriggers[j].Priority
+		})
+		triggers = append(triggers, taskTriggers...)
+	}
+
+	re.logger.LogMessage(utilities.Info, "RuleEngine",
+		fmt.Sprintf("Time trigger evaluation completed: rules=%d, tasks=%d, triggers=%d", len(rules), len(tasks), len(triggers)))
+
+	return triggers, nil
+}
+
+// evaluateTimeCondition checks the condition given by the trigger type of a rule and describes it; enriched
+// holds the column enter times for column age rules
+func (re *RuleEngine) evaluateTimeCondition(rule resource_access.Rule, task *board_access.TaskWithTimestamps, enriched *EnrichedContext, now time.Time) (string, bool) {
+	switch rule.TriggerType {
+	case resource_access.TriggerDueDate:
+		if task.Task.DueDate == nil {
+			return "", false
+		}
+		days, _ := resource_access.RuleActionDays(rule.Conditions[resource_access.ConditionDueWithinDays])
+		due := *task.Task.DueDate
+		if due.After(now.AddDate(0, 0, days)) {
+			return "", false
+		}
+		if due.Before(now) {
+			return fmt.Sprintf("Task '%s' is overdue since %s", task.Task.Title, due.Format("2006-01-02")), true
+		}
+		return fmt.Sprintf("Task '%s' is due on %s", task.Task.Title, due.Format("2006-01-02")), true
+
+	case resource_access.TriggerPromotionDate:
+		if task.Task.PriorityPromotionDate == nil || task.Task.PriorityPromotionDate.After(now) {
+			return "", false
+		}
+		return fmt.Sprintf("Task '%s' reached its priority promotion date %s", task.Task.Title, task.Task.PriorityPromotionDate.Format("2006-01-02")), true
+
+	case resource_access.TriggerColumnAge:
+		maxAgeDays, _ := resource_access.RuleActionDays(rule.Conditions[resource_access.ConditionMaxAgeDays])
+		enteredAt, exists := enriched.ColumnEnterTimes[task.Status.Column]
+		if !exists || enteredAt.IsZero() {
+			enteredAt = task.CreatedAt
+		}
+		age := now.Sub(enteredAt)
+		if age <= time.Duration(maxAgeDays)*24*time.Hour {
+			return "", false
+		}
+		return fmt.Sprintf("Task '%s' has been in %s for %d days (limit: %d days)", task.Task.Title, task.Status.Column, int(age.Hours()/24), maxAgeDays), true
+	}
+	return "", false
+}
+
+// watchesColumn reports whether a time-triggered rule applies to tasks in a column: the columns listed by
+// a column age rule, otherwise all but the done columns
+func watchesColumn(rule resource_access.Rule, column string, done bool) bool {
+	if rule.TriggerType == resource_access.TriggerColumnAge {
+		if columns, ok := resource_access.RuleActionStrings(rule.Conditions[resource_access.ConditionColumns]); ok {
+			for _, watched := range columns {
+				if watched == column {
+					return true
+				}
+			}
+			return false
+		}
+	}
+	return !done
+}
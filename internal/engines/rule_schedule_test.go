@@ -0,0 +1,112 @@
+package engines
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/rknuus/eisenkan/internal/resource_access"
+	"github.com/rknuus/eisenkan/internal/resource_access/board_access"
+)
+
+func TestUnit_RuleEngine_EvaluateTimeTriggers(t *testing.T) {
+	now := time.Now().AddDate(0, 0, 10)
+	day := func(days int) *time.Time {
+		date := now.AddDate(0, 0, days)
+		return &date
+	}
+	timeRule := func(id, category, triggerType string, conditions, actions map[string]interface{}) resource_access.Rule {
+		return resource_access.Rule{ID: id, Name: "Rule " + id, Category: category, TriggerType: triggerType,
+			Conditions: conditions, Actions: actions, Priority: 10, Enabled: true}
+	}
+	rulesAccess := &mockRulesAccess{
+		ruleSet: &resource_access.RuleSet{
+			Version: "1.0",
+			Rules: []resource_access.Rule{
+				timeRule("due-soon", "notification", resource_access.TriggerDueDate,
+					map[string]interface{}{resource_access.ConditionDueWithinDays: 2}, map[string]interface{}{"notify": true}),
+				timeRule("promote", "notification", resource_access.TriggerPromotionDate,
+					map[string]interface{}{resource_access.ConditionExpressionKey: `!task.urgent`}, map[string]interface{}{"message": "Time to act"}),
+				timeRule("stale", "automation", resource_access.TriggerColumnAge,
+					map[string]interface{}{resource_access.ConditionMaxAgeDays: 7}, map[string]interface{}{resource_access.ActionAddTags: []interface{}{"stale"}}),
+				timeRule("archive", "automation", resource_access.TriggerColumnAge,
+					map[string]interface{}{resource_access.ConditionMaxAgeDays: 7, resource_access.ConditionColumns: []interface{}{"done"}},
+					map[string]interface{}{resource_access.ActionArchiveDoneAfterDays: 7}),
+				timeRule("change-triggered", "automation", "task_transition",
+					map[string]interface{}{resource_access.ConditionExpressionKey: `true`}, map[string]interface{}{resource_access.ActionAddTags: []interface{}{"x"}}),
+				timeRule("validation", "validation", resource_access.TriggerDueDate,
+					map[string]interface{}{}, map[string]interface{}{"message": "never evaluated"}),
+			},
+		},
+	}
+
+	overdue := createMockTask("a-overdue", "Overdue report", "todo")
+	overdue.Task.DueDate = day(-1)
+	dueSoon := createMockTask("b-due", "Upcoming review", "doing")
+	dueSoon.Task.DueDate = day(2)
+	dueLater := createMockTask("c-later", "Later task", "todo")
+	dueLater.Task.DueDate = day(3)
+	promoted := createMockTask("d-promote", "Promote me", "todo")
+	promoted.Task.PriorityPromotionDate = day(0)
+	finished := createMockTask("e-done", "Finished", "done")
+	finished.Task.DueDate = day(-5)
+
+	boardAccess := &mockBoardAccess{
+		// The mock enters tasks into their column an hour before the test, ten days before now
+		tasks:  []*board_access.TaskWithTimestamps{promoted, finished, dueLater, dueSoon, overdue},
+		config: &board_access.BoardConfiguration{Name: "Test Board", Columns: []string{"todo", "doing", "done"}},
+	}
+	engine, err := NewRuleEngine(rulesAccess, boardAccess)
+	if err != nil {
+		t.Fatalf("NewRuleEngine() error = %v", err)
+	}
+
+	triggers, err := engine.EvaluateTimeTriggers(context.Background(), "/test/board", now)
+	if err != nil {
+		t.Fatalf("EvaluateTimeTriggers() error = %v", err)
+	}
+
+	// Triggers are ordered by task; tasks in the done column are only seen by the rule listing it
+	var got []string
+	for _, trigger := range triggers {
+		got = append(got, trigger.TaskID+" "+trigger.RuleID)
+	}
+	expected := []string{
+		"a-overdue due-soon", "a-overdue stale",
+		"b-due due-soon", "b-due stale",
+		"c-later stale",
+		"d-promote promote", "d-promote stale",
+		"e-done archive",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Expected triggers %v, got %v", expected, got)
+	}
+
+	messages := map[string]string{}
+	for _, trigger := range triggers {
+		messages[trigger.TaskID+" "+trigger.RuleID] = trigger.Message
+	}
+	if message := messages["a-overdue due-soon"]; message != "Task 'Overdue report' is overdue since "+day(-1).Format("2006-01-02") {
+		t.Errorf("Unexpected overdue message %q", message)
+	}
+	if message := messages["b-due due-soon"]; message != "Task 'Upcoming review' is due on "+day(2).Format("2006-01-02") {
+		t.Errorf("Unexpected due message %q", message)
+	}
+	if message := messages["d-promote promote"]; message != "Time to act" {
+		t.Errorf("Expected the message of the rule, got %q", message)
+	}
+	if message := messages["c-later stale"]; message != "Task 'Later task' has been in todo for 10 days (limit: 7 days)" {
+		t.Errorf("Unexpected column age message %q", message)
+	}
+	if trigger := triggers[1]; trigger.Category != "automation" || trigger.TriggerType != resource_access.TriggerColumnAge || trigger.Actions[resource_access.ActionAddTags] == nil {
+		t.Errorf("Expected the automation trigger with its actions, got %+v", trigger)
+	}
+
+	// Without time-triggered rules the board is not read
+	rulesAccess.ruleSet = &resource_access.RuleSet{Version: "1.0"}
+	boardAccess.err = context.Canceled
+	if triggers, err := engine.EvaluateTimeTriggers(context.Background(), "/test/board", now); err != nil || len(triggers) != 0 {
+		t.Errorf("Expected no triggers without time-triggered rules, got %v (%v)", triggers, err)
+	}
+}
@@ -0,0 +1,274 @@
+// Package engines provides Engine layer components implementing the iDesign methodology.
+// This file implements simulating a candidate rule set against the current board and its history.
+package engines
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rknuus/eisenkan/internal/resource_access"
+	"github.com/rknuus/eisenkan/internal/resource_access/board_access"
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// SimulatedViolation is a violation of a simulated rule by a task on the current board or by a change replayed
+// from the board history
+type SimulatedViolation struct {
+	RuleViolation
+	TaskID     string    `json:"task_id"`
+	TaskTitle  string    `json:"task_title"`
+	EventType  string    `json:"event_type,omitempty"`  // task_create or task_transition; empty for the current board
+	FromColumn string    `json:"from_column,omitempty"` // column a replayed transition left
+	ToColumn   string    `json:"to_column"`
+	Commit     string    `json:"commit,omitempty"`      // revision of a replayed change; empty for the current board
+	OccurredAt time.Time `json:"occurred_at,omitempty"` // commit time of a replayed change
+}
+
+// SimulationReport lists the violations a rule set would have caused on the current board and since a point
+// in the board history
+type SimulationReport struct {
+	Since             time.Time            `json:"since,omitempty"`
+	RulesSimulated    int                  `json:"rules_simulated"`
+	TasksEvaluated    int                  `json:"tasks_evaluated"`
+	RevisionsReplayed int                  `json:"revisions_replayed"`
+	ChangesReplayed   int                  `json:"changes_replayed"`
+	Violations        []SimulatedViolation `json:"violations,omitempty"` // current board first, then history oldest first
+}
+
+// SimulateRules evaluates the validation and workflow rules of a candidate rule set without storing it. Every task
+// of the current board is checked as if it entered its column alongside the other tasks, and every task creation
+// and column transition committed after since is replayed against the board as it was before the change. Disabled
+// rules are simulated as well, so rules can be tried before they are enabled; a nil rule set simulates the rules
+// of the board and a zero since replays the whole history.
+func (re *RuleEngine) SimulateRules(ctx context.Context, ruleSet *resource_access.RuleSet, boardPath string, since time.Time) (*SimulationReport, error) {
+	if ruleSet == nil {
+		var err error
+		if ruleSet, err = re.rulesAccess.ReadRules(boardPath); err != nil {
+			return nil, fmt.Errorf("RuleEngine.SimulateRules failed to read rules: %w", err)
+		}
+	} else {
+		validation, err := re.rulesAccess.ValidateRuleChanges(ruleSet)
+		if err != nil {
+			return nil, fmt.Errorf("RuleEngine.SimulateRules failed to validate rules: %w", err)
+		}
+		if !validation.Valid {
+			return nil, fmt.Errorf("RuleEngine.SimulateRules: invalid rule set: %s", strings.Join(validation.Errors, "; "))
+		}
+	}
+
+	var rules []resource_access.Rule
+	for _, rule := range ruleSet.Rules {
+		if (rule.Category == "validation" || rule.Category == "workflow") && !resource_access.IsTimeTrigger(rule.TriggerType) {
+			rules = append(rules, rule)
+		}
+	}
+	report := &SimulationReport{Since: since, RulesSimulated: len(rules)}
+	if len(rules) == 0 {
+		re.logger.LogMessage(utilities.Debug, "RuleEngine", "No rules to simulate")
+		return report, nil
+	}
+
+	// Replaying the history first records when tasks entered their current columns
+	enterTimes := make(map[string]map[string]time.Time)
+	history, err := re.replayHistory(ctx, rules, since, enterTimes, report)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := re.boardAccess.GetBoardConfiguration()
+	if err != nil {
+		return nil, fmt.Errorf("RuleEngine.SimulateRules failed to read board configuration: %w", err)
+	}
+	tasks, err := re.boardAccess.FindTasks(&board_access.QueryCriteria{})
+	if err != nil {
+		return nil, fmt.Errorf("RuleEngine.SimulateRules failed to find tasks: %w", err)
+	}
+	sortTasksByID(tasks)
+	for _, task := range tasks {
+		event := TaskEvent{
+			EventType:   "task_transition",
+			FutureState: &TaskState{Task: task.Task, Priority: task.Priority, Status: task.Status},
+			Timestamp:   time.Now(),
+		}
+		for _, violation := range re.simulateEvent(rules, "", simulationContext(event, tasks, config, enterTimes[task.Task.ID])) {
+			report.Violations = append(report.Violations, SimulatedViolation{
+				RuleViolation: violation,
+				TaskID:        task.Task.ID,
+				TaskTitle:     task.Task.Title,
+				ToColumn:      task.Status.Column,
+			})
+		}
+	}
+	report.TasksEvaluated = len(tasks)
+	report.Violations = append(report.Violations, history...)
+
+	re.logger.LogMessage(utilities.Info, "RuleEngine",
+		fmt.Sprintf("Rule simulation completed: rules=%d, tasks=%d, revisions=%d, changes=%d, violations=%d",
+			report.RulesSimulated, report.TasksEvaluated, report.RevisionsReplayed, report.ChangesReplayed, len(report.Violations)))
+
+	return report, nil
+}
+
+// replayHistory replays the task creations and column transitions of the revisions committed after since, oldest
+// first, and returns their violations; enterTimes collects when tasks entered columns
+func (re *RuleEngine) replayHistory(ctx context.Context, rules []resource_access.Rule, since time.Time, enterTimes map[string]map[string]time.Time, report *SimulationReport) ([]SimulatedViolation, error) {
+	revisions, err := re.boardAccess.ListRevisions(0)
+	if err != nil {
+		return nil, fmt.Errorf("RuleEngine.SimulateRules failed to list revisions: %w", err)
+	}
+
+	// Revisions are listed newest first; the newest one at or before since is the state the replay starts from
+	var replayed []utilities.CommitInfo
+	var baseline string
+	for _, revision := range revisions {
+		if !since.IsZero() && !revision.Timestamp.After(since) {
+			baseline = revision.ID
+			break
+		}
+		replayed = append(replayed, revision)
+	}
+
+	previous := &board_access.BoardSnapshot{}
+	if baseline != "" && len(replayed) > 0 {
+		if previous, err = re.boardAccess.LoadBoardAt(board_access.HistoryPoint{Commit: baseline}); err != nil {
+			return nil, fmt.Errorf("RuleEngine.SimulateRules failed to load revision %s: %w", baseline, err)
+		}
+	}
+
+	var violations []SimulatedViolation
+	for i := len(replayed) - 1; i >= 0; i-- {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("RuleEngine.SimulateRules cancelled: %w", err)
+		}
+		revision := replayed[i]
+		snapshot, err := re.boardAccess.LoadBoardAt(board_access.HistoryPoint{Commit: revision.ID})
+		if err != nil {
+			return nil, fmt.Errorf("RuleEngine.SimulateRules failed to load revision %s: %w", revision.ID, err)
+		}
+
+		config := previous.Configuration
+		if config == nil {
+			config = snapshot.Configuration
+		}
+		before := make(map[string]*board_access.TaskWithTimestamps, len(previous.Tasks))
+		for _, task := range previous.Tasks {
+			before[task.Task.ID] = task
+		}
+
+		tasks := append([]*board_access.TaskWithTimestamps(nil), snapshot.Tasks...)
+		sortTasksByID(tasks)
+		for _, task := range tasks {
+			event := TaskEvent{
+				EventType:   "task_create",
+				FutureState: &TaskState{Task: task.Task, Priority: task.Priority, Status: task.Status},
+				Timestamp:   revision.Timestamp,
+			}
+			var fromColumn string
+			if stored, found := before[task.Task.ID]; found {
+				if stored.Status.Column == task.Status.Column {
+					continue
+				}
+				event.EventType = "task_transition"
+				event.CurrentState = stored
+				fromColumn = stored.Status.Column
+			}
+			report.ChangesReplayed++
+
+			enriched := simulationContext(event, previous.Tasks, config, enterTimes[task.Task.ID])
+			for _, violation := range re.simulateEvent(rules, event.EventType, enriched) {
+				violations = append(violations, SimulatedViolation{
+					RuleViolation: violation,
+					TaskID:        task.Task.ID,
+					TaskTitle:     task.Task.Title,
+					EventType:     event.EventType,
+					FromColumn:    fromColumn,
+					ToColumn:      task.Status.Column,
+					Commit:        revision.ID,
+					OccurredAt:    revision.Timestamp,
+				})
+			}
+
+			if enterTimes[task.Task.ID] == nil {
+				enterTimes[task.Task.ID] = make(map[string]time.Time)
+			}
+			enterTimes[task.Task.ID][task.Status.Column] = revision.Timestamp
+		}
+
+		report.RevisionsReplayed++
+		previous = snapshot
+	}
+
+	return violations, nil
+}
+
+// simulateEvent evaluates the rules triggered by an event type, ordered by priority; an empty event type
+// evaluates all rules for a task on the current board
+func (re *RuleEngine) simulateEvent(rules []resource_access.Rule, eventType string, context *EnrichedContext) []RuleViolation {
+	var triggered []resource_access.Rule
+	for _, rule := range rules {
+		if eventType == "" || rule.TriggerType == eventType || rule.TriggerType == "all" {
+			triggered = append(triggered, rule)
+		}
+	}
+
+	violations := re.evaluateRules(triggered, context)
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].Priority > violations[j].Priority
+	})
+	return violations
+}
+
+// simulationContext builds the context of a task change from the tasks of the board before the change; a task
+// without current state is not counted in the columns it is evaluated against
+func simulationContext(event TaskEvent, tasks []*board_access.TaskWithTimestamps, config *board_access.BoardConfiguration, enterTimes map[string]time.Time) *EnrichedContext {
+	enriched := &EnrichedContext{
+		Event:            event,
+		WIPCounts:        make(map[string]int),
+		SubtaskWIPCounts: make(map[string]int),
+		ColumnTasks:      make(map[string][]*board_access.TaskWithTimestamps),
+		ColumnEnterTimes: make(map[string]time.Time),
+		ColumnSettings:   make(map[string]board_access.ColumnSettings),
+		BoardMetadata:    make(map[string]string),
+		HierarchyMap:     make(map[string][]string),
+	}
+	for column, enteredAt := range enterTimes {
+		enriched.ColumnEnterTimes[column] = enteredAt
+	}
+	if config != nil {
+		for _, column := range config.Columns {
+			settings := config.ColumnSettings[column]
+			settings.Done = config.IsDoneColumn(column)
+			enriched.ColumnSettings[column] = settings
+		}
+	}
+
+	taskID := event.FutureState.Task.ID
+	for _, task := range tasks {
+		if task.Task.ID == taskID && event.CurrentState == nil {
+			continue
+		}
+		if task.Task.ParentTaskID == nil {
+			enriched.WIPCounts[task.Status.Column]++
+		} else {
+			enriched.SubtaskWIPCounts[task.Status.Column]++
+			parentID := *task.Task.ParentTaskID
+			enriched.HierarchyMap[parentID] = append(enriched.HierarchyMap[parentID], task.Task.ID)
+			if parentID == taskID {
+				enriched.Subtasks = append(enriched.Subtasks, task)
+			}
+		}
+		enriched.ColumnTasks[task.Status.Column] = append(enriched.ColumnTasks[task.Status.Column], task)
+	}
+
+	return enriched
+}
+
+// sortTasksByID orders tasks by their ID so simulations report violations in a stable order
+func sortTasksByID(tasks []*board_access.TaskWithTimestamps) {
+	sort.SliceStable(tasks, func(i, j int) bool {
+		return tasks[i].Task.ID < tasks[j].Task.ID
+	})
+}
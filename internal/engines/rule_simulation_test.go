@@ -0,0 +1,106 @@
+package engines
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rknuus/eisenkan/internal/resource_access"
+	"github.com/rknuus/eisenkan/internal/resource_access/board_access"
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+func TestUnit_RuleEngine_SimulateRules(t *testing.T) {
+	start := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	snapshot := func(tasks ...*board_access.TaskWithTimestamps) *board_access.BoardSnapshot {
+		return &board_access.BoardSnapshot{Tasks: tasks}
+	}
+	boardAccess := &mockBoardAccess{
+		tasks: []*board_access.TaskWithTimestamps{
+			createMockTask("task5", "Plan", "todo"),
+			createMockTask("task4", "Test", "doing"),
+			createMockTask("task3", "Review", "doing"),
+			createMockTask("task1", "Draft", "doing"),
+		},
+		// Revisions are listed newest first
+		history: []utilities.CommitInfo{
+			{ID: "c3", Timestamp: start.Add(2 * time.Hour)},
+			{ID: "c2", Timestamp: start.Add(time.Hour)},
+			{ID: "c1", Timestamp: start},
+		},
+		snapshots: map[string]*board_access.BoardSnapshot{
+			"c1": snapshot(createMockTask("task1", "Draft", "todo"), createMockTask("task2", "Fix", "todo")),
+			"c2": snapshot(createMockTask("task1", "Draft", "doing"), createMockTask("task2", "Fix", "todo")),
+			"c3": snapshot(createMockTask("task1", "Draft", "doing"), createMockTask("task2", "Fix", "done"), createMockTask("task3", "Review", "doing")),
+		},
+	}
+	rulesAccess := &mockRulesAccess{ruleSet: &resource_access.RuleSet{Version: "1.0"}}
+	engine, err := NewRuleEngine(rulesAccess, boardAccess)
+	if err != nil {
+		t.Fatalf("NewRuleEngine() error = %v", err)
+	}
+
+	// The transition rule is disabled, candidate rules are simulated nevertheless
+	candidate := &resource_access.RuleSet{
+		Version: "1.0",
+		Rules: []resource_access.Rule{
+			{ID: "wip-limit", Name: "WIP limit", Category: "validation", TriggerType: "all",
+				Conditions: map[string]interface{}{"max_wip_limit": 2}, Priority: 10, Enabled: true},
+			{ID: "transitions", Name: "Transitions", Category: "workflow", TriggerType: "task_transition",
+				Conditions: map[string]interface{}{"allowed_transitions": map[string]interface{}{"todo": []interface{}{"doing"}, "doing": []interface{}{"done"}}},
+				Priority:   20},
+			{ID: "notify", Name: "Notify", Category: "notification", TriggerType: "all",
+				Conditions: map[string]interface{}{"expression": `true`}, Actions: map[string]interface{}{"notify": true}, Enabled: true},
+		},
+	}
+
+	report, err := engine.SimulateRules(context.Background(), candidate, "/test/board", time.Time{})
+	if err != nil {
+		t.Fatalf("SimulateRules() error = %v", err)
+	}
+	if report.RulesSimulated != 2 || report.TasksEvaluated != 4 || report.RevisionsReplayed != 3 || report.ChangesReplayed != 5 {
+		t.Errorf("Unexpected report counts %+v", report)
+	}
+
+	// Every task of the over-limit column violates the WIP limit, followed by the replayed transition skipping doing
+	expected := []SimulatedViolation{
+		{TaskID: "task1", ToColumn: "doing"},
+		{TaskID: "task3", ToColumn: "doing"},
+		{TaskID: "task4", ToColumn: "doing"},
+		{TaskID: "task2", EventType: "task_transition", FromColumn: "todo", ToColumn: "done", Commit: "c3", OccurredAt: start.Add(2 * time.Hour)},
+	}
+	if len(report.Violations) != len(expected) {
+		t.Fatalf("Expected %d violations, got %+v", len(expected), report.Violations)
+	}
+	for i, violation := range report.Violations {
+		want := expected[i]
+		if violation.TaskID != want.TaskID || violation.EventType != want.EventType || violation.FromColumn != want.FromColumn ||
+			violation.ToColumn != want.ToColumn || violation.Commit != want.Commit || !violation.OccurredAt.Equal(want.OccurredAt) {
+			t.Errorf("Violation %d: expected %+v, got %+v", i, want, violation)
+		}
+	}
+	if violation := report.Violations[0]; violation.RuleID != "wip-limit" || violation.Message != "WIP limit exceeded: column 'doing' has 2 tasks, limit is 2" {
+		t.Errorf("Unexpected WIP limit violation %+v", violation)
+	}
+	if violation := report.Violations[3]; violation.RuleID != "transitions" || violation.Message != "Invalid column transition from 'todo' to 'done'" {
+		t.Errorf("Unexpected transition violation %+v", violation)
+	}
+
+	// Only the changes after since are replayed, starting from the board as it was then
+	report, err = engine.SimulateRules(context.Background(), candidate, "/test/board", start.Add(90*time.Minute))
+	if err != nil {
+		t.Fatalf("SimulateRules() error = %v", err)
+	}
+	if report.RevisionsReplayed != 1 || report.ChangesReplayed != 2 || len(report.Violations) != 4 || report.Violations[3].Commit != "c3" {
+		t.Errorf("Expected only revision c3 to be replayed, got %+v", report)
+	}
+
+	// Without a candidate the rules of the board are simulated
+	report, err = engine.SimulateRules(context.Background(), nil, "/test/board", time.Time{})
+	if err != nil {
+		t.Fatalf("SimulateRules() error = %v", err)
+	}
+	if report.RulesSimulated != 0 || len(report.Violations) != 0 {
+		t.Errorf("Expected nothing to simulate for a board without rules, got %+v", report)
+	}
+}
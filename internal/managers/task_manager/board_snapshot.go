@@ -0,0 +1,131 @@
+// Package managers provides Manager layer components implementing the iDesign methodology.
+// This file implements browsing past board revisions and restoring single tasks from them.
+package task_manager
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rknuus/eisenkan/internal/resource_access/board_access"
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// BoardRevision describes a commit of the board history
+type BoardRevision struct {
+	Commit    string    `json:"commit"`
+	Author    string    `json:"author"`
+	Timestamp time.Time `json:"timestamp"`
+	Message   string    `json:"message"`
+}
+
+// BoardSnapshotResponse is the read-only board as of a past revision
+type BoardSnapshotResponse struct {
+	Revision      BoardRevision          `json:"revision"`
+	Tasks         []TaskResponse         `json:"tasks"`
+	ArchivedTasks []ArchivedTaskResponse `json:"archived_tasks,omitempty"`
+}
+
+// ListBoardRevisions returns the latest board revisions, newest first; a limit of 0 returns all of them
+func (tm *taskManager) ListBoardRevisions(limit int) ([]BoardRevision, error) {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+
+	tm.logger.LogMessage(utilities.Debug, "TaskManager", "Listing board revisions")
+
+	commits, err := tm.boardAccess.ListRevisions(limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list board revisions: %w", err)
+	}
+
+	revisions := make([]BoardRevision, 0, len(commits))
+	for _, commit := range commits {
+		revisions = append(revisions, convertToBoardRevision(commit))
+	}
+	return revisions, nil
+}
+
+// LoadBoardAt returns the board as of a commit or, without a commit, as of the latest revision at or before a time
+func (tm *taskManager) LoadBoardAt(commitID string, at time.Time) (BoardSnapshotResponse, error) {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+
+	tm.logger.LogMessage(utilities.Debug, "TaskManager", fmt.Sprintf("Loading board at %q / %v", commitID, at))
+
+	snapshot, err := tm.boardAccess.LoadBoardAt(board_access.HistoryPoint{Commit: commitID, Time: at})
+	if err != nil {
+		return BoardSnapshotResponse{}, fmt.Errorf("failed to load board revision: %w", err)
+	}
+
+	subtasksByParent := make(map[string][]*board_access.TaskWithTimestamps)
+	for _, task := range snapshot.Tasks {
+		if task.Task.ParentTaskID != nil {
+			subtasksByParent[*task.Task.ParentTaskID] = append(subtasksByParent[*task.Task.ParentTaskID], task)
+		}
+	}
+	for _, archivedTask := range snapshot.Archived {
+		if archivedTask.Task.ParentTaskID != nil {
+			parentID := *archivedTask.Task.ParentTaskID
+			subtasksByParent[parentID] = append(subtasksByParent[parentID], archivedTask.TaskWithTimestamps)
+		}
+	}
+
+	response := BoardSnapshotResponse{
+		Revision: convertToBoardRevision(snapshot.Revision),
+		Tasks:    make([]TaskResponse, 0, len(snapshot.Tasks)),
+	}
+	for _, task := range snapshot.Tasks {
+		response.Tasks = append(response.Tasks, tm.convertToTaskResponse(task, subtasksByParent[task.Task.ID]))
+	}
+	for _, archivedTask := range snapshot.Archived {
+		response.ArchivedTasks = append(response.ArchivedTasks, ArchivedTaskResponse{
+			TaskResponse: tm.convertToTaskResponse(archivedTask.TaskWithTimestamps, subtasksByParent[archivedTask.Task.ID]),
+			ArchivedAt:   archivedTask.ArchivedAt,
+		})
+	}
+	return response, nil
+}
+
+// RestoreTaskFrom brings a task back to its state at a past revision; the restore can be undone
+func (tm *taskManager) RestoreTaskFrom(commitID, taskID string) (TaskResponse, error) {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	tm.logger.LogMessage(utilities.Info, "TaskManager", fmt.Sprintf("Restoring task %s from revision %s", taskID, commitID))
+	before := tm.currentRevision()
+	previous, previousErr := tm.getTaskInternal(taskID)
+
+	if _, err := tm.boardAccess.RestoreTaskFrom(commitID, taskID); err != nil {
+		return TaskResponse{}, fmt.Errorf("task restore from revision %s failed: %w", commitID, err)
+	}
+
+	restored, err := tm.getTaskInternal(taskID)
+	if err != nil {
+		return TaskResponse{}, err
+	}
+
+	tm.logger.LogMessage(utilities.Info, "TaskManager", fmt.Sprintf("Task restored successfully from revision %s: %s", commitID, taskID))
+	tm.recordOperation(before, fmt.Sprintf("restore task %q from an earlier revision", restored.Description))
+
+	// A task restored after it was deleted or archived reappears on the board
+	switch {
+	case previousErr != nil:
+		tm.publishTaskEvent(TaskCreated, restored, "")
+	case previous.WorkflowStatus != restored.WorkflowStatus:
+		tm.publishTaskEvent(TaskMoved, restored, previous.WorkflowStatus)
+	default:
+		tm.publishTaskEvent(TaskUpdated, restored, "")
+	}
+	return restored, nil
+}
+
+// convertToBoardRevision converts a board commit to TaskManager format, leaving out the machine-readable trailers
+func convertToBoardRevision(commit utilities.CommitInfo) BoardRevision {
+	message := utilities.ParseCommitInfo(commit)
+	return BoardRevision{
+		Commit:    commit.ID,
+		Author:    commit.Author,
+		Timestamp: commit.Timestamp,
+		Message:   strings.TrimSpace(message.Summary + "\n\n" + message.Body),
+	}
+}
@@ -113,7 +113,7 @@ func (cf *contextFacet) Store(contextType string, data ContextData) error {
 	}
 
 	// Write file with atomic operations
-	if err := os.WriteFile(contextPath, content, 0644); err != nil {
+	if err := utilities.WriteFileAtomic(contextPath, content, 0644); err != nil {
 		return fmt.Errorf("failed to write context file: %w", err)
 	}
 
@@ -0,0 +1,173 @@
+// Package managers provides Manager layer components implementing the iDesign methodology.
+// This file implements publishing the notifications of notification rules and delivering them to notifiers.
+package task_manager
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/rknuus/eisenkan/internal/engines"
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// RuleNotification is the message of a notification rule that triggered for a task
+type RuleNotification struct {
+	RuleID      string `json:"rule_id"`
+	RuleName    string `json:"rule_name"`
+	TriggerType string `json:"trigger_type,omitempty"` // set for time-triggered rules
+	Message     string `json:"message"`
+}
+
+// ruleNotification returns the notification of a notification rule triggered by a task change
+func ruleNotification(rule engines.TriggeredAction) RuleNotification {
+	return RuleNotification{RuleID: rule.RuleID, RuleName: rule.RuleName, Message: rule.Message}
+}
+
+// publishRuleNotification notifies subscribers about a notification rule that triggered for a task
+func (tm *taskManager) publishRuleNotification(task TaskResponse, notification RuleNotification) {
+	tm.logger.LogMessage(utilities.Info, "TaskManager", fmt.Sprintf("Rule %s notifies about task %s: %s", notification.RuleID, task.ID, notification.Message))
+	tm.publish(TaskEvent{Type: RuleNotified, TaskID: task.ID, Task: &task, Notification: &notification})
+}
+
+// NotificationFromEvent returns the notification to deliver for a notified task event; it reports false for other events.
+// Notifications of the same rule for the same task share a key, so a NotificationDispatcher sends them once.
+func NotificationFromEvent(event TaskEvent) (utilities.Notification, bool) {
+	if event.Type != RuleNotified || event.Notification == nil {
+		return utilities.Notification{}, false
+	}
+
+	title := event.Notification.RuleName
+	if title == "" {
+		title = event.Notification.RuleID
+	}
+	return utilities.Notification{
+		Key:        event.Notification.RuleID + "/" + event.TaskID,
+		Title:      title,
+		Message:    event.Notification.Message,
+		RuleID:     event.Notification.RuleID,
+		TaskID:     event.TaskID,
+		OccurredAt: event.OccurredAt,
+	}, true
+}
+
+// notificationQueue buffers notified task events without bound, so a slow notifier delays notifications but loses none
+type notificationQueue struct {
+	mu      sync.Mutex
+	pending []TaskEvent
+	closed  bool // no more events are pushed; forward stops once the queue is drained
+	wake    chan struct{}
+}
+
+// newNotificationQueue creates an empty notification queue
+func newNotificationQueue() *notificationQueue {
+	return &notificationQueue{wake: make(chan struct{}, 1)}
+}
+
+// push appends an event to the queue
+func (q *notificationQueue) push(event TaskEvent) {
+	q.mu.Lock()
+	q.pending = append(q.pending, event)
+	q.mu.Unlock()
+	q.signal()
+}
+
+// close lets forward stop once the queued events are sent
+func (q *notificationQueue) close() {
+	q.mu.Lock()
+	q.closed = true
+	q.mu.Unlock()
+	q.signal()
+}
+
+// signal wakes forward if it waits for events
+func (q *notificationQueue) signal() {
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+// forward sends the queued events to out in order until ctx is done, then closes out
+func (q *notificationQueue) forward(ctx context.Context, out chan<- TaskEvent) {
+	defer close(out)
+
+	for {
+		q.mu.Lock()
+		if len(q.pending) == 0 {
+			closed := q.closed
+			q.mu.Unlock()
+			if closed {
+				return
+			}
+			select {
+			case <-q.wake:
+				continue
+			case <-ctx.Done():
+				return
+			}
+		}
+		event := q.pending[0]
+		q.pending = q.pending[1:]
+		q.mu.Unlock()
+
+		select {
+		case out <- event:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// SubscribeRuleNotifications returns the notified task events on a queue of their own, which never drops events and
+// is closed when ctx is done
+func (h *taskEventHub) SubscribeRuleNotifications(ctx context.Context) <-chan TaskEvent {
+	queue := newNotificationQueue()
+	notifications := make(chan TaskEvent)
+
+	h.mu.Lock()
+	h.queues[queue] = struct{}{}
+	h.mu.Unlock()
+
+	go func() {
+		queue.forward(ctx, notifications)
+		h.mu.Lock()
+		delete(h.queues, queue)
+		h.mu.Unlock()
+	}()
+
+	return notifications
+}
+
+// QueueRuleNotifications returns the notified events among events on a queue of their own, so a slow consumer does
+// not hold up events; the queue is closed when ctx is done or events is closed and drained
+func QueueRuleNotifications(ctx context.Context, events <-chan TaskEvent) <-chan TaskEvent {
+	queue := newNotificationQueue()
+	notifications := make(chan TaskEvent)
+
+	go func() {
+		defer queue.close()
+		for event := range events {
+			if event.Type == RuleNotified {
+				queue.push(event)
+			}
+		}
+	}()
+	go queue.forward(ctx, notifications)
+
+	return notifications
+}
+
+// DeliverRuleNotifications passes the notifications among the task events to the notifier until the channel is closed.
+// Notifiers may block, so events should come from SubscribeRuleNotifications rather than the lossy SubscribeTaskEvents.
+func DeliverRuleNotifications(events <-chan TaskEvent, notifier utilities.INotifier, logger utilities.ILoggingUtility) {
+	for event := range events {
+		notification, ok := NotificationFromEvent(event)
+		if !ok {
+			continue
+		}
+		if err := notifier.Notify(notification); err != nil {
+			logger.LogMessage(utilities.Warning, "TaskManager", fmt.Sprintf("Failed to deliver notification of rule %s: %v", notification.RuleID, err))
+		}
+	}
+}
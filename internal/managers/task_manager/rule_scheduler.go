@@ -0,0 +1,298 @@
+// Package managers provides Manager layer components implementing the iDesign methodology.
+// This file implements running time-triggered rules periodically.
+package task_manager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rknuus/eisenkan/internal/engines"
+	"github.com/rknuus/eisenkan/internal/resource_access"
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// DefaultScheduleInterval is how often a RuleScheduler runs the time-triggered rules by default
+const DefaultScheduleInterval = time.Minute
+
+// timeTriggersFile keeps the time-triggered rules holding in the latest scheduled run, relative to the board root,
+// so that a restarted application does not trigger them again; it is local state and never committed
+var timeTriggersFile = filepath.Join(".eisenkan", "time_triggers.json")
+
+// ErrSchedulerRunning is returned when starting a RuleScheduler that already runs
+var ErrSchedulerRunning = errors.New("rule scheduler is already running")
+
+// ScheduledTrigger is a time-triggered rule that started to hold for a task in a scheduled run
+type ScheduledTrigger struct {
+	RuleID      string `json:"rule_id"`
+	RuleName    string `json:"rule_name"`
+	Category    string `json:"category"`     // automation or notification
+	TriggerType string `json:"trigger_type"` // due_date, promotion_date or column_age
+	TaskID      string `json:"task_id"`
+	Message     string `json:"message"`
+}
+
+// ScheduledRulesResponse reports what a scheduled run of the time-triggered rules did
+type ScheduledRulesResponse struct {
+	RunAt     time.Time          `json:"run_at"`
+	Triggered []ScheduledTrigger `json:"triggered,omitempty"`
+	Promoted  []TaskResponse     `json:"promoted,omitempty"`
+}
+
+// RunScheduledRules evaluates the time-triggered rules across all tasks at the given time, applies the actions
+// of automation rules, publishes the notifications of notification rules and escalates tasks whose promotion
+// date is reached. A rule triggers for a task once when
+// its condition starts to hold and again only after it stopped holding in a run. A zero time runs at the current time.
+func (tm *taskManager) RunScheduledRules(now time.Time) (ScheduledRulesResponse, error) {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	tm.logger.LogMessage(utilities.Debug, "TaskManager", fmt.Sprintf("Running scheduled rules at %s", now.Format(time.RFC3339)))
+	response := ScheduledRulesResponse{RunAt: now}
+
+	triggers, err := tm.ruleEngine.EvaluateTimeTriggers(context.Background(), tm.boardPath, now)
+	if err != nil {
+		return response, fmt.Errorf("failed to evaluate time-triggered rules: %w", err)
+	}
+
+	if tm.timeTriggers == nil {
+		tm.timeTriggers = tm.loadTimeTriggers()
+	}
+
+	holding := make(map[string]bool, len(triggers))
+	automation := make(map[string][]engines.TriggeredAction)
+	var automatedTaskIDs []string
+	var notifications []ScheduledTrigger
+	for _, trigger := range triggers {
+		key := trigger.RuleID + "\x00" + trigger.TaskID
+		holding[key] = true
+		if tm.timeTriggers[key] {
+			continue
+		}
+
+		scheduled := ScheduledTrigger{
+			RuleID:      trigger.RuleID,
+			RuleName:    trigger.RuleName,
+			Category:    trigger.Category,
+			TriggerType: trigger.TriggerType,
+			TaskID:      trigger.TaskID,
+			Message:     trigger.Message,
+		}
+		response.Triggered = append(response.Triggered, scheduled)
+		if trigger.Category == "notification" {
+			notifications = append(notifications, scheduled)
+		}
+		if trigger.Category == "automation" && resource_access.HasRuleActions(trigger.Actions) {
+			if _, exists := automation[trigger.TaskID]; !exists {
+				automatedTaskIDs = append(automatedTaskIDs, trigger.TaskID)
+			}
+			automation[trigger.TaskID] = append(automation[trigger.TaskID], engines.TriggeredAction{
+				RuleID:   trigger.RuleID,
+				RuleName: trigger.RuleName,
+				Category: trigger.Category,
+				Priority: trigger.Priority,
+				Actions:  trigger.Actions,
+			})
+		}
+	}
+	tm.timeTriggers = holding
+	tm.storeTimeTriggers()
+
+	// Each task's automation is an operation of its own, so it can be undone separately
+	for _, taskID := range automatedTaskIDs {
+		task, err := tm.getTaskInternal(taskID)
+		if err != nil {
+			tm.logger.LogMessage(utilities.Warning, "TaskManager", fmt.Sprintf("Skipping scheduled automation of task %s: %v", taskID, err))
+			continue
+		}
+		before := tm.currentRevision()
+		tm.applyAutomation(task, automation[taskID])
+		tm.recordOperation(before, fmt.Sprintf("apply scheduled rules to task %q", task.Description))
+	}
+
+	for _, notification := range notifications {
+		task, err := tm.getTaskInternal(notification.TaskID)
+		if err != nil {
+			tm.logger.LogMessage(utilities.Warning, "TaskManager", fmt.Sprintf("Skipping notification of rule %s about task %s: %v", notification.RuleID, notification.TaskID, err))
+			continue
+		}
+		tm.publishRuleNotification(task, RuleNotification{
+			RuleID:      notification.RuleID,
+			RuleName:    notification.RuleName,
+			TriggerType: notification.TriggerType,
+			Message:     notification.Message,
+		})
+	}
+
+	promoted, err := tm.processPriorityPromotions(now)
+	if err != nil {
+		return response, err
+	}
+	response.Promoted = promoted
+
+	tm.logger.LogMessage(utilities.Info, "TaskManager", fmt.Sprintf("Scheduled rules run completed: triggered=%d, promoted=%d", len(response.Triggered), len(response.Promoted)))
+	return response, nil
+}
+
+// timeTriggerEntry is a rule holding for a task as stored in the time triggers file
+type timeTriggerEntry struct {
+	RuleID string `json:"rule_id"`
+	TaskID string `json:"task_id"`
+}
+
+// loadTimeTriggers reads the time-triggered rules holding in the latest scheduled run of an earlier session;
+// without a readable file every rule holding now triggers
+func (tm *taskManager) loadTimeTriggers() map[string]bool {
+	holding := make(map[string]bool)
+	if tm.boardPath == "" {
+		return holding
+	}
+
+	content, err := os.ReadFile(filepath.Join(tm.boardPath, timeTriggersFile))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			tm.logger.LogMessage(utilities.Warning, "TaskManager", fmt.Sprintf("Failed to read time triggers: %v", err))
+		}
+		return holding
+	}
+	var entries []timeTriggerEntry
+	if err := json.Unmarshal(content, &entries); err != nil {
+		tm.logger.LogMessage(utilities.Warning, "TaskManager", fmt.Sprintf("Ignoring unreadable time triggers: %v", err))
+		return holding
+	}
+	for _, entry := range entries {
+		holding[entry.RuleID+"\x00"+entry.TaskID] = true
+	}
+	return holding
+}
+
+// storeTimeTriggers writes the time-triggered rules holding in the latest scheduled run; a failure is only
+// logged, since it merely makes a restarted application trigger the rules again
+func (tm *taskManager) storeTimeTriggers() {
+	if tm.boardPath == "" {
+		return
+	}
+
+	entries := make([]timeTriggerEntry, 0, len(tm.timeTriggers))
+	for key := range tm.timeTriggers {
+		ruleID, taskID, _ := strings.Cut(key, "\x00")
+		entries = append(entries, timeTriggerEntry{RuleID: ruleID, TaskID: taskID})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].RuleID != entries[j].RuleID {
+			return entries[i].RuleID < entries[j].RuleID
+		}
+		return entries[i].TaskID < entries[j].TaskID
+	})
+
+	content, err := json.MarshalIndent(entries, "", "  ")
+	if err == nil {
+		path := filepath.Join(tm.boardPath, timeTriggersFile)
+		if err = os.MkdirAll(filepath.Dir(path), 0755); err == nil {
+			err = utilities.WriteFileAtomic(path, content, 0644)
+		}
+	}
+	if err != nil {
+		tm.logger.LogMessage(utilities.Warning, "TaskManager", fmt.Sprintf("Failed to store time triggers: %v", err))
+	}
+}
+
+// RuleScheduler runs the time-triggered rules of a board periodically, timed by a clock
+type RuleScheduler struct {
+	taskManager TaskManager
+	clock       utilities.Clock
+	interval    time.Duration
+	logger      utilities.ILoggingUtility
+
+	mutex   sync.Mutex
+	handler func(ScheduledRulesResponse, error)
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+// NewRuleScheduler creates a RuleScheduler running the scheduled rules of the task manager every interval,
+// DefaultScheduleInterval if the interval is not positive
+func NewRuleScheduler(taskManager TaskManager, clock utilities.Clock, interval time.Duration, logger utilities.ILoggingUtility) *RuleScheduler {
+	if interval <= 0 {
+		interval = DefaultScheduleInterval
+	}
+	return &RuleScheduler{
+		taskManager: taskManager,
+		clock:       clock,
+		interval:    interval,
+		logger:      logger,
+	}
+}
+
+// SetRunHandler sets the function called with the outcome of every run
+func (s *RuleScheduler) SetRunHandler(handler func(ScheduledRulesResponse, error)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.handler = handler
+}
+
+// Start runs the scheduled rules right away and then every interval until Stop is called
+func (s *RuleScheduler) Start() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.stop != nil {
+		return ErrSchedulerRunning
+	}
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
+	go s.run(s.stop, s.done)
+
+	s.logger.LogMessage(utilities.Info, "RuleScheduler", fmt.Sprintf("Rule scheduler started with interval %v", s.interval))
+	return nil
+}
+
+// Stop ends the periodic runs and waits for a run in progress to finish; stopping a stopped scheduler does nothing
+func (s *RuleScheduler) Stop() {
+	s.mutex.Lock()
+	stop, done := s.stop, s.done
+	s.stop, s.done = nil, nil
+	s.mutex.Unlock()
+
+	if stop == nil {
+		return
+	}
+	close(stop)
+	<-done
+	s.logger.LogMessage(utilities.Info, "RuleScheduler", "Rule scheduler stopped")
+}
+
+// run is the loop of a started scheduler
+func (s *RuleScheduler) run(stop <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
+	for {
+		response, err := s.taskManager.RunScheduledRules(s.clock.Now())
+		if err != nil {
+			s.logger.LogMessage(utilities.Warning, "RuleScheduler", fmt.Sprintf("Scheduled rules run failed: %v", err))
+		}
+
+		s.mutex.Lock()
+		handler := s.handler
+		s.mutex.Unlock()
+		if handler != nil {
+			handler(response, err)
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-s.clock.After(s.interval):
+		}
+	}
+}
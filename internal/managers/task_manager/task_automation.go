@@ -0,0 +1,339 @@
+// Package managers provides Manager layer components implementing the iDesign methodology.
+// This file implements applying the actions of automation rules after task changes.
+package task_manager
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rknuus/eisenkan/internal/engines"
+	"github.com/rknuus/eisenkan/internal/resource_access"
+	"github.com/rknuus/eisenkan/internal/resource_access/board_access"
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+const (
+	// maxAutomationDepth bounds how often changes made by automation trigger further automation
+	maxAutomationDepth = 5
+
+	// maxAutomationActions bounds the number of actions automation applies for a single operation
+	maxAutomationActions = 50
+)
+
+// automationStep is a changed task whose triggered automation rules are still to be applied; depth counts
+// the automation changes that led to it
+type automationStep struct {
+	task      TaskResponse
+	triggered []engines.TriggeredAction
+	depth     int
+}
+
+// automationOutcome is the result of an applied action: the note for the commit message, the notifications
+// to publish once committed and the changes whose triggered rules are applied next
+type automationOutcome struct {
+	note      string
+	publish   []func()
+	followUps []automationStep
+}
+
+// applyAutomation applies the rules triggered by a change in a transaction committed right after the change,
+// so that both are undone together. The change itself is made already; failing actions are logged and skipped.
+func (tm *taskManager) applyAutomation(task TaskResponse, triggered []engines.TriggeredAction) {
+	// Notifications change nothing, so they are sent right away
+	var automation []engines.TriggeredAction
+	for _, rule := range triggered {
+		if rule.Category == "notification" {
+			tm.publishRuleNotification(task, ruleNotification(rule))
+			continue
+		}
+		automation = append(automation, rule)
+	}
+	if len(automation) == 0 {
+		return
+	}
+
+	description := fmt.Sprintf("Apply automation rules to task '%s'", task.Description)
+	if err := tm.boardAccess.Begin(description); err != nil {
+		tm.logger.LogMessage(utilities.Warning, "TaskManager", fmt.Sprintf("Automation skipped, failed to start transaction: %v", err))
+		return
+	}
+
+	publish := tm.applyAutomationSteps([]automationStep{{task: task, triggered: automation, depth: 1}})
+
+	if _, err := tm.boardAccess.Commit(); err != nil {
+		tm.logger.LogMessage(utilities.Error, "TaskManager", fmt.Sprintf("Failed to commit automation: %v", err))
+		if err := tm.boardAccess.Rollback(); err != nil {
+			tm.logger.LogMessage(utilities.Error, "TaskManager", fmt.Sprintf("Failed to roll back automation: %v", err))
+		}
+		return
+	}
+	for _, notify := range publish {
+		notify()
+	}
+}
+
+// applyAutomationSteps applies the actions of triggered rules within the open transaction and returns the
+// notifications to publish once it is committed, including those of triggered notification rules. Each rule is applied at most once per task, and changes made
+// by automation trigger further rules only up to maxAutomationDepth, so rules triggering each other terminate.
+func (tm *taskManager) applyAutomationSteps(queue []automationStep) []func() {
+	var publish []func()
+	applied := make(map[string]bool)
+	actions := 0
+
+	for len(queue) > 0 {
+		step := queue[0]
+		queue = queue[1:]
+
+		for _, rule := range step.triggered {
+			key := rule.RuleID + "\x00" + step.task.ID
+			if applied[key] {
+				tm.logger.LogMessage(utilities.Debug, "TaskManager", fmt.Sprintf("Automation rule %s already applied to task %s", rule.RuleID, step.task.ID))
+				continue
+			}
+			applied[key] = true
+
+			if rule.Category == "notification" {
+				task, rule := step.task, rule
+				publish = append(publish, func() { tm.publishRuleNotification(task, ruleNotification(rule)) })
+				continue
+			}
+
+			for _, kind := range resource_access.RuleActionKinds {
+				value, exists := rule.Actions[kind]
+				if !exists {
+					continue
+				}
+				if actions >= maxAutomationActions {
+					tm.logger.LogMessage(utilities.Warning, "TaskManager", fmt.Sprintf("Automation stopped after %d actions", actions))
+					return publish
+				}
+
+				outcome, err := tm.applyAutomationAction(step.task.ID, kind, value)
+				if err != nil {
+					tm.logger.LogMessage(utilities.Warning, "TaskManager", fmt.Sprintf("Automation rule %s failed to apply %s to task %s: %v", rule.RuleID, kind, step.task.ID, err))
+					continue
+				}
+				if outcome == nil {
+					continue
+				}
+				actions++
+
+				note := fmt.Sprintf("Rule '%s' (%s): %s", rule.RuleName, rule.RuleID, outcome.note)
+				if err := tm.boardAccess.Annotate(note, utilities.CommitTrailer{Key: utilities.TrailerRuleID, Value: rule.RuleID}); err != nil {
+					tm.logger.LogMessage(utilities.Warning, "TaskManager", fmt.Sprintf("Failed to record automation rule %s: %v", rule.RuleID, err))
+				}
+				tm.logger.LogMessage(utilities.Info, "TaskManager", note)
+				publish = append(publish, outcome.publish...)
+
+				for _, followUp := range outcome.followUps {
+					if step.depth >= maxAutomationDepth {
+						tm.logger.LogMessage(utilities.Warning, "TaskManager", fmt.Sprintf("Automation of task %s stopped after %d levels", followUp.task.ID, maxAutomationDepth))
+						continue
+					}
+					followUp.depth = step.depth + 1
+					queue = append(queue, followUp)
+				}
+			}
+		}
+	}
+	return publish
+}
+
+// applyAutomationAction applies a single action to a task; the result is nil if the task needed no change.
+// Changes are validated by the rule engine like those made by the user.
+func (tm *taskManager) applyAutomationAction(taskID, kind string, value interface{}) (*automationOutcome, error) {
+	if kind == resource_access.ActionArchiveDoneAfterDays {
+		days, _ := resource_access.RuleActionDays(value)
+		return tm.archiveDoneTasks(days)
+	}
+
+	current, err := tm.getTaskInternal(taskID)
+	if err != nil {
+		return nil, err
+	}
+	title := fmt.Sprintf("task '%s'", current.Description)
+
+	switch kind {
+	case resource_access.ActionAddTags:
+		tags, _ := resource_access.RuleActionStrings(value)
+		var added []string
+		for _, tag := range tags {
+			if !containsTag(current.Tags, tag) && !containsTag(added, tag) {
+				added = append(added, tag)
+			}
+		}
+		if len(added) == 0 {
+			return nil, nil
+		}
+		request := taskRequestFrom(current)
+		request.Tags = append(append([]string{}, current.Tags...), added...)
+		return tm.updateTaskByAutomation(taskID, request, fmt.Sprintf("add tags %s to %s", strings.Join(added, ", "), title))
+
+	case resource_access.ActionSetPriority:
+		label, _ := value.(string)
+		if priorityLabel(current.Priority) == label {
+			return nil, nil
+		}
+		request := taskRequestFrom(current)
+		request.Priority = board_access.Priority{
+			Urgent:    !strings.HasPrefix(label, "not-urgent"),
+			Important: !strings.HasSuffix(label, "not-important"),
+			Label:     label,
+		}
+		return tm.updateTaskByAutomation(taskID, request, fmt.Sprintf("change priority of %s to %s", title, label))
+
+	case resource_access.ActionSetDueInDays:
+		// A due date set before, by the user or an earlier run of the rule, is kept
+		if current.Deadline != nil {
+			return nil, nil
+		}
+		days, _ := resource_access.RuleActionDays(value)
+		due := time.Now().AddDate(0, 0, days)
+		request := taskRequestFrom(current)
+		request.Deadline = &due
+		return tm.updateTaskByAutomation(taskID, request, fmt.Sprintf("set due date of %s to %s", title, due.Format("2006-01-02")))
+
+	case resource_access.ActionMoveTo:
+		column, _ := value.(string)
+		if string(current.WorkflowStatus) == column {
+			return nil, nil
+		}
+		previous, moved, triggered, err := tm.changeTaskStatusInternal(taskID, WorkflowStatus(column))
+		if err != nil {
+			return nil, err
+		}
+		return &automationOutcome{
+			note:      fmt.Sprintf("move %s to %s", title, column),
+			publish:   []func(){func() { tm.publishTaskEvent(TaskMoved, moved, previous.WorkflowStatus) }},
+			followUps: followUpSteps(moved, triggered),
+		}, nil
+
+	case resource_access.ActionCreateSubtasks:
+		titles, _ := resource_access.RuleActionStrings(value)
+
+		// A rule triggering again must not duplicate the subtasks it already created
+		existing := make(map[string]bool, len(current.SubtaskIDs))
+		for _, subtaskID := range current.SubtaskIDs {
+			subtask, err := tm.getTaskInternal(subtaskID)
+			if err != nil {
+				return nil, err
+			}
+			existing[subtask.Description] = true
+		}
+
+		outcome := &automationOutcome{}
+		var created []string
+		for _, subtaskTitle := range titles {
+			if existing[subtaskTitle] {
+				continue
+			}
+			existing[subtaskTitle] = true
+			subtask, triggered, err := tm.createTaskInternal(TaskRequest{
+				Description:    subtaskTitle,
+				Priority:       current.Priority,
+				WorkflowStatus: Todo,
+				ParentTaskID:   &current.ID,
+			})
+			if err != nil {
+				return nil, err
+			}
+			created = append(created, fmt.Sprintf("'%s'", subtaskTitle))
+			outcome.publish = append(outcome.publish, func() { tm.publishTaskEvent(TaskCreated, subtask, "") })
+			outcome.followUps = append(outcome.followUps, followUpSteps(subtask, triggered)...)
+		}
+		if len(created) == 0 {
+			return nil, nil
+		}
+		outcome.note = fmt.Sprintf("create subtasks %s of %s", strings.Join(created, ", "), title)
+		return outcome, nil
+	}
+
+	return nil, fmt.Errorf("unknown automation action %q", kind)
+}
+
+// updateTaskByAutomation stores the changed data of a task on behalf of an automation rule
+func (tm *taskManager) updateTaskByAutomation(taskID string, request TaskRequest, note string) (*automationOutcome, error) {
+	updated, triggered, err := tm.updateTaskInternal(taskID, request)
+	if err != nil {
+		return nil, err
+	}
+	return &automationOutcome{
+		note:      note,
+		publish:   []func(){func() { tm.publishTaskEvent(TaskUpdated, updated, "") }},
+		followUps: followUpSteps(updated, triggered),
+	}, nil
+}
+
+// archiveDoneTasks archives the top-level tasks in done columns that were not updated for the given number of days
+func (tm *taskManager) archiveDoneTasks(days int) (*automationOutcome, error) {
+	config, err := tm.boardAccess.GetBoardConfiguration()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read board configuration: %w", err)
+	}
+	var doneColumns []string
+	for _, column := range config.Columns {
+		if config.IsDoneColumn(column) {
+			doneColumns = append(doneColumns, column)
+		}
+	}
+	if len(doneColumns) == 0 {
+		return nil, nil
+	}
+
+	tasks, err := tm.boardAccess.FindTasks(&board_access.QueryCriteria{Columns: doneColumns, Hierarchy: board_access.TopLevelOnly})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find done tasks: %w", err)
+	}
+
+	cutoff := time.Now().AddDate(0, 0, -days)
+	outcome := &automationOutcome{}
+	archived := 0
+	for _, task := range tasks {
+		if task.Task.ParentTaskID != nil || task.UpdatedAt.After(cutoff) {
+			continue
+		}
+		archivedTask, err := tm.archiveTaskInternal(task.Task.ID, board_access.ArchiveSubtasks)
+		if err != nil {
+			return nil, err
+		}
+		archived++
+		outcome.publish = append(outcome.publish, func() { tm.publishArchivedTask(archivedTask, board_access.ArchiveSubtasks) })
+	}
+	if archived == 0 {
+		return nil, nil
+	}
+	outcome.note = fmt.Sprintf("archive %d done tasks not updated for %d days", archived, days)
+	return outcome, nil
+}
+
+// followUpSteps returns the step applying the rules triggered by an automation change, if any
+func followUpSteps(task TaskResponse, triggered []engines.TriggeredAction) []automationStep {
+	if len(triggered) == 0 {
+		return nil
+	}
+	return []automationStep{{task: task, triggered: triggered}}
+}
+
+// taskRequestFrom returns the request that stores a task unchanged
+func taskRequestFrom(task TaskResponse) TaskRequest {
+	return TaskRequest{
+		Description:           task.Description,
+		Priority:              task.Priority,
+		WorkflowStatus:        task.WorkflowStatus,
+		Tags:                  task.Tags,
+		Deadline:              task.Deadline,
+		PriorityPromotionDate: task.PriorityPromotionDate,
+		ParentTaskID:          task.ParentTaskID,
+	}
+}
+
+// containsTag reports whether tags contain tag
+func containsTag(tags []string, tag string) bool {
+	for _, existing := range tags {
+		if existing == tag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,192 @@
+// Package managers provides Manager layer components implementing the iDesign methodology.
+// This file implements applying one change to several tasks as a single board operation.
+package task_manager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rknuus/eisenkan/internal/engines"
+	"github.com/rknuus/eisenkan/internal/resource_access/board_access"
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// maxBatchSize is the number of tasks a batch can change
+const maxBatchSize = 100
+
+// BatchOperation names the change a batch applies to each of its tasks
+type BatchOperation string
+
+const (
+	BatchChangeStatus   BatchOperation = "change_status"
+	BatchChangePriority BatchOperation = "change_priority"
+	BatchArchive        BatchOperation = "archive"
+)
+
+// BatchRequest applies one change to several tasks; either all tasks are changed or none
+type BatchRequest struct {
+	Operation      BatchOperation        `json:"operation"`
+	TaskIDs        []string              `json:"task_ids"`
+	WorkflowStatus WorkflowStatus        `json:"workflow_status,omitempty"` // target of BatchChangeStatus
+	Priority       board_access.Priority `json:"priority"`                  // target of BatchChangePriority
+
+	CascadePolicy board_access.CascadePolicy `json:"cascade_policy,omitempty"` // subtasks of BatchArchive
+}
+
+// BatchResponse describes an applied batch
+type BatchResponse struct {
+	Tasks  []TaskResponse `json:"tasks"`            // changed tasks in request order; archived tasks as they were archived
+	Commit string         `json:"commit,omitempty"` // board commit holding all changes of the batch
+}
+
+// BatchError reports the task a batch failed on; none of the changes of the batch are kept
+type BatchError struct {
+	TaskID string
+	Err    error
+}
+
+// Error implements the error interface
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("batch failed at task %s, no task was changed: %v", e.TaskID, e.Err)
+}
+
+// Unwrap returns the error of the failed task
+func (e *BatchError) Unwrap() error {
+	return e.Err
+}
+
+// batchChange is the change of a single task of a batch; publish notifies subscribers once the batch is committed
+type batchChange struct {
+	task      TaskResponse
+	publish   func()
+	triggered []engines.TriggeredAction
+}
+
+// ExecuteBatch applies the change of a batch to each of its tasks in a single board commit, which is undone as one operation
+func (tm *taskManager) ExecuteBatch(request BatchRequest) (BatchResponse, error) {
+	if len(request.TaskIDs) == 0 {
+		return BatchResponse{}, fmt.Errorf("batch contains no tasks")
+	}
+	if len(request.TaskIDs) > maxBatchSize {
+		return BatchResponse{}, fmt.Errorf("batch of %d tasks exceeds the limit of %d", len(request.TaskIDs), maxBatchSize)
+	}
+
+	var description string
+	var apply func(taskID string) (batchChange, error)
+	switch request.Operation {
+	case BatchChangeStatus:
+		if request.WorkflowStatus == "" {
+			return BatchResponse{}, fmt.Errorf("batch status change requires a workflow status")
+		}
+		description = fmt.Sprintf("move %d tasks to %s", len(request.TaskIDs), request.WorkflowStatus)
+		apply = func(taskID string) (batchChange, error) {
+			previous, moved, triggered, err := tm.changeTaskStatusInternal(taskID, request.WorkflowStatus)
+			return batchChange{task: moved, publish: func() { tm.publishTaskEvent(TaskMoved, moved, previous.WorkflowStatus) }, triggered: triggered}, err
+		}
+	case BatchChangePriority:
+		description = fmt.Sprintf("change priority of %d tasks to %s", len(request.TaskIDs), priorityLabel(request.Priority))
+		apply = func(taskID string) (batchChange, error) {
+			updated, err := tm.changeTaskPriorityInternal(taskID, request.Priority)
+			return batchChange{task: updated, publish: func() { tm.publishTaskEvent(TaskUpdated, updated, "") }}, err
+		}
+	case BatchArchive:
+		if err := validateCascadePolicy(request.CascadePolicy); err != nil {
+			return BatchResponse{}, err
+		}
+		description = fmt.Sprintf("archive %d tasks", len(request.TaskIDs))
+		apply = func(taskID string) (batchChange, error) {
+			archived, err := tm.archiveTaskInternal(taskID, request.CascadePolicy)
+			return batchChange{task: archived, publish: func() { tm.publishArchivedTask(archived, request.CascadePolicy) }}, err
+		}
+	default:
+		return BatchResponse{}, fmt.Errorf("unknown batch operation %q", request.Operation)
+	}
+
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	tm.logger.LogMessage(utilities.Info, "TaskManager", fmt.Sprintf("Executing batch: %s", description))
+	before := tm.currentRevision()
+
+	if err := tm.boardAccess.Begin(strings.ToUpper(description[:1]) + description[1:]); err != nil {
+		return BatchResponse{}, fmt.Errorf("failed to start batch: %w", err)
+	}
+
+	changes := make([]batchChange, 0, len(request.TaskIDs))
+	for _, taskID := range request.TaskIDs {
+		change, err := apply(taskID)
+		if err != nil {
+			tm.rollbackBatch(description)
+			return BatchResponse{}, &BatchError{TaskID: taskID, Err: err}
+		}
+		changes = append(changes, change)
+	}
+
+	// Automation triggered by the tasks of the batch is part of the batch commit
+	var steps []automationStep
+	for _, change := range changes {
+		if len(change.triggered) > 0 {
+			steps = append(steps, automationStep{task: change.task, triggered: change.triggered, depth: 1})
+		}
+	}
+	publishAutomation := tm.applyAutomationSteps(steps)
+
+	commit, err := tm.boardAccess.Commit()
+	if err != nil {
+		tm.rollbackBatch(description)
+		return BatchResponse{}, fmt.Errorf("failed to commit batch: %w", err)
+	}
+
+	tm.logger.LogMessage(utilities.Info, "TaskManager", fmt.Sprintf("Batch executed successfully: %s", description))
+	tm.recordOperation(before, description)
+
+	response := BatchResponse{Tasks: make([]TaskResponse, 0, len(changes)), Commit: commit}
+	for _, change := range changes {
+		response.Tasks = append(response.Tasks, change.task)
+		change.publish()
+	}
+	for _, publish := range publishAutomation {
+		publish()
+	}
+	return response, nil
+}
+
+// rollbackBatch discards the changes of a failed batch
+func (tm *taskManager) rollbackBatch(description string) {
+	if err := tm.boardAccess.Rollback(); err != nil {
+		tm.logger.LogMessage(utilities.Error, "TaskManager", fmt.Sprintf("Failed to roll back batch %q: %v", description, err))
+		return
+	}
+	tm.logger.LogMessage(utilities.Warning, "TaskManager", fmt.Sprintf("Batch rolled back: %s", description))
+}
+
+// changeTaskPriorityInternal changes the priority of a task without locking, recording or publishing;
+// tasks in the todo column move to the section of their new priority
+func (tm *taskManager) changeTaskPriorityInternal(taskID string, priority board_access.Priority) (TaskResponse, error) {
+	currentTask, err := tm.getTaskInternal(taskID)
+	if err != nil {
+		return TaskResponse{}, fmt.Errorf("failed to get current task state: %w", err)
+	}
+
+	priority.Label = priorityLabel(priority)
+	err = tm.boardAccess.MoveTask(taskID, priority, mapWorkflowStatusWithPriority(currentTask.WorkflowStatus, priority))
+	if err != nil {
+		return TaskResponse{}, fmt.Errorf("priority change failed in storage: %w", err)
+	}
+
+	return tm.getTaskInternal(taskID)
+}
+
+// priorityLabel returns the Eisenhower label of a priority
+func priorityLabel(priority board_access.Priority) string {
+	switch {
+	case priority.Urgent && priority.Important:
+		return "urgent-important"
+	case priority.Urgent:
+		return "urgent-not-important"
+	case priority.Important:
+		return "not-urgent-important"
+	default:
+		return "not-urgent-not-important"
+	}
+}
@@ -0,0 +1,163 @@
+// Package managers provides Manager layer components implementing the iDesign methodology.
+// This file implements the task event stream that lets clients follow changes made elsewhere.
+package task_manager
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// TaskEventType names the kind of change a TaskEvent reports
+type TaskEventType string
+
+const (
+	TaskCreated  TaskEventType = "created"
+	TaskUpdated  TaskEventType = "updated"
+	TaskMoved    TaskEventType = "moved"
+	TaskArchived TaskEventType = "archived"
+	TaskDeleted  TaskEventType = "deleted"
+
+	// TaskConflicted reports task fields a sync merged provisionally; Conflicts lists them for review
+	TaskConflicted TaskEventType = "conflicted"
+
+	// BoardReloaded carries no task; the board configuration changed and clients should reload everything
+	BoardReloaded TaskEventType = "reloaded"
+
+	// RuleNotified reports a notification rule that triggered for a task; Notification holds its message
+	RuleNotified TaskEventType = "notified"
+)
+
+// taskEventBufferSize is the number of events a subscriber may fall behind before it is told to reload instead
+const taskEventBufferSize = 64
+
+// TaskEvent describes a change to a task on the board, made through TaskManager or directly to the board files
+type TaskEvent struct {
+	Type           TaskEventType  `json:"type"`
+	TaskID         string         `json:"task_id"`
+	Task           *TaskResponse  `json:"task,omitempty"`            // state after the change; nil for deleted tasks
+	PreviousStatus WorkflowStatus `json:"previous_status,omitempty"` // set for moved tasks
+	OccurredAt     time.Time      `json:"occurred_at"`
+
+	Conflicts    []TaskFieldConflict `json:"conflicts,omitempty"`    // set for conflicted tasks
+	Notification *RuleNotification   `json:"notification,omitempty"` // set for notified tasks
+}
+
+// ITaskEvents defines the interface for observing task changes
+type ITaskEvents interface {
+	SubscribeTaskEvents(ctx context.Context) <-chan TaskEvent
+	SubscribeRuleNotifications(ctx context.Context) <-chan TaskEvent
+}
+
+// taskEventHub fans task events out to all current subscribers
+type taskEventHub struct {
+	mu          sync.Mutex
+	subscribers map[chan TaskEvent]*taskEventSubscriber
+	queues      map[*notificationQueue]struct{} // notified events, kept apart so none are dropped
+	logger      utilities.ILoggingUtility
+}
+
+// taskEventSubscriber tracks whether a subscriber missed events and is owed a reload
+type taskEventSubscriber struct {
+	events  chan TaskEvent
+	done    <-chan struct{}
+	resync  bool           // events were dropped; a BoardReloaded event is pending
+	dropped int            // events dropped since the subscriber overflowed
+	pending sync.WaitGroup // running resync goroutine, waited for before events is closed
+}
+
+// newTaskEventHub creates an event hub without subscribers
+func newTaskEventHub(logger utilities.ILoggingUtility) *taskEventHub {
+	return &taskEventHub{
+		subscribers: make(map[chan TaskEvent]*taskEventSubscriber),
+		queues:      make(map[*notificationQueue]struct{}),
+		logger:      logger,
+	}
+}
+
+// SubscribeTaskEvents returns a channel of task events that is closed when ctx is done
+func (h *taskEventHub) SubscribeTaskEvents(ctx context.Context) <-chan TaskEvent {
+	sub := &taskEventSubscriber{
+		events: make(chan TaskEvent, taskEventBufferSize),
+		done:   ctx.Done(),
+	}
+
+	h.mu.Lock()
+	h.subscribers[sub.events] = sub
+	h.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		h.mu.Lock()
+		delete(h.subscribers, sub.events)
+		h.mu.Unlock()
+
+		// A resync may still be sending; it gives up once ctx is done
+		sub.pending.Wait()
+		close(sub.events)
+	}()
+
+	return sub.events
+}
+
+// publish delivers an event to every subscriber without blocking; a subscriber that
+// falls behind misses events and receives a BoardReloaded event once it catches up
+func (h *taskEventHub) publish(event TaskEvent) {
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now()
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if event.Type == RuleNotified {
+		for queue := range h.queues {
+			queue.push(event)
+		}
+	}
+	for _, sub := range h.subscribers {
+		if sub.resync {
+			sub.dropped++
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			h.logger.LogMessage(utilities.Warning, "TaskManager", fmt.Sprintf("Dropping %s event for task %s, subscriber is not keeping up and will be told to reload", event.Type, event.TaskID))
+			sub.resync = true
+			sub.dropped = 1
+			sub.pending.Add(1)
+			go h.resync(sub)
+		}
+	}
+}
+
+// resync sends a BoardReloaded event once the subscriber has room, repeating it while
+// events keep being dropped, so the last event a subscriber sees covers everything it missed
+func (h *taskEventHub) resync(sub *taskEventSubscriber) {
+	defer sub.pending.Done()
+
+	for {
+		h.mu.Lock()
+		dropped := sub.dropped
+		h.mu.Unlock()
+
+		select {
+		case sub.events <- TaskEvent{Type: BoardReloaded, OccurredAt: time.Now()}:
+		case <-sub.done:
+			return
+		}
+
+		h.mu.Lock()
+		if sub.dropped == dropped {
+			sub.resync = false
+			sub.dropped = 0
+			h.mu.Unlock()
+			return
+		}
+		h.mu.Unlock()
+	}
+}
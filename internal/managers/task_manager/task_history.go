@@ -0,0 +1,54 @@
+// Package managers provides Manager layer components implementing the iDesign methodology.
+// This file implements the field-level change history of single tasks.
+package task_manager
+
+import (
+	"fmt"
+
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// TaskFieldChange is the value of a task field before and after a revision; an empty value means unset
+type TaskFieldChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
+
+// TaskRevision is a board revision that changed a task, with the task fields it changed
+type TaskRevision struct {
+	Revision  BoardRevision     `json:"revision"`
+	Operation string            `json:"operation"` // created, updated, moved, archived, restored or removed
+	Changes   []TaskFieldChange `json:"changes,omitempty"`
+}
+
+// GetTaskHistory returns the latest revisions of a task, newest first; a limit of 0 returns the latest 100
+func (tm *taskManager) GetTaskHistory(taskID string, limit int) ([]TaskRevision, error) {
+	if taskID == "" {
+		return nil, fmt.Errorf("task ID is required")
+	}
+
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+
+	tm.logger.LogMessage(utilities.Debug, "TaskManager", fmt.Sprintf("Getting history of task %s", taskID))
+
+	revisions, err := tm.boardAccess.GetTaskHistory(taskID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get history of task %s: %w", taskID, err)
+	}
+
+	response := make([]TaskRevision, 0, len(revisions))
+	for _, revision := range revisions {
+		changes := make([]TaskFieldChange, 0, len(revision.Changes))
+		for _, change := range revision.Changes {
+			changes = append(changes, TaskFieldChange{Field: change.Field, Before: change.Before, After: change.After})
+		}
+		response = append(response, TaskRevision{
+			Revision:  convertToBoardRevision(revision.CommitInfo),
+			Operation: revision.Operation,
+			Changes:   changes,
+		})
+	}
+	return response, nil
+}
@@ -5,11 +5,13 @@ package task_manager
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
 
 	"github.com/rknuus/eisenkan/internal/engines"
+	"github.com/rknuus/eisenkan/internal/resource_access"
 	"github.com/rknuus/eisenkan/internal/resource_access/board_access"
 	"github.com/rknuus/eisenkan/internal/utilities"
 )
@@ -40,6 +42,12 @@ type TaskResponse struct {
 	UpdatedAt             time.Time                `json:"updated_at"`
 }
 
+// ArchivedTaskResponse represents an archived task; WorkflowStatus is the status it was archived from
+type ArchivedTaskResponse struct {
+	TaskResponse
+	ArchivedAt time.Time `json:"archived_at"`
+}
+
 // WorkflowStatus represents task workflow states
 type WorkflowStatus string
 
@@ -63,8 +71,26 @@ type QueryCriteria struct {
 
 // ValidationResult represents the outcome of task validation
 type ValidationResult struct {
-	Valid      bool                    `json:"valid"`
-	Violations []engines.RuleViolation `json:"violations,omitempty"`
+	Valid      bool                      `json:"valid"`
+	Violations []engines.RuleViolation   `json:"violations,omitempty"`
+	Actions    []engines.TriggeredAction `json:"actions,omitempty"` // automation and notification rules the change triggers
+}
+
+// ErrTaskNotFound reports that no task exists with the requested ID; BoardAccess reports missing tasks with it as well
+var ErrTaskNotFound = board_access.ErrTaskNotFound
+
+// ErrTaskModified reports that a task was changed since the update time a modification expected it to have
+var ErrTaskModified = errors.New("task has been modified")
+
+// RuleViolationError reports that a task operation was rejected by business rules
+type RuleViolationError struct {
+	Operation  string
+	Violations []engines.RuleViolation
+}
+
+// Error implements the error interface
+func (e *RuleViolationError) Error() string {
+	return fmt.Sprintf("%s violates business rules: %v", e.Operation, e.Violations)
 }
 
 // Board Management Types
@@ -79,6 +105,13 @@ type BoardValidationResponse struct {
 	Warnings      []string `json:"warnings,omitempty"`
 }
 
+// BoardRepairResponse represents the outcome of repairing the board
+type BoardRepairResponse struct {
+	Actions    []string                `json:"actions,omitempty"` // changes made to the board
+	Commit     string                  `json:"commit,omitempty"`
+	Validation BoardValidationResponse `json:"validation"`
+}
+
 // BoardMetadataResponse represents board metadata for UI display
 type BoardMetadataResponse struct {
 	Title         string            `json:"title"`
@@ -89,6 +122,18 @@ type BoardMetadataResponse struct {
 	CreatedAt     *time.Time        `json:"created_at,omitempty"`
 	ModifiedAt    *time.Time        `json:"modified_at,omitempty"`
 	Metadata      map[string]string `json:"metadata,omitempty"`
+	Columns       []BoardColumn     `json:"columns,omitempty"` // columns in board order with their settings from board.json
+}
+
+// BoardColumn represents a board column with its display settings and policies
+type BoardColumn struct {
+	ID          string `json:"id"`
+	Name        string `json:"name,omitempty"`
+	Color       string `json:"color,omitempty"`
+	WIPLimit    int    `json:"wip_limit,omitempty"` // 0 for no limit
+	Done        bool   `json:"done,omitempty"`      // tasks in the column are finished
+	EntryPolicy string `json:"entry_policy,omitempty"`
+	ExitPolicy  string `json:"exit_policy,omitempty"`
 }
 
 // BoardMetadataRequest represents board metadata update request
@@ -134,35 +179,107 @@ type BoardDeletionResponse struct {
 	Message        string `json:"message,omitempty"`
 }
 
+// Board Synchronization Types
+
+// BoardRemote describes a git remote the board is shared through
+type BoardRemote struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// SyncResponse represents the outcome of synchronising the board with a remote
+type SyncResponse struct {
+	Remote               string   `json:"remote"`
+	UpToDate             bool     `json:"up_to_date"`
+	FastForward          bool     `json:"fast_forward"`
+	MergeCommit          string   `json:"merge_commit,omitempty"`
+	ChangedTaskIDs       []string `json:"changed_task_ids,omitempty"`
+	ConfigurationChanged bool     `json:"configuration_changed"`
+
+	Conflicts []TaskFieldConflict `json:"conflicts,omitempty"`
+}
+
+// Resolutions of a TaskFieldConflict
+const (
+	ConflictKeptLocal  = "local"      // the board's value was newer
+	ConflictTookRemote = "remote"     // the remote's value was newer
+	ConflictUnresolved = "unresolved" // the board's value was kept provisionally and needs review
+)
+
+// TaskFieldConflict describes a task field changed differently on the board and on the remote
+type TaskFieldConflict struct {
+	TaskID      string `json:"task_id"`
+	Field       string `json:"field"`
+	LocalValue  string `json:"local_value"`
+	RemoteValue string `json:"remote_value"`
+	Resolution  string `json:"resolution"`
+	Reason      string `json:"reason,omitempty"`
+}
+
 // TaskManager defines the interface for task workflow orchestration
 type TaskManager interface {
 	// Task CRUD Operations
 	CreateTask(request TaskRequest) (TaskResponse, error)
-	UpdateTask(taskID string, request TaskRequest) (TaskResponse, error)
+	// The modifying operations fail with ErrTaskModified unless the task still has the expected update time;
+	// a nil expectedUpdatedAt skips the check
+	UpdateTask(taskID string, request TaskRequest, expectedUpdatedAt *time.Time) (TaskResponse, error)
 	GetTask(taskID string) (TaskResponse, error)
-	DeleteTask(taskID string) error
+	DeleteTask(taskID string, expectedUpdatedAt *time.Time) error
 
 	// Task Query Operations
 	ListTasks(criteria QueryCriteria) ([]TaskResponse, error)
 
 	// Workflow Operations
-	ChangeTaskStatus(taskID string, status WorkflowStatus) (TaskResponse, error)
+	ChangeTaskStatus(taskID string, status WorkflowStatus, expectedUpdatedAt *time.Time) (TaskResponse, error)
 
 	// Validation Operations
 	ValidateTask(request TaskRequest) (ValidationResult, error)
+	SimulateRules(ruleSet *resource_access.RuleSet, since time.Time) (*engines.SimulationReport, error)
 
 	// Priority Promotion Operations
 	ProcessPriorityPromotions() ([]TaskResponse, error)
 
+	// Scheduled Operations
+	RunScheduledRules(now time.Time) (ScheduledRulesResponse, error)
+
+	// Archive Operations
+	ArchiveTask(taskID string, policy board_access.CascadePolicy, expectedUpdatedAt *time.Time) (TaskResponse, error)
+	ListArchivedTasks() ([]ArchivedTaskResponse, error)
+	RestoreTask(taskID string, policy board_access.CascadePolicy) (TaskResponse, error)
+	PurgeArchive(olderThan time.Duration) ([]string, error)
+
 	// Board Management Operations
 	ValidateBoardDirectory(directoryPath string) (BoardValidationResponse, error)
+	RepairBoard() (BoardRepairResponse, error)
 	GetBoardMetadata(boardPath string) (BoardMetadataResponse, error)
+	GetBoardStatistics(boardPath string) (*board_access.BoardStatistics, error)
+	GetFlowMetrics(boardPath string, dateRange *board_access.DateRange) (*board_access.FlowMetrics, error)
 	CreateBoard(request BoardCreationRequest) (BoardCreationResponse, error)
 	UpdateBoardMetadata(boardPath string, metadata BoardMetadataRequest) (BoardMetadataResponse, error)
 	DeleteBoard(request BoardDeletionRequest) (BoardDeletionResponse, error)
 
+	// Board Synchronization Operations
+	ListBoardRemotes() ([]BoardRemote, error)
+	AddBoardRemote(name, url string) error
+	RemoveBoardRemote(name string) error
+	SyncBoard(remote string) (SyncResponse, error)
+
+	// History Operations
+	Undo() (UndoResponse, error)
+	Redo() (UndoResponse, error)
+	ListBoardRevisions(limit int) ([]BoardRevision, error)
+	LoadBoardAt(commitID string, at time.Time) (BoardSnapshotResponse, error)
+	RestoreTaskFrom(commitID, taskID string) (TaskResponse, error)
+	GetTaskHistory(taskID string, limit int) ([]TaskRevision, error)
+
+	// Batch Operations
+	ExecuteBatch(request BatchRequest) (BatchResponse, error)
+
 	// IContext facet operations for UI context management
 	IContext
+
+	// ITaskEvents facet operations for following task changes
+	ITaskEvents
 }
 
 // taskManager implements the TaskManager interface
@@ -172,17 +289,22 @@ type taskManager struct {
 	ruleEngine  engines.IRuleEngine
 	logger      utilities.ILoggingUtility
 	boardPath   string
+	watchOnce   sync.Once
+	history     undoHistory
+	timeTriggers map[string]bool // time-triggered rules holding for a task in the latest scheduled run, loaded from the board on the first run
 	IContext    // embedded context facet
+	*taskEventHub // embedded task event facet
 }
 
 // NewTaskManager creates a new TaskManager instance
 func NewTaskManager(boardAccess board_access.IBoardAccess, ruleEngine engines.IRuleEngine, logger utilities.ILoggingUtility, repository utilities.Repository, boardPath string) TaskManager {
 	return &taskManager{
-		boardAccess: boardAccess,
-		ruleEngine:  ruleEngine,
-		logger:      logger,
-		boardPath:   boardPath,
-		IContext:    newContextFacet(repository),
+		boardAccess:  boardAccess,
+		ruleEngine:   ruleEngine,
+		logger:       logger,
+		boardPath:    boardPath,
+		IContext:     newContextFacet(repository),
+		taskEventHub: newTaskEventHub(logger),
 	}
 }
 
@@ -192,14 +314,30 @@ func (tm *taskManager) CreateTask(request TaskRequest) (TaskResponse, error) {
 	defer tm.mu.Unlock()
 
 	tm.logger.LogMessage(utilities.Info, "TaskManager", "Creating new task")
+	before := tm.currentRevision()
 
+	created, triggered, err := tm.createTaskInternal(request)
+	if err != nil {
+		return TaskResponse{}, err
+	}
+
+	tm.logger.LogMessage(utilities.Info, "TaskManager", fmt.Sprintf("Task created successfully: %s", created.ID))
+	tm.publishTaskEvent(TaskCreated, created, "")
+	tm.applyAutomation(created, triggered)
+	tm.recordOperation(before, fmt.Sprintf("create task %q", request.Description))
+	return created, nil
+}
+
+// createTaskInternal validates and stores a new task without locking, recording or publishing; it returns the
+// created task and the automation rules its creation triggers
+func (tm *taskManager) createTaskInternal(request TaskRequest) (TaskResponse, []engines.TriggeredAction, error) {
 	// Validate business rules
 	validationResult, err := tm.validateTaskRequest(request)
 	if err != nil {
-		return TaskResponse{}, fmt.Errorf("task creation validation failed: %w", err)
+		return TaskResponse{}, nil, fmt.Errorf("task creation validation failed: %w", err)
 	}
 	if !validationResult.Valid {
-		return TaskResponse{}, fmt.Errorf("task creation violates business rules: %v", validationResult.Violations)
+		return TaskResponse{}, nil, &RuleViolationError{Operation: "task creation", Violations: validationResult.Violations}
 	}
 
 	// Create Task struct for BoardAccess
@@ -215,29 +353,58 @@ func (tm *taskManager) CreateTask(request TaskRequest) (TaskResponse, error) {
 	// Store task through BoardAccess
 	taskID, err := tm.boardAccess.CreateTask(task, request.Priority, mapWorkflowStatusWithPriority(request.WorkflowStatus, request.Priority), request.ParentTaskID)
 	if err != nil {
-		return TaskResponse{}, fmt.Errorf("task creation failed in storage: %w", err)
+		return TaskResponse{}, nil, fmt.Errorf("task creation failed in storage: %w", err)
 	}
 
-	tm.logger.LogMessage(utilities.Info, "TaskManager", fmt.Sprintf("Task created successfully: %s", taskID))
-
 	// Retrieve the created task to return complete information
-	return tm.getTaskInternal(taskID)
+	created, err := tm.getTaskInternal(taskID)
+	if err != nil {
+		return TaskResponse{}, nil, err
+	}
+	return created, validationResult.Actions, nil
 }
 
 // UpdateTask implements task modification with validation
-func (tm *taskManager) UpdateTask(taskID string, request TaskRequest) (TaskResponse, error) {
+func (tm *taskManager) UpdateTask(taskID string, request TaskRequest, expectedUpdatedAt *time.Time) (TaskResponse, error) {
 	tm.mu.Lock()
 	defer tm.mu.Unlock()
 
+	if err := tm.checkUnmodified(taskID, expectedUpdatedAt); err != nil {
+		return TaskResponse{}, err
+	}
+
 	tm.logger.LogMessage(utilities.Info, "TaskManager", fmt.Sprintf("Updating task: %s", taskID))
+	before := tm.currentRevision()
+
+	// Remember the workflow status to tell updates from moves
+	previous, previousErr := tm.getTaskInternal(taskID)
+
+	updated, triggered, err := tm.updateTaskInternal(taskID, request)
+	if err != nil {
+		return TaskResponse{}, err
+	}
 
+	tm.logger.LogMessage(utilities.Info, "TaskManager", fmt.Sprintf("Task updated successfully: %s", taskID))
+	if previousErr == nil && previous.WorkflowStatus != updated.WorkflowStatus {
+		tm.publishTaskEvent(TaskMoved, updated, previous.WorkflowStatus)
+	} else {
+		tm.publishTaskEvent(TaskUpdated, updated, "")
+	}
+	tm.applyAutomation(updated, triggered)
+	tm.recordOperation(before, fmt.Sprintf("update task %q", request.Description))
+	return updated, nil
+}
+
+// updateTaskInternal validates and stores the changed data of a task without locking, recording or publishing;
+// it returns the updated task and the automation rules the update triggers
+func (tm *taskManager) updateTaskInternal(taskID string, request TaskRequest) (TaskResponse, []engines.TriggeredAction, error) {
 	// Validate business rules
 	validationResult, err := tm.validateTaskRequest(request)
 	if err != nil {
-		return TaskResponse{}, fmt.Errorf("task update validation failed: %w", err)
+		return TaskResponse{}, nil, fmt.Errorf("task update validation failed: %w", err)
 	}
 	if !validationResult.Valid {
-		return TaskResponse{}, fmt.Errorf("task update violates business rules: %v", validationResult.Violations)
+		return TaskResponse{}, nil, &RuleViolationError{Operation: "task update", Violations: validationResult.Violations}
 	}
 
 	// Create updated Task struct
@@ -254,13 +421,31 @@ func (tm *taskManager) UpdateTask(taskID string, request TaskRequest) (TaskRespo
 	// Update task through BoardAccess
 	err = tm.boardAccess.ChangeTaskData(taskID, task, request.Priority, mapWorkflowStatusWithPriority(request.WorkflowStatus, request.Priority))
 	if err != nil {
-		return TaskResponse{}, fmt.Errorf("task update failed in storage: %w", err)
+		return TaskResponse{}, nil, fmt.Errorf("task update failed in storage: %w", err)
 	}
 
-	tm.logger.LogMessage(utilities.Info, "TaskManager", fmt.Sprintf("Task updated successfully: %s", taskID))
-
 	// Return updated task information
-	return tm.getTaskInternal(taskID)
+	updated, err := tm.getTaskInternal(taskID)
+	if err != nil {
+		return TaskResponse{}, nil, err
+	}
+	return updated, validationResult.Actions, nil
+}
+
+// checkUnmodified returns ErrTaskModified unless the task still has the expected update time, without locking;
+// a nil expectedUpdatedAt skips the check
+func (tm *taskManager) checkUnmodified(taskID string, expectedUpdatedAt *time.Time) error {
+	if expectedUpdatedAt == nil {
+		return nil
+	}
+	current, err := tm.getTaskInternal(taskID)
+	if err != nil {
+		return err
+	}
+	if !current.UpdatedAt.Equal(*expectedUpdatedAt) {
+		return fmt.Errorf("%w: %s", ErrTaskModified, taskID)
+	}
+	return nil
 }
 
 // GetTask retrieves a single task by ID
@@ -279,7 +464,7 @@ func (tm *taskManager) getTaskInternal(taskID string) (TaskResponse, error) {
 		return TaskResponse{}, fmt.Errorf("failed to retrieve task %s: %w", taskID, err)
 	}
 	if len(taskWithTimestamps) == 0 {
-		return TaskResponse{}, fmt.Errorf("task not found: %s", taskID)
+		return TaskResponse{}, fmt.Errorf("%w: %s", ErrTaskNotFound, taskID)
 	}
 
 	// Get subtasks
@@ -293,11 +478,19 @@ func (tm *taskManager) getTaskInternal(taskID string) (TaskResponse, error) {
 }
 
 // DeleteTask implements task deletion with cascade handling
-func (tm *taskManager) DeleteTask(taskID string) error {
+func (tm *taskManager) DeleteTask(taskID string, expectedUpdatedAt *time.Time) error {
 	tm.mu.Lock()
 	defer tm.mu.Unlock()
 
+	if err := tm.checkUnmodified(taskID, expectedUpdatedAt); err != nil {
+		return err
+	}
+
 	tm.logger.LogMessage(utilities.Info, "TaskManager", fmt.Sprintf("Deleting task: %s", taskID))
+	before := tm.currentRevision()
+
+	// Capture the subtasks that are deleted along with the task
+	deleted, deletedErr := tm.getTaskInternal(taskID)
 
 	// Handle cascade operations for subtasks (implementation depends on cascade policy)
 	err := tm.boardAccess.RemoveTask(taskID, board_access.DeleteSubtasks) // Default cascade policy
@@ -306,6 +499,18 @@ func (tm *taskManager) DeleteTask(taskID string) error {
 	}
 
 	tm.logger.LogMessage(utilities.Info, "TaskManager", fmt.Sprintf("Task deleted successfully: %s", taskID))
+	description := fmt.Sprintf("delete task %s", taskID)
+	if deletedErr == nil {
+		description = fmt.Sprintf("delete task %q", deleted.Description)
+	}
+	tm.recordOperation(before, description)
+
+	if deletedErr == nil {
+		for _, subtaskID := range deleted.SubtaskIDs {
+			tm.publish(TaskEvent{Type: TaskDeleted, TaskID: subtaskID})
+		}
+	}
+	tm.publish(TaskEvent{Type: TaskDeleted, TaskID: taskID})
 	return nil
 }
 
@@ -341,39 +546,62 @@ func (tm *taskManager) ListTasks(criteria QueryCriteria) ([]TaskResponse, error)
 }
 
 // ChangeTaskStatus implements workflow status changes with subtask coupling
-func (tm *taskManager) ChangeTaskStatus(taskID string, status WorkflowStatus) (TaskResponse, error) {
+func (tm *taskManager) ChangeTaskStatus(taskID string, status WorkflowStatus, expectedUpdatedAt *time.Time) (TaskResponse, error) {
 	tm.mu.Lock()
 	defer tm.mu.Unlock()
 
+	if err := tm.checkUnmodified(taskID, expectedUpdatedAt); err != nil {
+		return TaskResponse{}, err
+	}
+
 	tm.logger.LogMessage(utilities.Info, "TaskManager", fmt.Sprintf("Changing task status: %s to %s", taskID, status))
+	before := tm.currentRevision()
+
+	currentTask, moved, triggered, err := tm.changeTaskStatusInternal(taskID, status)
+	if err != nil {
+		return TaskResponse{}, err
+	}
 
+	tm.logger.LogMessage(utilities.Info, "TaskManager", fmt.Sprintf("Task status changed successfully: %s", taskID))
+	tm.publishTaskEvent(TaskMoved, moved, currentTask.WorkflowStatus)
+	tm.applyAutomation(moved, triggered)
+	tm.recordOperation(before, fmt.Sprintf("move task %q to %s", currentTask.Description, status))
+	return moved, nil
+}
+
+// changeTaskStatusInternal validates and applies a status change without locking, recording or publishing;
+// it returns the task before and after the change and the automation rules the change triggers
+func (tm *taskManager) changeTaskStatusInternal(taskID string, status WorkflowStatus) (TaskResponse, TaskResponse, []engines.TriggeredAction, error) {
 	// Get current task state
 	currentTask, err := tm.getTaskInternal(taskID)
 	if err != nil {
-		return TaskResponse{}, fmt.Errorf("failed to get current task state: %w", err)
+		return TaskResponse{}, TaskResponse{}, nil, fmt.Errorf("failed to get current task state: %w", err)
 	}
 
 	// Validate workflow transition with RuleEngine
-	if err := tm.validateWorkflowTransition(currentTask, status); err != nil {
-		return TaskResponse{}, fmt.Errorf("workflow transition validation failed: %w", err)
+	triggered, err := tm.validateWorkflowTransition(currentTask, status)
+	if err != nil {
+		return TaskResponse{}, TaskResponse{}, nil, fmt.Errorf("workflow transition validation failed: %w", err)
 	}
 
 	// Handle subtask workflow coupling
 	if err := tm.orchestrateSubtaskWorkflowCoupling(currentTask, status); err != nil {
-		return TaskResponse{}, fmt.Errorf("subtask workflow coupling failed: %w", err)
+		return TaskResponse{}, TaskResponse{}, nil, fmt.Errorf("subtask workflow coupling failed: %w", err)
 	}
 
 	// Apply the status change
 	boardStatus := mapWorkflowStatusWithPriority(status, currentTask.Priority)
 	err = tm.boardAccess.MoveTask(taskID, currentTask.Priority, boardStatus)
 	if err != nil {
-		return TaskResponse{}, fmt.Errorf("status change failed in storage: %w", err)
+		return TaskResponse{}, TaskResponse{}, nil, fmt.Errorf("status change failed in storage: %w", err)
 	}
 
-	tm.logger.LogMessage(utilities.Info, "TaskManager", fmt.Sprintf("Task status changed successfully: %s", taskID))
-
 	// Return updated task
-	return tm.getTaskInternal(taskID)
+	moved, err := tm.getTaskInternal(taskID)
+	if err != nil {
+		return TaskResponse{}, TaskResponse{}, nil, err
+	}
+	return currentTask, moved, triggered, nil
 }
 
 // ValidateTask validates task data without persistence
@@ -383,15 +611,34 @@ func (tm *taskManager) ValidateTask(request TaskRequest) (ValidationResult, erro
 	return tm.validateTaskRequest(request)
 }
 
+// SimulateRules reports the violations a candidate rule set would cause on the board and in its history since the
+// given time, without storing the rules; a nil rule set simulates the rules of the board
+func (tm *taskManager) SimulateRules(ruleSet *resource_access.RuleSet, since time.Time) (*engines.SimulationReport, error) {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+
+	tm.logger.LogMessage(utilities.Debug, "TaskManager", "Simulating rules")
+
+	report, err := tm.ruleEngine.SimulateRules(context.Background(), ruleSet, tm.boardPath, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to simulate rules: %w", err)
+	}
+	return report, nil
+}
+
 // ProcessPriorityPromotions automatically escalates tasks with reached promotion dates
 func (tm *taskManager) ProcessPriorityPromotions() ([]TaskResponse, error) {
 	tm.mu.Lock()
 	defer tm.mu.Unlock()
 
+	return tm.processPriorityPromotions(time.Now())
+}
+
+// processPriorityPromotions escalates the tasks whose promotion date is reached at the given time without locking
+func (tm *taskManager) processPriorityPromotions(now time.Time) ([]TaskResponse, error) {
 	tm.logger.LogMessage(utilities.Info, "TaskManager", "Processing priority promotions")
 
 	// Query tasks with promotion dates that have been reached
-	now := time.Now()
 	criteria := &board_access.QueryCriteria{
 		PriorityPromotionDate: &board_access.DateRange{
 			To: &now, // Tasks with promotion date <= now
@@ -440,6 +687,7 @@ func (tm *taskManager) ProcessPriorityPromotions() ([]TaskResponse, error) {
 			}
 
 			promotedTasks = append(promotedTasks, promotedTask)
+			tm.publishTaskEvent(TaskUpdated, promotedTask, "")
 		}
 	}
 
@@ -448,6 +696,168 @@ func (tm *taskManager) ProcessPriorityPromotions() ([]TaskResponse, error) {
 	return promotedTasks, nil
 }
 
+// Archive Operations
+
+// ArchiveTask moves a task into the board archive, applies the cascade policy to its subtasks and returns the archived task
+func (tm *taskManager) ArchiveTask(taskID string, policy board_access.CascadePolicy, expectedUpdatedAt *time.Time) (TaskResponse, error) {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	if err := tm.checkUnmodified(taskID, expectedUpdatedAt); err != nil {
+		return TaskResponse{}, err
+	}
+
+	tm.logger.LogMessage(utilities.Info, "TaskManager", fmt.Sprintf("Archiving task: %s (%s)", taskID, policy))
+	before := tm.currentRevision()
+
+	archivedTask, err := tm.archiveTaskInternal(taskID, policy)
+	if err != nil {
+		return TaskResponse{}, err
+	}
+
+	tm.logger.LogMessage(utilities.Info, "TaskManager", fmt.Sprintf("Task archived successfully: %s", taskID))
+	tm.recordOperation(before, fmt.Sprintf("archive task %q", archivedTask.Description))
+	tm.publishArchivedTask(archivedTask, policy)
+	return archivedTask, nil
+}
+
+// archiveTaskInternal archives a task and applies the cascade policy without locking, recording or publishing
+func (tm *taskManager) archiveTaskInternal(taskID string, policy board_access.CascadePolicy) (TaskResponse, error) {
+	if err := validateCascadePolicy(policy); err != nil {
+		return TaskResponse{}, err
+	}
+
+	// Capture the task before it leaves the board
+	archivedTask, err := tm.getTaskInternal(taskID)
+	if err != nil {
+		return TaskResponse{}, fmt.Errorf("failed to get task for archival: %w", err)
+	}
+
+	err = tm.boardAccess.ArchiveTask(taskID, policy)
+	if err != nil {
+		return TaskResponse{}, fmt.Errorf("task archival failed: %w", err)
+	}
+	return archivedTask, nil
+}
+
+// publishArchivedTask notifies subscribers about an archived task and what the cascade policy did to its subtasks
+func (tm *taskManager) publishArchivedTask(archivedTask TaskResponse, policy board_access.CascadePolicy) {
+	for _, subtaskID := range archivedTask.SubtaskIDs {
+		switch policy {
+		case board_access.ArchiveSubtasks:
+			tm.publish(TaskEvent{Type: TaskArchived, TaskID: subtaskID})
+		case board_access.DeleteSubtasks:
+			tm.publish(TaskEvent{Type: TaskDeleted, TaskID: subtaskID})
+		case board_access.PromoteSubtasks:
+			tm.publishTaskChange(TaskUpdated, subtaskID, "")
+		}
+	}
+	tm.publishTaskEvent(TaskArchived, archivedTask, "")
+}
+
+// validateCascadePolicy rejects cascade policies BoardAccess does not know
+func validateCascadePolicy(policy board_access.CascadePolicy) error {
+	switch policy {
+	case board_access.NoAction, board_access.ArchiveSubtasks, board_access.DeleteSubtasks, board_access.PromoteSubtasks:
+		return nil
+	}
+	return fmt.Errorf("unknown cascade policy %q", policy)
+}
+
+// ListArchivedTasks retrieves all archived tasks ordered by archival time
+func (tm *taskManager) ListArchivedTasks() ([]ArchivedTaskResponse, error) {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+
+	tm.logger.LogMessage(utilities.Debug, "TaskManager", "Listing archived tasks")
+
+	archivedTasks, err := tm.boardAccess.ListArchivedTasks()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list archived tasks: %w", err)
+	}
+
+	// Subtasks are archived alongside their parent, collect them for the responses
+	subtasksByParent := make(map[string][]*board_access.TaskWithTimestamps)
+	for _, archivedTask := range archivedTasks {
+		if archivedTask.Task.ParentTaskID != nil {
+			parentID := *archivedTask.Task.ParentTaskID
+			subtasksByParent[parentID] = append(subtasksByParent[parentID], archivedTask.TaskWithTimestamps)
+		}
+	}
+
+	responses := make([]ArchivedTaskResponse, 0, len(archivedTasks))
+	for _, archivedTask := range archivedTasks {
+		responses = append(responses, ArchivedTaskResponse{
+			TaskResponse: tm.convertToTaskResponse(archivedTask.TaskWithTimestamps, subtasksByParent[archivedTask.Task.ID]),
+			ArchivedAt:   archivedTask.ArchivedAt,
+		})
+	}
+
+	return responses, nil
+}
+
+// RestoreTask moves an archived task back onto the board and applies the cascade policy to its archived subtasks
+func (tm *taskManager) RestoreTask(taskID string, policy board_access.CascadePolicy) (TaskResponse, error) {
+	if err := validateCascadePolicy(policy); err != nil {
+		return TaskResponse{}, err
+	}
+
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	tm.logger.LogMessage(utilities.Info, "TaskManager", fmt.Sprintf("Restoring task: %s (%s)", taskID, policy))
+	before := tm.currentRevision()
+
+	// Promoted subtasks no longer list the task as parent, so find them before they leave the archive
+	archived, err := tm.boardAccess.ListArchivedTasks()
+	if err != nil {
+		return TaskResponse{}, fmt.Errorf("failed to list archived tasks: %w", err)
+	}
+	var subtaskIDs []string
+	for _, archivedTask := range archived {
+		if archivedTask.Task.ParentTaskID != nil && *archivedTask.Task.ParentTaskID == taskID {
+			subtaskIDs = append(subtaskIDs, archivedTask.Task.ID)
+		}
+	}
+
+	err = tm.boardAccess.RestoreTask(taskID, policy)
+	if err != nil {
+		return TaskResponse{}, fmt.Errorf("task restore failed: %w", err)
+	}
+
+	tm.logger.LogMessage(utilities.Info, "TaskManager", fmt.Sprintf("Task restored successfully: %s", taskID))
+
+	restored, err := tm.getTaskInternal(taskID)
+	if err != nil {
+		return TaskResponse{}, err
+	}
+	tm.recordOperation(before, fmt.Sprintf("restore task %q", restored.Description))
+	// A restored task reappears on the board, together with the subtasks the policy restored or promoted
+	tm.publishTaskEvent(TaskCreated, restored, "")
+	if policy == board_access.ArchiveSubtasks || policy == board_access.PromoteSubtasks {
+		for _, subtaskID := range subtaskIDs {
+			tm.publishTaskChange(TaskCreated, subtaskID, "")
+		}
+	}
+	return restored, nil
+}
+
+// PurgeArchive permanently deletes tasks archived longer ago than olderThan
+func (tm *taskManager) PurgeArchive(olderThan time.Duration) ([]string, error) {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	tm.logger.LogMessage(utilities.Info, "TaskManager", fmt.Sprintf("Purging tasks archived more than %v ago", olderThan))
+
+	purgedIDs, err := tm.boardAccess.PurgeArchive(olderThan)
+	if err != nil {
+		return nil, fmt.Errorf("archive purge failed: %w", err)
+	}
+
+	tm.logger.LogMessage(utilities.Info, "TaskManager", fmt.Sprintf("Purged %d archived tasks", len(purgedIDs)))
+	return purgedIDs, nil
+}
+
 // Board Management Operations
 
 // ValidateBoardDirectory validates that a directory can be used as a board (OP-9)
@@ -465,24 +875,34 @@ func (tm *taskManager) ValidateBoardDirectory(directoryPath string) (BoardValida
 	}
 
 	// Convert to TaskManager response format
-	response := BoardValidationResponse{
-		IsValid:       validationResult.IsValid,
-		GitRepoValid:  validationResult.GitRepoValid,
-		ConfigValid:   validationResult.ConfigValid,
-		DataIntegrity: validationResult.DataIntegrity,
-		Issues:        make([]string, 0),
-		Warnings:      make([]string, 0),
+	response := convertValidationResult(validationResult)
+
+	tm.logger.LogMessage(utilities.Info, "TaskManager", fmt.Sprintf("Board directory validation completed: %s (valid: %v)", directoryPath, response.IsValid))
+
+	return response, nil
+}
+
+// RepairBoard fixes the repairable validation issues of the board and commits the changes
+func (tm *taskManager) RepairBoard() (BoardRepairResponse, error) {
+	tm.logger.LogMessage(utilities.Info, "TaskManager", "Repairing board")
+
+	// A repair is not undoable, undoing it would bring back the corrupt files
+	tm.mu.Lock()
+	result, err := tm.boardAccess.RepairBoard(context.Background())
+	tm.mu.Unlock()
+	if err != nil {
+		return BoardRepairResponse{}, fmt.Errorf("board repair failed: %w", err)
 	}
 
-	// Convert issues and warnings
-	for _, issue := range validationResult.Issues {
-		response.Issues = append(response.Issues, issue.Message)
+	response := BoardRepairResponse{
+		Commit:     result.Commit,
+		Validation: convertValidationResult(result.Validation),
 	}
-	for _, warning := range validationResult.Warnings {
-		response.Warnings = append(response.Warnings, warning.Message)
+	for _, action := range result.Actions {
+		response.Actions = append(response.Actions, action.Message)
 	}
 
-	tm.logger.LogMessage(utilities.Info, "TaskManager", fmt.Sprintf("Board directory validation completed: %s (valid: %v)", directoryPath, response.IsValid))
+	tm.logger.LogMessage(utilities.Info, "TaskManager", fmt.Sprintf("Board repaired with %d changes (valid: %v)", len(response.Actions), response.Validation.IsValid))
 
 	return response, nil
 }
@@ -511,6 +931,7 @@ func (tm *taskManager) GetBoardMetadata(boardPath string) (BoardMetadataResponse
 		CreatedAt:     metadata.CreatedAt,
 		ModifiedAt:    metadata.ModifiedAt,
 		Metadata:      metadata.Metadata,
+		Columns:       convertBoardColumns(metadata.Configuration),
 	}
 
 	tm.logger.LogMessage(utilities.Info, "TaskManager", fmt.Sprintf("Board metadata extracted: %s (title: %s)", boardPath, response.Title))
@@ -518,6 +939,36 @@ func (tm *taskManager) GetBoardMetadata(boardPath string) (BoardMetadataResponse
 	return response, nil
 }
 
+// GetBoardStatistics calculates task counts and ages for a board
+func (tm *taskManager) GetBoardStatistics(boardPath string) (*board_access.BoardStatistics, error) {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+
+	tm.logger.LogMessage(utilities.Debug, "TaskManager", fmt.Sprintf("Calculating board statistics: %s", boardPath))
+
+	stats, err := tm.boardAccess.GetStatistics(context.Background(), boardPath)
+	if err != nil {
+		return nil, fmt.Errorf("board statistics calculation failed: %w", err)
+	}
+
+	return stats, nil
+}
+
+// GetFlowMetrics reports cycle time, lead time, throughput and cumulative flow for a board
+func (tm *taskManager) GetFlowMetrics(boardPath string, dateRange *board_access.DateRange) (*board_access.FlowMetrics, error) {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+
+	tm.logger.LogMessage(utilities.Debug, "TaskManager", fmt.Sprintf("Calculating flow metrics: %s", boardPath))
+
+	metrics, err := tm.boardAccess.GetFlowMetrics(context.Background(), boardPath, dateRange)
+	if err != nil {
+		return nil, fmt.Errorf("flow metrics calculation failed: %w", err)
+	}
+
+	return metrics, nil
+}
+
 // CreateBoard creates a new board with validation (OP-11)
 func (tm *taskManager) CreateBoard(request BoardCreationRequest) (BoardCreationResponse, error) {
 	tm.mu.Lock()
@@ -606,6 +1057,7 @@ func (tm *taskManager) UpdateBoardMetadata(boardPath string, metadata BoardMetad
 		CreatedAt:     updatedMetadata.CreatedAt,
 		ModifiedAt:    updatedMetadata.ModifiedAt,
 		Metadata:      updatedMetadata.Metadata,
+		Columns:       convertBoardColumns(updatedMetadata.Configuration),
 	}, nil
 }
 
@@ -651,8 +1103,142 @@ func (tm *taskManager) DeleteBoard(request BoardDeletionRequest) (BoardDeletionR
 	return response, nil
 }
 
+// ListBoardRemotes returns the git remotes the board is shared through
+func (tm *taskManager) ListBoardRemotes() ([]BoardRemote, error) {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+
+	remotes, err := tm.boardAccess.ListRemotes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list board remotes: %w", err)
+	}
+
+	response := make([]BoardRemote, 0, len(remotes))
+	for _, remote := range remotes {
+		boardRemote := BoardRemote{Name: remote.Name}
+		if len(remote.URLs) > 0 {
+			boardRemote.URL = remote.URLs[0]
+		}
+		response = append(response, boardRemote)
+	}
+	return response, nil
+}
+
+// AddBoardRemote configures a git remote to share the board through
+func (tm *taskManager) AddBoardRemote(name, url string) error {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	if err := tm.boardAccess.AddRemote(name, url); err != nil {
+		return fmt.Errorf("failed to add board remote: %w", err)
+	}
+	return nil
+}
+
+// RemoveBoardRemote removes a git remote from the board
+func (tm *taskManager) RemoveBoardRemote(name string) error {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	if err := tm.boardAccess.RemoveRemote(name); err != nil {
+		return fmt.Errorf("failed to remove board remote: %w", err)
+	}
+	return nil
+}
+
+// SyncBoard merges the remote's task changes into the board, pushes the result and publishes the remote changes as task events
+func (tm *taskManager) SyncBoard(remote string) (SyncResponse, error) {
+	tm.logger.LogMessage(utilities.Info, "TaskManager", fmt.Sprintf("Synchronising board with remote: %s", remote))
+
+	tm.mu.Lock()
+	result, err := tm.boardAccess.SyncBoard(remote)
+	tm.mu.Unlock()
+	if err != nil {
+		return SyncResponse{}, fmt.Errorf("board synchronisation failed: %w", err)
+	}
+
+	response := SyncResponse{
+		Remote:      result.Remote,
+		UpToDate:    result.UpToDate,
+		FastForward: result.FastForward,
+		MergeCommit: result.MergeCommit,
+	}
+	for _, change := range result.Changes {
+		if change.Type == board_access.ConfigurationFileChanged {
+			response.ConfigurationChanged = true
+		} else {
+			response.ChangedTaskIDs = append(response.ChangedTaskIDs, change.TaskID)
+		}
+		tm.publishBoardChange(change)
+	}
+
+	// Clients show the fields a merge could not settle so the user can review them
+	unresolved := make(map[string][]TaskFieldConflict)
+	var unresolvedTaskIDs []string
+	for _, conflict := range result.Conflicts {
+		taskConflict := convertFieldConflict(conflict)
+		response.Conflicts = append(response.Conflicts, taskConflict)
+		if taskConflict.Resolution == ConflictUnresolved {
+			if _, seen := unresolved[taskConflict.TaskID]; !seen {
+				unresolvedTaskIDs = append(unresolvedTaskIDs, taskConflict.TaskID)
+			}
+			unresolved[taskConflict.TaskID] = append(unresolved[taskConflict.TaskID], taskConflict)
+		}
+	}
+	for _, taskID := range unresolvedTaskIDs {
+		tm.publishTaskConflicts(taskID, unresolved[taskID])
+	}
+
+	tm.logger.LogMessage(utilities.Info, "TaskManager", fmt.Sprintf("Board synchronised with %s: %d tasks changed, %d field conflicts", response.Remote, len(response.ChangedTaskIDs), len(response.Conflicts)))
+
+	return response, nil
+}
+
 // Helper methods
 
+// convertBoardColumns converts the columns of a board configuration, nil without board.json
+func convertBoardColumns(config *board_access.BoardConfiguration) []BoardColumn {
+	if config == nil {
+		return nil
+	}
+	columns := make([]BoardColumn, 0, len(config.Columns))
+	for _, id := range config.Columns {
+		settings := config.ColumnSettings[id]
+		columns = append(columns, BoardColumn{
+			ID:          id,
+			Name:        config.ColumnNames[id],
+			Color:       settings.Color,
+			WIPLimit:    settings.WIPLimit,
+			Done:        config.IsDoneColumn(id),
+			EntryPolicy: settings.EntryPolicy,
+			ExitPolicy:  settings.ExitPolicy,
+		})
+	}
+	return columns
+}
+
+// convertValidationResult converts a BoardAccess validation result to the TaskManager response format
+func convertValidationResult(validationResult *board_access.BoardValidationResult) BoardValidationResponse {
+	response := BoardValidationResponse{
+		IsValid:       validationResult.IsValid,
+		GitRepoValid:  validationResult.GitRepoValid,
+		ConfigValid:   validationResult.ConfigValid,
+		DataIntegrity: validationResult.DataIntegrity,
+		Issues:        make([]string, 0),
+		Warnings:      make([]string, 0),
+	}
+
+	// Convert issues and warnings
+	for _, issue := range validationResult.Issues {
+		response.Issues = append(response.Issues, issue.Message)
+	}
+	for _, warning := range validationResult.Warnings {
+		response.Warnings = append(response.Warnings, warning.Message)
+	}
+
+	return response
+}
+
 // validateTaskRequest validates a task request using the RuleEngine
 func (tm *taskManager) validateTaskRequest(request TaskRequest) (ValidationResult, error) {
 	// Create TaskEvent for rule validation
@@ -684,11 +1270,12 @@ func (tm *taskManager) validateTaskRequest(request TaskRequest) (ValidationResul
 	return ValidationResult{
 		Valid:      result.Allowed,
 		Violations: result.Violations,
+		Actions:    result.Actions,
 	}, nil
 }
 
-// validateWorkflowTransition validates workflow status transitions
-func (tm *taskManager) validateWorkflowTransition(currentTask TaskResponse, newStatus WorkflowStatus) error {
+// validateWorkflowTransition validates workflow status transitions and returns the automation rules they trigger
+func (tm *taskManager) validateWorkflowTransition(currentTask TaskResponse, newStatus WorkflowStatus) ([]engines.TriggeredAction, error) {
 	// Create TaskEvent for workflow transition validation
 	futureState := &engines.TaskState{
 		Task: &board_access.Task{
@@ -713,14 +1300,14 @@ func (tm *taskManager) validateWorkflowTransition(currentTask TaskResponse, newS
 	// Validate with RuleEngine
 	result, err := tm.ruleEngine.EvaluateTaskChange(context.Background(), event, tm.boardPath)
 	if err != nil {
-		return fmt.Errorf("workflow transition rule validation failed: %w", err)
+		return nil, fmt.Errorf("workflow transition rule validation failed: %w", err)
 	}
 
 	if !result.Allowed {
-		return fmt.Errorf("workflow transition violates business rules: %v", result.Violations)
+		return nil, &RuleViolationError{Operation: "workflow transition", Violations: result.Violations}
 	}
 
-	return nil
+	return result.Actions, nil
 }
 
 // orchestrateSubtaskWorkflowCoupling handles parent-child workflow coupling
@@ -758,6 +1345,7 @@ func (tm *taskManager) handleFirstSubtaskTransition(parentTaskID string) error {
 		if err != nil {
 			return fmt.Errorf("failed to update parent task status: %w", err)
 		}
+		tm.publishTaskChange(TaskMoved, parentTaskID, Todo)
 	}
 
 	return nil
@@ -781,13 +1369,113 @@ func (tm *taskManager) handleParentTaskCompletion(parentTaskID string) error {
 			if err != nil {
 				tm.logger.LogMessage(utilities.Error, "TaskManager", fmt.Sprintf("Failed to update subtask %s: %v", subtask.Task.ID, err))
 				// Continue with other subtasks
+				continue
 			}
+			tm.publishTaskChange(TaskMoved, subtask.Task.ID, mapFromBoardStatus(subtask.Status))
 		}
 	}
 
 	return nil
 }
 
+// publishTaskEvent notifies subscribers about a change to task
+func (tm *taskManager) publishTaskEvent(eventType TaskEventType, task TaskResponse, previousStatus WorkflowStatus) {
+	tm.publish(TaskEvent{Type: eventType, TaskID: task.ID, Task: &task, PreviousStatus: previousStatus})
+}
+
+// publishTaskChange notifies subscribers about a change to a task that is not loaded yet
+func (tm *taskManager) publishTaskChange(eventType TaskEventType, taskID string, previousStatus WorkflowStatus) {
+	task, err := tm.getTaskInternal(taskID)
+	if err != nil {
+		tm.logger.LogMessage(utilities.Warning, "TaskManager", fmt.Sprintf("Failed to load task %s for %s event: %v", taskID, eventType, err))
+		return
+	}
+	tm.publishTaskEvent(eventType, task, previousStatus)
+}
+
+// SubscribeTaskEvents returns a channel of task events, including changes made to the board outside the application
+func (tm *taskManager) SubscribeTaskEvents(ctx context.Context) <-chan TaskEvent {
+	tm.watchOnce.Do(tm.watchBoard)
+	return tm.taskEventHub.SubscribeTaskEvents(ctx)
+}
+
+// watchBoard republishes external board modifications as task events until BoardAccess is closed
+func (tm *taskManager) watchBoard() {
+	changes, err := tm.boardAccess.WatchBoard(context.Background())
+	if err != nil {
+		tm.logger.LogMessage(utilities.Warning, "TaskManager", fmt.Sprintf("Not watching the board for external changes: %v", err))
+		return
+	}
+
+	go func() {
+		for change := range changes {
+			tm.publishBoardChange(change)
+		}
+	}()
+}
+
+// publishBoardChange translates an external board modification into the task event clients expect
+func (tm *taskManager) publishBoardChange(change board_access.BoardChange) {
+	if change.Type == board_access.ConfigurationFileChanged {
+		tm.publish(TaskEvent{Type: BoardReloaded, OccurredAt: change.OccurredAt})
+		return
+	}
+
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+
+	task, err := tm.getTaskInternal(change.TaskID)
+	switch {
+	case err == nil:
+		tm.publish(TaskEvent{Type: TaskUpdated, TaskID: task.ID, Task: &task, OccurredAt: change.OccurredAt})
+	case errors.Is(err, ErrTaskNotFound):
+		eventType := TaskDeleted
+		if archived, err := tm.boardAccess.ListArchivedTasks(); err == nil {
+			for _, archivedTask := range archived {
+				if archivedTask.Task.ID == change.TaskID {
+					eventType = TaskArchived
+					break
+				}
+			}
+		}
+		tm.publish(TaskEvent{Type: eventType, TaskID: change.TaskID, OccurredAt: change.OccurredAt})
+	default:
+		// A file may be half-written by an editor; the next change reports the final content
+		tm.logger.LogMessage(utilities.Warning, "TaskManager", fmt.Sprintf("Failed to load externally changed task %s: %v", change.TaskID, err))
+	}
+}
+
+// publishTaskConflicts reports the unresolved field conflicts of a task together with its merged state
+func (tm *taskManager) publishTaskConflicts(taskID string, conflicts []TaskFieldConflict) {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+
+	event := TaskEvent{Type: TaskConflicted, TaskID: taskID, Conflicts: conflicts}
+	if task, err := tm.getTaskInternal(taskID); err == nil {
+		event.Task = &task
+	}
+	tm.publish(event)
+}
+
+// convertFieldConflict converts a merge conflict of the versioning utility to TaskManager format
+func convertFieldConflict(conflict utilities.FieldConflict) TaskFieldConflict {
+	resolution := ConflictUnresolved
+	switch conflict.Resolution {
+	case utilities.ResolvedOurs:
+		resolution = ConflictKeptLocal
+	case utilities.ResolvedTheirs:
+		resolution = ConflictTookRemote
+	}
+	return TaskFieldConflict{
+		TaskID:      conflict.Record,
+		Field:       conflict.Field,
+		LocalValue:  conflict.Ours,
+		RemoteValue: conflict.Theirs,
+		Resolution:  resolution,
+		Reason:      conflict.Reason,
+	}
+}
+
 // convertToTaskResponse converts BoardAccess types to TaskManager response format
 func (tm *taskManager) convertToTaskResponse(taskWithTimestamps *board_access.TaskWithTimestamps, subtasks []*board_access.TaskWithTimestamps) TaskResponse {
 	subtaskIDs := make([]string, 0, len(subtasks))
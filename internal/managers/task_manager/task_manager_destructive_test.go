@@ -165,7 +165,7 @@ func TestDestructive_TaskManager_APIContractViolations(t *testing.T) {
 			ParentTaskID:   &childResponse.ID,
 		}
 		
-		_, err = taskManager.UpdateTask(parentResponse.ID, updateRequest)
+		_, err = taskManager.UpdateTask(parentResponse.ID, updateRequest, nil)
 		if err == nil {
 			t.Error("Expected error for circular hierarchy creation")
 		}
@@ -305,7 +305,7 @@ func TestDestructive_TaskManager_InvalidWorkflowTransitions(t *testing.T) {
 			WorkflowStatus: Todo,
 		}
 		
-		_, err = taskManager.UpdateTask(response.ID, updateRequest)
+		_, err = taskManager.UpdateTask(response.ID, updateRequest, nil)
 		if err == nil {
 			t.Error("Expected error for invalid done->todo transition")
 		}
@@ -344,7 +344,7 @@ func TestDestructive_TaskManager_InvalidWorkflowTransitions(t *testing.T) {
 			WorkflowStatus: Done,
 		}
 		
-		_, err = taskManager.UpdateTask(parentResponse.ID, updateRequest)
+		_, err = taskManager.UpdateTask(parentResponse.ID, updateRequest, nil)
 		// Should enforce parent completion dependency rules
 		if err == nil {
 			// Check if system allows this based on active policy
@@ -360,12 +360,12 @@ func TestDestructive_TaskManager_InvalidWorkflowTransitions(t *testing.T) {
 			WorkflowStatus: InProgress,
 		}
 		
-		_, err := taskManager.UpdateTask("", updateRequest)
+		_, err := taskManager.UpdateTask("", updateRequest, nil)
 		if err == nil {
 			t.Error("Expected error for empty task ID")
 		}
 		
-		_, err = taskManager.UpdateTask("invalid-id-format", updateRequest)
+		_, err = taskManager.UpdateTask("invalid-id-format", updateRequest, nil)
 		if err == nil {
 			t.Error("Expected error for invalid task ID format")
 		}
@@ -446,7 +446,7 @@ func TestDestructive_TaskManager_SubtaskWorkflowCoupling(t *testing.T) {
 			ParentTaskID:   &parentResponse.ID,
 		}
 		
-		_, err = taskManager.UpdateTask(subtaskResponse.ID, updateRequest)
+		_, err = taskManager.UpdateTask(subtaskResponse.ID, updateRequest, nil)
 		// This might be allowed or rejected based on workflow coupling rules
 		if err != nil {
 			t.Logf("System enforces workflow coupling: %v", err)
@@ -499,7 +499,7 @@ func TestDestructive_TaskManager_SubtaskWorkflowCoupling(t *testing.T) {
 					WorkflowStatus: InProgress,
 					ParentTaskID:   &parentResponse.ID,
 				}
-				_, err := taskManager.UpdateTask(id, updateRequest)
+				_, err := taskManager.UpdateTask(id, updateRequest, nil)
 				if err != nil {
 					errors <- err
 				}
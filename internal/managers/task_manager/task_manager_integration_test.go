@@ -1,10 +1,17 @@
 package task_manager
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/go-git/go-git/v5"
 	"github.com/rknuus/eisenkan/internal/engines"
 	"github.com/rknuus/eisenkan/internal/resource_access"
 	"github.com/rknuus/eisenkan/internal/resource_access/board_access"
@@ -372,7 +379,7 @@ func TestIntegration_TaskManager_FullWorkflow(t *testing.T) {
 		Tags:           []string{"workflow", "test", "updated"},
 	}
 
-	_, err = taskManager.UpdateTask(taskID, updateRequest)
+	_, err = taskManager.UpdateTask(taskID, updateRequest, nil)
 	if err != nil {
 		t.Fatalf("Failed to update task: %v", err)
 	}
@@ -390,4 +397,1095 @@ func TestIntegration_TaskManager_FullWorkflow(t *testing.T) {
 	if len(updatedTask.Tags) != 3 || updatedTask.Tags[2] != "updated" {
 		t.Errorf("Expected 3 tags including 'updated', got %v", updatedTask.Tags)
 	}
-}
\ No newline at end of file
+}
+func TestIntegration_TaskManager_TaskEvents(t *testing.T) {
+	tempDir := t.TempDir()
+
+	boardAccess, err := board_access.NewBoardAccess(tempDir)
+	if err != nil {
+		t.Fatalf("Failed to create BoardAccess: %v", err)
+	}
+	defer boardAccess.Close()
+
+	rulesAccess, err := resource_access.NewRulesAccess(tempDir)
+	if err != nil {
+		t.Fatalf("Failed to create RulesAccess: %v", err)
+	}
+	defer rulesAccess.Close()
+
+	ruleEngine, err := engines.NewRuleEngine(rulesAccess, boardAccess)
+	if err != nil {
+		t.Fatalf("Failed to create RuleEngine: %v", err)
+	}
+	defer ruleEngine.Close()
+
+	repository, err := utilities.InitializeRepositoryWithConfig(tempDir, &utilities.AuthorConfiguration{User: "Test User", Email: "test@example.com"})
+	if err != nil {
+		t.Fatalf("Failed to create repository: %v", err)
+	}
+	defer repository.Close()
+
+	taskManager := NewTaskManager(boardAccess, ruleEngine, utilities.NewLoggingUtility(), repository, tempDir)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := taskManager.SubscribeTaskEvents(ctx)
+
+	nextEvent := func() TaskEvent {
+		t.Helper()
+		select {
+		case event := <-events:
+			return event
+		case <-time.After(time.Second):
+			t.Fatal("Timed out waiting for a task event")
+			return TaskEvent{}
+		}
+	}
+
+	created, err := taskManager.CreateTask(TaskRequest{
+		Description:    "Observed task",
+		Priority:       board_access.Priority{Urgent: true, Important: true},
+		WorkflowStatus: Todo,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	if event := nextEvent(); event.Type != TaskCreated || event.TaskID != created.ID || event.Task == nil || event.OccurredAt.IsZero() {
+		t.Errorf("Expected a created event carrying the task, got %+v", event)
+	}
+
+	updateRequest := TaskRequest{
+		Description:    "Observed task, renamed",
+		Priority:       created.Priority,
+		WorkflowStatus: Todo,
+	}
+	if _, err := taskManager.UpdateTask(created.ID, updateRequest, nil); err != nil {
+		t.Fatalf("Failed to update task: %v", err)
+	}
+	if event := nextEvent(); event.Type != TaskUpdated || event.Task.Description != "Observed task, renamed" {
+		t.Errorf("Expected an updated event with the new description, got %+v", event)
+	}
+
+	if _, err := taskManager.ChangeTaskStatus(created.ID, InProgress, nil); err != nil {
+		t.Fatalf("Failed to move task: %v", err)
+	}
+	if event := nextEvent(); event.Type != TaskMoved || event.PreviousStatus != Todo || event.Task.WorkflowStatus != InProgress {
+		t.Errorf("Expected a moved event from todo to doing, got %+v", event)
+	}
+
+	if _, err := taskManager.ArchiveTask(created.ID, board_access.ArchiveSubtasks, nil); err != nil {
+		t.Fatalf("Failed to archive task: %v", err)
+	}
+	if event := nextEvent(); event.Type != TaskArchived || event.TaskID != created.ID {
+		t.Errorf("Expected an archived event, got %+v", event)
+	}
+
+	if _, err := taskManager.RestoreTask(created.ID, board_access.ArchiveSubtasks); err != nil {
+		t.Fatalf("Failed to restore task: %v", err)
+	}
+	if event := nextEvent(); event.Type != TaskCreated || event.TaskID != created.ID {
+		t.Errorf("Expected a restored task to be reported as created, got %+v", event)
+	}
+
+	if err := taskManager.DeleteTask(created.ID, nil); err != nil {
+		t.Fatalf("Failed to delete task: %v", err)
+	}
+	if event := nextEvent(); event.Type != TaskDeleted || event.TaskID != created.ID || event.Task != nil {
+		t.Errorf("Expected a deleted event without task data, got %+v", event)
+	}
+
+	// Cancelling the subscription closes the channel
+	cancel()
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Error("Expected no further events after cancellation")
+		}
+	case <-time.After(time.Second):
+		t.Error("Expected the event channel to be closed after cancellation")
+	}
+}
+
+func TestIntegration_TaskManager_ExternalChangeEvents(t *testing.T) {
+	tempDir := t.TempDir()
+
+	boardAccess, err := board_access.NewBoardAccess(tempDir)
+	if err != nil {
+		t.Fatalf("Failed to create BoardAccess: %v", err)
+	}
+	defer boardAccess.Close()
+
+	rulesAccess, err := resource_access.NewRulesAccess(tempDir)
+	if err != nil {
+		t.Fatalf("Failed to create RulesAccess: %v", err)
+	}
+	defer rulesAccess.Close()
+
+	ruleEngine, err := engines.NewRuleEngine(rulesAccess, boardAccess)
+	if err != nil {
+		t.Fatalf("Failed to create RuleEngine: %v", err)
+	}
+	defer ruleEngine.Close()
+
+	repository, err := utilities.InitializeRepositoryWithConfig(tempDir, &utilities.AuthorConfiguration{User: "Test User", Email: "test@example.com"})
+	if err != nil {
+		t.Fatalf("Failed to create repository: %v", err)
+	}
+	defer repository.Close()
+
+	taskManager := NewTaskManager(boardAccess, ruleEngine, utilities.NewLoggingUtility(), repository, tempDir)
+
+	created, err := taskManager.CreateTask(TaskRequest{
+		Description:    "Edited elsewhere",
+		Priority:       board_access.Priority{Urgent: true, Important: true},
+		WorkflowStatus: Todo,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	matches, err := filepath.Glob(filepath.Join(tempDir, "todo", "*", "*-task-"+created.ID+".json"))
+	if err != nil || len(matches) != 1 {
+		t.Fatalf("Failed to find the task file: %v %v", matches, err)
+	}
+	taskFile := matches[0]
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := taskManager.SubscribeTaskEvents(ctx)
+
+	nextEvent := func() TaskEvent {
+		t.Helper()
+		select {
+		case event := <-events:
+			return event
+		case <-time.After(5 * time.Second):
+			t.Fatal("Timed out waiting for a task event")
+			return TaskEvent{}
+		}
+	}
+
+	// Edit the description by hand, as someone would in a text editor
+	data, err := os.ReadFile(taskFile)
+	if err != nil {
+		t.Fatalf("Failed to read task file: %v", err)
+	}
+	var document map[string]interface{}
+	if err := json.Unmarshal(data, &document); err != nil {
+		t.Fatalf("Failed to parse task file: %v", err)
+	}
+	document["description"] = "Edited by hand"
+	data, _ = json.MarshalIndent(document, "", "  ")
+	if err := os.WriteFile(taskFile, data, 0644); err != nil {
+		t.Fatalf("Failed to write task file: %v", err)
+	}
+	if event := nextEvent(); event.Type != TaskUpdated || event.TaskID != created.ID || event.Task == nil || event.Task.Description != "Edited by hand" {
+		t.Errorf("Expected an updated event with the edited description, got %+v", event)
+	}
+
+	if err := os.Remove(taskFile); err != nil {
+		t.Fatalf("Failed to remove task file: %v", err)
+	}
+	if event := nextEvent(); event.Type != TaskDeleted || event.TaskID != created.ID {
+		t.Errorf("Expected a deleted event, got %+v", event)
+	}
+
+	if err := os.WriteFile(filepath.Join(tempDir, "board.json"), []byte(`{"name":"Edited","columns":["todo","doing","done"]}`), 0644); err != nil {
+		t.Fatalf("Failed to write board.json: %v", err)
+	}
+	if event := nextEvent(); event.Type != BoardReloaded || event.TaskID != "" {
+		t.Errorf("Expected a reloaded event, got %+v", event)
+	}
+}
+
+// newSharedBoard opens a board at boardPath that uses remotePath as origin
+func newSharedBoard(t *testing.T, boardPath, remotePath string) TaskManager {
+	t.Helper()
+
+	boardAccess, err := board_access.NewBoardAccess(boardPath)
+	if err != nil {
+		t.Fatalf("Failed to create BoardAccess: %v", err)
+	}
+	t.Cleanup(func() { boardAccess.Close() })
+
+	rulesAccess, err := resource_access.NewRulesAccess(boardPath)
+	if err != nil {
+		t.Fatalf("Failed to create RulesAccess: %v", err)
+	}
+	t.Cleanup(func() { rulesAccess.Close() })
+
+	ruleEngine, err := engines.NewRuleEngine(rulesAccess, boardAccess)
+	if err != nil {
+		t.Fatalf("Failed to create RuleEngine: %v", err)
+	}
+	t.Cleanup(func() { ruleEngine.Close() })
+
+	repository, err := utilities.InitializeRepositoryWithConfig(boardPath, &utilities.AuthorConfiguration{User: "Test User", Email: "test@example.com"})
+	if err != nil {
+		t.Fatalf("Failed to create repository: %v", err)
+	}
+	t.Cleanup(func() { repository.Close() })
+
+	taskManager := NewTaskManager(boardAccess, ruleEngine, utilities.NewLoggingUtility(), repository, boardPath)
+	if err := taskManager.AddBoardRemote(utilities.DefaultRemoteName, remotePath); err != nil {
+		t.Fatalf("Failed to add remote: %v", err)
+	}
+	return taskManager
+}
+
+func TestIntegration_TaskManager_SyncBoard(t *testing.T) {
+	root := t.TempDir()
+	remotePath := filepath.Join(root, "remote.git")
+	if _, err := git.PlainInit(remotePath, true); err != nil {
+		t.Fatalf("Failed to create bare repository: %v", err)
+	}
+	laptop := newSharedBoard(t, filepath.Join(root, "laptop"), remotePath)
+	desktop := newSharedBoard(t, filepath.Join(root, "desktop"), remotePath)
+
+	if remotes, err := laptop.ListBoardRemotes(); err != nil || len(remotes) != 1 || remotes[0].URL != remotePath {
+		t.Fatalf("Expected the origin remote, got %+v (%v)", remotes, err)
+	}
+
+	first, err := laptop.CreateTask(TaskRequest{Description: "Plan trip", Priority: board_access.Priority{Urgent: true, Important: true}, WorkflowStatus: Todo})
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	second, err := laptop.CreateTask(TaskRequest{Description: "Book hotel", Priority: board_access.Priority{Urgent: true, Important: true}, WorkflowStatus: Todo})
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	if _, err := laptop.SyncBoard(""); err != nil {
+		t.Fatalf("Laptop sync failed: %v", err)
+	}
+
+	// The other clone receives the tasks and reports them as task events
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := desktop.SubscribeTaskEvents(ctx)
+
+	response, err := desktop.SyncBoard("")
+	if err != nil {
+		t.Fatalf("Desktop sync failed: %v", err)
+	}
+	if !response.FastForward || len(response.ChangedTaskIDs) != 2 {
+		t.Errorf("Expected both tasks to be pulled, got %+v", response)
+	}
+	select {
+	case event := <-events:
+		if event.Type != TaskUpdated || event.Task == nil {
+			t.Errorf("Expected an updated event for a pulled task, got %+v", event)
+		}
+	case <-time.After(5 * time.Second):
+		t.Error("Timed out waiting for a task event")
+	}
+
+	// Each clone changes a different task; syncing merges both changes
+	if _, err := desktop.UpdateTask(first.ID, TaskRequest{Description: "Plan trip to Rome", Priority: first.Priority, WorkflowStatus: Todo}, nil); err != nil {
+		t.Fatalf("Failed to update task on desktop: %v", err)
+	}
+	if _, err := desktop.SyncBoard(""); err != nil {
+		t.Fatalf("Desktop sync failed: %v", err)
+	}
+	if _, err := laptop.ChangeTaskStatus(second.ID, InProgress, nil); err != nil {
+		t.Fatalf("Failed to move task on laptop: %v", err)
+	}
+
+	response, err = laptop.SyncBoard("")
+	if err != nil {
+		t.Fatalf("Laptop sync failed: %v", err)
+	}
+	if response.FastForward || response.MergeCommit == "" || len(response.ChangedTaskIDs) != 1 || response.ChangedTaskIDs[0] != first.ID {
+		t.Errorf("Expected a merge pulling the edited task, got %+v", response)
+	}
+	if task, err := laptop.GetTask(first.ID); err != nil || task.Description != "Plan trip to Rome" {
+		t.Errorf("Expected the desktop edit on the laptop, got %+v (%v)", task, err)
+	}
+
+	// Pulled tasks can be changed again right away
+	if _, err := laptop.UpdateTask(first.ID, TaskRequest{Description: "Plan trip to Florence", Priority: first.Priority, WorkflowStatus: Todo}, nil); err != nil {
+		t.Errorf("Failed to update a pulled task: %v", err)
+	}
+	if _, err := laptop.SyncBoard(""); err != nil {
+		t.Fatalf("Laptop sync failed: %v", err)
+	}
+
+	if _, err := desktop.SyncBoard(""); err != nil {
+		t.Fatalf("Desktop sync failed: %v", err)
+	}
+	if task, err := desktop.GetTask(second.ID); err != nil || task.WorkflowStatus != InProgress {
+		t.Errorf("Expected the laptop move on the desktop, got %+v (%v)", task, err)
+	}
+	if task, err := desktop.GetTask(first.ID); err != nil || task.Description != "Plan trip to Florence" {
+		t.Errorf("Expected the latest laptop edit on the desktop, got %+v (%v)", task, err)
+	}
+}
+
+func TestIntegration_TaskManager_SyncBoardMergesTaskFields(t *testing.T) {
+	root := t.TempDir()
+	remotePath := filepath.Join(root, "remote.git")
+	if _, err := git.PlainInit(remotePath, true); err != nil {
+		t.Fatalf("Failed to create bare repository: %v", err)
+	}
+	laptopPath := filepath.Join(root, "laptop")
+	laptop := newSharedBoard(t, laptopPath, remotePath)
+	desktop := newSharedBoard(t, filepath.Join(root, "desktop"), remotePath)
+
+	task, err := laptop.CreateTask(TaskRequest{Description: "Plan trip", Priority: board_access.Priority{Urgent: true, Important: true}, WorkflowStatus: Todo})
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	laptop.SyncBoard("")
+	desktop.SyncBoard("")
+
+	// The desktop moves the task while the laptop renames it; both changes survive
+	if _, err := desktop.ChangeTaskStatus(task.ID, InProgress, nil); err != nil {
+		t.Fatalf("Failed to move task on desktop: %v", err)
+	}
+	if _, err := desktop.SyncBoard(""); err != nil {
+		t.Fatalf("Desktop sync failed: %v", err)
+	}
+	if _, err := laptop.UpdateTask(task.ID, TaskRequest{Description: "Plan trip to Rome", Priority: task.Priority, WorkflowStatus: Todo}, nil); err != nil {
+		t.Fatalf("Failed to update task on laptop: %v", err)
+	}
+
+	response, err := laptop.SyncBoard("")
+	if err != nil {
+		t.Fatalf("Expected the move and the rename to merge, got %v", err)
+	}
+	if response.MergeCommit == "" || len(response.Conflicts) != 0 {
+		t.Errorf("Expected a merge without conflicts, got %+v", response)
+	}
+	if merged, err := laptop.GetTask(task.ID); err != nil || merged.Description != "Plan trip to Rome" || merged.WorkflowStatus != InProgress {
+		t.Errorf("Expected the renamed task in progress, got %+v (%v)", merged, err)
+	}
+
+	// Renaming on both sides keeps the later title and reports the conflict
+	desktop.SyncBoard("")
+	if _, err := desktop.UpdateTask(task.ID, TaskRequest{Description: "Plan trip to Milan", Priority: task.Priority, WorkflowStatus: InProgress}, nil); err != nil {
+		t.Fatalf("Failed to update task on desktop: %v", err)
+	}
+	desktop.SyncBoard("")
+	if _, err := laptop.UpdateTask(task.ID, TaskRequest{Description: "Plan trip to Naples", Priority: task.Priority, WorkflowStatus: InProgress}, nil); err != nil {
+		t.Fatalf("Failed to update task on laptop: %v", err)
+	}
+
+	response, err = laptop.SyncBoard("")
+	if err != nil {
+		t.Fatalf("Laptop sync failed: %v", err)
+	}
+	var titleConflict *TaskFieldConflict
+	for i := range response.Conflicts {
+		if response.Conflicts[i].Field == "title" {
+			titleConflict = &response.Conflicts[i]
+		}
+	}
+	if titleConflict == nil || titleConflict.TaskID != task.ID || titleConflict.Resolution != ConflictKeptLocal || titleConflict.RemoteValue != "Plan trip to Milan" {
+		t.Errorf("Expected the local title to win the conflict, got %+v", response.Conflicts)
+	}
+	if merged, err := laptop.GetTask(task.ID); err != nil || merged.Description != "Plan trip to Naples" {
+		t.Errorf("Expected the later title, got %+v (%v)", merged, err)
+	}
+
+	repository, err := utilities.InitializeRepositoryWithConfig(laptopPath, &utilities.AuthorConfiguration{User: "Test User", Email: "test@example.com"})
+	if err != nil {
+		t.Fatalf("Failed to open repository: %v", err)
+	}
+	defer repository.Close()
+	history, err := repository.GetHistory(1)
+	if err != nil || len(history) != 1 || !strings.Contains(history[0].Message, "kept local \"Plan trip to Naples\"") {
+		t.Errorf("Expected the resolution in the merge commit, got %+v (%v)", history, err)
+	}
+}
+
+func TestIntegration_TaskManager_UndoRedo(t *testing.T) {
+	root := t.TempDir()
+	remotePath := filepath.Join(root, "remote.git")
+	if _, err := git.PlainInit(remotePath, true); err != nil {
+		t.Fatalf("Failed to create bare repository: %v", err)
+	}
+	taskManager := newSharedBoard(t, filepath.Join(root, "board"), remotePath)
+
+	if _, err := taskManager.Undo(); !errors.Is(err, ErrNothingToUndo) {
+		t.Fatalf("Expected nothing to undo on a new board, got %v", err)
+	}
+
+	task, err := taskManager.CreateTask(TaskRequest{Description: "Misdropped task", Priority: board_access.Priority{Urgent: true, Important: true}, WorkflowStatus: Todo})
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	if _, err := taskManager.ChangeTaskStatus(task.ID, InProgress, nil); err != nil {
+		t.Fatalf("Failed to move task: %v", err)
+	}
+
+	// Undoing the move puts the task back, undoing the creation removes it
+	response, err := taskManager.Undo()
+	if err != nil {
+		t.Fatalf("Undo failed: %v", err)
+	}
+	if response.Commit == "" || !response.CanUndo || !response.CanRedo || len(response.ChangedTaskIDs) != 1 || response.ChangedTaskIDs[0] != task.ID {
+		t.Errorf("Expected the move to be undone, got %+v", response)
+	}
+	if undone, err := taskManager.GetTask(task.ID); err != nil || undone.WorkflowStatus != Todo {
+		t.Errorf("Expected the task back in todo, got %+v (%v)", undone, err)
+	}
+	if response, err = taskManager.Undo(); err != nil || response.CanUndo {
+		t.Fatalf("Expected the creation to be undone last, got %+v (%v)", response, err)
+	}
+	if _, err := taskManager.GetTask(task.ID); !errors.Is(err, ErrTaskNotFound) {
+		t.Errorf("Expected the task to be gone, got %v", err)
+	}
+
+	// Redoing reapplies both operations in order
+	if _, err := taskManager.Redo(); err != nil {
+		t.Fatalf("Redo failed: %v", err)
+	}
+	if response, err = taskManager.Redo(); err != nil || response.CanRedo || !response.CanUndo {
+		t.Fatalf("Expected the move to be redone last, got %+v (%v)", response, err)
+	}
+	if redone, err := taskManager.GetTask(task.ID); err != nil || redone.WorkflowStatus != InProgress {
+		t.Errorf("Expected the task in progress again, got %+v (%v)", redone, err)
+	}
+	if _, err := taskManager.Redo(); !errors.Is(err, ErrNothingToRedo) {
+		t.Errorf("Expected nothing to redo, got %v", err)
+	}
+
+	// A new operation after undoing discards what could be redone
+	if _, err := taskManager.Undo(); err != nil {
+		t.Fatalf("Undo failed: %v", err)
+	}
+	if _, err := taskManager.UpdateTask(task.ID, TaskRequest{Description: "Renamed task", Priority: task.Priority, WorkflowStatus: Todo}, nil); err != nil {
+		t.Fatalf("Failed to update task: %v", err)
+	}
+	if _, err := taskManager.Redo(); !errors.Is(err, ErrNothingToRedo) {
+		t.Errorf("Expected the redo stack to be cleared, got %v", err)
+	}
+}
+
+func TestIntegration_TaskManager_TimeTravel(t *testing.T) {
+	root := t.TempDir()
+	remotePath := filepath.Join(root, "remote.git")
+	if _, err := git.PlainInit(remotePath, true); err != nil {
+		t.Fatalf("Failed to create bare repository: %v", err)
+	}
+	taskManager := newSharedBoard(t, filepath.Join(root, "board"), remotePath)
+
+	task, err := taskManager.CreateTask(TaskRequest{Description: "Original wording", Priority: board_access.Priority{Urgent: true, Important: true}, WorkflowStatus: Todo})
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	revisions, err := taskManager.ListBoardRevisions(1)
+	if err != nil || len(revisions) != 1 {
+		t.Fatalf("Expected the latest revision, got %+v (%v)", revisions, err)
+	}
+	created := revisions[0]
+
+	if _, err := taskManager.UpdateTask(task.ID, TaskRequest{Description: "Worse wording", Priority: task.Priority, WorkflowStatus: Todo}, nil); err != nil {
+		t.Fatalf("Failed to update task: %v", err)
+	}
+	if _, err := taskManager.ChangeTaskStatus(task.ID, InProgress, nil); err != nil {
+		t.Fatalf("Failed to move task: %v", err)
+	}
+
+	// The snapshot shows the board as it was without touching the current one
+	snapshot, err := taskManager.LoadBoardAt(created.Commit, time.Time{})
+	if err != nil {
+		t.Fatalf("LoadBoardAt failed: %v", err)
+	}
+	if snapshot.Revision.Commit != created.Commit || len(snapshot.Tasks) != 1 || snapshot.Tasks[0].Description != "Original wording" || snapshot.Tasks[0].WorkflowStatus != Todo {
+		t.Errorf("Expected the original task in todo, got %+v", snapshot)
+	}
+	if current, _ := taskManager.GetTask(task.ID); current.Description != "Worse wording" {
+		t.Errorf("Expected the current task to be unchanged, got %q", current.Description)
+	}
+
+	events := taskManager.SubscribeTaskEvents(context.Background())
+	restored, err := taskManager.RestoreTaskFrom(created.Commit, task.ID)
+	if err != nil {
+		t.Fatalf("RestoreTaskFrom failed: %v", err)
+	}
+	if restored.Description != "Original wording" || restored.WorkflowStatus != Todo {
+		t.Errorf("Expected the original task back in todo, got %+v", restored)
+	}
+	select {
+	case event := <-events:
+		if event.Type != TaskMoved || event.TaskID != task.ID || event.PreviousStatus != InProgress {
+			t.Errorf("Expected a move event for the restored task, got %+v", event)
+		}
+	case <-time.After(5 * time.Second):
+		t.Error("Timed out waiting for the restore event")
+	}
+
+	// The restore is an ordinary operation that can be undone
+	if response, err := taskManager.Undo(); err != nil || response.Description != `restore task "Original wording" from an earlier revision` {
+		t.Fatalf("Expected the restore to be undone, got %+v (%v)", response, err)
+	}
+	if current, _ := taskManager.GetTask(task.ID); current.Description != "Worse wording" || current.WorkflowStatus != InProgress {
+		t.Errorf("Expected the task as before the restore, got %+v", current)
+	}
+}
+
+func TestIntegration_TaskManager_ExecuteBatch(t *testing.T) {
+	root := t.TempDir()
+	remotePath := filepath.Join(root, "remote.git")
+	if _, err := git.PlainInit(remotePath, true); err != nil {
+		t.Fatalf("Failed to create bare repository: %v", err)
+	}
+	taskManager := newSharedBoard(t, filepath.Join(root, "board"), remotePath)
+
+	var taskIDs []string
+	for _, description := range []string{"Book flights", "Book hotel", "Renew passport"} {
+		task, err := taskManager.CreateTask(TaskRequest{Description: description, Priority: board_access.Priority{Urgent: true, Important: true}, WorkflowStatus: Todo})
+		if err != nil {
+			t.Fatalf("Failed to create task: %v", err)
+		}
+		taskIDs = append(taskIDs, task.ID)
+	}
+
+	// All tasks of a batch change in a single commit
+	response, err := taskManager.ExecuteBatch(BatchRequest{Operation: BatchChangeStatus, TaskIDs: taskIDs[:2], WorkflowStatus: InProgress})
+	if err != nil {
+		t.Fatalf("Batch status change failed: %v", err)
+	}
+	if response.Commit == "" || len(response.Tasks) != 2 || response.Tasks[0].WorkflowStatus != InProgress || response.Tasks[1].WorkflowStatus != InProgress {
+		t.Errorf("Expected both tasks in progress, got %+v", response)
+	}
+	revisions, err := taskManager.ListBoardRevisions(1)
+	if err != nil || len(revisions) != 1 || revisions[0].Commit != response.Commit || !strings.HasPrefix(revisions[0].Message, "Move 2 tasks to doing") {
+		t.Errorf("Expected the batch commit on top, got %+v (%v)", revisions, err)
+	}
+
+	// A failing task rolls back the tasks changed before it
+	_, err = taskManager.ExecuteBatch(BatchRequest{Operation: BatchArchive, TaskIDs: []string{taskIDs[0], "missing-task"}, CascadePolicy: board_access.ArchiveSubtasks})
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) || batchErr.TaskID != "missing-task" || !errors.Is(err, ErrTaskNotFound) {
+		t.Fatalf("Expected the batch to fail at the missing task, got %v", err)
+	}
+	if task, err := taskManager.GetTask(taskIDs[0]); err != nil || task.WorkflowStatus != InProgress {
+		t.Errorf("Expected the first task to stay on the board, got %+v (%v)", task, err)
+	}
+	if after, err := taskManager.ListBoardRevisions(1); err != nil || after[0].Commit != response.Commit {
+		t.Errorf("Expected no commit for the failed batch, got %+v (%v)", after, err)
+	}
+	if archived, err := taskManager.ListArchivedTasks(); err != nil || len(archived) != 0 {
+		t.Errorf("Expected nothing archived, got %+v (%v)", archived, err)
+	}
+
+	// Priority changes move todo tasks to the section of their new priority; the batch is undone as one operation
+	response, err = taskManager.ExecuteBatch(BatchRequest{Operation: BatchChangePriority, TaskIDs: []string{taskIDs[2], taskIDs[0]}, Priority: board_access.Priority{Important: true}})
+	if err != nil {
+		t.Fatalf("Batch priority change failed: %v", err)
+	}
+	if len(response.Tasks) != 2 || response.Tasks[0].Priority.Label != "not-urgent-important" || response.Tasks[1].WorkflowStatus != InProgress {
+		t.Errorf("Expected the new priority, got %+v", response.Tasks)
+	}
+	undo, err := taskManager.Undo()
+	if err != nil || undo.Description != "change priority of 2 tasks to not-urgent-important" || len(undo.ChangedTaskIDs) != 2 {
+		t.Fatalf("Expected the batch to be undone at once, got %+v (%v)", undo, err)
+	}
+	if task, err := taskManager.GetTask(taskIDs[2]); err != nil || !task.Priority.Urgent {
+		t.Errorf("Expected the previous priority back, got %+v (%v)", task, err)
+	}
+
+	if _, err := taskManager.ExecuteBatch(BatchRequest{Operation: "rename", TaskIDs: taskIDs}); err == nil {
+		t.Error("Expected an unknown batch operation to be rejected")
+	}
+}
+
+func TestIntegration_TaskManager_ArchiveCascadePolicy(t *testing.T) {
+	root := t.TempDir()
+	remotePath := filepath.Join(root, "remote.git")
+	if _, err := git.PlainInit(remotePath, true); err != nil {
+		t.Fatalf("Failed to create bare repository: %v", err)
+	}
+	taskManager := newSharedBoard(t, filepath.Join(root, "board"), remotePath)
+
+	priority := board_access.Priority{Urgent: true, Important: true}
+	parent, err := taskManager.CreateTask(TaskRequest{Description: "Move house", Priority: priority, WorkflowStatus: Todo})
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	subtask, err := taskManager.CreateTask(TaskRequest{Description: "Pack books", Priority: priority, WorkflowStatus: Todo, ParentTaskID: &parent.ID})
+	if err != nil {
+		t.Fatalf("Failed to create subtask: %v", err)
+	}
+
+	if _, err := taskManager.ArchiveTask(parent.ID, "orphan", nil); err == nil {
+		t.Error("Expected an unknown cascade policy to be rejected")
+	}
+
+	// Promoted subtasks stay on the board as top-level tasks
+	if _, err := taskManager.ArchiveTask(parent.ID, board_access.PromoteSubtasks, nil); err != nil {
+		t.Fatalf("Failed to archive task: %v", err)
+	}
+	if promoted, err := taskManager.GetTask(subtask.ID); err != nil || promoted.ParentTaskID != nil {
+		t.Errorf("Expected the subtask promoted to a top-level task, got %+v (%v)", promoted, err)
+	}
+	if archived, err := taskManager.ListArchivedTasks(); err != nil || len(archived) != 1 || archived[0].ID != parent.ID {
+		t.Errorf("Expected only the parent archived, got %+v (%v)", archived, err)
+	}
+
+	// Subtasks archived with their parent stay archived unless the restore brings them back
+	if _, err := taskManager.RestoreTask(parent.ID, board_access.NoAction); err != nil {
+		t.Fatalf("Failed to restore task: %v", err)
+	}
+	child, err := taskManager.CreateTask(TaskRequest{Description: "Label boxes", Priority: priority, WorkflowStatus: Todo, ParentTaskID: &parent.ID})
+	if err != nil {
+		t.Fatalf("Failed to create subtask: %v", err)
+	}
+	if _, err := taskManager.ArchiveTask(parent.ID, board_access.ArchiveSubtasks, nil); err != nil {
+		t.Fatalf("Failed to archive task: %v", err)
+	}
+	if _, err := taskManager.RestoreTask(parent.ID, board_access.NoAction); err != nil {
+		t.Fatalf("Failed to restore task: %v", err)
+	}
+	if _, err := taskManager.GetTask(child.ID); !errors.Is(err, ErrTaskNotFound) {
+		t.Errorf("Expected the subtask to stay archived, got %v", err)
+	}
+	if archived, err := taskManager.ListArchivedTasks(); err != nil || len(archived) != 1 || archived[0].ID != child.ID {
+		t.Errorf("Expected the subtask in the archive, got %+v (%v)", archived, err)
+	}
+}
+
+func TestIntegration_TaskManager_ExpectedUpdateTime(t *testing.T) {
+	root := t.TempDir()
+	remotePath := filepath.Join(root, "remote.git")
+	if _, err := git.PlainInit(remotePath, true); err != nil {
+		t.Fatalf("Failed to create bare repository: %v", err)
+	}
+	taskManager := newSharedBoard(t, filepath.Join(root, "board"), remotePath)
+
+	task, err := taskManager.CreateTask(TaskRequest{Description: "Write report", Priority: board_access.Priority{Urgent: true, Important: true}, WorkflowStatus: Todo})
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	stale := task.UpdatedAt
+	updated, err := taskManager.UpdateTask(task.ID, TaskRequest{Description: "Write final report", Priority: task.Priority, WorkflowStatus: Todo}, &stale)
+	if err != nil {
+		t.Fatalf("Expected the update with the current update time to succeed, got %v", err)
+	}
+
+	// Every modification expecting the former update time is rejected and leaves the task as it is
+	if _, err := taskManager.UpdateTask(task.ID, TaskRequest{Description: "Lost update", Priority: task.Priority, WorkflowStatus: Todo}, &stale); !errors.Is(err, ErrTaskModified) {
+		t.Errorf("Expected ErrTaskModified for the update, got %v", err)
+	}
+	if _, err := taskManager.ChangeTaskStatus(task.ID, InProgress, &stale); !errors.Is(err, ErrTaskModified) {
+		t.Errorf("Expected ErrTaskModified for the status change, got %v", err)
+	}
+	if _, err := taskManager.ArchiveTask(task.ID, board_access.ArchiveSubtasks, &stale); !errors.Is(err, ErrTaskModified) {
+		t.Errorf("Expected ErrTaskModified for the archival, got %v", err)
+	}
+	if err := taskManager.DeleteTask(task.ID, &stale); !errors.Is(err, ErrTaskModified) {
+		t.Errorf("Expected ErrTaskModified for the deletion, got %v", err)
+	}
+	if current, err := taskManager.GetTask(task.ID); err != nil || current.Description != "Write final report" || current.WorkflowStatus != Todo || !current.UpdatedAt.Equal(updated.UpdatedAt) {
+		t.Errorf("Expected the task to be unchanged, got %+v (%v)", current, err)
+	}
+
+	if err := taskManager.DeleteTask(task.ID, &updated.UpdatedAt); err != nil {
+		t.Errorf("Expected the deletion with the current update time to succeed, got %v", err)
+	}
+}
+
+func TestIntegration_TaskManager_AutomationRules(t *testing.T) {
+	root := t.TempDir()
+	remotePath := filepath.Join(root, "remote.git")
+	if _, err := git.PlainInit(remotePath, true); err != nil {
+		t.Fatalf("Failed to create bare repository: %v", err)
+	}
+	boardPath := filepath.Join(root, "board")
+	taskManager := newSharedBoard(t, boardPath, remotePath)
+
+	rulesAccess, err := resource_access.NewRulesAccess(boardPath)
+	if err != nil {
+		t.Fatalf("Failed to create RulesAccess: %v", err)
+	}
+	defer rulesAccess.Close()
+	automation := func(id, expression string, actions map[string]interface{}) resource_access.Rule {
+		return resource_access.Rule{ID: id, Name: id, Category: "automation", TriggerType: "all", Enabled: true,
+			Conditions: map[string]interface{}{resource_access.ConditionExpressionKey: expression}, Actions: actions}
+	}
+	rules := []resource_access.Rule{
+		automation("triage-new", `previous == null && !task.is_subtask && task.urgent && !("ping" in task.tags)`, map[string]interface{}{
+			resource_access.ActionAddTags:        []interface{}{"triage"},
+			resource_access.ActionCreateSubtasks: []interface{}{"Write reproduction"},
+		}),
+		automation("start-triage", `"triage" in task.tags && task.column == "todo"`, map[string]interface{}{resource_access.ActionMoveTo: "doing"}),
+		automation("ping", `"ping" in task.tags && task.column == "todo"`, map[string]interface{}{resource_access.ActionMoveTo: "doing"}),
+		automation("pong", `"ping" in task.tags && task.column == "doing"`, map[string]interface{}{resource_access.ActionMoveTo: "todo"}),
+		automation("due", `"due" in task.tags`, map[string]interface{}{resource_access.ActionSetDueInDays: 5}),
+		automation("checklist", `"checklist" in task.tags && !task.is_subtask`, map[string]interface{}{resource_access.ActionCreateSubtasks: []interface{}{"Draft", "Review", "Draft"}}),
+	}
+	if err := rulesAccess.ChangeRules(boardPath, &resource_access.RuleSet{Version: "1.0", Rules: rules}); err != nil {
+		t.Fatalf("Failed to store rules: %v", err)
+	}
+
+	// Tagging makes the follow-up rule move the task, all in one commit after the creation
+	task, err := taskManager.CreateTask(TaskRequest{Description: "Crash on startup", Priority: board_access.Priority{Urgent: true, Important: true}, WorkflowStatus: Todo})
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	current, err := taskManager.GetTask(task.ID)
+	if err != nil || len(current.Tags) != 1 || current.Tags[0] != "triage" || current.WorkflowStatus != InProgress {
+		t.Errorf("Expected the task tagged and in progress, got %+v (%v)", current, err)
+	}
+	subtasks, err := taskManager.ListTasks(QueryCriteria{ParentTaskID: &task.ID})
+	if err != nil || len(subtasks) != 1 || subtasks[0].Description != "Write reproduction" {
+		t.Errorf("Expected a follow-up subtask, got %+v (%v)", subtasks, err)
+	}
+	repository, err := git.PlainOpen(boardPath)
+	if err != nil {
+		t.Fatalf("Failed to open board repository: %v", err)
+	}
+	head, err := repository.Head()
+	if err != nil {
+		t.Fatalf("Failed to read HEAD: %v", err)
+	}
+	commit, err := repository.CommitObject(head.Hash())
+	if err != nil {
+		t.Fatalf("Failed to read the automation commit: %v", err)
+	}
+	for _, expected := range []string{"Rule 'triage-new' (triage-new): add tags triage to task 'Crash on startup'", "Rule 'start-triage' (start-triage): move task 'Crash on startup' to doing", "Rule-ID: triage-new", "Rule-ID: start-triage"} {
+		if !strings.Contains(commit.Message, expected) {
+			t.Errorf("Expected %q in the automation commit, got:\n%s", expected, commit.Message)
+		}
+	}
+
+	// Undoing the creation undoes the automation it triggered
+	if _, err := taskManager.Undo(); err != nil {
+		t.Fatalf("Undo failed: %v", err)
+	}
+	if _, err := taskManager.GetTask(task.ID); !errors.Is(err, ErrTaskNotFound) {
+		t.Errorf("Expected the task to be gone, got %v", err)
+	}
+	if tasks, err := taskManager.ListTasks(QueryCriteria{}); err != nil || len(tasks) != 0 {
+		t.Errorf("Expected no tasks left, got %+v (%v)", tasks, err)
+	}
+
+	// Rules triggering each other are applied once per task
+	looping, err := taskManager.CreateTask(TaskRequest{Description: "Ping pong", Priority: board_access.Priority{Important: true}, WorkflowStatus: Todo, Tags: []string{"ping"}})
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	if current, err := taskManager.GetTask(looping.ID); err != nil || current.WorkflowStatus != Todo {
+		t.Errorf("Expected the task back in todo after one round, got %+v (%v)", current, err)
+	}
+
+	// A due date is only set for tasks without one, so later edits keep the date chosen by the user
+	release, err := taskManager.CreateTask(TaskRequest{Description: "Release notes", Priority: board_access.Priority{Important: true}, WorkflowStatus: Todo, Tags: []string{"due"}})
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	current, err = taskManager.GetTask(release.ID)
+	if err != nil || current.Deadline == nil || current.Deadline.Format("2006-01-02") != time.Now().AddDate(0, 0, 5).Format("2006-01-02") {
+		t.Fatalf("Expected a due date in five days, got %+v (%v)", current, err)
+	}
+	chosen := time.Now().AddDate(0, 1, 0).Truncate(time.Second)
+	request := taskRequestFrom(current)
+	request.Deadline = &chosen
+	if _, err := taskManager.UpdateTask(release.ID, request, nil); err != nil {
+		t.Fatalf("Failed to update task: %v", err)
+	}
+	if current, err := taskManager.GetTask(release.ID); err != nil || current.Deadline == nil || !current.Deadline.Equal(chosen) {
+		t.Errorf("Expected the chosen due date %v to be kept, got %+v (%v)", chosen, current, err)
+	}
+
+	// A rule triggering again for the same task does not create its subtasks twice
+	checklist, err := taskManager.CreateTask(TaskRequest{Description: "Checklist", Priority: board_access.Priority{Important: true}, WorkflowStatus: Todo, Tags: []string{"checklist"}})
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	if subtasks, err := taskManager.ListTasks(QueryCriteria{ParentTaskID: &checklist.ID}); err != nil || len(subtasks) != 2 {
+		t.Fatalf("Expected the subtasks Draft and Review, got %+v (%v)", subtasks, err)
+	}
+	current, err = taskManager.GetTask(checklist.ID)
+	if err != nil {
+		t.Fatalf("Failed to get task: %v", err)
+	}
+	request = taskRequestFrom(current)
+	request.Description = "Checklist for the release"
+	if _, err := taskManager.UpdateTask(checklist.ID, request, nil); err != nil {
+		t.Fatalf("Failed to update task: %v", err)
+	}
+	if subtasks, err := taskManager.ListTasks(QueryCriteria{ParentTaskID: &checklist.ID}); err != nil || len(subtasks) != 2 {
+		t.Errorf("Expected the rule to create no further subtasks, got %+v (%v)", subtasks, err)
+	}
+}
+
+func TestIntegration_TaskManager_RuleScheduler(t *testing.T) {
+	root := t.TempDir()
+	remotePath := filepath.Join(root, "remote.git")
+	if _, err := git.PlainInit(remotePath, true); err != nil {
+		t.Fatalf("Failed to create bare repository: %v", err)
+	}
+	boardPath := filepath.Join(root, "board")
+	taskManager := newSharedBoard(t, boardPath, remotePath)
+
+	rulesAccess, err := resource_access.NewRulesAccess(boardPath)
+	if err != nil {
+		t.Fatalf("Failed to create RulesAccess: %v", err)
+	}
+	defer rulesAccess.Close()
+	rules := []resource_access.Rule{
+		{ID: "due-soon", Name: "Due Soon", Category: "notification", TriggerType: resource_access.TriggerDueDate, Enabled: true,
+			Conditions: map[string]interface{}{resource_access.ConditionDueWithinDays: 1}, Actions: map[string]interface{}{"message": "Due tomorrow"}},
+		{ID: "stale", Name: "Stale", Category: "automation", TriggerType: resource_access.TriggerColumnAge, Enabled: true,
+			Conditions: map[string]interface{}{resource_access.ConditionMaxAgeDays: 7}, Actions: map[string]interface{}{resource_access.ActionAddTags: []interface{}{"stale"}}},
+	}
+	if err := rulesAccess.ChangeRules(boardPath, &resource_access.RuleSet{Version: "1.0", Rules: rules}); err != nil {
+		t.Fatalf("Failed to store rules: %v", err)
+	}
+
+	clock := utilities.NewManualClock(time.Now())
+	deadline := clock.Now().AddDate(0, 0, 3)
+	report, err := taskManager.CreateTask(TaskRequest{Description: "Quarterly report", Priority: board_access.Priority{Urgent: true, Important: true}, WorkflowStatus: Todo, Deadline: &deadline})
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	promotion := clock.Now().AddDate(0, 0, 1)
+	planning, err := taskManager.CreateTask(TaskRequest{Description: "Plan next quarter", Priority: board_access.Priority{Important: true}, WorkflowStatus: Todo, PriorityPromotionDate: &promotion})
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+
+	runs := make(chan ScheduledRulesResponse, 10)
+	scheduler := NewRuleScheduler(taskManager, clock, time.Hour, utilities.NewLoggingUtility())
+	scheduler.SetRunHandler(func(response ScheduledRulesResponse, err error) {
+		if err != nil {
+			t.Errorf("Scheduled run failed: %v", err)
+		}
+		runs <- response
+	})
+	next := func() ScheduledRulesResponse {
+		t.Helper()
+		select {
+		case response := <-runs:
+			return response
+		case <-time.After(5 * time.Second):
+			t.Fatal("Timed out waiting for a scheduled run")
+		}
+		return ScheduledRulesResponse{}
+	}
+	advance := func(d time.Duration) {
+		t.Helper()
+		for start := time.Now(); clock.Waiters() == 0; time.Sleep(time.Millisecond) {
+			if time.Since(start) > 5*time.Second {
+				t.Fatal("Timed out waiting for the scheduler to wait for the next run")
+			}
+		}
+		clock.Advance(d)
+	}
+
+	if err := scheduler.Start(); err != nil {
+		t.Fatalf("Failed to start scheduler: %v", err)
+	}
+	defer scheduler.Stop()
+	if err := scheduler.Start(); !errors.Is(err, ErrSchedulerRunning) {
+		t.Errorf("Expected a running scheduler not to start again, got %v", err)
+	}
+
+	// The first run happens right away; nothing is due yet
+	if response := next(); len(response.Triggered) != 0 || len(response.Promoted) != 0 || !response.RunAt.Equal(clock.Now()) {
+		t.Errorf("Expected an empty first run at the clock's time, got %+v", response)
+	}
+
+	// Two days later the report is due within a day and the planning task is promoted
+	advance(48 * time.Hour)
+	response := next()
+	if len(response.Triggered) != 1 || response.Triggered[0].RuleID != "due-soon" || response.Triggered[0].TaskID != report.ID || response.Triggered[0].Message != "Due tomorrow" {
+		t.Errorf("Expected the due date notification, got %+v", response.Triggered)
+	}
+	if len(response.Promoted) != 1 || response.Promoted[0].ID != planning.ID || !response.Promoted[0].Priority.Urgent {
+		t.Errorf("Expected the planning task to be promoted, got %+v", response.Promoted)
+	}
+
+	// A rule that still holds does not trigger again
+	advance(time.Hour)
+	if response := next(); len(response.Triggered) != 0 {
+		t.Errorf("Expected no repeated triggers, got %+v", response.Triggered)
+	}
+
+	// After a week in todo both tasks are tagged as stale
+	advance(6 * 24 * time.Hour)
+	response = next()
+	if len(response.Triggered) != 2 || response.Triggered[0].RuleID != "stale" || response.Triggered[1].RuleID != "stale" {
+		t.Errorf("Expected both tasks to become stale, got %+v", response.Triggered)
+	}
+	for _, taskID := range []string{report.ID, planning.ID} {
+		if task, err := taskManager.GetTask(taskID); err != nil || len(task.Tags) != 1 || task.Tags[0] != "stale" {
+			t.Errorf("Expected task %s to be tagged stale, got %+v (%v)", taskID, task, err)
+		}
+	}
+
+	scheduler.Stop()
+	clock.Advance(time.Hour)
+	select {
+	case response := <-runs:
+		t.Errorf("Expected no run after stopping, got %+v", response)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestIntegration_TaskManager_RuleSchedulerAfterRestart(t *testing.T) {
+	boardPath := t.TempDir()
+	open := func() (TaskManager, func()) {
+		t.Helper()
+		boardAccess, err := board_access.NewBoardAccess(boardPath)
+		if err != nil {
+			t.Fatalf("Failed to create BoardAccess: %v", err)
+		}
+		rulesAccess, err := resource_access.NewRulesAccess(boardPath)
+		if err != nil {
+			t.Fatalf("Failed to create RulesAccess: %v", err)
+		}
+		ruleEngine, err := engines.NewRuleEngine(rulesAccess, boardAccess)
+		if err != nil {
+			t.Fatalf("Failed to create RuleEngine: %v", err)
+		}
+		repository, err := utilities.InitializeRepositoryWithConfig(boardPath, &utilities.AuthorConfiguration{User: "Test User", Email: "test@example.com"})
+		if err != nil {
+			t.Fatalf("Failed to create repository: %v", err)
+		}
+		return NewTaskManager(boardAccess, ruleEngine, utilities.NewLoggingUtility(), repository, boardPath), func() {
+			repository.Close()
+			ruleEngine.Close()
+			rulesAccess.Close()
+			boardAccess.Close()
+		}
+	}
+
+	taskManager, closeBoard := open()
+	rulesAccess, err := resource_access.NewRulesAccess(boardPath)
+	if err != nil {
+		t.Fatalf("Failed to create RulesAccess: %v", err)
+	}
+	defer rulesAccess.Close()
+	rules := []resource_access.Rule{
+		{ID: "due-soon", Name: "Due Soon", Category: "notification", TriggerType: resource_access.TriggerDueDate, Enabled: true,
+			Conditions: map[string]interface{}{resource_access.ConditionDueWithinDays: 1}, Actions: map[string]interface{}{"message": "Due tomorrow"}},
+	}
+	if err := rulesAccess.ChangeRules(boardPath, &resource_access.RuleSet{Version: "1.0", Rules: rules}); err != nil {
+		t.Fatalf("Failed to store rules: %v", err)
+	}
+
+	now := time.Now()
+	deadline := now.Add(12 * time.Hour)
+	report, err := taskManager.CreateTask(TaskRequest{Description: "Quarterly report", Priority: board_access.Priority{Urgent: true, Important: true}, WorkflowStatus: Todo, Deadline: &deadline})
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	if response, err := taskManager.RunScheduledRules(now); err != nil || len(response.Triggered) != 1 || response.Triggered[0].TaskID != report.ID {
+		t.Fatalf("Expected the due date notification, got %+v (%v)", response, err)
+	}
+	closeBoard()
+
+	// A restarted application remembers the rules that already hold and does not trigger them again
+	taskManager, closeBoard = open()
+	defer closeBoard()
+	if response, err := taskManager.RunScheduledRules(now.Add(time.Minute)); err != nil || len(response.Triggered) != 0 {
+		t.Errorf("Expected no repeated triggers after the restart, got %+v (%v)", response, err)
+	}
+
+	// Rules that start to hold after the restart still trigger
+	review, err := taskManager.CreateTask(TaskRequest{Description: "Code review", Priority: board_access.Priority{Urgent: true, Important: true}, WorkflowStatus: Todo, Deadline: &deadline})
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	if response, err := taskManager.RunScheduledRules(now.Add(2 * time.Minute)); err != nil || len(response.Triggered) != 1 || response.Triggered[0].TaskID != review.ID {
+		t.Errorf("Expected only the new task's notification, got %+v (%v)", response, err)
+	}
+}
+
+func TestIntegration_TaskManager_RuleNotifications(t *testing.T) {
+	root := t.TempDir()
+	remotePath := filepath.Join(root, "remote.git")
+	if _, err := git.PlainInit(remotePath, true); err != nil {
+		t.Fatalf("Failed to create bare repository: %v", err)
+	}
+	boardPath := filepath.Join(root, "board")
+	taskManager := newSharedBoard(t, boardPath, remotePath)
+
+	rulesAccess, err := resource_access.NewRulesAccess(boardPath)
+	if err != nil {
+		t.Fatalf("Failed to create RulesAccess: %v", err)
+	}
+	defer rulesAccess.Close()
+	rules := []resource_access.Rule{
+		{ID: "blocked", Name: "Blocked", Category: "notification", TriggerType: "all", Enabled: true,
+			Conditions: map[string]interface{}{resource_access.ConditionExpressionKey: `"blocked" in task.tags`}, Actions: map[string]interface{}{"notify": true}},
+		{ID: "block-outages", Name: "Block Outages", Category: "automation", TriggerType: "all", Enabled: true,
+			Conditions: map[string]interface{}{resource_access.ConditionExpressionKey: `"outage" in task.title`},
+			Actions:    map[string]interface{}{resource_access.ActionAddTags: []interface{}{"blocked"}}},
+		{ID: "due-soon", Name: "Due Soon", Category: "notification", TriggerType: resource_access.TriggerDueDate, Enabled: true,
+			Conditions: map[string]interface{}{resource_access.ConditionDueWithinDays: 1}, Actions: map[string]interface{}{"notify": true}},
+	}
+	if err := rulesAccess.ChangeRules(boardPath, &resource_access.RuleSet{Version: "1.0", Rules: rules}); err != nil {
+		t.Fatalf("Failed to store rules: %v", err)
+	}
+
+	clock := utilities.NewManualClock(time.Now())
+	dispatcher := utilities.NewNotificationDispatcher(clock, time.Hour, utilities.NewLoggingUtility())
+	var delivered []utilities.Notification
+	deliveries := make(chan struct{}, 10)
+	dispatcher.AddNotifier(utilities.NotifierFunc(func(notification utilities.Notification) error {
+		delivered = append(delivered, notification)
+		deliveries <- struct{}{}
+		return nil
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := taskManager.SubscribeRuleNotifications(ctx)
+	done := make(chan struct{})
+	go func() {
+		DeliverRuleNotifications(events, dispatcher, utilities.NewLoggingUtility())
+		close(done)
+	}()
+
+	// A change triggers the notification, a repeated one is delivered once
+	blocked, err := taskManager.CreateTask(TaskRequest{Description: "Fix login", Priority: board_access.Priority{Urgent: true, Important: true}, WorkflowStatus: Todo, Tags: []string{"blocked"}})
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	request := taskRequestFrom(blocked)
+	request.Description = "Fix login page"
+	if _, err := taskManager.UpdateTask(blocked.ID, request, nil); err != nil {
+		t.Fatalf("Failed to update task: %v", err)
+	}
+
+	// Changes made by automation trigger notifications as well
+	outage, err := taskManager.CreateTask(TaskRequest{Description: "Database outage", Priority: board_access.Priority{Urgent: true, Important: true}, WorkflowStatus: Todo})
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+
+	// Time-triggered notification rules are sent by scheduled runs
+	deadline := clock.Now().Add(time.Hour)
+	report, err := taskManager.CreateTask(TaskRequest{Description: "Quarterly report", Priority: board_access.Priority{Important: true}, WorkflowStatus: Todo, Deadline: &deadline})
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	if _, err := taskManager.RunScheduledRules(clock.Now()); err != nil {
+		t.Fatalf("RunScheduledRules() error = %v", err)
+	}
+
+	// Notifications are delivered from a queue of their own, wait for them before unsubscribing
+	for i := 0; i < 3; i++ {
+		select {
+		case <-deliveries:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Expected 3 notifications, got %d", i)
+		}
+	}
+	cancel()
+	<-done
+
+	var got []string
+	for _, notification := range delivered {
+		got = append(got, notification.Key+": "+notification.Title+": "+notification.Message)
+	}
+	expected := []string{
+		"blocked/" + blocked.ID + ": Blocked: Rule 'Blocked' triggered for task 'Fix login'",
+		"blocked/" + outage.ID + ": Blocked: Rule 'Blocked' triggered for task 'Database outage'",
+		"due-soon/" + report.ID + ": Due Soon: Task 'Quarterly report' is due on " + deadline.Format("2006-01-02"),
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected notifications %v, got %v", expected, got)
+	}
+}
@@ -2,10 +2,13 @@ package task_manager
 
 import (
 	"context"
+	"fmt"
+	"reflect"
 	"testing"
 	"time"
 
 	"github.com/rknuus/eisenkan/internal/engines"
+	"github.com/rknuus/eisenkan/internal/resource_access"
 	"github.com/rknuus/eisenkan/internal/resource_access/board_access"
 	"github.com/rknuus/eisenkan/internal/utilities"
 )
@@ -57,12 +60,28 @@ func (m *MockBoardAccess) RemoveTask(taskID string, cascadePolicy board_access.C
 	return nil
 }
 
+func (m *MockBoardAccess) ListArchivedTasks() ([]*board_access.ArchivedTask, error) {
+	return []*board_access.ArchivedTask{}, nil
+}
+
+func (m *MockBoardAccess) RestoreTask(taskID string, cascadePolicy board_access.CascadePolicy) error {
+	return nil
+}
+
+func (m *MockBoardAccess) PurgeArchive(olderThan time.Duration) ([]string, error) {
+	return []string{}, nil
+}
+
 func (m *MockBoardAccess) FindTasks(criteria *board_access.QueryCriteria) ([]*board_access.TaskWithTimestamps, error) {
 	return []*board_access.TaskWithTimestamps{}, nil
 }
 
-func (m *MockBoardAccess) GetTaskHistory(taskID string, limit int) ([]utilities.CommitInfo, error) {
-	return []utilities.CommitInfo{}, nil
+func (m *MockBoardAccess) GetTaskHistory(taskID string, limit int) ([]board_access.TaskRevision, error) {
+	return []board_access.TaskRevision{}, nil
+}
+
+func (m *MockBoardAccess) GetTaskTimeline(taskID string) ([]board_access.TaskTimelineEntry, error) {
+	return []board_access.TaskTimelineEntry{}, nil
 }
 
 func (m *MockBoardAccess) GetSubtasks(parentTaskID string) ([]*board_access.TaskWithTimestamps, error) {
@@ -91,10 +110,77 @@ func (m *MockBoardAccess) GetRulesData(taskID string, targetColumns []string) (*
 	return &board_access.RulesData{}, nil
 }
 
+func (m *MockBoardAccess) GetColumnWIPData(column string) (*board_access.ColumnWIPData, error) {
+	return &board_access.ColumnWIPData{Column: column}, nil
+}
+
 func (m *MockBoardAccess) Close() error {
 	return nil
 }
 
+// IWatch facet mock methods
+func (m *MockBoardAccess) WatchBoard(ctx context.Context) (<-chan board_access.BoardChange, error) {
+	changes := make(chan board_access.BoardChange)
+	close(changes)
+	return changes, nil
+}
+
+func (m *MockBoardAccess) LockStatus() board_access.BoardLockStatus {
+	return board_access.BoardLockStatus{}
+}
+
+func (m *MockBoardAccess) ListRemotes() ([]utilities.RemoteInfo, error) {
+	return []utilities.RemoteInfo{}, nil
+}
+
+func (m *MockBoardAccess) AddRemote(name, url string) error {
+	return nil
+}
+
+func (m *MockBoardAccess) RemoveRemote(name string) error {
+	return nil
+}
+
+func (m *MockBoardAccess) SyncBoard(remote string) (*board_access.SyncResult, error) {
+	return &board_access.SyncResult{Remote: remote, UpToDate: true}, nil
+}
+
+func (m *MockBoardAccess) CurrentRevision() (string, error) {
+	return "", nil
+}
+
+func (m *MockBoardAccess) RevertChanges(from, to, message string) (*board_access.RevertResult, error) {
+	return &board_access.RevertResult{Previous: to}, nil
+}
+
+func (m *MockBoardAccess) ListRevisions(limit int) ([]utilities.CommitInfo, error) {
+	return []utilities.CommitInfo{}, nil
+}
+
+func (m *MockBoardAccess) LoadBoardAt(point board_access.HistoryPoint) (*board_access.BoardSnapshot, error) {
+	return &board_access.BoardSnapshot{Revision: utilities.CommitInfo{ID: point.Commit}, Tasks: []*board_access.TaskWithTimestamps{}}, nil
+}
+
+func (m *MockBoardAccess) RestoreTaskFrom(commit, taskID string) (*board_access.RestoreResult, error) {
+	return &board_access.RestoreResult{Task: &board_access.TaskWithTimestamps{Task: &board_access.Task{ID: taskID}}}, nil
+}
+
+func (m *MockBoardAccess) Begin(description string) error {
+	return nil
+}
+
+func (m *MockBoardAccess) Commit() (string, error) {
+	return "", nil
+}
+
+func (m *MockBoardAccess) Rollback() error {
+	return nil
+}
+
+func (m *MockBoardAccess) Annotate(note string, trailers ...utilities.CommitTrailer) error {
+	return nil
+}
+
 // IConfiguration facet mock methods
 func (m *MockBoardAccess) Load(configType string, identifier string) (board_access.ConfigurationData, error) {
 	return board_access.ConfigurationData{
@@ -120,9 +206,18 @@ func (m *MockBoardAccess) ExtractMetadata(ctx context.Context, boardPath string)
 	return &board_access.BoardMetadata{
 		Title:     "Mock Board",
 		TaskCount: 0,
+		Configuration: &board_access.BoardConfiguration{
+			Columns:        []string{"todo", "doing", "done"},
+			ColumnNames:    map[string]string{"doing": "In Progress"},
+			ColumnSettings: map[string]board_access.ColumnSettings{"doing": {Color: "orange", WIPLimit: 3, ExitPolicy: "Reviewed"}},
+		},
 	}, nil
 }
 
+func (m *MockBoardAccess) GetFlowMetrics(ctx context.Context, boardPath string, dateRange *board_access.DateRange) (*board_access.FlowMetrics, error) {
+	return &board_access.FlowMetrics{}, nil
+}
+
 func (m *MockBoardAccess) GetStatistics(ctx context.Context, boardPath string) (*board_access.BoardStatistics, error) {
 	return &board_access.BoardStatistics{
 		TotalTasks:    0,
@@ -140,6 +235,11 @@ func (m *MockBoardAccess) ValidateStructure(ctx context.Context, boardPath strin
 	}, nil
 }
 
+func (m *MockBoardAccess) RepairBoard(ctx context.Context) (*board_access.BoardRepairResult, error) {
+	validation, _ := m.ValidateStructure(ctx, "")
+	return &board_access.BoardRepairResult{Validation: validation}, nil
+}
+
 func (m *MockBoardAccess) LoadConfiguration(ctx context.Context, boardPath string, configType string) (map[string]interface{}, error) {
 	return map[string]interface{}{
 		"name":    "Mock Board",
@@ -210,6 +310,58 @@ func (m *MockRepository) GetFileDifferences(hash1, hash2 string) ([]byte, error)
 	return []byte{}, nil
 }
 
+func (m *MockRepository) GetChangeHistory(limit int) ([]utilities.CommitChanges, error) {
+	return []utilities.CommitChanges{}, nil
+}
+
+func (m *MockRepository) GetChangeHistorySince(hash string) ([]utilities.CommitChanges, bool, error) {
+	return []utilities.CommitChanges{}, false, nil
+}
+
+func (m *MockRepository) AddRemote(name, url string) error {
+	return nil
+}
+
+func (m *MockRepository) RemoveRemote(name string) error {
+	return nil
+}
+
+func (m *MockRepository) ListRemotes() ([]utilities.RemoteInfo, error) {
+	return []utilities.RemoteInfo{}, nil
+}
+
+func (m *MockRepository) Fetch(remote string) error {
+	return nil
+}
+
+func (m *MockRepository) Pull(remote string) (*utilities.PullResult, error) {
+	return &utilities.PullResult{Remote: remote, UpToDate: true}, nil
+}
+
+func (m *MockRepository) GetSnapshot(hash string) (*utilities.CommitSnapshot, error) {
+	return &utilities.CommitSnapshot{Commit: utilities.CommitInfo{ID: hash}, Files: map[string][]byte{}}, nil
+}
+
+func (m *MockRepository) GetFileAt(hash, filePath string) ([]byte, error) {
+	return []byte{}, nil
+}
+
+func (m *MockRepository) RevertChanges(from, to, message string) (*utilities.RevertResult, error) {
+	return &utilities.RevertResult{Previous: to}, nil
+}
+
+func (m *MockRepository) DiscardChanges(paths []string) ([]string, error) {
+	return []string{}, nil
+}
+
+func (m *MockRepository) PullWithMergeDriver(remote string, driver utilities.MergeDriver) (*utilities.PullResult, error) {
+	return m.Pull(remote)
+}
+
+func (m *MockRepository) Push(remote string) error {
+	return nil
+}
+
 func (m *MockRepository) ValidateRepositoryAndPaths(request utilities.RepositoryValidationRequest) (*utilities.RepositoryValidationResult, error) {
 	return &utilities.RepositoryValidationResult{
 		RepositoryValid: true,
@@ -239,6 +391,14 @@ func (m *MockRuleEngine) EvaluateBoardConfigurationChange(ctx context.Context, e
 	}, nil
 }
 
+func (m *MockRuleEngine) EvaluateTimeTriggers(ctx context.Context, boardPath string, now time.Time) ([]engines.TimeTrigger, error) {
+	return nil, nil
+}
+
+func (m *MockRuleEngine) SimulateRules(ctx context.Context, ruleSet *resource_access.RuleSet, boardPath string, since time.Time) (*engines.SimulationReport, error) {
+	return &engines.SimulationReport{Since: since}, nil
+}
+
 func (m *MockRuleEngine) Close() error {
 	return nil
 }
@@ -353,6 +513,16 @@ func TestIntegration_TaskManager_GetBoardMetadata(t *testing.T) {
 	if response.TaskCount != 0 {
 		t.Errorf("Expected task count to be 0, got %d", response.TaskCount)
 	}
+
+	// Columns carry their settings, the last column is done when none is marked done
+	expected := []BoardColumn{
+		{ID: "todo"},
+		{ID: "doing", Name: "In Progress", Color: "orange", WIPLimit: 3, ExitPolicy: "Reviewed"},
+		{ID: "done", Done: true},
+	}
+	if !reflect.DeepEqual(response.Columns, expected) {
+		t.Errorf("Expected columns %+v, got %+v", expected, response.Columns)
+	}
 }
 
 // TestIntegration_TaskManager_CreateBoard tests OP-11 board creation
@@ -520,4 +690,80 @@ func TestIntegration_TaskManager_BoardOperationsWorkflow(t *testing.T) {
 	if !deleteResponse.Success {
 		t.Fatalf("Expected board deletion to succeed")
 	}
+}
+
+func TestUnit_TaskManager_SlowSubscriberIsToldToReload(t *testing.T) {
+	hub := newTaskEventHub(utilities.NewLoggingUtility())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := hub.SubscribeTaskEvents(ctx)
+
+	// Overflow the subscriber's buffer without reading
+	for i := 0; i < taskEventBufferSize+10; i++ {
+		hub.publish(TaskEvent{Type: TaskUpdated, TaskID: "task"})
+	}
+
+	for i := 0; i < taskEventBufferSize; i++ {
+		if event := <-events; event.Type != TaskUpdated {
+			t.Fatalf("Expected buffered event %d to be delivered, got %s", i, event.Type)
+		}
+	}
+	select {
+	case event := <-events:
+		if event.Type != BoardReloaded {
+			t.Fatalf("Expected a reload event after the dropped events, got %s", event.Type)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected a reload event after the dropped events")
+	}
+
+	// Once caught up, events are delivered again
+	for resyncing := true; resyncing; {
+		hub.mu.Lock()
+		for _, sub := range hub.subscribers {
+			resyncing = sub.resync
+		}
+		hub.mu.Unlock()
+		time.Sleep(time.Millisecond)
+	}
+	hub.publish(TaskEvent{Type: TaskCreated, TaskID: "next"})
+	if event := <-events; event.Type != TaskCreated || event.TaskID != "next" {
+		t.Errorf("Expected events to be delivered after the reload, got %+v", event)
+	}
+}
+
+func TestUnit_TaskManager_RuleNotificationsAreNotDropped(t *testing.T) {
+	hub := newTaskEventHub(utilities.NewLoggingUtility())
+	ctx, cancel := context.WithCancel(context.Background())
+	notifications := hub.SubscribeRuleNotifications(ctx)
+
+	// Notifications queue up behind a slow notifier, other events are not queued
+	count := taskEventBufferSize * 2
+	for i := 0; i < count; i++ {
+		hub.publish(TaskEvent{Type: TaskUpdated, TaskID: "task"})
+		hub.publish(TaskEvent{Type: RuleNotified, TaskID: fmt.Sprintf("task-%d", i), Notification: &RuleNotification{RuleID: "notify"}})
+	}
+	for i := 0; i < count; i++ {
+		if event := <-notifications; event.Type != RuleNotified || event.TaskID != fmt.Sprintf("task-%d", i) {
+			t.Fatalf("Expected notification %d, got %+v", i, event)
+		}
+	}
+
+	cancel()
+	if _, ok := <-notifications; ok {
+		t.Error("Expected the notifications to be closed once ctx is done")
+	}
+
+	// Notifications of a task event stream are queued the same way
+	events := make(chan TaskEvent, 2)
+	events <- TaskEvent{Type: TaskCreated, TaskID: "task"}
+	events <- TaskEvent{Type: RuleNotified, TaskID: "task"}
+	close(events)
+	var queued []TaskEvent
+	for event := range QueueRuleNotifications(context.Background(), events) {
+		queued = append(queued, event)
+	}
+	if len(queued) != 1 || queued[0].Type != RuleNotified {
+		t.Errorf("Expected only the notification to be queued, got %+v", queued)
+	}
 }
\ No newline at end of file
@@ -0,0 +1,151 @@
+// Package managers provides Manager layer components implementing the iDesign methodology.
+// This file implements undo and redo of board operations through revert commits in the board history.
+package task_manager
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// maxUndoDepth is the number of board operations that can be undone
+const maxUndoDepth = 50
+
+// ErrNothingToUndo reports that no board operation is left to undo
+var ErrNothingToUndo = errors.New("nothing to undo")
+
+// ErrNothingToRedo reports that no undone board operation is left to redo
+var ErrNothingToRedo = errors.New("nothing to redo")
+
+// UndoResponse describes an undone or redone board operation
+type UndoResponse struct {
+	Description    string   `json:"description"`      // operation that was undone or redone
+	Commit         string   `json:"commit,omitempty"` // revert commit on the board
+	ChangedTaskIDs []string `json:"changed_task_ids,omitempty"`
+	CanUndo        bool     `json:"can_undo"`
+	CanRedo        bool     `json:"can_redo"`
+}
+
+// undoEntry is a board operation given by the commits between two board revisions
+type undoEntry struct {
+	from        string
+	to          string
+	description string
+}
+
+// undoHistory holds the operations that can be undone and redone; guarded by taskManager.mu
+type undoHistory struct {
+	undo []undoEntry
+	redo []undoEntry
+}
+
+// pushUndoEntry adds an entry to a stack, dropping the oldest entries beyond maxUndoDepth
+func pushUndoEntry(stack []undoEntry, entry undoEntry) []undoEntry {
+	stack = append(stack, entry)
+	if len(stack) > maxUndoDepth {
+		stack = stack[len(stack)-maxUndoDepth:]
+	}
+	return stack
+}
+
+// popUndoEntry removes the latest entry from a stack
+func popUndoEntry(stack []undoEntry) ([]undoEntry, undoEntry, bool) {
+	if len(stack) == 0 {
+		return stack, undoEntry{}, false
+	}
+	return stack[:len(stack)-1], stack[len(stack)-1], true
+}
+
+// boardRevision is the board revision captured before an operation; an empty hash stands for the empty board
+type boardRevision struct {
+	hash  string
+	known bool
+}
+
+// currentRevision returns the board revision before an operation; operations starting from an unknown revision cannot be undone
+func (tm *taskManager) currentRevision() boardRevision {
+	hash, err := tm.boardAccess.CurrentRevision()
+	if err != nil {
+		tm.logger.LogMessage(utilities.Warning, "TaskManager", fmt.Sprintf("Operation cannot be undone, failed to read board revision: %v", err))
+		return boardRevision{}
+	}
+	return boardRevision{hash: hash, known: true}
+}
+
+// recordOperation makes the commits an operation added since revision before undoable; a new operation discards the redo stack
+func (tm *taskManager) recordOperation(before boardRevision, description string) {
+	if !before.known {
+		return
+	}
+	after := tm.currentRevision()
+	if !after.known || after.hash == "" || after.hash == before.hash {
+		return
+	}
+	tm.history.undo = pushUndoEntry(tm.history.undo, undoEntry{from: before.hash, to: after.hash, description: description})
+	tm.history.redo = nil
+}
+
+// Undo reverts the latest board operation in a new commit
+func (tm *taskManager) Undo() (UndoResponse, error) {
+	return tm.revertOperation(true)
+}
+
+// Redo reapplies the latest undone board operation in a new commit
+func (tm *taskManager) Redo() (UndoResponse, error) {
+	return tm.revertOperation(false)
+}
+
+// revertOperation reverts the latest entry of the undo or redo stack and makes the revert revertible from the other stack;
+// operations whose files were changed again since are dropped as they can no longer be reverted
+func (tm *taskManager) revertOperation(undo bool) (UndoResponse, error) {
+	tm.mu.Lock()
+
+	verb, source, target, empty := "Undo", &tm.history.undo, &tm.history.redo, ErrNothingToUndo
+	if !undo {
+		verb, source, target, empty = "Redo", &tm.history.redo, &tm.history.undo, ErrNothingToRedo
+	}
+
+	var entry undoEntry
+	var ok bool
+	*source, entry, ok = popUndoEntry(*source)
+	if !ok {
+		tm.mu.Unlock()
+		return UndoResponse{}, empty
+	}
+
+	tm.logger.LogMessage(utilities.Info, "TaskManager", fmt.Sprintf("%s: %s", verb, entry.description))
+
+	message := utilities.NewCommitMessage(strings.ToLower(verb), fmt.Sprintf("%s: %s", verb, entry.description)).
+		With(utilities.TrailerRevision, entry.to)
+	result, err := tm.boardAccess.RevertChanges(entry.from, entry.to, message.String())
+	if err != nil {
+		if !errors.Is(err, utilities.ErrRevertConflict) {
+			*source = pushUndoEntry(*source, entry)
+		}
+		tm.mu.Unlock()
+		return UndoResponse{}, fmt.Errorf("%s of %q failed: %w", verb, entry.description, err)
+	}
+	if result.Commit != "" {
+		*target = pushUndoEntry(*target, undoEntry{from: result.Previous, to: result.Commit, description: entry.description})
+	}
+
+	response := UndoResponse{
+		Description: entry.description,
+		Commit:      result.Commit,
+		CanUndo:     len(tm.history.undo) > 0,
+		CanRedo:     len(tm.history.redo) > 0,
+	}
+	tm.mu.Unlock()
+
+	for _, change := range result.Changes {
+		if change.TaskID != "" {
+			response.ChangedTaskIDs = append(response.ChangedTaskIDs, change.TaskID)
+		}
+		tm.publishBoardChange(change)
+	}
+
+	tm.logger.LogMessage(utilities.Info, "TaskManager", fmt.Sprintf("%s of %q changed %d tasks", verb, entry.description, len(response.ChangedTaskIDs)))
+	return response, nil
+}
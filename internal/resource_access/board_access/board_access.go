@@ -40,13 +40,47 @@ type WorkflowStatus struct {
 	Position int    `json:"position"` // Order within column/section
 }
 
-// BoardConfiguration defines the board structure (simplified)
+// BoardConfiguration defines the board structure (simplified); board.json stores it as column objects,
+// see board_schema.go
 type BoardConfiguration struct {
-	Name     string              `json:"name"`
-	Columns  []string            `json:"columns"`   // ["todo", "doing", "done"]
-	Sections map[string][]string `json:"sections"`  // column -> sections mapping
-	GitUser  string              `json:"git_user"`  // Git commit author name
-	GitEmail string              `json:"git_email"` // Git commit author email
+	SchemaVersion  int                       `json:"schema_version"`  // version board.json was read in, written as the current one
+	Name           string                    `json:"name"`
+	Columns        []string                  `json:"columns"`         // ["todo", "doing", "done"]
+	ColumnNames    map[string]string         `json:"column_names"`    // column -> display name, e.g. "To Do"
+	ColumnSettings map[string]ColumnSettings `json:"column_settings"` // column -> colour, WIP limit, done and policies
+	Sections       map[string][]string       `json:"sections"`        // column -> sections mapping
+	GitUser        string                    `json:"git_user"`        // Git commit author name
+	GitEmail       string                    `json:"git_email"`       // Git commit author email
+}
+
+// ColumnSettings holds the settings of a board column besides its display name and sections
+type ColumnSettings struct {
+	Color       string `json:"color,omitempty"`        // e.g. "red" or "#d9534f"
+	WIPLimit    int    `json:"wip_limit,omitempty"`    // maximum number of top-level tasks, 0 for no limit
+	Done        bool   `json:"done,omitempty"`         // tasks in the column are finished
+	EntryPolicy string `json:"entry_policy,omitempty"` // when a task may enter the column
+	ExitPolicy  string `json:"exit_policy,omitempty"`  // when a task may leave the column
+}
+
+// IsDoneColumn reports whether tasks in the column are finished; on boards without a column marked done,
+// the last column holds the finished tasks
+func (c *BoardConfiguration) IsDoneColumn(column string) bool {
+	for _, settings := range c.ColumnSettings {
+		if settings.Done {
+			return c.ColumnSettings[column].Done
+		}
+	}
+	return len(c.Columns) > 0 && c.Columns[len(c.Columns)-1] == column
+}
+
+// DoneColumn returns the first column holding finished tasks, or "" for a board without columns
+func (c *BoardConfiguration) DoneColumn() string {
+	for _, column := range c.Columns {
+		if c.IsDoneColumn(column) {
+			return column
+		}
+	}
+	return ""
 }
 
 // HierarchyFilter defines task hierarchy filtering options
@@ -95,17 +129,54 @@ type TaskWithTimestamps struct {
 	UpdatedAt time.Time      `json:"updated_at"`
 }
 
+// ArchivedTask represents a task in the archive; Status holds the location it was archived from
+type ArchivedTask struct {
+	*TaskWithTimestamps
+	ArchivedAt time.Time `json:"archived_at"`
+}
+
+// TaskTimelineEntry records one stay of a task in a column and section, reconstructed from git history
+type TaskTimelineEntry struct {
+	Column    string     `json:"column"`
+	Section   string     `json:"section,omitempty"`
+	EnteredAt time.Time  `json:"entered_at"`
+	LeftAt    *time.Time `json:"left_at,omitempty"` // nil while the task is still there
+	CommitID  string     `json:"commit_id"`         // commit that moved the task here
+}
+
+// TaskFieldChange records the value of one task field before and after a revision; an empty value means unset
+type TaskFieldChange struct {
+	Field  string `json:"field"` // title, description, priority, parent, column, section, position, tags, due_date or priority_promotion_date
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
+
+// TaskRevision is a commit that changed a task, with the task fields it changed, reconstructed from git history
+type TaskRevision struct {
+	utilities.CommitInfo
+	Operation string            `json:"operation"` // created, updated, moved, archived, restored or removed
+	Changes   []TaskFieldChange `json:"changes,omitempty"`
+}
+
 // RulesData contains all rule-related context data in a single structure
 type RulesData struct {
 	WIPCounts        map[string]int                               `json:"wip_counts"`        // column -> task count
 	ColumnTasks      map[string][]*TaskWithTimestamps             `json:"column_tasks"`      // column -> tasks
 	TaskHistory      []utilities.CommitInfo                       `json:"task_history"`      // for age calculations
 	ColumnEnterTimes map[string]time.Time                         `json:"column_enter_times"` // column -> enter timestamp
+	ColumnSettings   map[string]ColumnSettings                    `json:"column_settings"`   // column -> settings from board.json
 	BoardMetadata    map[string]string                            `json:"board_metadata"`    // board configuration data
 	SubtaskWIPCounts map[string]int                               `json:"subtask_wip_counts"` // column -> subtask count
 	HierarchyMap     map[string][]string                          `json:"hierarchy_map"`     // parent_id -> child_ids
 }
 
+// ColumnWIPData is the WIP limit of a column from board.json and the number of top-level tasks counting against it
+type ColumnWIPData struct {
+	Column   string         `json:"column"`
+	Settings ColumnSettings `json:"settings"`  // Done is resolved for boards without a column marked done
+	WIPCount int            `json:"wip_count"` // only counted for columns with a WIP limit
+}
+
 // IBoardAccess defines the contract for board data operations using faceted design
 type IBoardAccess interface {
 	// Task and subtask operations facet
@@ -117,7 +188,20 @@ type IBoardAccess interface {
 	// Board management operations facet
 	IBoard
 
+	// External modification watch facet
+	IWatch
+
+	// Remote synchronisation facet
+	ISync
+
+	// History facet
+	IHistory
+
+	// Transaction facet
+	ITransaction
+
 	// Utility Operations
+	LockStatus() BoardLockStatus
 	Close() error
 }
 
@@ -126,9 +210,15 @@ type boardAccess struct {
 	repository utilities.Repository
 	logger     utilities.ILoggingUtility
 	mutex      *sync.RWMutex
+	lock       *boardLock
+	watch      *watchFacet
 	ITask          // embedded task facet
 	IRules         // embedded rules facet
 	IBoard         // embedded board facet
+	IWatch         // embedded watch facet
+	ISync          // embedded sync facet
+	IHistory       // embedded history facet
+	ITransaction   // embedded transaction facet
 }
 
 // NewBoardAccess creates a new BoardAccess instance
@@ -181,16 +271,43 @@ func NewBoardAccess(repositoryPath string) (IBoardAccess, error) {
 		return nil, fmt.Errorf("BoardAccess.NewBoardAccess failed to initialize repository with config: %w", err)
 	}
 
+	// Keep other processes from writing concurrently; a second opener gets a read-only board
+	lock := acquireBoardLock(repository.Path(), logger)
+
+	journal := newFileJournal()
+
+	// Convert boards still using the single tasks.json file to the per-task layout
+	if !lock.status.ReadOnly {
+		if err := migrateLegacyTaskStorage(repository, logger); err != nil {
+			lock.release()
+			repository.Close()
+			return nil, fmt.Errorf("BoardAccess.NewBoardAccess failed to migrate legacy task storage: %w", err)
+		}
+		if err := migrateBoardSchema(repository, logger, journal); err != nil {
+			lock.release()
+			repository.Close()
+			return nil, fmt.Errorf("BoardAccess.NewBoardAccess failed to migrate board schema: %w", err)
+		}
+	}
+
 	mutex := &sync.RWMutex{}
-	taskFacetImpl := newTaskFacet(repository, logger, mutex)
+	transaction := &boardTransaction{}
+	taskFacetImpl := newTaskFacet(repository, logger, mutex, journal, lock, transaction)
+	watchFacetImpl := newWatchFacet(repository.Path(), journal, logger, mutex)
 
 	boardAccess := &boardAccess{
-		repository: repository,
-		logger:     logger,
-		mutex:      mutex,
-		ITask:      taskFacetImpl,
-		IRules:     newRulesFacet(taskFacetImpl, logger, mutex),
-		IBoard:     newBoardFacet(repository, logger, mutex, nil),
+		repository:   repository,
+		logger:       logger,
+		mutex:        mutex,
+		lock:         lock,
+		watch:        watchFacetImpl,
+		ITask:        taskFacetImpl,
+		IRules:       newRulesFacet(repository.Path(), taskFacetImpl, logger, mutex),
+		IBoard:       newBoardFacet(repository, logger, mutex, nil, journal, lock, transaction),
+		IWatch:       watchFacetImpl,
+		ISync:        newSyncFacet(repository, logger, mutex, journal, lock, transaction),
+		IHistory:     newHistoryFacet(repository, logger, mutex, journal, lock, transaction),
+		ITransaction: newTransactionFacet(repository, logger, mutex, journal, lock, transaction),
 	}
 
 	logger.LogMessage(utilities.Info, "BoardAccess", "BoardAccess initialized successfully")
@@ -198,8 +315,35 @@ func NewBoardAccess(repositoryPath string) (IBoardAccess, error) {
 	return boardAccess, nil
 }
 
+// migrateLegacyTaskStorage performs the one-shot migration from tasks.json to per-task files in a single commit
+func migrateLegacyTaskStorage(repository utilities.Repository, logger utilities.ILoggingUtility) error {
+	paths, err := newTaskStorage(repository.Path()).migrateLegacyTasks()
+	if err != nil {
+		return err
+	}
+	if paths == nil {
+		return nil
+	}
+
+	if err := repository.Stage(paths); err != nil {
+		return fmt.Errorf("failed to stage migrated task files: %w", err)
+	}
+	message := utilities.NewCommitMessage(OperationMigrate, fmt.Sprintf("Migrate %d tasks from tasks.json to per-task files", len(paths)-1))
+	if _, err := repository.Commit(message.String()); err != nil {
+		return fmt.Errorf("failed to commit migrated task files: %w", err)
+	}
+
+	logger.LogMessage(utilities.Info, "BoardAccess", fmt.Sprintf("Migrated %d tasks from tasks.json to per-task files", len(paths)-1))
+	return nil
+}
+
 // Close implements the utility operation to clean up resources
 func (ba *boardAccess) Close() error {
+	// Stop watching first, the watcher takes the board lock while comparing files
+	if err := ba.watch.close(); err != nil {
+		ba.logger.LogMessage(utilities.Warning, "BoardAccess", err.Error())
+	}
+
 	ba.mutex.Lock()
 	defer ba.mutex.Unlock()
 
@@ -210,5 +354,15 @@ func (ba *boardAccess) Close() error {
 		return fmt.Errorf("failed to close repository: %w", err)
 	}
 
+	// Let other processes write to the board
+	if err := ba.lock.release(); err != nil {
+		return err
+	}
+
 	return nil
+}
+
+// LockStatus reports whether this BoardAccess holds the board's lock or opened the board read-only
+func (ba *boardAccess) LockStatus() BoardLockStatus {
+	return ba.lock.status
 }
\ No newline at end of file
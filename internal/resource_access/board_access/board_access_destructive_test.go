@@ -4,6 +4,7 @@
 package board_access
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -156,6 +157,9 @@ func TestAcceptance_BoardAccess_InvalidTaskDataHandling(t *testing.T) {
 		if !strings.Contains(err.Error(), "task file not found") {
 			t.Errorf("Expected 'task file not found' error, got: %s", err.Error())
 		}
+		if !errors.Is(err, ErrTaskNotFound) {
+			t.Errorf("Expected ErrTaskNotFound, got: %v", err)
+		}
 	})
 
 	t.Run("ConcurrentUpdatesToSameTask", func(t *testing.T) {
@@ -1111,7 +1115,7 @@ func TestAcceptance_BoardAccess_JSONCorruptionHandling(t *testing.T) {
 		}
 
 		// Find and corrupt the JSON file
-		urgentImportantDir := filepath.Join(tempDir, "01_todo", "urgent-important")
+		urgentImportantDir := filepath.Join(tempDir, "todo", "urgent-important")
 		files, err := os.ReadDir(urgentImportantDir)
 		if err != nil {
 			t.Fatalf("Failed to read directory: %v", err)
@@ -1181,7 +1185,7 @@ func TestAcceptance_BoardAccess_ServiceRecoveryAfterFailures(t *testing.T) {
 		}
 
 		// Simulate temporary filesystem issue by removing the task file
-		taskPath := filepath.Join(tempDir, "01_todo", "urgent-important", "0001-task-"+taskID+".json")
+		taskPath := filepath.Join(tempDir, "todo", "urgent-important", "001-task-"+taskID+".json")
 		originalData, err := os.ReadFile(taskPath)
 		if err != nil {
 			t.Skipf("Cannot read task file for simulation: %v", err)
@@ -1256,7 +1260,7 @@ func TestAcceptance_BoardAccess_PartialFunctionalityUnderConstraints(t *testing.
 		}
 
 		// Simulate partial constraints by temporarily removing one task file
-		task2Path := filepath.Join(tempDir, "01_todo", "not-urgent-important", "0001-task-"+taskID2+".json")
+		task2Path := filepath.Join(tempDir, "todo", "not-urgent-important", "001-task-"+taskID2+".json")
 		task2Data, err := os.ReadFile(task2Path)
 		if err != nil {
 			t.Skipf("Cannot read task2 file for simulation: %v", err)
@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 )
 
 func TestUnit_BoardAccess_NewBoardAccess(t *testing.T) {
@@ -103,10 +104,10 @@ func TestUnit_BoardAccess_StoreAndGetTasksData(t *testing.T) {
 		t.Error("UpdatedAt timestamp should not be zero")
 	}
 
-	// Verify task is stored in the JSON file
-	expectedPath := filepath.Join(tempDir, "tasks.json")
+	// Verify task is stored in its own file below column and section
+	expectedPath := filepath.Join(tempDir, "todo", "urgent-important", "001-task-"+taskID+".json")
 	if _, err := os.Stat(expectedPath); os.IsNotExist(err) {
-		t.Errorf("Expected tasks file does not exist at %s", expectedPath)
+		t.Errorf("Expected task file does not exist at %s", expectedPath)
 	}
 }
 
@@ -217,7 +218,7 @@ func TestUnit_BoardAccess_ArchiveTask(t *testing.T) {
 		t.Fatalf("Failed to archive task: %v", err)
 	}
 
-	// Verify task is removed from active tasks (current simple archiving behavior)
+	// Verify task is removed from active tasks
 	archivedTasks, err := ba.GetTasksData([]string{taskID}, false)
 	if err != nil {
 		t.Fatalf("Failed to check archived task: %v", err)
@@ -228,6 +229,176 @@ func TestUnit_BoardAccess_ArchiveTask(t *testing.T) {
 	}
 }
 
+func TestUnit_BoardAccess_ArchiveTaskKeepsTaskWhenArchiveFails(t *testing.T) {
+	tempDir := t.TempDir()
+	ba, err := NewBoardAccess(tempDir)
+	if err != nil {
+		t.Fatalf("Failed to create BoardAccess: %v", err)
+	}
+	defer ba.Close()
+
+	taskID, err := ba.CreateTask(&Task{Title: "Task to Archive"}, Priority{Important: true}, WorkflowStatus{Column: "done", Position: 1}, nil)
+	if err != nil {
+		t.Fatalf("Failed to store task: %v", err)
+	}
+
+	// A directory in place of the archive copy makes writing it fail
+	if err := os.MkdirAll(filepath.Join(tempDir, archivePathFor(taskID)), 0755); err != nil {
+		t.Fatalf("Failed to block archive file: %v", err)
+	}
+
+	if err := ba.ArchiveTask(taskID, NoAction); err == nil {
+		t.Fatal("Expected archiving to fail")
+	}
+
+	tasks, err := ba.GetTasksData([]string{taskID}, false)
+	if err != nil {
+		t.Fatalf("Failed to read task: %v", err)
+	}
+	if len(tasks) != 1 {
+		t.Errorf("Expected the task to stay on the board when its archive copy cannot be written, got %d tasks", len(tasks))
+	}
+}
+
+func TestUnit_BoardAccess_ArchiveAndRestoreWithSubtasks(t *testing.T) {
+	tempDir := t.TempDir()
+
+	ba, err := NewBoardAccess(tempDir)
+	if err != nil {
+		t.Fatalf("Failed to create BoardAccess: %v", err)
+	}
+	defer ba.Close()
+
+	priority := Priority{Urgent: true, Important: true}
+	status := WorkflowStatus{Column: "doing", Position: 2}
+
+	parentID, err := ba.CreateTask(&Task{Title: "Parent"}, priority, status, nil)
+	if err != nil {
+		t.Fatalf("Failed to create parent task: %v", err)
+	}
+	subtaskID, err := ba.CreateTask(&Task{Title: "Subtask"}, priority, WorkflowStatus{Column: "doing", Position: 1}, &parentID)
+	if err != nil {
+		t.Fatalf("Failed to create subtask: %v", err)
+	}
+
+	// Archiving with ArchiveSubtasks moves both tasks into the archive
+	if err := ba.ArchiveTask(parentID, ArchiveSubtasks); err != nil {
+		t.Fatalf("Failed to archive task: %v", err)
+	}
+
+	taskIDs, err := ba.ListTaskIdentifiers(AllTasks)
+	if err != nil {
+		t.Fatalf("Failed to list tasks: %v", err)
+	}
+	if len(taskIDs) != 0 {
+		t.Errorf("Expected no active tasks after archiving, got %v", taskIDs)
+	}
+
+	archived, err := ba.ListArchivedTasks()
+	if err != nil {
+		t.Fatalf("Failed to list archived tasks: %v", err)
+	}
+	if len(archived) != 2 {
+		t.Fatalf("Expected 2 archived tasks, got %d", len(archived))
+	}
+	for _, task := range archived {
+		if task.ArchivedAt.IsZero() {
+			t.Errorf("Archived task %s has no archival time", task.Task.ID)
+		}
+		if task.Status.Column != "doing" {
+			t.Errorf("Expected archived task to remember column doing, got %s", task.Status.Column)
+		}
+	}
+
+	archivePath := filepath.Join(tempDir, "archived", "task-"+parentID+".json")
+	if _, err := os.Stat(archivePath); err != nil {
+		t.Errorf("Expected archive file at %s: %v", archivePath, err)
+	}
+
+	// A subtask cannot be restored while its parent is archived
+	if err := ba.RestoreTask(subtaskID, NoAction); err == nil {
+		t.Error("Expected error restoring a subtask of an archived parent")
+	}
+
+	// Restoring with ArchiveSubtasks brings back the subtask to its original place
+	if err := ba.RestoreTask(parentID, ArchiveSubtasks); err != nil {
+		t.Fatalf("Failed to restore task: %v", err)
+	}
+
+	restored, err := ba.GetTasksData([]string{parentID, subtaskID}, false)
+	if err != nil {
+		t.Fatalf("Failed to get restored tasks: %v", err)
+	}
+	if len(restored) != 2 {
+		t.Fatalf("Expected 2 restored tasks, got %d", len(restored))
+	}
+	if restored[0].Status.Column != "doing" || restored[0].Status.Position != 2 {
+		t.Errorf("Expected parent restored to doing/2, got %+v", restored[0].Status)
+	}
+	if restored[1].Task.ParentTaskID == nil || *restored[1].Task.ParentTaskID != parentID {
+		t.Error("Expected restored subtask to keep its parent")
+	}
+
+	archived, err = ba.ListArchivedTasks()
+	if err != nil {
+		t.Fatalf("Failed to list archived tasks: %v", err)
+	}
+	if len(archived) != 0 {
+		t.Errorf("Expected empty archive after restore, got %d tasks", len(archived))
+	}
+
+	if err := ba.RestoreTask(parentID, NoAction); err == nil {
+		t.Error("Expected error restoring a task that is not archived")
+	}
+}
+
+func TestUnit_BoardAccess_PurgeArchive(t *testing.T) {
+	tempDir := t.TempDir()
+
+	ba, err := NewBoardAccess(tempDir)
+	if err != nil {
+		t.Fatalf("Failed to create BoardAccess: %v", err)
+	}
+	defer ba.Close()
+
+	taskID, err := ba.CreateTask(&Task{Title: "Old task"}, Priority{Urgent: true, Important: false}, WorkflowStatus{Column: "done"}, nil)
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	if err := ba.ArchiveTask(taskID, NoAction); err != nil {
+		t.Fatalf("Failed to archive task: %v", err)
+	}
+
+	// Nothing has been archived for a day yet
+	purged, err := ba.PurgeArchive(24 * time.Hour)
+	if err != nil {
+		t.Fatalf("Failed to purge archive: %v", err)
+	}
+	if len(purged) != 0 {
+		t.Errorf("Expected nothing purged, got %v", purged)
+	}
+
+	if _, err := ba.PurgeArchive(-time.Hour); err == nil {
+		t.Error("Expected error for negative purge age")
+	}
+
+	purged, err = ba.PurgeArchive(0)
+	if err != nil {
+		t.Fatalf("Failed to purge archive: %v", err)
+	}
+	if len(purged) != 1 || purged[0] != taskID {
+		t.Errorf("Expected task %s purged, got %v", taskID, purged)
+	}
+
+	archived, err := ba.ListArchivedTasks()
+	if err != nil {
+		t.Fatalf("Failed to list archived tasks: %v", err)
+	}
+	if len(archived) != 0 {
+		t.Errorf("Expected empty archive after purge, got %d tasks", len(archived))
+	}
+}
+
 func TestUnit_BoardAccess_FindTasks(t *testing.T) {
 	// Create temporary directory for test
 	tempDir, err := os.MkdirTemp("", "boardaccess_test_")
@@ -359,6 +530,10 @@ func TestUnit_BoardAccess_GetTaskHistory(t *testing.T) {
 	if len(history) < 2 {
 		t.Errorf("Expected at least 2 history entries, got %d", len(history))
 	}
+	if len(history) > 0 && (history[0].Operation != "updated" || len(history[0].Changes) != 1 ||
+		history[0].Changes[0] != (TaskFieldChange{Field: "title", Before: "Test Task for History", After: "Updated Task Title"})) {
+		t.Errorf("Expected the latest revision to change the title, got %+v", history[0])
+	}
 
 	// Test default limit
 	historyDefault, err := ba.GetTaskHistory(taskID, 0) // Should use default limit of 100
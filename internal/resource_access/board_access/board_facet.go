@@ -41,10 +41,64 @@ type BoardStatistics struct {
 	TasksByPriority   map[string]int    `json:"tasks_by_priority"`
 	AverageTaskAge    float64           `json:"average_task_age_days"`
 	OldestTaskAge     float64           `json:"oldest_task_age_days"`
+	AverageColumnAge  float64           `json:"average_column_age_days"` // time active tasks spent in their current column
 	LastActivity      *time.Time        `json:"last_activity,omitempty"`
 	BoardHealthScore  float64           `json:"board_health_score"` // 0.0 - 1.0
 }
 
+// FlowTimeStatistics summarizes a distribution of durations, in days
+type FlowTimeStatistics struct {
+	Count       int     `json:"count"`
+	AverageDays float64 `json:"average_days"`
+	MedianDays  float64 `json:"median_days"`
+	P85Days     float64 `json:"p85_days"`
+	MaxDays     float64 `json:"max_days"`
+}
+
+// TaskFlow describes how a single top-level task moved through the board
+type TaskFlow struct {
+	TaskID        string     `json:"task_id"`
+	Title         string     `json:"title"`
+	Quadrant      string     `json:"quadrant"`
+	Column        string     `json:"column"` // current column, or "archived"
+	CreatedAt     time.Time  `json:"created_at"`
+	StartedAt     *time.Time `json:"started_at,omitempty"`   // first left the first column
+	CompletedAt   *time.Time `json:"completed_at,omitempty"` // entered the last column or was archived
+	LeadTimeDays  float64    `json:"lead_time_days,omitempty"`
+	CycleTimeDays float64    `json:"cycle_time_days,omitempty"`
+}
+
+// ThroughputPeriod counts the tasks completed in one week, starting on Monday
+type ThroughputPeriod struct {
+	WeekStart  time.Time      `json:"week_start"`
+	Completed  int            `json:"completed"`
+	ByQuadrant map[string]int `json:"by_quadrant"`
+}
+
+// CumulativeFlowPoint captures the board at the end of one day
+type CumulativeFlowPoint struct {
+	Date          time.Time                 `json:"date"`
+	Columns       map[string]int            `json:"columns"`   // column -> tasks, the done column includes archived completions
+	Quadrants     map[string]map[string]int `json:"quadrants"` // quadrant -> column -> tasks
+	WIP           int                       `json:"wip"`       // tasks between the first and the last column
+	WIPByQuadrant map[string]int            `json:"wip_by_quadrant"`
+}
+
+// FlowMetrics reports cycle time, lead time, throughput and cumulative flow for a date range
+type FlowMetrics struct {
+	From                time.Time                     `json:"from"`
+	To                  time.Time                     `json:"to"`
+	Columns             []string                      `json:"columns"`
+	DoneColumn          string                        `json:"done_column"`
+	Tasks               []TaskFlow                    `json:"tasks"`
+	LeadTime            FlowTimeStatistics            `json:"lead_time"`
+	CycleTime           FlowTimeStatistics            `json:"cycle_time"`
+	LeadTimeByQuadrant  map[string]FlowTimeStatistics `json:"lead_time_by_quadrant"`
+	CycleTimeByQuadrant map[string]FlowTimeStatistics `json:"cycle_time_by_quadrant"`
+	Throughput          []ThroughputPeriod            `json:"throughput"`
+	CumulativeFlow      []CumulativeFlowPoint         `json:"cumulative_flow"`
+}
+
 // BoardValidationResult contains validation status and diagnostics
 type BoardValidationResult struct {
 	IsValid       bool                       `json:"is_valid"`
@@ -63,6 +117,31 @@ type BoardValidationIssue struct {
 	Message     string `json:"message"`
 	Details     string `json:"details,omitempty"`
 	Suggestion  string `json:"suggestion,omitempty"`
+	Path        string `json:"path,omitempty"`   // board-relative file or directory the issue refers to
+	Repair      string `json:"repair,omitempty"` // repair RepairBoard applies, empty if it cannot fix the issue
+}
+
+// Repairs RepairBoard applies to validation issues
+const (
+	RepairRestoreFile     = "restore-file"
+	RepairCreateDirectory = "create-directory"
+	RepairRemoveTempFile  = "remove-temp-file"
+	RepairRemoveDuplicate = "remove-duplicate"
+	RepairReindex         = "reindex-positions"
+)
+
+// BoardRepairAction describes a change RepairBoard made to the board
+type BoardRepairAction struct {
+	Repair  string `json:"repair"`
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// BoardRepairResult contains the changes made by a repair and the validation of the repaired board
+type BoardRepairResult struct {
+	Actions    []BoardRepairAction    `json:"actions,omitempty"`
+	Commit     string                 `json:"commit,omitempty"` // commit recording the repair, empty if nothing changed
+	Validation *BoardValidationResult `json:"validation"`
 }
 
 // BoardDeletionRequest contains parameters for board deletion
@@ -110,10 +189,14 @@ type IBoard interface {
 	// Metadata Operations
 	ExtractMetadata(ctx context.Context, boardPath string) (*BoardMetadata, error)
 	GetStatistics(ctx context.Context, boardPath string) (*BoardStatistics, error)
+	GetFlowMetrics(ctx context.Context, boardPath string, dateRange *DateRange) (*FlowMetrics, error)
 
 	// Validation Operations
 	ValidateStructure(ctx context.Context, boardPath string) (*BoardValidationResult, error)
 
+	// Repair Operations
+	RepairBoard(ctx context.Context) (*BoardRepairResult, error)
+
 	// Configuration Operations
 	LoadConfiguration(ctx context.Context, boardPath string, configType string) (map[string]interface{}, error)
 	StoreConfiguration(ctx context.Context, boardPath string, configType string, configData map[string]interface{}) error
@@ -8,6 +8,7 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -27,16 +28,22 @@ type boardFacet struct {
 	mutex        *sync.RWMutex
 	ruleEngine   BoardConfigurationValidator  // For board configuration validation
 	configFacet  IConfiguration              // For board configuration operations
+	journal      *fileJournal                // Records the files a repair rewrites
+	lock         *boardLock                  // Refuses configuration writes to a read-only board
+	transaction  *boardTransaction           // Refuses configuration commits while task changes are collected
 }
 
 // newBoardFacet creates a new board facet implementation
-func newBoardFacet(repository utilities.Repository, logger utilities.ILoggingUtility, mutex *sync.RWMutex, ruleEngine BoardConfigurationValidator) IBoard {
+func newBoardFacet(repository utilities.Repository, logger utilities.ILoggingUtility, mutex *sync.RWMutex, ruleEngine BoardConfigurationValidator, journal *fileJournal, lock *boardLock, transaction *boardTransaction) IBoard {
 	return &boardFacet{
 		repository:  repository,
 		logger:      logger,
 		mutex:       mutex,
 		ruleEngine:  ruleEngine,
 		configFacet: newConfigurationFacet(repository, logger),
+		journal:     journal,
+		lock:        lock,
+		transaction: transaction,
 	}
 }
 
@@ -176,28 +183,25 @@ func (bf *boardFacet) ExtractMetadata(ctx context.Context, boardPath string) (*B
 		metadata.ModifiedAt = &modTime
 	}
 
-	// Count tasks by reading task files (simplified)
-	taskFiles := []string{"active.json", "archived.json"}
+	// Count tasks by scanning task file names, unreadable task files still count
 	totalTasks := 0
-
-	for _, taskFile := range taskFiles {
-		taskPath := filepath.Join(boardPath, taskFile)
-		if taskData, err := os.ReadFile(taskPath); err == nil {
-			var tasks []TaskWithTimestamps
-			if json.Unmarshal(taskData, &tasks) == nil {
-				totalTasks += len(tasks)
-
-				// Count tasks by column
-				for _, task := range tasks {
-					column := task.Status.Column
-					metadata.ColumnCounts[column]++
-				}
-			}
+	if refs, err := newTaskStorage(boardPath).scan(); err == nil {
+		totalTasks = len(refs)
+		for _, ref := range refs {
+			metadata.ColumnCounts[ref.Column]++
 		}
 	}
 
 	metadata.TaskCount = totalTasks
-	metadata.SchemaVersion = "1.0" // Default version
+	metadata.SchemaVersion = strconv.Itoa(CurrentBoardSchemaVersion) // A board without board.json gets the current schema
+	if metadata.Configuration != nil {
+		metadata.SchemaVersion = strconv.Itoa(metadata.Configuration.SchemaVersion)
+	}
+
+	// Tell users up front that another process is writing to the board
+	if holder := activeBoardLockHolder(boardPath); holder != nil {
+		metadata.Metadata[LockedByMetadataKey] = holder.String()
+	}
 
 	bf.logger.LogMessage(utilities.Info, "BoardFacet", fmt.Sprintf("Extracted metadata for board %s: %d tasks", boardPath, totalTasks))
 	return metadata, nil
@@ -217,57 +221,66 @@ func (bf *boardFacet) GetStatistics(ctx context.Context, boardPath string) (*Boa
 	}
 
 	// Read active tasks
-	activePath := filepath.Join(boardPath, "active.json")
-	if taskData, err := os.ReadFile(activePath); err == nil {
-		var tasks []TaskWithTimestamps
-		if json.Unmarshal(taskData, &tasks) == nil {
-			stats.ActiveTasks = len(tasks)
-			stats.TotalTasks += len(tasks)
+	if tasks, err := newTaskStorage(boardPath).loadAll(); err == nil {
+		stats.ActiveTasks = len(tasks)
+		stats.TotalTasks += len(tasks)
+
+		var totalAge float64
+		var oldestAge float64
+		var lastActivity time.Time
+
+		for _, task := range tasks {
+			// Count by column
+			stats.TasksByColumn[task.Status.Column]++
+
+			// Count by priority
+			priorityLabel := task.Priority.Label
+			stats.TasksByPriority[priorityLabel]++
+
+			// Calculate age
+			age := time.Since(task.CreatedAt).Hours() / 24 // days
+			totalAge += age
+			if age > oldestAge {
+				oldestAge = age
+			}
 
-			var totalAge float64
-			var oldestAge float64
-			var lastActivity time.Time
+			// Track last activity
+			if task.UpdatedAt.After(lastActivity) {
+				lastActivity = task.UpdatedAt
+			}
+		}
 
-			for _, task := range tasks {
-				// Count by column
-				stats.TasksByColumn[task.Status.Column]++
-
-				// Count by priority
-				priorityLabel := task.Priority.Label
-				stats.TasksByPriority[priorityLabel]++
-
-				// Calculate age
-				age := time.Since(task.CreatedAt).Hours() / 24 // days
-				totalAge += age
-				if age > oldestAge {
-					oldestAge = age
-				}
+		if len(tasks) > 0 {
+			stats.AverageTaskAge = totalAge / float64(len(tasks))
+		}
 
-				// Track last activity
-				if task.UpdatedAt.After(lastActivity) {
-					lastActivity = task.UpdatedAt
+		// Time in the current column comes from the board's git history
+		if timelines, err := bf.loadTimelines(boardPath); err != nil {
+			bf.logger.LogMessage(utilities.Warning, "BoardFacet", fmt.Sprintf("Failed to reconstruct task timelines for %s: %v", boardPath, err))
+		} else {
+			var totalColumnAge float64
+			var trackedTasks int
+			for _, task := range tasks {
+				if enteredAt, exists := columnEnterTimes(timelines[task.Task.ID])[task.Status.Column]; exists {
+					totalColumnAge += time.Since(enteredAt).Hours() / 24 // days
+					trackedTasks++
 				}
 			}
-
-			if len(tasks) > 0 {
-				stats.AverageTaskAge = totalAge / float64(len(tasks))
+			if trackedTasks > 0 {
+				stats.AverageColumnAge = totalColumnAge / float64(trackedTasks)
 			}
-			stats.OldestTaskAge = oldestAge
+		}
+		stats.OldestTaskAge = oldestAge
 
-			if !lastActivity.IsZero() {
-				stats.LastActivity = &lastActivity
-			}
+		if !lastActivity.IsZero() {
+			stats.LastActivity = &lastActivity
 		}
 	}
 
-	// Read completed tasks
-	archivedPath := filepath.Join(boardPath, "archived.json")
-	if taskData, err := os.ReadFile(archivedPath); err == nil {
-		var tasks []TaskWithTimestamps
-		if json.Unmarshal(taskData, &tasks) == nil {
-			stats.CompletedTasks = len(tasks)
-			stats.TotalTasks += len(tasks)
-		}
+	// Read archived tasks
+	if archived, err := newTaskStorage(boardPath).loadArchive(); err == nil {
+		stats.CompletedTasks = len(archived)
+		stats.TotalTasks += len(archived)
 	}
 
 	// Calculate health score (simplified metric)
@@ -277,6 +290,113 @@ func (bf *boardFacet) GetStatistics(ctx context.Context, boardPath string) (*Boa
 	return stats, nil
 }
 
+// GetFlowMetrics computes cycle time, lead time, throughput and cumulative flow from the board history
+func (bf *boardFacet) GetFlowMetrics(ctx context.Context, boardPath string, dateRange *DateRange) (*FlowMetrics, error) {
+	bf.logger.LogMessage(utilities.Debug, "BoardFacet", fmt.Sprintf("Calculating flow metrics for board: %s", boardPath))
+
+	if boardPath == "" {
+		return nil, fmt.Errorf("board path cannot be empty")
+	}
+
+	storage := newTaskStorage(boardPath)
+	tasks, err := storage.loadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tasks: %w", err)
+	}
+	archived, err := storage.loadArchive()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load archived tasks: %w", err)
+	}
+	timelines, err := bf.loadTimelines(boardPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reconstruct task timelines: %w", err)
+	}
+
+	// Subtasks are part of their parent's flow and are not counted separately
+	var flowTasks []flowTask
+	for _, task := range tasks {
+		if task.Task.ParentTaskID == nil {
+			flowTasks = append(flowTasks, flowTask{task: task, timeline: timelines[task.Task.ID]})
+		}
+	}
+	for _, task := range archived {
+		if task.Task.ParentTaskID == nil {
+			archivedAt := task.ArchivedAt
+			flowTasks = append(flowTasks, flowTask{task: task.TaskWithTimestamps, timeline: timelines[task.Task.ID], archivedAt: &archivedAt})
+		}
+	}
+
+	// The range defaults to the whole board history up to now. It is clamped to that history,
+	// as the cumulative flow is sampled for every day of the range.
+	now := time.Now()
+	start := historyStart(flowTasks, now)
+	from, to := start, now
+	if dateRange != nil && dateRange.From != nil {
+		from = *dateRange.From
+	}
+	if dateRange != nil && dateRange.To != nil {
+		to = *dateRange.To
+	}
+	if from.After(to) {
+		return nil, fmt.Errorf("invalid date range: %s is after %s", from.Format(time.RFC3339), to.Format(time.RFC3339))
+	}
+	if from.Before(start) {
+		from = start
+	}
+	if to.After(now) {
+		to = now
+	}
+	if from.After(to) {
+		from = to // the range lies entirely before the board history or after now
+	}
+
+	config := readBoardConfiguration(boardPath)
+	metrics := computeFlowMetrics(flowTasks, config.Columns, config.DoneColumn(), from, to)
+
+	bf.logger.LogMessage(utilities.Info, "BoardFacet", fmt.Sprintf("Calculated flow metrics for board %s: %d tasks, %d completed", boardPath, len(metrics.Tasks), metrics.LeadTime.Count))
+	return metrics, nil
+}
+
+// loadTimelines reconstructs task timelines from the git history of a board
+func (bf *boardFacet) loadTimelines(boardPath string) (map[string][]TaskTimelineEntry, error) {
+	repository := bf.repository
+
+	absBoardPath, err := filepath.Abs(boardPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve board path: %w", err)
+	}
+	absRepositoryPath, err := filepath.Abs(bf.repository.Path())
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve repository path: %w", err)
+	}
+
+	// Other boards are opened read-only for the duration of the call
+	if absBoardPath != absRepositoryPath {
+		validation, err := utilities.ValidateRepositoryAndPaths(utilities.RepositoryValidationRequest{DirectoryPath: boardPath})
+		if err != nil {
+			return nil, err
+		}
+		if !validation.RepositoryValid {
+			return nil, fmt.Errorf("board is not a git repository: %s", validation.ErrorMessage)
+		}
+
+		repository, err = utilities.InitializeRepositoryWithConfig(boardPath, &utilities.AuthorConfiguration{
+			User:  "BoardAccess",
+			Email: "boardaccess@eisenkan.local",
+		})
+		if err != nil {
+			return nil, err
+		}
+		defer repository.Close()
+	}
+
+	history, err := repository.GetChangeHistory(0)
+	if err != nil {
+		return nil, err
+	}
+	return buildTaskTimelines(history), nil
+}
+
 // calculateHealthScore computes a simple board health metric
 func (bf *boardFacet) calculateHealthScore(stats *BoardStatistics) float64 {
 	if stats.TotalTasks == 0 {
@@ -352,13 +472,25 @@ func (bf *boardFacet) ValidateStructure(ctx context.Context, boardPath string) (
 		})
 	}
 
+	// Another process holding the lock makes the board read-only here
+	if holder := activeBoardLockHolder(boardPath); holder != nil {
+		result.Warnings = append(result.Warnings, BoardValidationIssue{
+			Severity:   "warning",
+			Component:  "structure",
+			Message:    fmt.Sprintf("Board is in use by %s and opens read-only", holder),
+			Suggestion: "Close the board in the other program to make changes",
+		})
+	}
+
 	// Validate configuration file
 	configPath := filepath.Join(boardPath, "board.json")
 	if configData, err := os.ReadFile(configPath); err == nil {
 		var config BoardConfiguration
-		if json.Unmarshal(configData, &config) == nil {
+		if err := json.Unmarshal(configData, &config); err == nil {
 			result.ConfigValid = true
-			result.SchemaVersion = "1.0"
+			result.SchemaVersion = strconv.Itoa(config.SchemaVersion)
+			bf.validateSchemaVersion(config.SchemaVersion, result)
+			bf.validateColumnSettings(&config, result)
 
 			// Validate configuration content using RuleEngine if available
 			if bf.ruleEngine != nil {
@@ -373,6 +505,8 @@ func (bf *boardFacet) ValidateStructure(ctx context.Context, boardPath string) (
 					result.IsValid = false
 				}
 			}
+
+			bf.validateDirectories(boardPath, &config, result)
 		} else {
 			result.ConfigValid = false
 			result.Issues = append(result.Issues, BoardValidationIssue{
@@ -381,6 +515,8 @@ func (bf *boardFacet) ValidateStructure(ctx context.Context, boardPath string) (
 				Message:    "Invalid board.json format",
 				Details:    err.Error(),
 				Suggestion: "Fix JSON syntax errors",
+				Path:       "board.json",
+				Repair:     RepairRestoreFile,
 			})
 			result.IsValid = false
 		}
@@ -396,6 +532,7 @@ func (bf *boardFacet) ValidateStructure(ctx context.Context, boardPath string) (
 
 	// Validate data files integrity
 	result.DataIntegrity = bf.validateDataFiles(boardPath, result)
+	bf.validateTempFiles(boardPath, result)
 
 	if !result.DataIntegrity {
 		result.IsValid = false
@@ -440,28 +577,199 @@ func (bf *boardFacet) validateConfigWithRuleEngine(ctx context.Context, config *
 // validateDataFiles checks integrity of task data files
 func (bf *boardFacet) validateDataFiles(boardPath string, result *BoardValidationResult) bool {
 	dataIntegrity := true
-	taskFiles := []string{"active.json", "archived.json"}
-
-	for _, taskFile := range taskFiles {
-		taskPath := filepath.Join(boardPath, taskFile)
-		if taskData, err := os.ReadFile(taskPath); err == nil {
-			var tasks []TaskWithTimestamps
-			if json.Unmarshal(taskData, &tasks) != nil {
-				result.Issues = append(result.Issues, BoardValidationIssue{
-					Severity:   "error",
-					Component:  "data",
-					Message:    fmt.Sprintf("Invalid JSON in %s", taskFile),
-					Suggestion: "Fix JSON syntax errors in task data",
-				})
-				dataIntegrity = false
-			}
+	storage := newTaskStorage(boardPath)
+
+	refs, err := storage.scan()
+	if err != nil {
+		result.Issues = append(result.Issues, BoardValidationIssue{
+			Severity:   "error",
+			Component:  "data",
+			Message:    fmt.Sprintf("Cannot read task directories: %v", err),
+			Suggestion: "Check permissions of the board directory",
+		})
+		return false
+	}
+
+	kept := make(map[string]int) // task ID -> index of the file that stays in refs
+	var tasks []*TaskWithTimestamps
+	for i, ref := range refs {
+		task, err := storage.read(ref)
+		tasks = append(tasks, task)
+		if err != nil {
+			result.Issues = append(result.Issues, BoardValidationIssue{
+				Severity:   "error",
+				Component:  "data",
+				Message:    fmt.Sprintf("Invalid task file %s", ref.RelPath),
+				Details:    err.Error(),
+				Suggestion: "Fix JSON syntax errors in task data",
+				Path:       ref.RelPath,
+				Repair:     RepairRestoreFile,
+			})
+			dataIntegrity = false
+			continue
+		}
+
+		// An interrupted move can leave a task in two files, the most recently updated one is kept
+		previous, seen := kept[ref.TaskID]
+		if !seen {
+			kept[ref.TaskID] = i
+			continue
+		}
+		duplicate := previous
+		if tasks[i].UpdatedAt.After(tasks[previous].UpdatedAt) {
+			kept[ref.TaskID] = i
+		} else {
+			duplicate = i
+		}
+		result.Issues = append(result.Issues, BoardValidationIssue{
+			Severity:   "error",
+			Component:  "data",
+			Message:    fmt.Sprintf("Task %s is stored in %s and %s", ref.TaskID, refs[previous].RelPath, ref.RelPath),
+			Suggestion: "Remove the outdated task file",
+			Path:       refs[duplicate].RelPath,
+			Repair:     RepairRemoveDuplicate,
+		})
+		dataIntegrity = false
+	}
+	// An empty board has no task files, which is not an error
+
+	// Position 0 leaves a task unordered, explicit positions must be unique within a directory
+	positions := make(map[string]map[int]bool)
+	reported := make(map[string]bool)
+	for _, ref := range refs {
+		if ref.Position == 0 {
+			continue
+		}
+		dir := filepath.Dir(ref.RelPath)
+		if positions[dir] == nil {
+			positions[dir] = make(map[int]bool)
+		}
+		if positions[dir][ref.Position] && !reported[dir] {
+			reported[dir] = true
+			result.Warnings = append(result.Warnings, BoardValidationIssue{
+				Severity:   "warning",
+				Component:  "data",
+				Message:    fmt.Sprintf("Several tasks share position %d in %s", ref.Position, dir),
+				Suggestion: "Renumber the task files of the directory",
+				Path:       dir,
+				Repair:     RepairReindex,
+			})
 		}
-		// Missing task files are not errors (board might be empty)
+		positions[dir][ref.Position] = true
 	}
 
 	return dataIntegrity
 }
 
+// validateSchemaVersion reports a board.json that still has to be migrated, or that is newer than supported
+func (bf *boardFacet) validateSchemaVersion(version int, result *BoardValidationResult) {
+	switch {
+	case version > CurrentBoardSchemaVersion:
+		result.Issues = append(result.Issues, BoardValidationIssue{
+			Severity:   "error",
+			Component:  "config",
+			Message:    fmt.Sprintf("board.json has schema version %d, this version of EisenKan supports up to %d", version, CurrentBoardSchemaVersion),
+			Suggestion: "Update EisenKan to open this board",
+			Path:       boardConfigFileName,
+		})
+		result.IsValid = false
+	case version < CurrentBoardSchemaVersion:
+		result.Warnings = append(result.Warnings, BoardValidationIssue{
+			Severity:   "warning",
+			Component:  "config",
+			Message:    fmt.Sprintf("board.json has schema version %d, the current version is %d", version, CurrentBoardSchemaVersion),
+			Suggestion: "Open the board to migrate it",
+			Path:       boardConfigFileName,
+		})
+	}
+}
+
+// validateColumnSettings reports column settings that cannot be applied
+func (bf *boardFacet) validateColumnSettings(config *BoardConfiguration, result *BoardValidationResult) {
+	for _, column := range config.Columns {
+		if err := validateColumnName(column); err != nil {
+			result.Issues = append(result.Issues, BoardValidationIssue{
+				Severity:   "error",
+				Component:  "config",
+				Message:    fmt.Sprintf("Column %s cannot store tasks: %v", column, err),
+				Suggestion: "Rename the column; archived and names starting with a dot are reserved",
+				Path:       boardConfigFileName,
+			})
+			result.IsValid = false
+		}
+		if limit := config.ColumnSettings[column].WIPLimit; limit < 0 {
+			result.Issues = append(result.Issues, BoardValidationIssue{
+				Severity:   "error",
+				Component:  "config",
+				Message:    fmt.Sprintf("Column %s has a negative WIP limit %d", column, limit),
+				Suggestion: "Set the WIP limit to 0 for no limit",
+				Path:       boardConfigFileName,
+			})
+			result.IsValid = false
+		}
+	}
+}
+
+// validateDirectories reports column and section directories of the configuration that are missing;
+// git does not track empty directories, so a cloned board lacks those without tasks
+func (bf *boardFacet) validateDirectories(boardPath string, config *BoardConfiguration, result *BoardValidationResult) {
+	var dirs []string
+	for _, column := range config.Columns {
+		dirs = append(dirs, column)
+		for _, section := range config.Sections[column] {
+			dirs = append(dirs, filepath.Join(column, section))
+		}
+	}
+
+	for _, dir := range dirs {
+		if validatePathComponent("directory", filepath.Base(dir)) != nil {
+			continue
+		}
+		if stat, err := os.Stat(filepath.Join(boardPath, dir)); err == nil && stat.IsDir() {
+			continue
+		}
+		result.Warnings = append(result.Warnings, BoardValidationIssue{
+			Severity:   "warning",
+			Component:  "structure",
+			Message:    fmt.Sprintf("Directory %s is missing", dir),
+			Suggestion: "Create the directory",
+			Path:       dir,
+			Repair:     RepairCreateDirectory,
+		})
+	}
+}
+
+// validateTempFiles reports temporary files left behind by writes interrupted by a crash
+func (bf *boardFacet) validateTempFiles(boardPath string, result *BoardValidationResult) {
+	filepath.WalkDir(boardPath, func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if entry.IsDir() {
+			if entry.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !utilities.IsAtomicTempFile(entry.Name()) {
+			return nil
+		}
+		relPath, err := filepath.Rel(boardPath, path)
+		if err != nil {
+			return nil
+		}
+		result.Warnings = append(result.Warnings, BoardValidationIssue{
+			Severity:   "warning",
+			Component:  "data",
+			Message:    fmt.Sprintf("Temporary file %s of an interrupted write", relPath),
+			Suggestion: "Remove the temporary file",
+			Path:       relPath,
+			Repair:     RepairRemoveTempFile,
+		})
+		return nil
+	})
+}
+
 // LoadBoardConfiguration loads board configuration data
 func (bf *boardFacet) LoadConfiguration(ctx context.Context, boardPath string, configType string) (map[string]interface{}, error) {
 	bf.logger.LogMessage(utilities.Debug, "BoardFacet", fmt.Sprintf("Loading configuration for board: %s, type: %s", boardPath, configType))
@@ -496,6 +804,14 @@ func (bf *boardFacet) StoreConfiguration(ctx context.Context, boardPath string,
 	if boardPath == "" {
 		return fmt.Errorf("board path cannot be empty")
 	}
+	if bf.isOwnBoard(boardPath) {
+		if err := bf.lock.checkWritable(); err != nil {
+			return err
+		}
+		if err := bf.checkNoTransaction(); err != nil {
+			return err
+		}
+	}
 
 	// Validate configuration using RuleEngine if available
 	if bf.ruleEngine != nil {
@@ -511,8 +827,7 @@ func (bf *boardFacet) StoreConfiguration(ctx context.Context, boardPath string,
 	}
 
 	// Write to file
-	configPath := filepath.Join(boardPath, "board.json")
-	if err := os.WriteFile(configPath, jsonData, 0644); err != nil {
+	if err := bf.writeConfigurationFile(boardPath, jsonData); err != nil {
 		return fmt.Errorf("failed to write configuration: %w", err)
 	}
 
@@ -523,6 +838,27 @@ func (bf *boardFacet) StoreConfiguration(ctx context.Context, boardPath string,
 	return nil
 }
 
+// writeConfigurationFile writes board.json. On the own board it refuses to overwrite external edits not seen
+// yet and records the new content, so that the watch does not report our own write.
+func (bf *boardFacet) writeConfigurationFile(boardPath string, data []byte) error {
+	configPath := filepath.Join(boardPath, boardConfigFileName)
+	if !bf.isOwnBoard(boardPath) {
+		return utilities.WriteFileAtomic(configPath, data, 0644)
+	}
+
+	bf.mutex.Lock()
+	defer bf.mutex.Unlock()
+
+	if err := bf.journal.verify(bf.repository.Path(), boardConfigFileName); err != nil {
+		return err
+	}
+	if err := utilities.WriteFileAtomic(configPath, data, 0644); err != nil {
+		return err
+	}
+	bf.journal.record(boardConfigFileName, contentHash(data))
+	return nil
+}
+
 // validateConfigDataWithRuleEngine validates configuration data using RuleEngine
 func (bf *boardFacet) validateConfigDataWithRuleEngine(ctx context.Context, configData map[string]interface{}) error {
 	// Convert generic config data to BoardConfiguration for validation
@@ -542,8 +878,8 @@ func (bf *boardFacet) validateConfigDataWithRuleEngine(ctx context.Context, conf
 // commitConfigurationChange commits configuration changes to git
 func (bf *boardFacet) commitConfigurationChange(boardPath string, configType string) {
 	// Best effort git commit - don't fail if git operations fail
-	message := fmt.Sprintf("Update board configuration: %s", configType)
-	if _, err := bf.repository.Commit(message); err != nil {
+	message := newConfigurationCommitMessage(configType, fmt.Sprintf("Update board configuration: %s", configType))
+	if _, err := bf.repository.Commit(message.String()); err != nil {
 		bf.logger.LogMessage(utilities.Warning, "BoardFacet", fmt.Sprintf("Failed to commit configuration changes: %v", err))
 	}
 }
@@ -612,24 +948,27 @@ func (bf *boardFacet) Create(ctx context.Context, request *BoardCreationRequest)
 	}
 
 	// Write configuration file
-	configPath := filepath.Join(request.BoardPath, "board.json")
 	configData, err := json.MarshalIndent(config, "", "  ")
 	if err != nil {
 		return nil, fmt.Errorf("failed to serialize board configuration: %w", err)
 	}
 
-	if err := os.WriteFile(configPath, configData, 0644); err != nil {
+	if err := bf.writeConfigurationFile(request.BoardPath, configData); err != nil {
 		return nil, fmt.Errorf("failed to write board configuration: %w", err)
 	}
 
-	result.ConfigPath = configPath
+	result.ConfigPath = filepath.Join(request.BoardPath, boardConfigFileName)
 
-	// Initialize empty task files
-	emptyTasks := "[]"
-	for _, taskFile := range []string{"active.json", "archived.json"} {
-		taskPath := filepath.Join(request.BoardPath, taskFile)
-		if err := os.WriteFile(taskPath, []byte(emptyTasks), 0644); err != nil {
-			return nil, fmt.Errorf("failed to create task file %s: %w", taskFile, err)
+	// Create column and section directories for the task files
+	for _, column := range config.Columns {
+		dirs := []string{filepath.Join(request.BoardPath, column)}
+		for _, section := range config.Sections[column] {
+			dirs = append(dirs, filepath.Join(request.BoardPath, column, section))
+		}
+		for _, dir := range dirs {
+			if err := os.MkdirAll(dir, 0755); err != nil {
+				return nil, fmt.Errorf("failed to create task directory %s: %w", dir, err)
+			}
 		}
 	}
 
@@ -784,5 +1123,28 @@ func (bf *boardFacet) GetBoardConfiguration() (*BoardConfiguration, error) {
 // UpdateBoardConfiguration updates the board configuration (integrated from IConfiguration)
 func (bf *boardFacet) UpdateBoardConfiguration(config *BoardConfiguration) error {
 	bf.logger.LogMessage(utilities.Debug, "BoardFacet", "Updating board configuration")
+	if err := bf.lock.checkWritable(); err != nil {
+		return err
+	}
+	if err := bf.checkNoTransaction(); err != nil {
+		return err
+	}
 	return bf.configFacet.UpdateBoardConfiguration(config)
+}
+
+// checkNoTransaction refuses configuration commits, which would include the staged changes of an open transaction
+func (bf *boardFacet) checkNoTransaction() error {
+	bf.mutex.RLock()
+	defer bf.mutex.RUnlock()
+	return bf.transaction.checkIdle()
+}
+
+// isOwnBoard reports whether a board path refers to the board this BoardAccess was opened on
+func (bf *boardFacet) isOwnBoard(boardPath string) bool {
+	absPath, err := filepath.Abs(boardPath)
+	if err != nil {
+		return false
+	}
+	ownPath, err := filepath.Abs(bf.repository.Path())
+	return err == nil && absPath == ownPath
 }
\ No newline at end of file
@@ -0,0 +1,230 @@
+// Package board_access provides BoardAccess layer components implementing the iDesign methodology.
+// This file implements the advisory lock that keeps a second process from writing to an open board.
+package board_access
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// ErrBoardReadOnly reports a write to a board that was opened read-only because another process holds its lock
+var ErrBoardReadOnly = errors.New("board is opened read-only")
+
+// LockedByMetadataKey is the BoardMetadata entry describing the process holding a board's lock
+const LockedByMetadataKey = "locked_by"
+
+// boardLockFile is the lock file location relative to the board root
+var boardLockFile = filepath.Join(".eisenkan", "board.lock")
+
+// unreadableLockGracePeriod is how long an unreadable lock file is assumed to be still being written by its creator
+const unreadableLockGracePeriod = 5 * time.Second
+
+// BoardLockHolder identifies the process holding a board's write lock
+type BoardLockHolder struct {
+	PID        int       `json:"pid"`
+	Hostname   string    `json:"hostname"`
+	Program    string    `json:"program"`
+	AcquiredAt time.Time `json:"acquired_at"`
+}
+
+// String describes the holder for error messages and dialogs
+func (h *BoardLockHolder) String() string {
+	return fmt.Sprintf("%s (pid %d on %s) since %s", h.Program, h.PID, h.Hostname, h.AcquiredAt.Local().Format("2006-01-02 15:04"))
+}
+
+// BoardLockStatus describes whether a BoardAccess may write to its board
+type BoardLockStatus struct {
+	ReadOnly bool             `json:"read_only"`
+	Holder   *BoardLockHolder `json:"holder,omitempty"` // the other process, when it holds the lock
+	Reason   string           `json:"reason,omitempty"` // why the board is read-only
+}
+
+// processBoardLocks counts the BoardAccess instances of this process sharing a board lock,
+// since the lock only guards against other processes
+var processBoardLocks = struct {
+	sync.Mutex
+	held map[string]int
+}{held: make(map[string]int)}
+
+// boardLock is the lock of one BoardAccess instance, or its read-only fallback
+type boardLock struct {
+	path   string
+	status BoardLockStatus
+}
+
+// acquireBoardLock takes the board's lock, removing a stale one left by a crashed process.
+// If the lock cannot be taken the board is opened read-only instead of failing.
+func acquireBoardLock(boardPath string, logger utilities.ILoggingUtility) *boardLock {
+	lock := &boardLock{path: filepath.Join(boardPath, boardLockFile)}
+	if absPath, err := filepath.Abs(lock.path); err == nil {
+		lock.path = absPath
+	}
+
+	processBoardLocks.Lock()
+	defer processBoardLocks.Unlock()
+
+	if processBoardLocks.held[lock.path] > 0 {
+		processBoardLocks.held[lock.path]++
+		return lock
+	}
+
+	err := lock.create()
+	if errors.Is(err, os.ErrExist) {
+		// Our own open instances are counted above, so a lock of this process is left over as well
+		holder := readBoardLockHolder(lock.path)
+		if (holder == nil && lockFileAge(lock.path) > unreadableLockGracePeriod) || (holder != nil && (holder.isStale() || holder.isCurrentProcess())) {
+			logger.LogMessage(utilities.Warning, "BoardAccess", fmt.Sprintf("Removing stale board lock %s", lock.path))
+			if removeErr := os.Remove(lock.path); removeErr == nil || os.IsNotExist(removeErr) {
+				err = lock.create()
+			}
+		}
+	}
+
+	if err != nil {
+		lock.status.ReadOnly = true
+		if holder := readBoardLockHolder(lock.path); errors.Is(err, os.ErrExist) && holder != nil {
+			lock.status.Holder = holder
+			lock.status.Reason = fmt.Sprintf("in use by %s", holder)
+		} else {
+			lock.status.Reason = fmt.Sprintf("failed to lock board: %v", err)
+		}
+		logger.LogMessage(utilities.Warning, "BoardAccess", fmt.Sprintf("Opening board %s read-only, %s", boardPath, lock.status.Reason))
+		return lock
+	}
+
+	processBoardLocks.held[lock.path] = 1
+	return lock
+}
+
+// create writes the lock file, failing with os.ErrExist if another process holds the lock
+func (l *boardLock) create() error {
+	if err := os.MkdirAll(filepath.Dir(l.path), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(currentBoardLockHolder(), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(l.path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		os.Remove(l.path)
+		return err
+	}
+	return file.Close()
+}
+
+// checkWritable fails with ErrBoardReadOnly if the board was opened read-only
+func (l *boardLock) checkWritable() error {
+	if l.status.ReadOnly {
+		return fmt.Errorf("%w: %s", ErrBoardReadOnly, l.status.Reason)
+	}
+	return nil
+}
+
+// release gives up the lock once the last BoardAccess of this process sharing it is closed
+func (l *boardLock) release() error {
+	if l.status.ReadOnly {
+		return nil
+	}
+
+	processBoardLocks.Lock()
+	defer processBoardLocks.Unlock()
+
+	processBoardLocks.held[l.path]--
+	if processBoardLocks.held[l.path] > 0 {
+		return nil
+	}
+	delete(processBoardLocks.held, l.path)
+
+	// Leave a lock alone that another process took over after declaring ours stale
+	if holder := readBoardLockHolder(l.path); holder != nil && !holder.isCurrentProcess() {
+		return nil
+	}
+	if err := os.Remove(l.path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove board lock: %w", err)
+	}
+	return nil
+}
+
+// currentBoardLockHolder describes this process as lock holder
+func currentBoardLockHolder() *BoardLockHolder {
+	hostname, _ := os.Hostname()
+	return &BoardLockHolder{
+		PID:        os.Getpid(),
+		Hostname:   hostname,
+		Program:    filepath.Base(os.Args[0]),
+		AcquiredAt: time.Now(),
+	}
+}
+
+// readBoardLockHolder returns the holder recorded in a lock file, or nil if it is missing or unreadable
+func readBoardLockHolder(path string) *BoardLockHolder {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var holder BoardLockHolder
+	if json.Unmarshal(data, &holder) != nil || holder.PID == 0 {
+		return nil
+	}
+	return &holder
+}
+
+// lockFileAge returns how long ago a lock file was written
+func lockFileAge(path string) time.Duration {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0
+	}
+	return time.Since(info.ModTime())
+}
+
+// activeBoardLockHolder returns the other process holding a board's lock, or nil if the board is free
+func activeBoardLockHolder(boardPath string) *BoardLockHolder {
+	holder := readBoardLockHolder(filepath.Join(boardPath, boardLockFile))
+	if holder == nil || holder.isCurrentProcess() || holder.isStale() {
+		return nil
+	}
+	return holder
+}
+
+// isCurrentProcess reports whether the lock was taken by this process
+func (h *BoardLockHolder) isCurrentProcess() bool {
+	hostname, _ := os.Hostname()
+	return h.PID == os.Getpid() && h.Hostname == hostname
+}
+
+// isStale reports whether the holding process is gone; locks held on other hosts are never considered stale
+func (h *BoardLockHolder) isStale() bool {
+	hostname, _ := os.Hostname()
+	return h.Hostname == hostname && !processAlive(h.PID)
+}
+
+// processAlive reports whether a process with the given ID exists on this host
+func processAlive(pid int) bool {
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	// Windows cannot signal processes, but FindProcess already failed there if the process is gone
+	if runtime.GOOS == "windows" {
+		return true
+	}
+	err = process.Signal(syscall.Signal(0))
+	return err == nil || errors.Is(err, syscall.EPERM)
+}
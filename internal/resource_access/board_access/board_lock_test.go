@@ -0,0 +1,137 @@
+package board_access
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeForeignLock makes a board look locked by the given process
+func writeForeignLock(t *testing.T, boardDir string, pid int) {
+	t.Helper()
+	hostname, _ := os.Hostname()
+	data, err := json.Marshal(BoardLockHolder{PID: pid, Hostname: hostname, Program: "other-eisenkan", AcquiredAt: time.Now()})
+	if err != nil {
+		t.Fatalf("Failed to encode lock: %v", err)
+	}
+	lockPath := filepath.Join(boardDir, boardLockFile)
+	if err := os.MkdirAll(filepath.Dir(lockPath), 0755); err != nil {
+		t.Fatalf("Failed to create lock directory: %v", err)
+	}
+	if err := os.WriteFile(lockPath, data, 0644); err != nil {
+		t.Fatalf("Failed to write lock: %v", err)
+	}
+}
+
+func TestIntegration_BoardAccess_LockSharedWithinProcess(t *testing.T) {
+	boardDir := t.TempDir()
+	lockPath := filepath.Join(boardDir, boardLockFile)
+
+	first, err := NewBoardAccess(boardDir)
+	if err != nil {
+		t.Fatalf("Failed to create BoardAccess: %v", err)
+	}
+	if first.LockStatus().ReadOnly {
+		t.Fatalf("Expected the first opener to hold the lock, got %+v", first.LockStatus())
+	}
+	if _, err := os.Stat(lockPath); err != nil {
+		t.Fatalf("Expected a lock file: %v", err)
+	}
+
+	// Several BoardAccess instances of one process, e.g. TaskManager and the rules, share the lock
+	second, err := NewBoardAccess(boardDir)
+	if err != nil {
+		t.Fatalf("Failed to create second BoardAccess: %v", err)
+	}
+	if _, err := second.CreateTask(&Task{Title: "Shared"}, Priority{Urgent: true}, WorkflowStatus{Column: "todo", Section: "urgent-not-important"}, nil); err != nil {
+		t.Errorf("Expected the second instance to be writable, got %v", err)
+	}
+
+	if err := first.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	if _, err := os.Stat(lockPath); err != nil {
+		t.Errorf("Expected the lock to be kept while the second instance is open: %v", err)
+	}
+	if err := second.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	if _, err := os.Stat(lockPath); !os.IsNotExist(err) {
+		t.Errorf("Expected the lock to be released on Close, got %v", err)
+	}
+}
+
+func TestIntegration_BoardAccess_LockedBoardOpensReadOnly(t *testing.T) {
+	boardDir := t.TempDir()
+	setup, err := NewBoardAccess(boardDir)
+	if err != nil {
+		t.Fatalf("Failed to create BoardAccess: %v", err)
+	}
+	taskID, err := setup.CreateTask(&Task{Title: "Existing"}, Priority{Urgent: true}, WorkflowStatus{Column: "todo", Section: "urgent-not-important"}, nil)
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	setup.Close()
+
+	// The parent process, e.g. the go tool, stands in for another EisenKan instance
+	writeForeignLock(t, boardDir, os.Getppid())
+
+	ba, err := NewBoardAccess(boardDir)
+	if err != nil {
+		t.Fatalf("Expected a locked board to open read-only, got %v", err)
+	}
+	defer ba.Close()
+
+	status := ba.LockStatus()
+	if !status.ReadOnly || status.Holder == nil || status.Holder.PID != os.Getppid() {
+		t.Fatalf("Expected a read-only status naming the holder, got %+v", status)
+	}
+
+	if tasks, err := ba.GetTasksData([]string{taskID}, false); err != nil || len(tasks) != 1 {
+		t.Errorf("Expected reads to work, got %v (%v)", tasks, err)
+	}
+	if _, err := ba.CreateTask(&Task{Title: "Refused"}, Priority{}, WorkflowStatus{Column: "todo"}, nil); !errors.Is(err, ErrBoardReadOnly) {
+		t.Errorf("Expected ErrBoardReadOnly from CreateTask, got %v", err)
+	}
+	if err := ba.ArchiveTask(taskID, NoAction); !errors.Is(err, ErrBoardReadOnly) {
+		t.Errorf("Expected ErrBoardReadOnly from ArchiveTask, got %v", err)
+	}
+	if err := ba.UpdateBoardConfiguration(&BoardConfiguration{Name: "Renamed", Columns: []string{"todo", "doing", "done"}}); !errors.Is(err, ErrBoardReadOnly) {
+		t.Errorf("Expected ErrBoardReadOnly from UpdateBoardConfiguration, got %v", err)
+	}
+
+	metadata, err := ba.ExtractMetadata(t.Context(), boardDir)
+	if err != nil {
+		t.Fatalf("ExtractMetadata failed: %v", err)
+	}
+	if metadata.Metadata[LockedByMetadataKey] == "" {
+		t.Errorf("Expected metadata to name the lock holder, got %+v", metadata.Metadata)
+	}
+
+	// Closing the read-only instance leaves the other process' lock alone
+	ba.Close()
+	if holder := readBoardLockHolder(filepath.Join(boardDir, boardLockFile)); holder == nil || holder.PID != os.Getppid() {
+		t.Errorf("Expected the foreign lock to be kept, got %+v", holder)
+	}
+}
+
+func TestIntegration_BoardAccess_TakesOverStaleLock(t *testing.T) {
+	boardDir := t.TempDir()
+	writeForeignLock(t, boardDir, 1<<30) // no such process
+
+	ba, err := NewBoardAccess(boardDir)
+	if err != nil {
+		t.Fatalf("Failed to create BoardAccess: %v", err)
+	}
+	defer ba.Close()
+
+	if ba.LockStatus().ReadOnly {
+		t.Fatalf("Expected the stale lock to be taken over, got %+v", ba.LockStatus())
+	}
+	if holder := readBoardLockHolder(filepath.Join(boardDir, boardLockFile)); holder == nil || !holder.isCurrentProcess() {
+		t.Errorf("Expected the lock to name this process, got %+v", holder)
+	}
+}
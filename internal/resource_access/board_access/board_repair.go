@@ -0,0 +1,268 @@
+// Package board_access provides BoardAccess layer components implementing the iDesign methodology.
+// This file implements the repair of boards left inconsistent by crashes or edits outside the application.
+package board_access
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+const (
+	// boardConfigFileName is the board configuration checked by ValidateStructure
+	boardConfigFileName = "board.json"
+
+	// maxRepairPasses bounds how often issues are collected again; restoring board.json can reveal missing
+	// directories of its columns, and renumbering depends on which duplicates were removed
+	maxRepairPasses = 3
+
+	// maxRestoreCandidates is the number of committed versions searched for a parseable one
+	maxRestoreCandidates = 100
+)
+
+// quarantineDir keeps corrupt files without a parseable committed version, so their content is not lost
+var quarantineDir = filepath.Join(".eisenkan", "quarantine")
+
+// RepairBoard fixes the issues ValidateStructure reports for the board: corrupt JSON files are restored from the
+// last commit holding a parseable version, missing directories re-created, leftovers of interrupted writes removed
+// and colliding task positions renumbered; all changes are recorded in a single commit
+func (bf *boardFacet) RepairBoard(ctx context.Context) (*BoardRepairResult, error) {
+	boardPath := bf.repository.Path()
+	bf.logger.LogMessage(utilities.Debug, "BoardFacet", fmt.Sprintf("Repairing board: %s", boardPath))
+
+	if err := bf.lock.checkWritable(); err != nil {
+		return nil, err
+	}
+
+	bf.mutex.Lock()
+	defer bf.mutex.Unlock()
+
+	if err := bf.transaction.checkIdle(); err != nil {
+		return nil, err
+	}
+
+	result := &BoardRepairResult{}
+	attempted := make(map[string]bool)
+	var changed []string
+	for pass := 0; pass < maxRepairPasses; pass++ {
+		validation, err := bf.ValidateStructure(ctx, boardPath)
+		if err != nil {
+			return nil, err
+		}
+
+		repaired := false
+		for _, issue := range append(validation.Issues, validation.Warnings...) {
+			key := issue.Repair + ":" + issue.Path
+			if issue.Repair == "" || issue.Path == "" || attempted[key] {
+				continue
+			}
+			attempted[key] = true
+
+			actions, paths, err := bf.repairIssue(boardPath, issue)
+			if err != nil {
+				return nil, fmt.Errorf("failed to repair %s: %w", issue.Path, err)
+			}
+			result.Actions = append(result.Actions, actions...)
+			changed = append(changed, paths...)
+			repaired = true
+		}
+		if !repaired {
+			break
+		}
+	}
+
+	if len(changed) > 0 {
+		commit, err := bf.commitRepair(changed, len(result.Actions))
+		if err != nil {
+			return nil, err
+		}
+		result.Commit = commit
+	}
+
+	validation, err := bf.ValidateStructure(ctx, boardPath)
+	if err != nil {
+		return nil, err
+	}
+	result.Validation = validation
+
+	bf.logger.LogMessage(utilities.Info, "BoardFacet", fmt.Sprintf("Repaired board %s with %d actions: valid=%t", boardPath, len(result.Actions), validation.IsValid))
+	return result, nil
+}
+
+// repairIssue applies the repair of a validation issue and returns the board-relative paths to commit
+func (bf *boardFacet) repairIssue(boardPath string, issue BoardValidationIssue) ([]BoardRepairAction, []string, error) {
+	fullPath := filepath.Join(boardPath, issue.Path)
+
+	switch issue.Repair {
+	case RepairRestoreFile:
+		action, err := bf.restoreFile(boardPath, issue.Path)
+		if err != nil {
+			return nil, nil, err
+		}
+		return []BoardRepairAction{*action}, []string{issue.Path}, nil
+
+	case RepairCreateDirectory:
+		if err := os.MkdirAll(fullPath, 0755); err != nil {
+			return nil, nil, fmt.Errorf("failed to create directory: %w", err)
+		}
+		// Git does not track directories, there is nothing to commit
+		return []BoardRepairAction{{Repair: RepairCreateDirectory, Path: issue.Path, Message: fmt.Sprintf("Created directory %s", issue.Path)}}, nil, nil
+
+	case RepairRemoveTempFile:
+		if err := os.Remove(fullPath); err != nil && !os.IsNotExist(err) {
+			return nil, nil, fmt.Errorf("failed to remove temporary file: %w", err)
+		}
+		return []BoardRepairAction{{Repair: RepairRemoveTempFile, Path: issue.Path, Message: fmt.Sprintf("Removed temporary file %s", issue.Path)}}, nil, nil
+
+	case RepairRemoveDuplicate:
+		// The outdated file may have been changed outside the application, so the journal is not consulted
+		if err := newTaskStorage(boardPath).remove(issue.Path); err != nil {
+			return nil, nil, err
+		}
+		bf.journal.record(issue.Path, absentContent)
+		return []BoardRepairAction{{Repair: RepairRemoveDuplicate, Path: issue.Path, Message: fmt.Sprintf("Removed outdated task file %s", issue.Path)}}, []string{issue.Path}, nil
+
+	case RepairReindex:
+		return bf.reindexPositions(boardPath, issue.Path)
+	}
+
+	return nil, nil, fmt.Errorf("unknown repair %s", issue.Repair)
+}
+
+// restoreFile replaces a corrupt file by its newest parseable committed version. Without one, board.json is
+// rewritten from the board configuration and a task file is moved to the quarantine.
+func (bf *boardFacet) restoreFile(boardPath, relPath string) (*BoardRepairAction, error) {
+	slashPath := filepath.ToSlash(relPath)
+	history, err := bf.repository.GetFileHistory(slashPath, maxRestoreCandidates)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history: %w", err)
+	}
+
+	for _, commit := range history {
+		data, err := bf.repository.GetFileAt(commit.ID, slashPath)
+		if err != nil || !isParseableBoardFile(relPath, data) {
+			continue
+		}
+		if err := bf.writeRepairedFile(boardPath, relPath, data); err != nil {
+			return nil, err
+		}
+		return &BoardRepairAction{Repair: RepairRestoreFile, Path: relPath, Message: fmt.Sprintf("Restored %s from commit %s", relPath, shortRevision(commit.ID))}, nil
+	}
+
+	if relPath == boardConfigFileName {
+		config, err := bf.configFacet.GetBoardConfiguration()
+		if err != nil {
+			return nil, err
+		}
+		data, err := json.MarshalIndent(config, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize board configuration: %w", err)
+		}
+		if err := bf.writeRepairedFile(boardPath, relPath, data); err != nil {
+			return nil, err
+		}
+		return &BoardRepairAction{Repair: RepairRestoreFile, Path: relPath, Message: fmt.Sprintf("Recreated %s from the board configuration", relPath)}, nil
+	}
+
+	quarantinePath := filepath.Join(quarantineDir, relPath)
+	if err := os.MkdirAll(filepath.Join(boardPath, filepath.Dir(quarantinePath)), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create quarantine directory: %w", err)
+	}
+	if err := os.Rename(filepath.Join(boardPath, relPath), filepath.Join(boardPath, quarantinePath)); err != nil {
+		return nil, fmt.Errorf("failed to quarantine file: %w", err)
+	}
+	bf.journal.record(relPath, absentContent)
+	return &BoardRepairAction{Repair: RepairRestoreFile, Path: relPath, Message: fmt.Sprintf("Moved %s without a valid committed version to %s", relPath, quarantinePath)}, nil
+}
+
+// isParseableBoardFile reports whether content restored for a board file can be read again
+func isParseableBoardFile(relPath string, data []byte) bool {
+	if relPath == boardConfigFileName {
+		var config BoardConfiguration
+		return json.Unmarshal(data, &config) == nil
+	}
+	if ref, ok := parseTaskPath(filepath.ToSlash(relPath)); ok {
+		_, err := decodeTask(*ref, data, "")
+		return err == nil
+	}
+	return json.Valid(data)
+}
+
+// writeRepairedFile replaces a board file and records the new content as written by the application
+func (bf *boardFacet) writeRepairedFile(boardPath, relPath string, data []byte) error {
+	fullPath := filepath.Join(boardPath, relPath)
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+		return fmt.Errorf("failed to create directory for %s: %w", relPath, err)
+	}
+	if err := utilities.WriteFileAtomic(fullPath, data, 0644); err != nil {
+		return err
+	}
+	bf.journal.record(relPath, contentHash(data))
+	return nil
+}
+
+// reindexPositions renumbers the explicitly positioned task files of a directory 1..N in their current order
+func (bf *boardFacet) reindexPositions(boardPath, relDir string) ([]BoardRepairAction, []string, error) {
+	refs, err := newTaskStorage(boardPath).scan()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var actions []BoardRepairAction
+	var paths []string
+	position := 0
+	for _, ref := range refs {
+		if ref.Position == 0 || filepath.Dir(ref.RelPath) != relDir {
+			continue
+		}
+		position++
+		if ref.Position == position {
+			continue
+		}
+
+		name := filepath.Base(ref.RelPath)
+		match := taskFileNamePattern.FindStringSubmatch(name)
+		newPath := filepath.Join(relDir, fmt.Sprintf("%03d", position)+name[len(match[1]):])
+		if _, err := os.Stat(filepath.Join(boardPath, newPath)); err == nil {
+			return nil, nil, fmt.Errorf("cannot renumber %s, %s already exists", ref.RelPath, newPath)
+		}
+		if err := os.Rename(filepath.Join(boardPath, ref.RelPath), filepath.Join(boardPath, newPath)); err != nil {
+			return nil, nil, fmt.Errorf("failed to renumber %s: %w", ref.RelPath, err)
+		}
+
+		content, err := currentContent(filepath.Join(boardPath, newPath))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read renumbered file %s: %w", newPath, err)
+		}
+		bf.journal.record(ref.RelPath, absentContent)
+		bf.journal.record(newPath, content)
+
+		actions = append(actions, BoardRepairAction{Repair: RepairReindex, Path: newPath, Message: fmt.Sprintf("Renumbered %s to position %d", ref.RelPath, position)})
+		paths = append(paths, ref.RelPath, newPath)
+	}
+
+	return actions, paths, nil
+}
+
+// commitRepair records the repaired files in a single commit; restoring the committed version of a file
+// leaves nothing to commit
+func (bf *boardFacet) commitRepair(paths []string, actionCount int) (string, error) {
+	if err := bf.repository.Stage(paths); err != nil {
+		return "", fmt.Errorf("failed to stage repaired files: %w", err)
+	}
+
+	message := withAffectedTasks(utilities.NewCommitMessage(OperationRepair, fmt.Sprintf("Repair board with %d changes", actionCount)), paths)
+	commit, err := bf.repository.Commit(message.String())
+	if errors.Is(err, utilities.ErrNothingToCommit) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to commit repaired files: %w", err)
+	}
+	return commit, nil
+}
@@ -0,0 +1,193 @@
+package board_access
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+func TestIntegration_BoardAccess_RepairCorruptFixture(t *testing.T) {
+	boardDir := copyFixtureBoard(t, "board_eisenhower_corrupt")
+
+	ba, err := NewBoardAccess(boardDir)
+	if err != nil {
+		t.Fatalf("Failed to create BoardAccess: %v", err)
+	}
+	defer ba.Close()
+
+	validation, err := ba.ValidateStructure(context.Background(), boardDir)
+	if err != nil {
+		t.Fatalf("ValidateStructure failed: %v", err)
+	}
+	if validation.IsValid || validation.ConfigValid {
+		t.Fatalf("Expected the corrupt board.json to be reported, got %+v", validation)
+	}
+	if len(validation.Issues) != 1 || validation.Issues[0].Path != "board.json" || validation.Issues[0].Repair != RepairRestoreFile {
+		t.Fatalf("Expected a repairable board.json issue, got %+v", validation.Issues)
+	}
+
+	result, err := ba.RepairBoard(context.Background())
+	if err != nil {
+		t.Fatalf("RepairBoard failed: %v", err)
+	}
+	if !result.Validation.IsValid || !result.Validation.ConfigValid {
+		t.Fatalf("Expected a valid board after the repair, got %+v", result.Validation)
+	}
+	for _, warning := range result.Validation.Warnings {
+		if warning.Repair != "" {
+			t.Errorf("Expected all repairable warnings to be fixed, got %+v", warning)
+		}
+	}
+
+	// Without history board.json is recreated from the board configuration, then its directories are created
+	data, err := os.ReadFile(filepath.Join(boardDir, "board.json"))
+	if err != nil {
+		t.Fatalf("Failed to read board.json: %v", err)
+	}
+	var config BoardConfiguration
+	if err := json.Unmarshal(data, &config); err != nil || len(config.Columns) == 0 {
+		t.Fatalf("Expected a parseable board.json, got %q (%v)", data, err)
+	}
+	for _, dir := range []string{"todo", filepath.Join("todo", "urgent-important"), "doing", "done"} {
+		if stat, err := os.Stat(filepath.Join(boardDir, dir)); err != nil || !stat.IsDir() {
+			t.Errorf("Expected directory %s to be created: %v", dir, err)
+		}
+	}
+	if len(result.Actions) < 2 || result.Actions[0].Repair != RepairRestoreFile {
+		t.Errorf("Expected board.json to be restored before directories are created, got %+v", result.Actions)
+	}
+	if result.Commit == "" {
+		t.Error("Expected the recreated board.json to be committed")
+	}
+}
+
+func TestIntegration_BoardAccess_RepairBoard(t *testing.T) {
+	boardDir := t.TempDir()
+	ba, err := NewBoardAccess(boardDir)
+	if err != nil {
+		t.Fatalf("Failed to create BoardAccess: %v", err)
+	}
+	defer ba.Close()
+
+	// Two tasks created at the same position collide
+	priority := Priority{Urgent: true, Important: true}
+	todo := WorkflowStatus{Column: "todo", Section: "urgent-important", Position: 1}
+	firstID, err := ba.CreateTask(&Task{Title: "First"}, priority, todo, nil)
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	if _, err := ba.CreateTask(&Task{Title: "Second"}, priority, todo, nil); err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	thirdID, err := ba.CreateTask(&Task{Title: "Third"}, Priority{Important: true}, WorkflowStatus{Column: "doing"}, nil)
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+
+	// A corrupt task file, a task left in two columns and a temporary file of an interrupted write
+	storage := newTaskStorage(boardDir)
+	first, err := storage.locate(firstID)
+	if err != nil || first == nil {
+		t.Fatalf("Failed to locate task: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(boardDir, first.RelPath), []byte(`{ "id": "`+firstID), 0644); err != nil {
+		t.Fatalf("Failed to corrupt task file: %v", err)
+	}
+	third, err := storage.locate(thirdID)
+	if err != nil || third == nil {
+		t.Fatalf("Failed to locate task: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(boardDir, third.RelPath))
+	if err != nil {
+		t.Fatalf("Failed to read task file: %v", err)
+	}
+	duplicate := filepath.Join("done", filepath.Base(third.RelPath))
+	if err := os.MkdirAll(filepath.Join(boardDir, "done"), 0755); err != nil {
+		t.Fatalf("Failed to create column: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(boardDir, duplicate), data, 0644); err != nil {
+		t.Fatalf("Failed to duplicate task file: %v", err)
+	}
+	tempFile := filepath.Join("todo", ".000-task-x.json.tmp-123")
+	if err := os.WriteFile(filepath.Join(boardDir, tempFile), []byte("{"), 0644); err != nil {
+		t.Fatalf("Failed to write temporary file: %v", err)
+	}
+
+	validation, err := ba.ValidateStructure(context.Background(), boardDir)
+	if err != nil {
+		t.Fatalf("ValidateStructure failed: %v", err)
+	}
+	repairs := make(map[string]string)
+	for _, issue := range append(validation.Issues, validation.Warnings...) {
+		repairs[issue.Path] = issue.Repair
+	}
+	expected := map[string]string{
+		first.RelPath: RepairRestoreFile,
+		duplicate:     RepairRemoveDuplicate,
+		tempFile:      RepairRemoveTempFile,
+		filepath.Join("todo", "urgent-important"): RepairReindex,
+	}
+	for path, repair := range expected {
+		if repairs[path] != repair {
+			t.Errorf("Expected %s for %s, got %q in %+v", repair, path, repairs[path], validation)
+		}
+	}
+
+	result, err := ba.RepairBoard(context.Background())
+	if err != nil {
+		t.Fatalf("RepairBoard failed: %v", err)
+	}
+	if !result.Validation.IsValid || len(result.Validation.Issues) != 0 {
+		t.Fatalf("Expected a valid board after the repair, got %+v", result.Validation)
+	}
+	for _, warning := range result.Validation.Warnings {
+		if warning.Repair != "" {
+			t.Errorf("Expected all repairable warnings to be fixed, got %+v", warning)
+		}
+	}
+
+	tasks, err := ba.GetTasksData([]string{firstID}, false)
+	if err != nil || len(tasks) != 1 || tasks[0].Task.Title != "First" {
+		t.Fatalf("Expected the task to be restored from git, got %v (%v)", tasks, err)
+	}
+	for _, path := range []string{duplicate, tempFile} {
+		if _, err := os.Stat(filepath.Join(boardDir, path)); !os.IsNotExist(err) {
+			t.Errorf("Expected %s to be removed: %v", path, err)
+		}
+	}
+	refs, err := storage.scan()
+	if err != nil {
+		t.Fatalf("Failed to scan board: %v", err)
+	}
+	var positions []int
+	for _, ref := range refs {
+		if ref.Section == "urgent-important" {
+			positions = append(positions, ref.Position)
+		}
+	}
+	if len(positions) != 2 || positions[0] != 1 || positions[1] != 2 {
+		t.Errorf("Expected positions 1 and 2, got %v", positions)
+	}
+
+	// The repair is one commit, and the repaired files are no longer reported as changed externally
+	revisions, err := ba.ListRevisions(1)
+	if err != nil || len(revisions) != 1 || revisions[0].ID != result.Commit {
+		t.Fatalf("Expected the repair commit on top, got %+v (%v)", revisions, err)
+	}
+	if message := utilities.ParseCommitInfo(revisions[0]); message.Operation() != OperationRepair {
+		t.Errorf("Expected a repair commit, got %+v", message)
+	}
+	if err := ba.ChangeTaskData(firstID, &Task{ID: firstID, Title: "First again"}, priority, tasks[0].Status); err != nil {
+		t.Errorf("Expected the restored task to be writable, got %v", err)
+	}
+
+	// A repaired board needs no further changes
+	again, err := ba.RepairBoard(context.Background())
+	if err != nil || len(again.Actions) != 0 || again.Commit != "" {
+		t.Errorf("Expected nothing to repair, got %+v (%v)", again, err)
+	}
+}
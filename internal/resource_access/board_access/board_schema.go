@@ -0,0 +1,251 @@
+// Package board_access provides BoardAccess layer components implementing the iDesign methodology.
+// This file implements the versioned board.json format and the migrations upgrading older boards.
+package board_access
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// CurrentBoardSchemaVersion is the board.json schema version written by this version of EisenKan.
+// Version 1 has no schema_version field and lists columns as IDs with a separate sections map, or as
+// column objects; version 2 stores the version and lists columns as column objects, which may carry the
+// column settings.
+const CurrentBoardSchemaVersion = 2
+
+// ErrUnsupportedSchemaVersion reports a board written by a newer version of EisenKan
+var ErrUnsupportedSchemaVersion = errors.New("unsupported board schema version")
+
+// boardColumnDocument is a column object of board.json
+type boardColumnDocument struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+	ColumnSettings
+	Sections []string `json:"sections,omitempty"`
+}
+
+// boardDocument is the on-disk JSON representation of board.json
+type boardDocument struct {
+	SchemaVersion int                   `json:"schema_version,omitempty"`
+	Name          string                `json:"name"`
+	Columns       []boardColumnDocument `json:"columns"`
+	Sections      map[string][]string   `json:"sections,omitempty"` // schema version 1 only
+	GitUser       string                `json:"git_user,omitempty"`
+	GitEmail      string                `json:"git_email,omitempty"`
+}
+
+// UnmarshalJSON accepts a column object as well as the plain column ID of schema version 1
+func (c *boardColumnDocument) UnmarshalJSON(data []byte) error {
+	var id string
+	if err := json.Unmarshal(data, &id); err == nil {
+		*c = boardColumnDocument{ID: id}
+		return nil
+	}
+
+	type columnObject boardColumnDocument
+	return json.Unmarshal(data, (*columnObject)(c))
+}
+
+// MarshalJSON writes a board configuration in the current board.json schema
+func (c BoardConfiguration) MarshalJSON() ([]byte, error) {
+	doc := boardDocument{
+		SchemaVersion: CurrentBoardSchemaVersion,
+		Name:          c.Name,
+		Columns:       make([]boardColumnDocument, 0, len(c.Columns)),
+		GitUser:       c.GitUser,
+		GitEmail:      c.GitEmail,
+	}
+	for _, column := range c.Columns {
+		doc.Columns = append(doc.Columns, boardColumnDocument{
+			ID:             column,
+			Name:           c.ColumnNames[column],
+			ColumnSettings: c.ColumnSettings[column],
+			Sections:       c.Sections[column],
+		})
+	}
+	return json.Marshal(doc)
+}
+
+// UnmarshalJSON reads a board configuration written in any supported board.json schema
+func (c *BoardConfiguration) UnmarshalJSON(data []byte) error {
+	var doc boardDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+
+	*c = BoardConfiguration{
+		SchemaVersion: doc.SchemaVersion,
+		Name:          doc.Name,
+		GitUser:       doc.GitUser,
+		GitEmail:      doc.GitEmail,
+	}
+	if c.SchemaVersion == 0 {
+		c.SchemaVersion = 1
+	}
+	for _, column := range doc.Columns {
+		c.Columns = append(c.Columns, column.ID)
+		if column.Name != "" {
+			if c.ColumnNames == nil {
+				c.ColumnNames = make(map[string]string)
+			}
+			c.ColumnNames[column.ID] = column.Name
+		}
+		if column.ColumnSettings != (ColumnSettings{}) {
+			if c.ColumnSettings == nil {
+				c.ColumnSettings = make(map[string]ColumnSettings)
+			}
+			c.ColumnSettings[column.ID] = column.ColumnSettings
+		}
+		if len(column.Sections) > 0 {
+			if c.Sections == nil {
+				c.Sections = make(map[string][]string)
+			}
+			c.Sections[column.ID] = column.Sections
+		}
+	}
+	for column, sections := range doc.Sections {
+		if c.Sections == nil {
+			c.Sections = make(map[string][]string)
+		}
+		if _, exists := c.Sections[column]; !exists {
+			c.Sections[column] = sections
+		}
+	}
+	return nil
+}
+
+// readBoardConfiguration returns the configuration in board.json, or the default columns when it is
+// missing or cannot be parsed
+func readBoardConfiguration(boardPath string) *BoardConfiguration {
+	if data, err := os.ReadFile(filepath.Join(boardPath, boardConfigFileName)); err == nil {
+		var config BoardConfiguration
+		if json.Unmarshal(data, &config) == nil && len(config.Columns) > 0 {
+			return &config
+		}
+	}
+	return &BoardConfiguration{Columns: []string{"todo", "doing", "done"}}
+}
+
+// boardMigration upgrades board.json from one schema version to the next. Migrations work on the generic
+// JSON document so that fields unknown to this version of EisenKan survive.
+type boardMigration struct {
+	from        int
+	description string
+	migrate     func(document map[string]interface{}) error
+}
+
+// boardMigrations lists the migrations in order, the migration from version n upgrades to version n+1
+var boardMigrations = []boardMigration{
+	{from: 1, description: "store columns as column objects", migrate: migrateColumnObjects},
+}
+
+// migrateColumnObjects replaces column IDs and the sections map by column objects
+func migrateColumnObjects(document map[string]interface{}) error {
+	sections, _ := document["sections"].(map[string]interface{})
+	columns, _ := document["columns"].([]interface{})
+
+	migrated := make([]interface{}, 0, len(columns))
+	for _, column := range columns {
+		switch value := column.(type) {
+		case string:
+			object := map[string]interface{}{"id": value}
+			if columnSections, ok := sections[value]; ok {
+				object["sections"] = columnSections
+			}
+			migrated = append(migrated, object)
+		case map[string]interface{}:
+			if id, ok := value["id"].(string); ok {
+				if _, hasSections := value["sections"]; !hasSections && sections[id] != nil {
+					value["sections"] = sections[id]
+				}
+			}
+			migrated = append(migrated, value)
+		default:
+			return fmt.Errorf("unexpected column %v", column)
+		}
+	}
+
+	document["columns"] = migrated
+	delete(document, "sections")
+	return nil
+}
+
+// boardSchemaVersion returns the schema version of a board.json document
+func boardSchemaVersion(document map[string]interface{}) (int, error) {
+	value, exists := document["schema_version"]
+	if !exists {
+		return 1, nil
+	}
+	version, ok := value.(float64)
+	if !ok || version < 1 || version != float64(int(version)) {
+		return 0, fmt.Errorf("invalid schema version %v", value)
+	}
+	return int(version), nil
+}
+
+// migrateBoardSchema upgrades board.json to the current schema version with one commit per migration.
+// A board without board.json, or with one that cannot be parsed, is left to validation and repair.
+func migrateBoardSchema(repository utilities.Repository, logger utilities.ILoggingUtility, journal *fileJournal) error {
+	configPath := filepath.Join(repository.Path(), boardConfigFileName)
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read %s: %w", boardConfigFileName, err)
+	}
+	journal.observe(boardConfigFileName, data)
+
+	var document map[string]interface{}
+	if json.Unmarshal(data, &document) != nil || document == nil {
+		return nil
+	}
+	version, err := boardSchemaVersion(document)
+	if err != nil {
+		return nil
+	}
+	if version > CurrentBoardSchemaVersion {
+		return fmt.Errorf("%s has schema version %d, this version of EisenKan supports up to %d: %w", boardConfigFileName, version, CurrentBoardSchemaVersion, ErrUnsupportedSchemaVersion)
+	}
+
+	for _, migration := range boardMigrations {
+		if migration.from != version {
+			continue
+		}
+		if err := migration.migrate(document); err != nil {
+			return fmt.Errorf("failed to migrate %s to schema version %d: %w", boardConfigFileName, version+1, err)
+		}
+		version++
+		document["schema_version"] = version
+
+		data, err := json.MarshalIndent(document, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to serialize %s: %w", boardConfigFileName, err)
+		}
+		if err := journal.verify(repository.Path(), boardConfigFileName); err != nil {
+			return err
+		}
+		if err := utilities.WriteFileAtomic(configPath, data, 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", boardConfigFileName, err)
+		}
+		journal.record(boardConfigFileName, contentHash(data))
+		if err := repository.Stage([]string{boardConfigFileName}); err != nil {
+			return fmt.Errorf("failed to stage %s: %w", boardConfigFileName, err)
+		}
+		message := utilities.NewCommitMessage(OperationMigrate, fmt.Sprintf("Migrate %s to schema version %d: %s", boardConfigFileName, version, migration.description)).
+			With(utilities.TrailerSchemaVersion, strconv.Itoa(version))
+		if _, err := repository.Commit(message.String()); err != nil {
+			return fmt.Errorf("failed to commit migrated %s: %w", boardConfigFileName, err)
+		}
+
+		logger.LogMessage(utilities.Info, "BoardAccess", fmt.Sprintf("Migrated %s to schema version %d: %s", boardConfigFileName, version, migration.description))
+	}
+
+	return nil
+}
@@ -0,0 +1,265 @@
+package board_access
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+func TestUnit_BoardAccess_BoardConfigurationSchemas(t *testing.T) {
+	// Schema version 1 lists columns as IDs with a separate sections map, or as column objects
+	var legacy BoardConfiguration
+	if err := json.Unmarshal([]byte(`{"name":"Legacy","columns":["todo","done"],"sections":{"todo":["urgent-important"]}}`), &legacy); err != nil {
+		t.Fatalf("Failed to parse column IDs: %v", err)
+	}
+	var objects BoardConfiguration
+	if err := json.Unmarshal([]byte(`{"name":"Legacy","columns":[{"id":"todo","name":"To Do","sections":["urgent-important"]},{"id":"done"}]}`), &objects); err != nil {
+		t.Fatalf("Failed to parse column objects: %v", err)
+	}
+	for _, config := range []BoardConfiguration{legacy, objects} {
+		if config.SchemaVersion != 1 || !reflect.DeepEqual(config.Columns, []string{"todo", "done"}) ||
+			!reflect.DeepEqual(config.Sections, map[string][]string{"todo": {"urgent-important"}}) {
+			t.Errorf("Unexpected configuration %+v", config)
+		}
+	}
+	if objects.ColumnNames["todo"] != "To Do" {
+		t.Errorf("Expected the column name, got %v", objects.ColumnNames)
+	}
+
+	// Configurations are written in the current schema and read back unchanged
+	data, err := json.Marshal(objects)
+	if err != nil {
+		t.Fatalf("Failed to serialize configuration: %v", err)
+	}
+	var document map[string]interface{}
+	if err := json.Unmarshal(data, &document); err != nil || document["schema_version"] != float64(CurrentBoardSchemaVersion) || document["sections"] != nil {
+		t.Errorf("Expected the current schema, got %s", data)
+	}
+	var current BoardConfiguration
+	if err := json.Unmarshal(data, &current); err != nil {
+		t.Fatalf("Failed to parse current schema: %v", err)
+	}
+	objects.SchemaVersion = CurrentBoardSchemaVersion
+	if !reflect.DeepEqual(current, objects) {
+		t.Errorf("Expected %+v after a round trip, got %+v", objects, current)
+	}
+
+	// Column settings are written into the column objects
+	withSettings := BoardConfiguration{
+		Name:        "Kanban",
+		Columns:     []string{"todo", "doing", "review"},
+		ColumnNames: map[string]string{"doing": "In Progress"},
+		ColumnSettings: map[string]ColumnSettings{
+			"doing":  {Color: "#f0ad4e", WIPLimit: 3, EntryPolicy: "Assigned and estimated", ExitPolicy: "Tests pass"},
+			"review": {Done: true},
+		},
+	}
+	data, err = json.Marshal(withSettings)
+	if err != nil {
+		t.Fatalf("Failed to serialize configuration: %v", err)
+	}
+	var settingsDocument struct {
+		Columns []map[string]interface{} `json:"columns"`
+	}
+	if err := json.Unmarshal(data, &settingsDocument); err != nil || len(settingsDocument.Columns) != 3 ||
+		settingsDocument.Columns[1]["wip_limit"] != float64(3) || settingsDocument.Columns[1]["exit_policy"] != "Tests pass" ||
+		settingsDocument.Columns[2]["done"] != true || len(settingsDocument.Columns[0]) != 1 {
+		t.Errorf("Expected column settings in the column objects, got %s", data)
+	}
+	var settings BoardConfiguration
+	if err := json.Unmarshal(data, &settings); err != nil {
+		t.Fatalf("Failed to parse column settings: %v", err)
+	}
+	withSettings.SchemaVersion = CurrentBoardSchemaVersion
+	if !reflect.DeepEqual(settings, withSettings) {
+		t.Errorf("Expected %+v after a round trip, got %+v", withSettings, settings)
+	}
+
+	// The column marked done holds finished tasks, otherwise the last column does
+	if !settings.IsDoneColumn("review") || settings.IsDoneColumn("doing") || settings.DoneColumn() != "review" {
+		t.Errorf("Expected review to be the done column")
+	}
+	settings.ColumnSettings = map[string]ColumnSettings{"review": {Done: false}, "todo": {WIPLimit: 5}}
+	if !settings.IsDoneColumn("review") || settings.IsDoneColumn("todo") {
+		t.Errorf("Expected the last column to be done without a column marked done")
+	}
+
+	// Every schema version before the current one has a migration
+	if last := boardMigrations[len(boardMigrations)-1]; last.from+1 != CurrentBoardSchemaVersion {
+		t.Errorf("Expected the last migration to reach version %d, got %d", CurrentBoardSchemaVersion, last.from+1)
+	}
+	for i, migration := range boardMigrations {
+		if migration.from != i+1 {
+			t.Errorf("Expected migration %d to start at version %d, got %d", i, i+1, migration.from)
+		}
+	}
+}
+
+func TestIntegration_BoardAccess_MigratesBoardSchema(t *testing.T) {
+	testCases := []struct {
+		name      string
+		boardJSON string
+	}{
+		{"column IDs", `{"name":"Legacy","columns":["todo","doing","done"],"sections":{"todo":["urgent-important"]},"theme":"dark"}`},
+		{"column objects", `{"name":"Legacy","columns":[{"id":"todo","name":"To Do","sections":["urgent-important"]},{"id":"doing"},{"id":"done"}],"theme":"dark"}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			boardDir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(boardDir, "board.json"), []byte(tc.boardJSON), 0644); err != nil {
+				t.Fatalf("Failed to write board.json: %v", err)
+			}
+
+			ba, err := NewBoardAccess(boardDir)
+			if err != nil {
+				t.Fatalf("Failed to create BoardAccess: %v", err)
+			}
+
+			data, err := os.ReadFile(filepath.Join(boardDir, "board.json"))
+			if err != nil {
+				t.Fatalf("Failed to read board.json: %v", err)
+			}
+			var document map[string]interface{}
+			if err := json.Unmarshal(data, &document); err != nil {
+				t.Fatalf("Failed to parse migrated board.json: %v", err)
+			}
+			columns, _ := document["columns"].([]interface{})
+			first, _ := columns[0].(map[string]interface{})
+			if document["schema_version"] != float64(CurrentBoardSchemaVersion) || len(columns) != 3 || first["id"] != "todo" || first["sections"] == nil {
+				t.Errorf("Expected column objects in the current schema, got %s", data)
+			}
+			if document["sections"] != nil || document["theme"] != "dark" {
+				t.Errorf("Expected the sections map to be dropped and unknown fields to be kept, got %s", data)
+			}
+
+			// One commit per migration
+			revisions, err := ba.ListRevisions(10)
+			if err != nil || len(revisions) != len(boardMigrations) {
+				t.Fatalf("Expected %d migration commits, got %+v (%v)", len(boardMigrations), revisions, err)
+			}
+			message := utilities.ParseCommitInfo(revisions[0])
+			if version, _ := message.Trailer(utilities.TrailerSchemaVersion); message.Operation() != OperationMigrate || version != "2" {
+				t.Errorf("Unexpected migration commit %+v", message)
+			}
+
+			validation, err := ba.ValidateStructure(t.Context(), boardDir)
+			if err != nil || validation.SchemaVersion != "2" || !validation.ConfigValid {
+				t.Errorf("Expected a valid current board.json, got %+v (%v)", validation, err)
+			}
+			ba.Close()
+
+			// A migrated board is not migrated again
+			ba, err = NewBoardAccess(boardDir)
+			if err != nil {
+				t.Fatalf("Failed to reopen BoardAccess: %v", err)
+			}
+			defer ba.Close()
+			if revisions, _ := ba.ListRevisions(10); len(revisions) != len(boardMigrations) {
+				t.Errorf("Expected no further commits, got %d revisions", len(revisions))
+			}
+		})
+	}
+}
+
+func TestIntegration_BoardAccess_MigratesFixtureBoard(t *testing.T) {
+	boardDir := copyFixtureBoard(t, "board_eisenhower_populated")
+
+	ba, err := NewBoardAccess(boardDir)
+	if err != nil {
+		t.Fatalf("Failed to create BoardAccess: %v", err)
+	}
+	defer ba.Close()
+
+	metadata, err := ba.ExtractMetadata(t.Context(), boardDir)
+	if err != nil {
+		t.Fatalf("ExtractMetadata failed: %v", err)
+	}
+	if metadata.SchemaVersion != "2" || metadata.Configuration == nil || metadata.Configuration.ColumnNames["todo"] != "To Do" ||
+		len(metadata.Configuration.Sections["todo"]) != 4 {
+		t.Errorf("Expected the fixture's columns to survive the migration, got %+v", metadata.Configuration)
+	}
+}
+
+func TestIntegration_BoardAccess_RefusesNewerBoardSchema(t *testing.T) {
+	boardDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(boardDir, "board.json"), []byte(`{"schema_version":99,"name":"Future","columns":[]}`), 0644); err != nil {
+		t.Fatalf("Failed to write board.json: %v", err)
+	}
+
+	if _, err := NewBoardAccess(boardDir); !errors.Is(err, ErrUnsupportedSchemaVersion) {
+		t.Fatalf("Expected ErrUnsupportedSchemaVersion, got %v", err)
+	}
+
+	// The refused board is not left locked
+	if holder := activeBoardLockHolder(boardDir); holder != nil {
+		t.Errorf("Expected the board lock to be released, held by %s", holder)
+	}
+}
+
+func TestIntegration_BoardAccess_ColumnSettings(t *testing.T) {
+	boardDir := t.TempDir()
+	boardJSON := `{"schema_version":2,"name":"Kanban","columns":[` +
+		`{"id":"todo","name":"To Do"},` +
+		`{"id":"doing","name":"In Progress","color":"orange","wip_limit":2,"entry_policy":"Assigned"},` +
+		`{"id":"done","name":"Done"},` +
+		`{"id":"archive","name":"Archive"}]}`
+	if err := os.WriteFile(filepath.Join(boardDir, "board.json"), []byte(boardJSON), 0644); err != nil {
+		t.Fatalf("Failed to write board.json: %v", err)
+	}
+
+	ba, err := NewBoardAccess(boardDir)
+	if err != nil {
+		t.Fatalf("Failed to create BoardAccess: %v", err)
+	}
+	defer ba.Close()
+
+	// Without a column marked done the last column is done
+	rulesData, err := ba.GetRulesData("", nil)
+	if err != nil {
+		t.Fatalf("GetRulesData failed: %v", err)
+	}
+	if doing := rulesData.ColumnSettings["doing"]; doing.WIPLimit != 2 || doing.Color != "orange" || doing.EntryPolicy != "Assigned" || doing.Done {
+		t.Errorf("Unexpected settings of doing: %+v", doing)
+	}
+	if rulesData.ColumnSettings["done"].Done || !rulesData.ColumnSettings["archive"].Done {
+		t.Errorf("Expected the last column to be done, got %+v", rulesData.ColumnSettings)
+	}
+
+	// Only the top-level tasks of a limited column are counted
+	parentID, err := ba.CreateTask(&Task{Title: "Parent"}, Priority{Important: true}, WorkflowStatus{Column: "doing"}, nil)
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	if _, err := ba.CreateTask(&Task{Title: "Child"}, Priority{Important: true}, WorkflowStatus{Column: "doing"}, &parentID); err != nil {
+		t.Fatalf("Failed to create subtask: %v", err)
+	}
+	if wipData, err := ba.GetColumnWIPData("doing"); err != nil || wipData.WIPCount != 1 || wipData.Settings.WIPLimit != 2 {
+		t.Errorf("Expected one task counting against the limit of doing, got %+v (%v)", wipData, err)
+	}
+	if wipData, err := ba.GetColumnWIPData("archive"); err != nil || !wipData.Settings.Done || wipData.WIPCount != 0 {
+		t.Errorf("Expected the unlimited done column not to be counted, got %+v (%v)", wipData, err)
+	}
+
+	metadata, err := ba.ExtractMetadata(t.Context(), boardDir)
+	if err != nil || metadata.Configuration == nil || metadata.Configuration.ColumnSettings["doing"].WIPLimit != 2 {
+		t.Fatalf("Expected the column settings in the metadata, got %+v (%v)", metadata, err)
+	}
+
+	// A negative WIP limit is reported
+	if err := os.WriteFile(filepath.Join(boardDir, "board.json"), []byte(`{"schema_version":2,"name":"Kanban","columns":[{"id":"doing","wip_limit":-1}]}`), 0644); err != nil {
+		t.Fatalf("Failed to write board.json: %v", err)
+	}
+	validation, err := ba.ValidateStructure(t.Context(), boardDir)
+	if err != nil {
+		t.Fatalf("ValidateStructure failed: %v", err)
+	}
+	if validation.IsValid || len(validation.Issues) != 1 || validation.Issues[0].Path != "board.json" {
+		t.Errorf("Expected the negative WIP limit to be reported, got %+v", validation.Issues)
+	}
+}
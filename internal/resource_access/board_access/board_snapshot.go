@@ -0,0 +1,104 @@
+// Package board_access provides BoardAccess layer components implementing the iDesign methodology.
+// This file implements materialising the board from the files of a past commit.
+package board_access
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// newBoardSnapshot decodes the task, archive and configuration files of a commit; files the commit
+// lacks timestamps for get the commit time
+func newBoardSnapshot(commit *utilities.CommitSnapshot) (*BoardSnapshot, error) {
+	snapshot := &BoardSnapshot{
+		Revision: commit.Commit,
+		Tasks:    []*TaskWithTimestamps{},
+	}
+	committedAt := commit.Commit.Timestamp
+
+	var refs []*taskFileRef
+	for slashPath, data := range commit.Files {
+		if ref, ok := parseTaskPath(slashPath); ok {
+			refs = append(refs, ref)
+			continue
+		}
+		if taskID, ok := parseArchivedPath(slashPath); ok {
+			archived, err := decodeArchivedTask(taskID, data, "")
+			if err != nil {
+				return nil, err
+			}
+			fillSnapshotTimes(archived.TaskWithTimestamps, committedAt)
+			if archived.ArchivedAt.IsZero() {
+				archived.ArchivedAt = committedAt
+			}
+			snapshot.Archived = append(snapshot.Archived, archived)
+			continue
+		}
+		if slashPath == "board.json" {
+			var config BoardConfiguration
+			if err := json.Unmarshal(data, &config); err != nil {
+				return nil, fmt.Errorf("failed to parse board.json of revision %s: %w", commit.Commit.ID, err)
+			}
+			snapshot.Configuration = &config
+		}
+	}
+
+	// Same order as the live board
+	sort.Slice(refs, func(i, j int) bool {
+		if refs[i].Column != refs[j].Column {
+			return refs[i].Column < refs[j].Column
+		}
+		if refs[i].Section != refs[j].Section {
+			return refs[i].Section < refs[j].Section
+		}
+		if refs[i].Position != refs[j].Position {
+			return refs[i].Position < refs[j].Position
+		}
+		return refs[i].RelPath < refs[j].RelPath
+	})
+	seen := make(map[string]string)
+	for _, ref := range refs {
+		if previous, exists := seen[ref.TaskID]; exists {
+			return nil, fmt.Errorf("duplicate task ID %s in %s and %s of revision %s", ref.TaskID, previous, ref.RelPath, commit.Commit.ID)
+		}
+		seen[ref.TaskID] = ref.RelPath
+
+		task, err := decodeTask(*ref, commit.Files[filepath.ToSlash(ref.RelPath)], "")
+		if err != nil {
+			return nil, err
+		}
+		fillSnapshotTimes(task, committedAt)
+		snapshot.Tasks = append(snapshot.Tasks, task)
+	}
+
+	sort.SliceStable(snapshot.Archived, func(i, j int) bool {
+		return snapshot.Archived[i].ArchivedAt.Before(snapshot.Archived[j].ArchivedAt)
+	})
+
+	return snapshot, nil
+}
+
+// fillSnapshotTimes sets missing timestamps of a task read from history
+func fillSnapshotTimes(task *TaskWithTimestamps, committedAt time.Time) {
+	if task.CreatedAt.IsZero() {
+		task.CreatedAt = committedAt
+	}
+	if task.UpdatedAt.IsZero() {
+		task.UpdatedAt = committedAt
+	}
+}
+
+// snapshotTask returns a task on the board of a snapshot, or nil
+func (snapshot *BoardSnapshot) snapshotTask(taskID string) *TaskWithTimestamps {
+	for _, task := range snapshot.Tasks {
+		if task.Task.ID == taskID {
+			return task
+		}
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+// Package board_access provides BoardAccess layer components implementing the iDesign methodology.
+// This file builds the structured commit messages of board operations.
+package board_access
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// Operations recorded in the Operation trailer of board commits
+const (
+	OperationCreate          = "create"
+	OperationUpdate          = "update"
+	OperationMove            = "move"
+	OperationArchive         = "archive"
+	OperationRemove          = "remove"
+	OperationRestore         = "restore"
+	OperationPurge           = "purge"
+	OperationRestoreRevision = "restore-revision"
+	OperationConfigure       = "configure"
+	OperationMigrate         = "migrate"
+	OperationBatch           = "batch"
+	OperationRepair          = "repair"
+)
+
+// maxSummaryTitleLength is the number of characters of a task title quoted in a commit summary
+const maxSummaryTitleLength = 50
+
+// summaryTitle quotes a task title for a commit summary, shortening long titles
+func summaryTitle(task *TaskWithTimestamps) string {
+	title := ""
+	if task != nil && task.Task != nil {
+		title = strings.Join(strings.Fields(task.Task.Title), " ")
+	}
+	if runes := []rune(title); len(runes) > maxSummaryTitleLength {
+		title = string(runes[:maxSummaryTitleLength-1]) + "…"
+	}
+	return "'" + title + "'"
+}
+
+// boardLocation formats the column and section of a task file, e.g. todo/urgent-important
+func boardLocation(ref *taskFileRef) string {
+	if ref == nil {
+		return ""
+	}
+	if ref.Section == "" {
+		return ref.Column
+	}
+	return ref.Column + "/" + ref.Section
+}
+
+// newTaskCommitMessage describes an operation on a task moving it from location before to location after;
+// a nil location means the task is not on the board, e.g. before it was created or after it was archived
+func newTaskCommitMessage(operation string, task *TaskWithTimestamps, before, after *taskFileRef) *utilities.CommitMessage {
+	title := summaryTitle(task)
+	from, to := boardLocation(before), boardLocation(after)
+
+	var summary string
+	switch operation {
+	case OperationCreate:
+		summary = fmt.Sprintf("Create task %s in %s", title, to)
+	case OperationMove:
+		if from == to {
+			summary = fmt.Sprintf("Move task %s within %s", title, to)
+		} else {
+			summary = fmt.Sprintf("Move task %s %s → %s", title, from, to)
+		}
+	case OperationArchive:
+		summary = fmt.Sprintf("Archive task %s from %s", title, from)
+	case OperationRemove:
+		summary = fmt.Sprintf("Remove task %s from %s", title, from)
+	case OperationRestore:
+		summary = fmt.Sprintf("Restore task %s to %s", title, to)
+	default:
+		summary = fmt.Sprintf("Update task %s", title)
+		if before != nil && after != nil && from != to {
+			summary += fmt.Sprintf(" %s → %s", from, to)
+		}
+	}
+
+	message := utilities.NewCommitMessage(operation, summary)
+	if task != nil && task.Task != nil {
+		message.With(utilities.TrailerTaskID, task.Task.ID)
+	}
+	if before != nil {
+		message.With(utilities.TrailerFromColumn, before.Column).With(utilities.TrailerFromSection, before.Section)
+	}
+	if after != nil {
+		message.With(utilities.TrailerToColumn, after.Column).With(utilities.TrailerToSection, after.Section)
+	}
+	return message
+}
+
+// newConfigurationCommitMessage describes a change of a configuration
+func newConfigurationCommitMessage(configType, summary string) *utilities.CommitMessage {
+	return utilities.NewCommitMessage(OperationConfigure, summary).With(utilities.TrailerConfigType, configType)
+}
+
+// withAffectedTasks adds a Task-ID trailer for every task whose file is among paths and that has none yet,
+// e.g. subtasks archived along with their parent
+func withAffectedTasks(message *utilities.CommitMessage, paths []string) *utilities.CommitMessage {
+	known := make(map[string]bool)
+	for _, taskID := range message.TrailerValues(utilities.TrailerTaskID) {
+		known[taskID] = true
+	}
+
+	for _, path := range paths {
+		path = filepath.ToSlash(path)
+		taskID := ""
+		if ref, ok := parseTaskPath(path); ok {
+			taskID = ref.TaskID
+		} else if archivedID, ok := parseArchivedPath(path); ok {
+			taskID = archivedID
+		}
+		if taskID != "" && !known[taskID] {
+			known[taskID] = true
+			message.With(utilities.TrailerTaskID, taskID)
+		}
+	}
+	return message
+}
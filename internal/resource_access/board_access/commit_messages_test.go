@@ -0,0 +1,105 @@
+package board_access
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+func TestIntegration_BoardAccess_StructuredCommitMessages(t *testing.T) {
+	ba, err := NewBoardAccess(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create BoardAccess: %v", err)
+	}
+	defer ba.Close()
+
+	urgentImportant := Priority{Urgent: true, Important: true}
+	parentID, err := ba.CreateTask(&Task{Title: "Write report"}, urgentImportant,
+		WorkflowStatus{Column: "todo", Section: "urgent-important", Position: 1}, nil)
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	subtaskID, err := ba.CreateTask(&Task{Title: "Collect numbers"}, urgentImportant,
+		WorkflowStatus{Column: "todo", Section: "urgent-important", Position: 1}, &parentID)
+	if err != nil {
+		t.Fatalf("Failed to create subtask: %v", err)
+	}
+	if err := ba.MoveTask(parentID, urgentImportant, WorkflowStatus{Column: "doing", Position: 1}); err != nil {
+		t.Fatalf("Failed to move task: %v", err)
+	}
+	if err := ba.ArchiveTask(parentID, ArchiveSubtasks); err != nil {
+		t.Fatalf("Failed to archive task: %v", err)
+	}
+	if err := ba.RestoreTask(parentID, ArchiveSubtasks); err != nil {
+		t.Fatalf("Failed to restore task: %v", err)
+	}
+
+	revisions, err := ba.ListRevisions(5)
+	if err != nil || len(revisions) != 5 {
+		t.Fatalf("Expected 5 revisions, got %d (%v)", len(revisions), err)
+	}
+
+	expected := []struct {
+		summary  string
+		trailers []utilities.CommitTrailer
+	}{
+		{"Restore task 'Write report' to doing", []utilities.CommitTrailer{
+			{Key: "Operation", Value: "restore"}, {Key: "Task-ID", Value: parentID}, {Key: "To-Column", Value: "doing"},
+			{Key: "Task-ID", Value: subtaskID},
+		}},
+		{"Archive task 'Write report' from doing", []utilities.CommitTrailer{
+			{Key: "Operation", Value: "archive"}, {Key: "Task-ID", Value: parentID}, {Key: "From-Column", Value: "doing"},
+			{Key: "Task-ID", Value: subtaskID},
+		}},
+		{"Move task 'Write report' todo/urgent-important → doing", []utilities.CommitTrailer{
+			{Key: "Operation", Value: "move"}, {Key: "Task-ID", Value: parentID},
+			{Key: "From-Column", Value: "todo"}, {Key: "From-Section", Value: "urgent-important"}, {Key: "To-Column", Value: "doing"},
+		}},
+		{"Create task 'Collect numbers' in todo/urgent-important", []utilities.CommitTrailer{
+			{Key: "Operation", Value: "create"}, {Key: "Task-ID", Value: subtaskID},
+			{Key: "To-Column", Value: "todo"}, {Key: "To-Section", Value: "urgent-important"},
+		}},
+		{"Create task 'Write report' in todo/urgent-important", []utilities.CommitTrailer{
+			{Key: "Operation", Value: "create"}, {Key: "Task-ID", Value: parentID},
+			{Key: "To-Column", Value: "todo"}, {Key: "To-Section", Value: "urgent-important"},
+		}},
+	}
+	for i, want := range expected {
+		message := utilities.ParseCommitInfo(revisions[i])
+		if message.Summary != want.summary {
+			t.Errorf("Revision %d: expected summary %q, got %q", i, want.summary, message.Summary)
+		}
+		if !reflect.DeepEqual(message.Trailers, want.trailers) {
+			t.Errorf("Revision %d: expected trailers %+v, got %+v", i, want.trailers, message.Trailers)
+		}
+	}
+
+	// Configuration changes name the configuration type
+	config, err := ba.GetBoardConfiguration()
+	if err != nil {
+		t.Fatalf("Failed to get board configuration: %v", err)
+	}
+	config.Name = "Renamed"
+	if err := ba.UpdateBoardConfiguration(config); err != nil {
+		t.Fatalf("Failed to update board configuration: %v", err)
+	}
+	revisions, err = ba.ListRevisions(1)
+	if err != nil || len(revisions) != 1 {
+		t.Fatalf("Expected the configuration revision, got %+v (%v)", revisions, err)
+	}
+	message := utilities.ParseCommitInfo(revisions[0])
+	if configType, _ := message.Trailer(utilities.TrailerConfigType); message.Operation() != OperationConfigure || configType == "" {
+		t.Errorf("Expected a configuration commit, got %+v", message)
+	}
+}
+
+func TestUnit_BoardAccess_SummaryTitle(t *testing.T) {
+	long := &TaskWithTimestamps{Task: &Task{Title: "An  overly long\ntitle that goes on and on well beyond the summary line"}}
+	if got := summaryTitle(long); got != "'An overly long title that goes on and on well bey…'" {
+		t.Errorf("Unexpected summary title %s", got)
+	}
+	if got := summaryTitle(nil); got != "''" {
+		t.Errorf("Expected an empty title, got %s", got)
+	}
+}
@@ -130,7 +130,7 @@ func (cf *configurationFacet) Store(configType string, identifier string, data C
 	}
 
 	// Write file with atomic operations
-	if err := os.WriteFile(configPath, content, 0644); err != nil {
+	if err := utilities.WriteFileAtomic(configPath, content, 0644); err != nil {
 		return fmt.Errorf("failed to write configuration file: %w", err)
 	}
 
@@ -141,8 +141,8 @@ func (cf *configurationFacet) Store(configType string, identifier string, data C
 	}
 
 	// Commit changes
-	commitMessage := fmt.Sprintf("Update %s configuration: %s", configType, identifier)
-	if _, err := cf.repository.Commit(commitMessage); err != nil {
+	commitMessage := newConfigurationCommitMessage(configType, fmt.Sprintf("Update %s configuration: %s", configType, identifier))
+	if _, err := cf.repository.Commit(commitMessage.String()); err != nil {
 		return fmt.Errorf("failed to commit configuration changes: %w", err)
 	}
 
@@ -256,6 +256,11 @@ func (cf *configurationFacet) UpdateBoardConfiguration(config *BoardConfiguratio
 	if config == nil {
 		return fmt.Errorf("board configuration cannot be nil")
 	}
+	for _, column := range config.Columns {
+		if err := validateColumnName(column); err != nil {
+			return fmt.Errorf("invalid board configuration: %w", err)
+		}
+	}
 
 	// Convert BoardConfiguration to ConfigurationData
 	configData := ConfigurationData{
@@ -0,0 +1,125 @@
+// Package board_access provides BoardAccess layer components implementing the iDesign methodology.
+// This file implements the journal of board file contents used to tell external edits from our own writes.
+package board_access
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// ErrModifiedExternally reports that a board file was changed outside the application since it was last read
+var ErrModifiedExternally = errors.New("modified outside of EisenKan")
+
+// absentContent is the journal entry of a file known not to exist
+const absentContent = ""
+
+// fileJournal remembers the content of board files as last seen by the application
+type fileJournal struct {
+	mu      sync.Mutex
+	entries map[string]string // board-relative path -> content hash, or absentContent
+}
+
+// newFileJournal creates an empty journal
+func newFileJournal() *fileJournal {
+	return &fileJournal{entries: make(map[string]string)}
+}
+
+// contentHash returns the journal representation of file content
+func contentHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// currentContent returns the journal representation of a file as it is on disk now
+func currentContent(fullPath string) (string, error) {
+	data, err := os.ReadFile(fullPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return absentContent, nil
+		}
+		return "", err
+	}
+	return contentHash(data), nil
+}
+
+// observe records content read from a file unless the file is already known; later reads must not
+// silently accept an external edit that has not been reported yet
+func (j *fileJournal) observe(relPath string, data []byte) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if _, known := j.entries[relPath]; !known {
+		j.entries[relPath] = contentHash(data)
+	}
+}
+
+// record sets the known content of a file, after the application wrote it or accepted an external change
+func (j *fileJournal) record(relPath string, content string) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.entries[relPath] = content
+}
+
+// known returns the last seen content of a file
+func (j *fileJournal) known(relPath string) (string, bool) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	content, ok := j.entries[relPath]
+	return content, ok
+}
+
+// knownBelow returns the known paths inside a directory, used when a whole directory disappears
+func (j *fileJournal) knownBelow(relDir string) []string {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	prefix := relDir + string(filepath.Separator)
+	var paths []string
+	for relPath, content := range j.entries {
+		if content != absentContent && strings.HasPrefix(relPath, prefix) {
+			paths = append(paths, relPath)
+		}
+	}
+	return paths
+}
+
+// verify fails with ErrModifiedExternally if a known file no longer has the content last seen
+func (j *fileJournal) verify(root, relPath string) error {
+	expected, ok := j.known(relPath)
+	if !ok {
+		return nil
+	}
+
+	actual, err := currentContent(filepath.Join(root, relPath))
+	if err != nil {
+		return fmt.Errorf("failed to check task file %s: %w", relPath, err)
+	}
+	if actual != expected {
+		return fmt.Errorf("task file %s was %w since it was last read", relPath, ErrModifiedExternally)
+	}
+	return nil
+}
+
+// accept records the current content of files the repository changed on our behalf and returns their
+// board-relative paths
+func (j *fileJournal) accept(root string, slashPaths []string) ([]string, error) {
+	relPaths := make([]string, 0, len(slashPaths))
+	for _, slashPath := range slashPaths {
+		relPath := filepath.FromSlash(slashPath)
+		content, err := currentContent(filepath.Join(root, relPath))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read changed file %s: %w", slashPath, err)
+		}
+		j.record(relPath, content)
+		relPaths = append(relPaths, relPath)
+	}
+	return relPaths, nil
+}
@@ -0,0 +1,411 @@
+// Package board_access provides BoardAccess layer components implementing the iDesign methodology.
+// This file computes flow metrics from task timelines and exports them as CSV.
+package board_access
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// eisenhowerQuadrants lists the priority labels in reporting order
+var eisenhowerQuadrants = []string{
+	"urgent-important",
+	"urgent-not-important",
+	"not-urgent-important",
+	"not-urgent-not-important",
+}
+
+// flowTask bundles a top-level task with its timeline reconstructed from git history
+type flowTask struct {
+	task       *TaskWithTimestamps
+	timeline   []TaskTimelineEntry
+	archivedAt *time.Time // set for tasks in the archive
+}
+
+// computeFlowMetrics derives flow metrics for the tasks over [from, to]. The first column is treated
+// as the backlog; entering the done column or archiving counts as completion. Quadrants use the current
+// priority of each task.
+func computeFlowMetrics(tasks []flowTask, columns []string, doneColumn string, from, to time.Time) *FlowMetrics {
+	firstColumn := columns[0]
+
+	metrics := &FlowMetrics{
+		From:                from,
+		To:                  to,
+		Columns:             reportColumns(tasks, columns),
+		DoneColumn:          doneColumn,
+		Tasks:               []TaskFlow{},
+		LeadTimeByQuadrant:  make(map[string]FlowTimeStatistics),
+		CycleTimeByQuadrant: make(map[string]FlowTimeStatistics),
+		Throughput:          []ThroughputPeriod{},
+		CumulativeFlow:      []CumulativeFlowPoint{},
+	}
+
+	flows := make([]TaskFlow, len(tasks))
+	for i, ft := range tasks {
+		flows[i] = newTaskFlow(ft, firstColumn, doneColumn)
+	}
+
+	// Lead and cycle time of tasks completed within the range
+	var leadTimes, cycleTimes []float64
+	leadByQuadrant := make(map[string][]float64)
+	cycleByQuadrant := make(map[string][]float64)
+
+	for _, flow := range flows {
+		if flow.CreatedAt.After(to) || (flow.CompletedAt != nil && flow.CompletedAt.Before(from)) {
+			continue
+		}
+		metrics.Tasks = append(metrics.Tasks, flow)
+
+		if flow.CompletedAt == nil || flow.CompletedAt.After(to) {
+			continue
+		}
+		leadTimes = append(leadTimes, flow.LeadTimeDays)
+		leadByQuadrant[flow.Quadrant] = append(leadByQuadrant[flow.Quadrant], flow.LeadTimeDays)
+		if flow.StartedAt != nil {
+			cycleTimes = append(cycleTimes, flow.CycleTimeDays)
+			cycleByQuadrant[flow.Quadrant] = append(cycleByQuadrant[flow.Quadrant], flow.CycleTimeDays)
+		}
+	}
+
+	metrics.LeadTime = summarizeDurations(leadTimes)
+	metrics.CycleTime = summarizeDurations(cycleTimes)
+	for quadrant, durations := range leadByQuadrant {
+		metrics.LeadTimeByQuadrant[quadrant] = summarizeDurations(durations)
+	}
+	for quadrant, durations := range cycleByQuadrant {
+		metrics.CycleTimeByQuadrant[quadrant] = summarizeDurations(durations)
+	}
+
+	// Weekly throughput
+	for week := startOfWeek(from); !week.After(to); week = week.AddDate(0, 0, 7) {
+		period := ThroughputPeriod{WeekStart: week, ByQuadrant: make(map[string]int)}
+		weekEnd := week.AddDate(0, 0, 7)
+		for _, flow := range metrics.Tasks {
+			if flow.CompletedAt == nil || flow.CompletedAt.Before(week) || !flow.CompletedAt.Before(weekEnd) ||
+				flow.CompletedAt.Before(from) || flow.CompletedAt.After(to) {
+				continue
+			}
+			period.Completed++
+			period.ByQuadrant[flow.Quadrant]++
+		}
+		metrics.Throughput = append(metrics.Throughput, period)
+	}
+
+	// Daily cumulative flow and WIP, sampled at the end of each day
+	for day := startOfDay(from); !day.After(to); day = day.AddDate(0, 0, 1) {
+		sampleAt := day.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		if sampleAt.After(to) {
+			sampleAt = to
+		}
+
+		point := CumulativeFlowPoint{
+			Date:          day,
+			Columns:       make(map[string]int),
+			Quadrants:     make(map[string]map[string]int),
+			WIPByQuadrant: make(map[string]int),
+		}
+		for _, column := range metrics.Columns {
+			point.Columns[column] = 0
+		}
+
+		for i, ft := range tasks {
+			column, onBoard := locationAt(ft, flows[i], sampleAt, doneColumn)
+			if !onBoard {
+				continue
+			}
+			quadrant := flows[i].Quadrant
+			point.Columns[column]++
+			if point.Quadrants[quadrant] == nil {
+				point.Quadrants[quadrant] = make(map[string]int)
+			}
+			point.Quadrants[quadrant][column]++
+			if column != firstColumn && column != doneColumn {
+				point.WIP++
+				point.WIPByQuadrant[quadrant]++
+			}
+		}
+
+		metrics.CumulativeFlow = append(metrics.CumulativeFlow, point)
+	}
+
+	return metrics
+}
+
+// historyStart returns when the first of the tasks was created or entered a column, now without tasks
+func historyStart(tasks []flowTask, now time.Time) time.Time {
+	start := now
+	for _, ft := range tasks {
+		if !ft.task.CreatedAt.IsZero() && ft.task.CreatedAt.Before(start) {
+			start = ft.task.CreatedAt
+		}
+		if len(ft.timeline) > 0 && ft.timeline[0].EnteredAt.Before(start) {
+			start = ft.timeline[0].EnteredAt
+		}
+	}
+	return start
+}
+
+// newTaskFlow determines when a task was created, started and completed
+func newTaskFlow(ft flowTask, firstColumn, doneColumn string) TaskFlow {
+	flow := TaskFlow{
+		TaskID:    ft.task.Task.ID,
+		Title:     ft.task.Task.Title,
+		Quadrant:  quadrantOf(ft.task.Priority),
+		Column:    ft.task.Status.Column,
+		CreatedAt: ft.task.CreatedAt,
+	}
+	if ft.archivedAt != nil {
+		flow.Column = archiveDirName
+	}
+
+	for _, entry := range ft.timeline {
+		if entry.Column != firstColumn {
+			startedAt := entry.EnteredAt
+			flow.StartedAt = &startedAt
+			break
+		}
+	}
+
+	// A task completes when it enters the done column for the last time, or when it is archived
+	// from elsewhere. Tasks without recorded history fall back to their stored timestamps.
+	n := len(ft.timeline)
+	switch {
+	case n > 0 && ft.timeline[n-1].Column == doneColumn && (ft.timeline[n-1].LeftAt == nil || ft.archivedAt != nil):
+		completedAt := columnEnterTimes(ft.timeline)[doneColumn]
+		flow.CompletedAt = &completedAt
+	case ft.archivedAt != nil:
+		completedAt := *ft.archivedAt
+		flow.CompletedAt = &completedAt
+	case n == 0 && ft.task.Status.Column == doneColumn:
+		completedAt := ft.task.UpdatedAt
+		flow.CompletedAt = &completedAt
+	}
+
+	if flow.CompletedAt != nil {
+		flow.LeadTimeDays = durationDays(flow.CreatedAt, *flow.CompletedAt)
+		if flow.StartedAt != nil {
+			flow.CycleTimeDays = durationDays(*flow.StartedAt, *flow.CompletedAt)
+		}
+	}
+
+	return flow
+}
+
+// locationAt returns the column a task occupied at a point in time; completed tasks stay in the done column
+func locationAt(ft flowTask, flow TaskFlow, at time.Time, doneColumn string) (string, bool) {
+	if flow.CompletedAt != nil && !flow.CompletedAt.After(at) {
+		return doneColumn, true
+	}
+
+	for i := len(ft.timeline) - 1; i >= 0; i-- {
+		entry := ft.timeline[i]
+		if entry.EnteredAt.After(at) {
+			continue
+		}
+		if entry.LeftAt == nil || entry.LeftAt.After(at) {
+			return entry.Column, true
+		}
+		return "", false
+	}
+
+	// Tasks not yet committed are placed in their current column from creation on
+	if len(ft.timeline) == 0 && ft.archivedAt == nil && !flow.CreatedAt.After(at) {
+		return ft.task.Status.Column, true
+	}
+	return "", false
+}
+
+// reportColumns returns the configured columns followed by any other column tasks passed through
+func reportColumns(tasks []flowTask, columns []string) []string {
+	known := make(map[string]bool)
+	for _, column := range columns {
+		known[column] = true
+	}
+
+	var extra []string
+	addColumn := func(column string) {
+		if column != "" && !known[column] {
+			known[column] = true
+			extra = append(extra, column)
+		}
+	}
+	for _, ft := range tasks {
+		if ft.archivedAt == nil {
+			addColumn(ft.task.Status.Column)
+		}
+		for _, entry := range ft.timeline {
+			addColumn(entry.Column)
+		}
+	}
+	sort.Strings(extra)
+
+	return append(append([]string{}, columns...), extra...)
+}
+
+// summarizeDurations computes count, average, median, 85th percentile and maximum of durations in days
+func summarizeDurations(days []float64) FlowTimeStatistics {
+	if len(days) == 0 {
+		return FlowTimeStatistics{}
+	}
+
+	sorted := append([]float64{}, days...)
+	sort.Float64s(sorted)
+
+	var total float64
+	for _, d := range sorted {
+		total += d
+	}
+
+	n := len(sorted)
+	median := sorted[n/2]
+	if n%2 == 0 {
+		median = (sorted[n/2-1] + sorted[n/2]) / 2
+	}
+	p85Index := int(math.Ceil(0.85*float64(n))) - 1
+
+	return FlowTimeStatistics{
+		Count:       n,
+		AverageDays: total / float64(n),
+		MedianDays:  median,
+		P85Days:     sorted[p85Index],
+		MaxDays:     sorted[n-1],
+	}
+}
+
+// quadrantOf returns the Eisenhower quadrant label of a priority
+func quadrantOf(priority Priority) string {
+	if priority.Label != "" {
+		return priority.Label
+	}
+	return priorityLabel(priority)
+}
+
+// durationDays returns the time between two instants in days, never negative
+func durationDays(from, to time.Time) float64 {
+	if to.Before(from) {
+		return 0
+	}
+	return to.Sub(from).Hours() / 24
+}
+
+// startOfDay truncates a time to midnight in its location
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+// startOfWeek returns midnight of the Monday starting the week of t
+func startOfWeek(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + 6) % 7
+	return startOfDay(t).AddDate(0, 0, -offset)
+}
+
+// formatDays formats a duration in days for CSV output
+func formatDays(days float64) string {
+	return strconv.FormatFloat(days, 'f', 2, 64)
+}
+
+// formatOptionalTime formats an optional timestamp for CSV output
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// WriteTasksCSV writes one row per task with its creation, start and completion times
+func (fm *FlowMetrics) WriteTasksCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+
+	records := [][]string{{"task_id", "title", "quadrant", "column", "created_at", "started_at", "completed_at", "lead_time_days", "cycle_time_days"}}
+	for _, flow := range fm.Tasks {
+		leadTime, cycleTime := "", ""
+		if flow.CompletedAt != nil {
+			leadTime = formatDays(flow.LeadTimeDays)
+			if flow.StartedAt != nil {
+				cycleTime = formatDays(flow.CycleTimeDays)
+			}
+		}
+		records = append(records, []string{
+			flow.TaskID,
+			flow.Title,
+			flow.Quadrant,
+			flow.Column,
+			flow.CreatedAt.Format(time.RFC3339),
+			formatOptionalTime(flow.StartedAt),
+			formatOptionalTime(flow.CompletedAt),
+			leadTime,
+			cycleTime,
+		})
+	}
+
+	if err := writer.WriteAll(records); err != nil {
+		return fmt.Errorf("failed to write task flow CSV: %w", err)
+	}
+	return nil
+}
+
+// WriteThroughputCSV writes one row per week with the number of completed tasks per quadrant
+func (fm *FlowMetrics) WriteThroughputCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+
+	header := append([]string{"week_start", "completed"}, eisenhowerQuadrants...)
+	records := [][]string{header}
+	for _, period := range fm.Throughput {
+		record := []string{period.WeekStart.Format("2006-01-02"), strconv.Itoa(period.Completed)}
+		for _, quadrant := range eisenhowerQuadrants {
+			record = append(record, strconv.Itoa(period.ByQuadrant[quadrant]))
+		}
+		records = append(records, record)
+	}
+
+	if err := writer.WriteAll(records); err != nil {
+		return fmt.Errorf("failed to write throughput CSV: %w", err)
+	}
+	return nil
+}
+
+// WriteCumulativeFlowCSV writes one row per day with task counts per column, WIP, and counts per quadrant and column
+func (fm *FlowMetrics) WriteCumulativeFlowCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+
+	header := []string{"date"}
+	header = append(header, fm.Columns...)
+	header = append(header, "wip")
+	for _, quadrant := range eisenhowerQuadrants {
+		header = append(header, "wip_"+quadrant)
+	}
+	for _, quadrant := range eisenhowerQuadrants {
+		for _, column := range fm.Columns {
+			header = append(header, quadrant+"/"+column)
+		}
+	}
+
+	records := [][]string{header}
+	for _, point := range fm.CumulativeFlow {
+		record := []string{point.Date.Format("2006-01-02")}
+		for _, column := range fm.Columns {
+			record = append(record, strconv.Itoa(point.Columns[column]))
+		}
+		record = append(record, strconv.Itoa(point.WIP))
+		for _, quadrant := range eisenhowerQuadrants {
+			record = append(record, strconv.Itoa(point.WIPByQuadrant[quadrant]))
+		}
+		for _, quadrant := range eisenhowerQuadrants {
+			for _, column := range fm.Columns {
+				record = append(record, strconv.Itoa(point.Quadrants[quadrant][column]))
+			}
+		}
+		records = append(records, record)
+	}
+
+	if err := writer.WriteAll(records); err != nil {
+		return fmt.Errorf("failed to write cumulative flow CSV: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+package board_access
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestUnit_BoardAccess_ComputeFlowMetrics(t *testing.T) {
+	// Monday, 3 March 2025
+	start := time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC)
+	day := func(n int) time.Time { return start.AddDate(0, 0, n) }
+	left := func(n int) *time.Time { at := day(n); return &at }
+
+	newTask := func(id string, priority Priority, column string, createdDay int) *TaskWithTimestamps {
+		return &TaskWithTimestamps{
+			Task:      &Task{ID: id, Title: "Task " + id},
+			Priority:  priority,
+			Status:    WorkflowStatus{Column: column},
+			CreatedAt: day(createdDay),
+			UpdatedAt: day(createdDay),
+		}
+	}
+	urgentImportant := Priority{Urgent: true, Important: true, Label: "urgent-important"}
+	important := Priority{Important: true, Label: "not-urgent-important"}
+	archivedAt := day(9)
+
+	tasks := []flowTask{
+		{
+			// Completed in week one: created day 0, started day 1, done day 3
+			task: newTask("a", urgentImportant, "done", 0),
+			timeline: []TaskTimelineEntry{
+				{Column: "todo", EnteredAt: day(0), LeftAt: left(1)},
+				{Column: "doing", EnteredAt: day(1), LeftAt: left(3)},
+				{Column: "done", EnteredAt: day(3)},
+			},
+		},
+		{
+			// Archived from doing in week two
+			task: newTask("b", important, "doing", 1),
+			timeline: []TaskTimelineEntry{
+				{Column: "todo", EnteredAt: day(1), LeftAt: left(2)},
+				{Column: "doing", EnteredAt: day(2), LeftAt: left(9)},
+			},
+			archivedAt: &archivedAt,
+		},
+		{
+			// Still in progress
+			task: newTask("c", important, "doing", 2),
+			timeline: []TaskTimelineEntry{
+				{Column: "todo", EnteredAt: day(2), LeftAt: left(4)},
+				{Column: "doing", EnteredAt: day(4)},
+			},
+		},
+	}
+
+	metrics := computeFlowMetrics(tasks, []string{"todo", "doing", "done"}, "done", day(0), day(10))
+
+	if len(metrics.Tasks) != 3 {
+		t.Fatalf("Expected 3 tasks in range, got %d", len(metrics.Tasks))
+	}
+	if metrics.LeadTime.Count != 2 || metrics.LeadTime.MaxDays != 8 || metrics.LeadTime.AverageDays != 5.5 {
+		t.Errorf("Unexpected lead time statistics: %+v", metrics.LeadTime)
+	}
+	if metrics.CycleTime.Count != 2 || metrics.CycleTime.MedianDays != 4.5 {
+		t.Errorf("Unexpected cycle time statistics: %+v", metrics.CycleTime)
+	}
+	if stats := metrics.CycleTimeByQuadrant["urgent-important"]; stats.Count != 1 || stats.AverageDays != 2 {
+		t.Errorf("Unexpected urgent-important cycle time: %+v", stats)
+	}
+
+	if len(metrics.Throughput) != 2 {
+		t.Fatalf("Expected 2 weeks of throughput, got %d", len(metrics.Throughput))
+	}
+	if metrics.Throughput[0].Completed != 1 || metrics.Throughput[0].ByQuadrant["urgent-important"] != 1 {
+		t.Errorf("Unexpected first week throughput: %+v", metrics.Throughput[0])
+	}
+	if metrics.Throughput[1].Completed != 1 || metrics.Throughput[1].ByQuadrant["not-urgent-important"] != 1 {
+		t.Errorf("Unexpected second week throughput: %+v", metrics.Throughput[1])
+	}
+
+	if len(metrics.CumulativeFlow) != 11 {
+		t.Fatalf("Expected 11 daily points, got %d", len(metrics.CumulativeFlow))
+	}
+	day5 := metrics.CumulativeFlow[5]
+	if day5.Columns["todo"] != 0 || day5.Columns["doing"] != 2 || day5.Columns["done"] != 1 {
+		t.Errorf("Unexpected cumulative flow on day 5: %+v", day5.Columns)
+	}
+	if day5.WIP != 2 || day5.WIPByQuadrant["not-urgent-important"] != 2 {
+		t.Errorf("Unexpected WIP on day 5: %d %+v", day5.WIP, day5.WIPByQuadrant)
+	}
+	// Archived tasks accumulate in the done column
+	last := metrics.CumulativeFlow[10]
+	if last.Columns["done"] != 2 || last.Quadrants["not-urgent-important"]["done"] != 1 {
+		t.Errorf("Unexpected final cumulative flow: %+v %+v", last.Columns, last.Quadrants)
+	}
+
+	// A later range excludes tasks completed before it
+	later := computeFlowMetrics(tasks, []string{"todo", "doing", "done"}, "done", day(5), day(10))
+	if len(later.Tasks) != 2 || later.LeadTime.Count != 1 {
+		t.Errorf("Expected 2 tasks and 1 completion from day 5, got %d and %d", len(later.Tasks), later.LeadTime.Count)
+	}
+
+	// CSV export
+	var buffer bytes.Buffer
+	if err := metrics.WriteTasksCSV(&buffer); err != nil {
+		t.Fatalf("Failed to write task CSV: %v", err)
+	}
+	records, err := csv.NewReader(&buffer).ReadAll()
+	if err != nil {
+		t.Fatalf("Task CSV is not valid: %v", err)
+	}
+	if len(records) != 4 || records[1][0] != "a" || records[1][7] != "3.00" || records[1][8] != "2.00" {
+		t.Errorf("Unexpected task CSV: %v", records)
+	}
+
+	buffer.Reset()
+	if err := metrics.WriteThroughputCSV(&buffer); err != nil {
+		t.Fatalf("Failed to write throughput CSV: %v", err)
+	}
+	if !strings.HasPrefix(buffer.String(), "week_start,completed,urgent-important,") ||
+		!strings.Contains(buffer.String(), "2025-03-03,1,1,0,0,0") {
+		t.Errorf("Unexpected throughput CSV: %s", buffer.String())
+	}
+
+	buffer.Reset()
+	if err := metrics.WriteCumulativeFlowCSV(&buffer); err != nil {
+		t.Fatalf("Failed to write cumulative flow CSV: %v", err)
+	}
+	records, err = csv.NewReader(&buffer).ReadAll()
+	if err != nil {
+		t.Fatalf("Cumulative flow CSV is not valid: %v", err)
+	}
+	if len(records) != 12 || strings.Join(records[0][:5], ",") != "date,todo,doing,done,wip" {
+		t.Errorf("Unexpected cumulative flow CSV header: %v", records[0])
+	}
+	if strings.Join(records[6][:5], ",") != "2025-03-08,0,2,1,2" {
+		t.Errorf("Unexpected cumulative flow CSV row: %v", records[6])
+	}
+}
+
+func TestIntegration_BoardAccess_GetFlowMetrics(t *testing.T) {
+	boardDir := t.TempDir()
+
+	ba, err := NewBoardAccess(boardDir)
+	if err != nil {
+		t.Fatalf("Failed to create BoardAccess: %v", err)
+	}
+	defer ba.Close()
+
+	priority := Priority{Urgent: true, Important: true}
+	doneID, err := ba.CreateTask(&Task{Title: "Finished"}, priority, WorkflowStatus{Column: "todo", Section: "urgent-important", Position: 1}, nil)
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	if _, err := ba.CreateTask(&Task{Title: "Waiting"}, priority, WorkflowStatus{Column: "todo", Section: "urgent-important", Position: 2}, nil); err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	if err := ba.MoveTask(doneID, priority, WorkflowStatus{Column: "doing", Position: 1}); err != nil {
+		t.Fatalf("Failed to move task: %v", err)
+	}
+	if err := ba.MoveTask(doneID, priority, WorkflowStatus{Column: "done", Position: 1}); err != nil {
+		t.Fatalf("Failed to move task: %v", err)
+	}
+
+	metrics, err := ba.GetFlowMetrics(context.Background(), boardDir, nil)
+	if err != nil {
+		t.Fatalf("Failed to get flow metrics: %v", err)
+	}
+
+	if metrics.DoneColumn != "done" || len(metrics.Tasks) != 2 {
+		t.Fatalf("Expected 2 tasks with done column 'done', got %d tasks and %q", len(metrics.Tasks), metrics.DoneColumn)
+	}
+	if metrics.LeadTime.Count != 1 || metrics.CycleTime.Count != 1 {
+		t.Errorf("Expected one completed task, got lead %d cycle %d", metrics.LeadTime.Count, metrics.CycleTime.Count)
+	}
+	if len(metrics.Throughput) == 0 || metrics.Throughput[len(metrics.Throughput)-1].Completed != 1 {
+		t.Errorf("Expected a completion in the current week, got %+v", metrics.Throughput)
+	}
+	last := metrics.CumulativeFlow[len(metrics.CumulativeFlow)-1]
+	if last.Columns["todo"] != 1 || last.Columns["done"] != 1 || last.WIP != 0 {
+		t.Errorf("Unexpected current cumulative flow: %+v (WIP %d)", last.Columns, last.WIP)
+	}
+
+	// A range beyond the board history is clamped to it instead of sampling every day
+	from, to := time.Now().AddDate(-100, 0, 0), time.Now().AddDate(100, 0, 0)
+	clamped, err := ba.GetFlowMetrics(context.Background(), boardDir, &DateRange{From: &from, To: &to})
+	if err != nil {
+		t.Fatalf("Failed to get flow metrics for a wide range: %v", err)
+	}
+	if clamped.From.Before(metrics.From) || clamped.To.After(time.Now()) || len(clamped.CumulativeFlow) > 2 {
+		t.Errorf("Expected the range to be clamped to the board history, got %s to %s with %d days",
+			clamped.From.Format(time.RFC3339), clamped.To.Format(time.RFC3339), len(clamped.CumulativeFlow))
+	}
+
+	// An inverted range is rejected
+	from = time.Now()
+	to = from.Add(-time.Hour)
+	if _, err := ba.GetFlowMetrics(context.Background(), boardDir, &DateRange{From: &from, To: &to}); err == nil {
+		t.Error("Expected error for inverted date range")
+	}
+}
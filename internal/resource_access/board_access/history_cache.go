@@ -0,0 +1,68 @@
+// Package board_access provides BoardAccess layer components implementing the iDesign methodology.
+// This file caches the change history of a board so task histories and timelines do not replay every commit.
+package board_access
+
+import (
+	"sync"
+
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// historyCache holds the change history of a board and the task timelines replayed from it as of a HEAD commit
+type historyCache struct {
+	mutex     sync.Mutex
+	head      string
+	history   []utilities.CommitChanges // newest first
+	timelines map[string][]TaskTimelineEntry
+}
+
+// newHistoryCache creates an empty history cache
+func newHistoryCache() *historyCache {
+	return &historyCache{timelines: make(map[string][]TaskTimelineEntry)}
+}
+
+// changeHistory returns the change history of the repository, newest first
+func (hc *historyCache) changeHistory(repository utilities.Repository) ([]utilities.CommitChanges, error) {
+	hc.mutex.Lock()
+	defer hc.mutex.Unlock()
+
+	if err := hc.update(repository); err != nil {
+		return nil, err
+	}
+	return hc.history, nil
+}
+
+// timeline returns a copy of the timeline of a task
+func (hc *historyCache) timeline(repository utilities.Repository, taskID string) ([]TaskTimelineEntry, error) {
+	hc.mutex.Lock()
+	defer hc.mutex.Unlock()
+
+	if err := hc.update(repository); err != nil {
+		return nil, err
+	}
+	return append([]TaskTimelineEntry{}, hc.timelines[taskID]...), nil
+}
+
+// update walks the commits following the cached HEAD only and replays them onto the cached timelines; a history
+// not containing the cached HEAD any more is replayed from scratch
+func (hc *historyCache) update(repository utilities.Repository) error {
+	newer, found, err := repository.GetChangeHistorySince(hc.head)
+	if err != nil {
+		return err
+	}
+	if !found {
+		hc.history = nil
+		hc.timelines = make(map[string][]TaskTimelineEntry)
+	}
+	if len(newer) == 0 {
+		if !found {
+			hc.head = ""
+		}
+		return nil
+	}
+
+	replayTaskTimelines(hc.timelines, newer)
+	hc.history = append(newer, hc.history...)
+	hc.head = hc.history[0].Commit.ID
+	return nil
+}
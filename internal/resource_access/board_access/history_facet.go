@@ -0,0 +1,57 @@
+// Package board_access provides BoardAccess layer components implementing the iDesign methodology.
+// This file implements the IHistory facet for stepping back and forth through the board's git history.
+package board_access
+
+import (
+	"time"
+
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// IHistory defines the interface for undoing board changes recorded in git
+type IHistory interface {
+	// CurrentRevision returns the commit the board is at
+	CurrentRevision() (string, error)
+
+	// RevertChanges undoes the board changes between revision from and its descendant to in a new commit;
+	// an empty from stands for the empty board
+	RevertChanges(from, to, message string) (*RevertResult, error)
+
+	// ListRevisions returns the latest board revisions, newest first; a limit of 0 returns all of them
+	ListRevisions(limit int) ([]utilities.CommitInfo, error)
+
+	// LoadBoardAt reads the board as of a past revision without changing the working tree
+	LoadBoardAt(point HistoryPoint) (*BoardSnapshot, error)
+
+	// RestoreTaskFrom brings a single task back to its state at a past revision in a new commit
+	RestoreTaskFrom(commit, taskID string) (*RestoreResult, error)
+}
+
+// HistoryPoint selects a board revision by commit or, without a commit, as the latest revision at or
+// before a time; the zero value selects the current revision
+type HistoryPoint struct {
+	Commit string    `json:"commit,omitempty"`
+	Time   time.Time `json:"time,omitempty"`
+}
+
+// BoardSnapshot is the read-only content of the board as of a past revision
+type BoardSnapshot struct {
+	Revision      utilities.CommitInfo  `json:"revision"`
+	Tasks         []*TaskWithTimestamps `json:"tasks"`
+	Archived      []*ArchivedTask       `json:"archived,omitempty"`
+	Configuration *BoardConfiguration   `json:"configuration,omitempty"` // nil if the revision has no board.json
+}
+
+// RestoreResult describes a task restored from a past revision
+type RestoreResult struct {
+	Task    *TaskWithTimestamps `json:"task"`
+	Commit  string              `json:"commit"`
+	Changes []BoardChange       `json:"changes,omitempty"`
+}
+
+// RevertResult describes a revert of board changes
+type RevertResult struct {
+	Previous string        `json:"previous"`         // revision before the revert
+	Commit   string        `json:"commit,omitempty"` // revert commit; empty if there was nothing to revert
+	Changes  []BoardChange `json:"changes,omitempty"`
+}
@@ -0,0 +1,247 @@
+// Package board_access provides BoardAccess layer components implementing the iDesign methodology.
+// This file implements the IHistory facet on top of the revert operation of the repository.
+package board_access
+
+import (
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// historyFacet implements the IHistory interface
+type historyFacet struct {
+	repository  utilities.Repository
+	storage     *taskStorage
+	logger      utilities.ILoggingUtility
+	mutex       *sync.RWMutex
+	journal     *fileJournal
+	lock        *boardLock
+	transaction *boardTransaction
+}
+
+// newHistoryFacet creates a history facet sharing the journal of the task facet
+func newHistoryFacet(repository utilities.Repository, logger utilities.ILoggingUtility, mutex *sync.RWMutex, journal *fileJournal, lock *boardLock, transaction *boardTransaction) IHistory {
+	storage := newTaskStorage(repository.Path())
+	storage.journal = journal
+
+	return &historyFacet{
+		repository:  repository,
+		storage:     storage,
+		logger:      logger,
+		mutex:       mutex,
+		journal:     journal,
+		lock:        lock,
+		transaction: transaction,
+	}
+}
+
+// CurrentRevision returns the latest commit of the board repository
+func (hf *historyFacet) CurrentRevision() (string, error) {
+	hf.mutex.RLock()
+	defer hf.mutex.RUnlock()
+
+	history, err := hf.repository.GetHistory(1)
+	if err != nil {
+		return "", fmt.Errorf("failed to read board revision: %w", err)
+	}
+	if len(history) == 0 {
+		return "", nil
+	}
+	return history[0].ID, nil
+}
+
+// RevertChanges reverts the board files to revision from, keeping later changes to other files
+func (hf *historyFacet) RevertChanges(from, to, message string) (*RevertResult, error) {
+	hf.mutex.Lock()
+	defer hf.mutex.Unlock()
+
+	if err := hf.lock.checkWritable(); err != nil {
+		return nil, err
+	}
+	if err := hf.transaction.checkIdle(); err != nil {
+		return nil, err
+	}
+
+	hf.logger.LogMessage(utilities.Info, "HistoryFacet", fmt.Sprintf("Reverting board changes %s..%s", from, to))
+
+	revert, err := hf.repository.RevertChanges(from, to, message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revert board changes: %w", err)
+	}
+
+	// Reverted files are our own writes; the watcher must not report them and later writes must not be refused
+	relPaths, err := hf.journal.accept(hf.repository.Path(), revert.ChangedFiles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to accept reverted files: %w", err)
+	}
+
+	return &RevertResult{
+		Previous: revert.Previous,
+		Commit:   revert.Commit,
+		Changes:  groupBoardChanges(relPaths, time.Now()),
+	}, nil
+}
+
+// ListRevisions returns the commits of the board repository, newest first
+func (hf *historyFacet) ListRevisions(limit int) ([]utilities.CommitInfo, error) {
+	hf.mutex.RLock()
+	defer hf.mutex.RUnlock()
+
+	revisions, err := hf.repository.GetHistory(limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read board revisions: %w", err)
+	}
+	return revisions, nil
+}
+
+// LoadBoardAt materialises the board from the files of the selected revision
+func (hf *historyFacet) LoadBoardAt(point HistoryPoint) (*BoardSnapshot, error) {
+	hf.mutex.RLock()
+	defer hf.mutex.RUnlock()
+
+	commit, err := hf.resolveRevision(point)
+	if err != nil {
+		return nil, err
+	}
+	return hf.loadSnapshot(commit)
+}
+
+// resolveRevision returns the commit a history point refers to
+func (hf *historyFacet) resolveRevision(point HistoryPoint) (string, error) {
+	if point.Commit != "" {
+		return point.Commit, nil
+	}
+
+	revisions, err := hf.repository.GetHistory(0)
+	if err != nil {
+		return "", fmt.Errorf("failed to read board revisions: %w", err)
+	}
+	for _, revision := range revisions {
+		if point.Time.IsZero() || !revision.Timestamp.After(point.Time) {
+			return revision.ID, nil
+		}
+	}
+	if point.Time.IsZero() {
+		return "", fmt.Errorf("board has no revisions yet")
+	}
+	return "", fmt.Errorf("board has no revision at or before %s", point.Time.Format(time.RFC3339))
+}
+
+// loadSnapshot reads the board as of a commit
+func (hf *historyFacet) loadSnapshot(commit string) (*BoardSnapshot, error) {
+	files, err := hf.repository.GetSnapshot(commit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read board revision %s: %w", commit, err)
+	}
+	return newBoardSnapshot(files)
+}
+
+// RestoreTaskFrom writes a task as it was at a past revision to the board, replacing its current or archived file.
+// The task returns to its column, section and position of that revision; a subtask whose parent no longer exists
+// becomes a top-level task, while a subtask of an archived parent cannot be restored.
+func (hf *historyFacet) RestoreTaskFrom(commit, taskID string) (*RestoreResult, error) {
+	hf.mutex.Lock()
+	defer hf.mutex.Unlock()
+
+	if err := hf.lock.checkWritable(); err != nil {
+		return nil, err
+	}
+	if err := hf.transaction.checkIdle(); err != nil {
+		return nil, err
+	}
+	if err := validatePathComponent("task ID", taskID); err != nil {
+		return nil, err
+	}
+
+	snapshot, err := hf.loadSnapshot(commit)
+	if err != nil {
+		return nil, err
+	}
+	task := snapshot.snapshotTask(taskID)
+	if task == nil {
+		return nil, fmt.Errorf("task %s is not on the board at revision %s", taskID, commit)
+	}
+
+	if task.Task.ParentTaskID != nil {
+		parent, err := hf.storage.locate(*task.Task.ParentTaskID)
+		if err != nil {
+			return nil, err
+		}
+		if parent == nil {
+			archivedParent, err := hf.storage.readArchived(*task.Task.ParentTaskID)
+			if err != nil {
+				return nil, err
+			}
+			if archivedParent != nil {
+				return nil, fmt.Errorf("cannot restore subtask %s while its parent task %s is archived", taskID, *task.Task.ParentTaskID)
+			}
+			task.Task.ParentTaskID = nil
+		}
+	}
+
+	hf.logger.LogMessage(utilities.Info, "HistoryFacet", fmt.Sprintf("Restoring task %s from revision %s", taskID, commit))
+
+	previous, err := hf.storage.locate(taskID)
+	if err != nil {
+		return nil, err
+	}
+	if previous != nil {
+		if err := hf.storage.verify(previous.RelPath); err != nil {
+			return nil, err
+		}
+	}
+	archived, err := hf.storage.readArchived(taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The restore is the latest change to the task, so it must win when merged with other clones
+	now := time.Now()
+	task.UpdatedAt = now
+	relPath, err := hf.storage.write(task)
+	if err != nil {
+		return nil, err
+	}
+	location, _ := parseTaskPath(filepath.ToSlash(relPath))
+	paths := []string{relPath}
+	if previous != nil && previous.RelPath != relPath {
+		if err := hf.storage.remove(previous.RelPath); err != nil {
+			return nil, err
+		}
+		paths = append(paths, previous.RelPath)
+	}
+	if archived != nil {
+		archivePath := archivePathFor(taskID)
+		if err := hf.storage.remove(archivePath); err != nil {
+			return nil, err
+		}
+		paths = append(paths, archivePath)
+	}
+
+	if err := hf.repository.Stage(paths); err != nil {
+		return nil, fmt.Errorf("failed to stage task files: %w", err)
+	}
+	message := newTaskCommitMessage(OperationRestoreRevision, task, previous, location)
+	message.Summary = fmt.Sprintf("Restore task %s from %s", summaryTitle(task), shortRevision(snapshot.Revision.ID))
+	restoreCommit, err := hf.repository.Commit(withAffectedTasks(message.With(utilities.TrailerRevision, snapshot.Revision.ID), paths).String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit restored task: %w", err)
+	}
+
+	return &RestoreResult{
+		Task:    task,
+		Commit:  restoreCommit,
+		Changes: groupBoardChanges(paths, now),
+	}, nil
+}
+
+// shortRevision abbreviates a commit hash for messages
+func shortRevision(commit string) string {
+	if len(commit) > 7 {
+		return commit[:7]
+	}
+	return commit
+}
@@ -0,0 +1,130 @@
+package board_access
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIntegration_BoardAccess_RevertChanges(t *testing.T) {
+	ba, _, taskID, _ := newWatchedTask(t)
+	priority := Priority{Urgent: true, Important: true}
+	status := WorkflowStatus{Column: "todo", Section: "urgent-important"}
+
+	before, err := ba.CurrentRevision()
+	if err != nil || before == "" {
+		t.Fatalf("CurrentRevision failed: %q (%v)", before, err)
+	}
+	if err := ba.ChangeTaskData(taskID, &Task{ID: taskID, Title: "Renamed task"}, priority, status); err != nil {
+		t.Fatalf("ChangeTaskData failed: %v", err)
+	}
+	after, _ := ba.CurrentRevision()
+
+	result, err := ba.RevertChanges(before, after, "Undo rename")
+	if err != nil {
+		t.Fatalf("RevertChanges failed: %v", err)
+	}
+	if result.Previous != after || result.Commit == "" {
+		t.Errorf("Expected a revert commit on top of %s, got %+v", after, result)
+	}
+	if len(result.Changes) != 1 || result.Changes[0].TaskID != taskID {
+		t.Errorf("Expected the reverted task to be reported, got %+v", result.Changes)
+	}
+	if current, _ := ba.CurrentRevision(); current != result.Commit {
+		t.Errorf("Expected the board at the revert commit, got %s", current)
+	}
+
+	tasks, err := ba.GetTasksData([]string{taskID}, false)
+	if err != nil || len(tasks) != 1 || tasks[0].Task.Title != "Watched task" {
+		t.Fatalf("Expected the original title back, got %+v (%v)", tasks, err)
+	}
+
+	// The reverted file is known content, so the task can be changed again
+	if err := ba.ChangeTaskData(taskID, &Task{ID: taskID, Title: "Renamed again"}, priority, status); err != nil {
+		t.Errorf("Expected the reverted task to be writable, got %v", err)
+	}
+}
+
+func TestIntegration_BoardAccess_LoadBoardAt(t *testing.T) {
+	ba, _, taskID, _ := newWatchedTask(t)
+	priority := Priority{Urgent: true, Important: true}
+
+	created, err := ba.CurrentRevision()
+	if err != nil {
+		t.Fatalf("CurrentRevision failed: %v", err)
+	}
+	if err := ba.MoveTask(taskID, priority, WorkflowStatus{Column: "doing"}); err != nil {
+		t.Fatalf("MoveTask failed: %v", err)
+	}
+	if _, err := ba.CreateTask(&Task{Title: "Later task"}, priority, WorkflowStatus{Column: "todo", Section: "urgent-important"}, nil); err != nil {
+		t.Fatalf("CreateTask failed: %v", err)
+	}
+
+	snapshot, err := ba.LoadBoardAt(HistoryPoint{Commit: created})
+	if err != nil {
+		t.Fatalf("LoadBoardAt failed: %v", err)
+	}
+	if snapshot.Revision.ID != created {
+		t.Errorf("Expected revision %s, got %s", created, snapshot.Revision.ID)
+	}
+	if len(snapshot.Tasks) != 1 || snapshot.Tasks[0].Task.Title != "Watched task" || snapshot.Tasks[0].Status.Column != "todo" {
+		t.Fatalf("Expected only the watched task in todo, got %+v", snapshot.Tasks)
+	}
+
+	// The current board is left alone
+	if tasks, err := ba.GetTasksData([]string{taskID}, false); err != nil || len(tasks) != 1 || tasks[0].Status.Column != "doing" {
+		t.Errorf("Expected the task to stay in doing, got %+v (%v)", tasks, err)
+	}
+
+	// A time selects the latest revision at or before it
+	revisions, err := ba.ListRevisions(0)
+	if err != nil || len(revisions) < 3 || revisions[0].Timestamp.Before(revisions[len(revisions)-1].Timestamp) {
+		t.Fatalf("Expected the revisions newest first, got %+v (%v)", revisions, err)
+	}
+	latest, err := ba.LoadBoardAt(HistoryPoint{Time: time.Now().Add(time.Hour)})
+	if err != nil || latest.Revision.ID != revisions[0].ID || len(latest.Tasks) != 2 {
+		t.Errorf("Expected the current board, got %+v (%v)", latest, err)
+	}
+	if _, err := ba.LoadBoardAt(HistoryPoint{Time: revisions[len(revisions)-1].Timestamp.Add(-time.Hour)}); err == nil {
+		t.Error("Expected a time before the first revision to be rejected")
+	}
+}
+
+func TestIntegration_BoardAccess_RestoreTaskFrom(t *testing.T) {
+	ba, _, taskID, _ := newWatchedTask(t)
+	priority := Priority{Urgent: true, Important: true}
+
+	original, _ := ba.CurrentRevision()
+	if err := ba.ChangeTaskData(taskID, &Task{ID: taskID, Title: "Renamed task"}, priority, WorkflowStatus{Column: "doing"}); err != nil {
+		t.Fatalf("ChangeTaskData failed: %v", err)
+	}
+	otherID, err := ba.CreateTask(&Task{Title: "Other task"}, priority, WorkflowStatus{Column: "todo", Section: "urgent-important"}, nil)
+	if err != nil {
+		t.Fatalf("CreateTask failed: %v", err)
+	}
+	if err := ba.ArchiveTask(taskID, NoAction); err != nil {
+		t.Fatalf("ArchiveTask failed: %v", err)
+	}
+
+	result, err := ba.RestoreTaskFrom(original, taskID)
+	if err != nil {
+		t.Fatalf("RestoreTaskFrom failed: %v", err)
+	}
+	if result.Commit == "" || result.Task.Task.Title != "Watched task" || result.Task.Status.Column != "todo" {
+		t.Errorf("Expected the original task in todo, got %+v", result)
+	}
+	if len(result.Changes) != 1 || result.Changes[0].TaskID != taskID || len(result.Changes[0].Paths) != 2 {
+		t.Errorf("Expected the task file and its archive entry to change, got %+v", result.Changes)
+	}
+
+	tasks, err := ba.GetTasksData([]string{taskID, otherID}, false)
+	if err != nil || len(tasks) != 2 {
+		t.Fatalf("Expected both tasks on the board, got %+v (%v)", tasks, err)
+	}
+	if archived, _ := ba.ListArchivedTasks(); len(archived) != 0 {
+		t.Errorf("Expected the archive entry to be removed, got %+v", archived)
+	}
+
+	if _, err := ba.RestoreTaskFrom(original, otherID); err == nil {
+		t.Error("Expected a task created later to be rejected")
+	}
+}
@@ -6,4 +6,5 @@ package board_access
 type IRules interface {
 	// Rule Engine Helper Operations
 	GetRulesData(taskID string, targetColumns []string) (*RulesData, error)
+	GetColumnWIPData(column string) (*ColumnWIPData, error)
 }
\ No newline at end of file
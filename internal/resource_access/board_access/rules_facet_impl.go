@@ -11,14 +11,16 @@ import (
 
 // rulesFacet implements the IRules interface
 type rulesFacet struct {
+	boardPath string
 	taskFacet ITask
 	logger    utilities.ILoggingUtility
 	mutex     *sync.RWMutex
 }
 
 // newRulesFacet creates a new rules facet instance
-func newRulesFacet(taskFacet ITask, logger utilities.ILoggingUtility, mutex *sync.RWMutex) IRules {
+func newRulesFacet(boardPath string, taskFacet ITask, logger utilities.ILoggingUtility, mutex *sync.RWMutex) IRules {
 	return &rulesFacet{
+		boardPath: boardPath,
 		taskFacet: taskFacet,
 		logger:    logger,
 		mutex:     mutex,
@@ -36,10 +38,19 @@ func (rf *rulesFacet) GetRulesData(taskID string, targetColumns []string) (*Rule
 		SubtaskWIPCounts: make(map[string]int),
 		ColumnTasks:      make(map[string][]*TaskWithTimestamps),
 		ColumnEnterTimes: make(map[string]time.Time),
+		ColumnSettings:   make(map[string]ColumnSettings),
 		BoardMetadata:    make(map[string]string),
 		HierarchyMap:     make(map[string][]string),
 	}
 
+	// Column settings from board.json, with the done column resolved for boards without one marked done
+	config := readBoardConfiguration(rf.boardPath)
+	for _, column := range config.Columns {
+		settings := config.ColumnSettings[column]
+		settings.Done = config.IsDoneColumn(column)
+		rulesData.ColumnSettings[column] = settings
+	}
+
 	// Get all tasks
 	allTasks, err := rf.taskFacet.FindTasks(&QueryCriteria{})
 	if err != nil {
@@ -74,12 +85,19 @@ func (rf *rulesFacet) GetRulesData(taskID string, targetColumns []string) (*Rule
 		if err != nil {
 			rf.logger.LogMessage(utilities.Warning, "RulesFacet", "Failed to get task history")
 		} else {
-			rulesData.TaskHistory = taskHistory
+			for _, revision := range taskHistory {
+				rulesData.TaskHistory = append(rulesData.TaskHistory, revision.CommitInfo)
+			}
 		}
 
-		// Mock column enter times based on task history
-		for _, column := range targetColumns {
-			rulesData.ColumnEnterTimes[column] = time.Now().Add(-time.Hour)
+		// Column enter times come from the task's timeline in git history
+		timeline, err := rf.taskFacet.GetTaskTimeline(taskID)
+		if err != nil {
+			rf.logger.LogMessage(utilities.Warning, "RulesFacet", "Failed to get task timeline")
+		} else {
+			for column, enteredAt := range columnEnterTimes(timeline) {
+				rulesData.ColumnEnterTimes[column] = enteredAt
+			}
 		}
 	}
 
@@ -90,6 +108,31 @@ func (rf *rulesFacet) GetRulesData(taskID string, targetColumns []string) (*Rule
 	return rulesData, nil
 }
 
+// GetColumnWIPData reads the WIP limit of a column and counts its top-level tasks only if the column is limited
+func (rf *rulesFacet) GetColumnWIPData(column string) (*ColumnWIPData, error) {
+	rf.mutex.RLock()
+	defer rf.mutex.RUnlock()
+
+	config := readBoardConfiguration(rf.boardPath)
+	settings := config.ColumnSettings[column]
+	settings.Done = config.IsDoneColumn(column)
+	data := &ColumnWIPData{Column: column, Settings: settings}
+	if settings.WIPLimit <= 0 || settings.Done {
+		return data, nil
+	}
+
+	tasks, err := rf.taskFacet.FindTasks(&QueryCriteria{Columns: []string{column}})
+	if err != nil {
+		return nil, err
+	}
+	for _, task := range tasks {
+		if task.Task.ParentTaskID == nil {
+			data.WIPCount++
+		}
+	}
+	return data, nil
+}
+
 // Helper function
 func (rf *rulesFacet) containsString(slice []string, item string) bool {
 	for _, s := range slice {
@@ -0,0 +1,31 @@
+// Package board_access provides BoardAccess layer components implementing the iDesign methodology.
+// This file implements the ISync facet for sharing a board with other clones through git remotes.
+package board_access
+
+import (
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// ISync defines the interface for exchanging board changes with git remotes
+type ISync interface {
+	// Remote management
+	ListRemotes() ([]utilities.RemoteInfo, error)
+	AddRemote(name, url string) error
+	RemoveRemote(name string) error
+
+	// SyncBoard merges the remote's changes into the board task by task and pushes the result back;
+	// an empty remote name selects origin
+	SyncBoard(remote string) (*SyncResult, error)
+}
+
+// SyncResult describes the outcome of a board synchronisation
+type SyncResult struct {
+	Remote      string        `json:"remote"`
+	UpToDate    bool          `json:"up_to_date"`             // the remote had no changes for this board
+	FastForward bool          `json:"fast_forward"`           // the board had no local changes the remote lacked
+	MergeCommit string        `json:"merge_commit,omitempty"` // commit merging local and remote changes
+	Changes     []BoardChange `json:"changes,omitempty"`      // remote modifications applied to the board
+
+	// Conflicts lists task fields changed differently on both sides; unresolved ones keep a provisional value
+	Conflicts []utilities.FieldConflict `json:"conflicts,omitempty"`
+}
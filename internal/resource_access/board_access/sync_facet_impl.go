@@ -0,0 +1,92 @@
+// Package board_access provides BoardAccess layer components implementing the iDesign methodology.
+// This file implements the ISync facet on top of the remote operations of the repository.
+package board_access
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// syncFacet implements the ISync interface
+type syncFacet struct {
+	repository  utilities.Repository
+	logger      utilities.ILoggingUtility
+	mutex       *sync.RWMutex
+	journal     *fileJournal
+	lock        *boardLock
+	transaction *boardTransaction
+}
+
+// newSyncFacet creates a sync facet sharing the journal of the task facet
+func newSyncFacet(repository utilities.Repository, logger utilities.ILoggingUtility, mutex *sync.RWMutex, journal *fileJournal, lock *boardLock, transaction *boardTransaction) ISync {
+	return &syncFacet{
+		repository:  repository,
+		logger:      logger,
+		mutex:       mutex,
+		journal:     journal,
+		lock:        lock,
+		transaction: transaction,
+	}
+}
+
+// ListRemotes returns the remotes configured for the board
+func (sf *syncFacet) ListRemotes() ([]utilities.RemoteInfo, error) {
+	return sf.repository.ListRemotes()
+}
+
+// AddRemote configures a remote for the board
+func (sf *syncFacet) AddRemote(name, url string) error {
+	sf.logger.LogMessage(utilities.Info, "SyncFacet", fmt.Sprintf("Adding remote %s: %s", name, url))
+	return sf.repository.AddRemote(name, url)
+}
+
+// RemoveRemote removes a remote from the board
+func (sf *syncFacet) RemoveRemote(name string) error {
+	sf.logger.LogMessage(utilities.Info, "SyncFacet", fmt.Sprintf("Removing remote %s", name))
+	return sf.repository.RemoveRemote(name)
+}
+
+// SyncBoard pulls the remote's changes merging tasks field by field, accepts the pulled files as known content and pushes the merged board
+func (sf *syncFacet) SyncBoard(remote string) (*SyncResult, error) {
+	sf.mutex.Lock()
+	defer sf.mutex.Unlock()
+
+	if err := sf.lock.checkWritable(); err != nil {
+		return nil, err
+	}
+	if err := sf.transaction.checkIdle(); err != nil {
+		return nil, err
+	}
+
+	sf.logger.LogMessage(utilities.Info, "SyncFacet", fmt.Sprintf("Synchronising board with remote %s", remote))
+
+	pull, err := sf.repository.PullWithMergeDriver(remote, newTaskMergeDriver())
+	if err != nil {
+		return nil, fmt.Errorf("failed to pull board changes: %w", err)
+	}
+
+	// Pulled files are our own writes; the watcher must not report them and later writes must not be refused
+	relPaths, err := sf.journal.accept(sf.repository.Path(), pull.ChangedFiles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to accept pulled files: %w", err)
+	}
+
+	if err := sf.repository.Push(pull.Remote); err != nil {
+		return nil, fmt.Errorf("failed to push board changes: %w", err)
+	}
+
+	result := &SyncResult{
+		Remote:      pull.Remote,
+		UpToDate:    pull.UpToDate,
+		FastForward: pull.FastForward,
+		MergeCommit: pull.MergeCommit,
+		Changes:     groupBoardChanges(relPaths, time.Now()),
+		Conflicts:   pull.Conflicts,
+	}
+
+	sf.logger.LogMessage(utilities.Info, "SyncFacet", fmt.Sprintf("Board synchronised with %s: %d remote changes", pull.Remote, len(result.Changes)))
+	return result, nil
+}
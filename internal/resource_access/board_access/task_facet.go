@@ -3,9 +3,13 @@
 package board_access
 
 import (
-	"github.com/rknuus/eisenkan/internal/utilities"
+	"errors"
+	"time"
 )
 
+// ErrTaskNotFound reports that no task exists with the requested ID
+var ErrTaskNotFound = errors.New("task not found")
+
 // ITask defines the interface for task and subtask operations
 type ITask interface {
 	// Task CRUD Operations
@@ -17,9 +21,15 @@ type ITask interface {
 	ArchiveTask(taskID string, cascadePolicy CascadePolicy) error
 	RemoveTask(taskID string, cascadePolicy CascadePolicy) error
 	FindTasks(criteria *QueryCriteria) ([]*TaskWithTimestamps, error)
-	GetTaskHistory(taskID string, limit int) ([]utilities.CommitInfo, error)
+	GetTaskHistory(taskID string, limit int) ([]TaskRevision, error)
+	GetTaskTimeline(taskID string) ([]TaskTimelineEntry, error)
 
 	// Subtask Operations
 	GetSubtasks(parentTaskID string) ([]*TaskWithTimestamps, error)
 	GetParentTask(subtaskID string) (*TaskWithTimestamps, error)
+
+	// Archive Operations
+	ListArchivedTasks() ([]*ArchivedTask, error)
+	RestoreTask(taskID string, cascadePolicy CascadePolicy) error
+	PurgeArchive(olderThan time.Duration) ([]string, error)
 }
\ No newline at end of file
@@ -3,9 +3,7 @@
 package board_access
 
 import (
-	"encoding/json"
 	"fmt"
-	"os"
 	"path/filepath"
 	"strings"
 	"sync"
@@ -17,17 +15,29 @@ import (
 
 // taskFacet implements the ITask interface
 type taskFacet struct {
-	repository utilities.Repository
-	logger     utilities.ILoggingUtility
-	mutex      *sync.RWMutex
+	repository  utilities.Repository
+	storage     *taskStorage
+	logger      utilities.ILoggingUtility
+	mutex       *sync.RWMutex
+	lock        *boardLock
+	transaction *boardTransaction // collects changes instead of committing them while open
+	history     *historyCache
 }
 
 // newTaskFacet creates a new task facet instance
-func newTaskFacet(repository utilities.Repository, logger utilities.ILoggingUtility, mutex *sync.RWMutex) ITask {
+func newTaskFacet(repository utilities.Repository, logger utilities.ILoggingUtility, mutex *sync.RWMutex, journal *fileJournal, lock *boardLock, transaction *boardTransaction) ITask {
+	storage := newTaskStorage(repository.Path())
+	storage.journal = journal
+	storage.transaction = transaction
+
 	return &taskFacet{
-		repository: repository,
-		logger:     logger,
-		mutex:      mutex,
+		repository:  repository,
+		storage:     storage,
+		logger:      logger,
+		mutex:       mutex,
+		lock:        lock,
+		transaction: transaction,
+		history:     newHistoryCache(),
 	}
 }
 
@@ -36,6 +46,10 @@ func (tf *taskFacet) CreateTask(task *Task, priority Priority, status WorkflowSt
 	tf.mutex.Lock()
 	defer tf.mutex.Unlock()
 
+	if err := tf.lock.checkWritable(); err != nil {
+		return "", err
+	}
+
 	if task == nil {
 		return "", fmt.Errorf("task cannot be nil")
 	}
@@ -79,7 +93,7 @@ func (tf *taskFacet) CreateTask(task *Task, priority Priority, status WorkflowSt
 	}
 
 	// Save to storage
-	if err := tf.saveTaskToStorage(taskWithTimestamps); err != nil {
+	if err := tf.saveTaskToStorage(taskWithTimestamps, OperationCreate); err != nil {
 		return "", fmt.Errorf("failed to save task: %w", err)
 	}
 
@@ -151,6 +165,10 @@ func (tf *taskFacet) ChangeTaskData(taskID string, task *Task, priority Priority
 	tf.mutex.Lock()
 	defer tf.mutex.Unlock()
 
+	if err := tf.lock.checkWritable(); err != nil {
+		return err
+	}
+
 	if task == nil {
 		return fmt.Errorf("task cannot be nil")
 	}
@@ -161,7 +179,7 @@ func (tf *taskFacet) ChangeTaskData(taskID string, task *Task, priority Priority
 		return fmt.Errorf("failed to get existing task: %w", err)
 	}
 	if existingTask == nil {
-		return fmt.Errorf("task not found: %s", taskID)
+		return fmt.Errorf("%w: task file not found: %s", ErrTaskNotFound, taskID)
 	}
 
 	// Update the task data
@@ -175,7 +193,7 @@ func (tf *taskFacet) ChangeTaskData(taskID string, task *Task, priority Priority
 	}
 
 	// Save updated task
-	if err := tf.saveTaskToStorage(updatedTask); err != nil {
+	if err := tf.saveTaskToStorage(updatedTask, OperationUpdate); err != nil {
 		return fmt.Errorf("failed to save updated task: %w", err)
 	}
 
@@ -188,13 +206,17 @@ func (tf *taskFacet) MoveTask(taskID string, priority Priority, status WorkflowS
 	tf.mutex.Lock()
 	defer tf.mutex.Unlock()
 
+	if err := tf.lock.checkWritable(); err != nil {
+		return err
+	}
+
 	// Load existing task
 	existingTask, err := tf.getTaskByID(taskID)
 	if err != nil {
 		return fmt.Errorf("failed to get existing task: %w", err)
 	}
 	if existingTask == nil {
-		return fmt.Errorf("task not found: %s", taskID)
+		return fmt.Errorf("%w: task file not found: %s", ErrTaskNotFound, taskID)
 	}
 
 	// Update only priority and status
@@ -207,7 +229,7 @@ func (tf *taskFacet) MoveTask(taskID string, priority Priority, status WorkflowS
 	}
 
 	// Save updated task
-	if err := tf.saveTaskToStorage(updatedTask); err != nil {
+	if err := tf.saveTaskToStorage(updatedTask, OperationMove); err != nil {
 		return fmt.Errorf("failed to save moved task: %w", err)
 	}
 
@@ -215,30 +237,38 @@ func (tf *taskFacet) MoveTask(taskID string, priority Priority, status WorkflowS
 	return nil
 }
 
-// ArchiveTask archives a task with cascade policy
+// ArchiveTask moves a task into the archive with cascade policy
 func (tf *taskFacet) ArchiveTask(taskID string, cascadePolicy CascadePolicy) error {
 	tf.mutex.Lock()
 	defer tf.mutex.Unlock()
 
+	if err := tf.lock.checkWritable(); err != nil {
+		return err
+	}
+
 	// Get the task to archive
 	task, err := tf.getTaskByID(taskID)
 	if err != nil {
 		return fmt.Errorf("failed to get task for archival: %w", err)
 	}
 	if task == nil {
-		return fmt.Errorf("task not found: %s", taskID)
+		return fmt.Errorf("%w: %s", ErrTaskNotFound, taskID)
 	}
 
-	// Handle cascade operations for subtasks
-	if err := tf.handleCascadeOperation(taskID, cascadePolicy, true); err != nil {
-		return fmt.Errorf("failed to handle cascade archival: %w", err)
+	location, err := tf.storage.locate(taskID)
+	if err != nil {
+		return fmt.Errorf("failed to locate task for archival: %w", err)
 	}
 
-	// Archive the task (move to archived tasks file)
-	if err := tf.archiveTaskInStorage(task); err != nil {
+	paths, err := tf.archiveTaskInternal(task, cascadePolicy, time.Now())
+	if err != nil {
 		return fmt.Errorf("failed to archive task in storage: %w", err)
 	}
 
+	if err := tf.commitPaths(paths, newTaskCommitMessage(OperationArchive, task, location, nil)); err != nil {
+		return fmt.Errorf("failed to commit archived task: %w", err)
+	}
+
 	tf.logger.LogMessage(utilities.Info, "TaskFacet", fmt.Sprintf("Task archived: %s", taskID))
 	return nil
 }
@@ -248,6 +278,10 @@ func (tf *taskFacet) RemoveTask(taskID string, cascadePolicy CascadePolicy) erro
 	tf.mutex.Lock()
 	defer tf.mutex.Unlock()
 
+	if err := tf.lock.checkWritable(); err != nil {
+		return err
+	}
+
 	// Get the task to remove
 	task, err := tf.getTaskByID(taskID)
 	if err != nil {
@@ -258,20 +292,142 @@ func (tf *taskFacet) RemoveTask(taskID string, cascadePolicy CascadePolicy) erro
 		return nil
 	}
 
-	// Handle cascade operations for subtasks
-	if err := tf.handleCascadeOperation(taskID, cascadePolicy, false); err != nil {
-		return fmt.Errorf("failed to handle cascade removal: %w", err)
+	location, err := tf.storage.locate(taskID)
+	if err != nil {
+		return fmt.Errorf("failed to locate task for removal: %w", err)
 	}
 
-	// Remove the task from storage
-	if err := tf.removeTaskFromStorage(taskID); err != nil {
+	paths, err := tf.removeTaskInternal(taskID, cascadePolicy)
+	if err != nil {
 		return fmt.Errorf("failed to remove task from storage: %w", err)
 	}
 
+	if err := tf.commitPaths(paths, newTaskCommitMessage(OperationRemove, task, location, nil)); err != nil {
+		return fmt.Errorf("failed to commit removed task: %w", err)
+	}
+
 	tf.logger.LogMessage(utilities.Info, "TaskFacet", fmt.Sprintf("Task removed: %s", taskID))
 	return nil
 }
 
+// ListArchivedTasks returns all archived tasks ordered by archival time
+func (tf *taskFacet) ListArchivedTasks() ([]*ArchivedTask, error) {
+	tf.mutex.RLock()
+	defer tf.mutex.RUnlock()
+
+	archived, err := tf.storage.loadArchive()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load archived tasks: %w", err)
+	}
+
+	return archived, nil
+}
+
+// RestoreTask moves an archived task back to the location it was archived from.
+// The cascade policy applies to archived subtasks: ArchiveSubtasks restores them as well,
+// DeleteSubtasks purges them, PromoteSubtasks restores them as top-level tasks and NoAction
+// keeps them archived.
+func (tf *taskFacet) RestoreTask(taskID string, cascadePolicy CascadePolicy) error {
+	tf.mutex.Lock()
+	defer tf.mutex.Unlock()
+
+	if err := tf.lock.checkWritable(); err != nil {
+		return err
+	}
+
+	archived, err := tf.storage.readArchived(taskID)
+	if err != nil {
+		return fmt.Errorf("failed to get archived task: %w", err)
+	}
+	if archived == nil {
+		return fmt.Errorf("archived task not found: %s", taskID)
+	}
+
+	// A subtask can only be restored below an active parent
+	if archived.Task.ParentTaskID != nil {
+		parentTaskID := *archived.Task.ParentTaskID
+		parent, err := tf.getTaskByID(parentTaskID)
+		if err != nil {
+			return fmt.Errorf("failed to get parent task: %w", err)
+		}
+		if parent == nil {
+			archivedParent, err := tf.storage.readArchived(parentTaskID)
+			if err != nil {
+				return fmt.Errorf("failed to get archived parent task: %w", err)
+			}
+			if archivedParent != nil {
+				return fmt.Errorf("parent task %s is archived, restore it first", parentTaskID)
+			}
+			// The parent no longer exists, restore the subtask as top-level task
+			archived.Task.ParentTaskID = nil
+		}
+	}
+
+	allArchived, err := tf.storage.loadArchive()
+	if err != nil {
+		return fmt.Errorf("failed to load archived tasks: %w", err)
+	}
+
+	paths, err := tf.restoreTaskInternal(archived, cascadePolicy, allArchived)
+	if err != nil {
+		return fmt.Errorf("failed to restore task in storage: %w", err)
+	}
+
+	location, _ := parseTaskPath(filepath.ToSlash(paths[0]))
+	if err := tf.commitPaths(paths, newTaskCommitMessage(OperationRestore, archived.TaskWithTimestamps, nil, location)); err != nil {
+		return fmt.Errorf("failed to commit restored task: %w", err)
+	}
+
+	tf.logger.LogMessage(utilities.Info, "TaskFacet", fmt.Sprintf("Task restored: %s", taskID))
+	return nil
+}
+
+// PurgeArchive permanently deletes tasks archived longer ago than olderThan and returns their IDs
+func (tf *taskFacet) PurgeArchive(olderThan time.Duration) ([]string, error) {
+	tf.mutex.Lock()
+	defer tf.mutex.Unlock()
+
+	if err := tf.lock.checkWritable(); err != nil {
+		return nil, err
+	}
+
+	if olderThan < 0 {
+		return nil, fmt.Errorf("purge age cannot be negative")
+	}
+
+	archived, err := tf.storage.loadArchive()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load archived tasks: %w", err)
+	}
+
+	cutoff := time.Now().Add(-olderThan)
+	purgedIDs := []string{}
+	var paths []string
+	for _, task := range archived {
+		if !task.ArchivedAt.Before(cutoff) {
+			continue
+		}
+		relPath := archivePathFor(task.Task.ID)
+		if err := tf.storage.remove(relPath); err != nil {
+			return nil, fmt.Errorf("failed to purge archived task %s: %w", task.Task.ID, err)
+		}
+		paths = append(paths, relPath)
+		purgedIDs = append(purgedIDs, task.Task.ID)
+	}
+
+	if len(paths) == 0 {
+		return purgedIDs, nil
+	}
+
+	message := utilities.NewCommitMessage(OperationPurge, fmt.Sprintf("Purge %d archived tasks", len(paths)))
+	if err := tf.commitPaths(paths, message); err != nil {
+		return nil, fmt.Errorf("failed to commit purged archive: %w", err)
+	}
+
+	tf.logger.LogMessage(utilities.Info, "TaskFacet", fmt.Sprintf("Purged %d archived tasks", len(purgedIDs)))
+	return purgedIDs, nil
+}
+
 // FindTasks searches for tasks based on criteria
 func (tf *taskFacet) FindTasks(criteria *QueryCriteria) ([]*TaskWithTimestamps, error) {
 	tf.mutex.RLock()
@@ -299,27 +455,37 @@ func (tf *taskFacet) FindTasks(criteria *QueryCriteria) ([]*TaskWithTimestamps,
 	return results, nil
 }
 
-// GetTaskHistory retrieves task history from git commits
-func (tf *taskFacet) GetTaskHistory(taskID string, limit int) ([]utilities.CommitInfo, error) {
+// GetTaskHistory returns the latest revisions of a task with the fields each changed, newest first
+func (tf *taskFacet) GetTaskHistory(taskID string, limit int) ([]TaskRevision, error) {
 	tf.mutex.RLock()
 	defer tf.mutex.RUnlock()
 
-	// Use repository to get file history for this task
-	// This is a simplified implementation - in practice, you'd track which file contains the task
-	history, err := tf.repository.GetHistory(limit)
+	if limit <= 0 {
+		limit = defaultTaskHistoryLimit
+	}
+
+	history, err := tf.history.changeHistory(tf.repository)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get task history: %w", err)
 	}
 
-	// Filter commits that might be related to this task
-	var taskHistory []utilities.CommitInfo
-	for _, commit := range history {
-		if strings.Contains(commit.Message, taskID) || strings.Contains(commit.Message, "task") {
-			taskHistory = append(taskHistory, commit)
-		}
+	revisions, err := buildTaskRevisions(history, taskID, limit, tf.repository.GetFileAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reconstruct history of task %s: %w", taskID, err)
 	}
+	return revisions, nil
+}
+
+// GetTaskTimeline reconstructs the columns and sections a task passed through from git history
+func (tf *taskFacet) GetTaskTimeline(taskID string) ([]TaskTimelineEntry, error) {
+	tf.mutex.RLock()
+	defer tf.mutex.RUnlock()
 
-	return taskHistory, nil
+	timeline, err := tf.history.timeline(tf.repository, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get change history: %w", err)
+	}
+	return timeline, nil
 }
 
 // GetSubtasks retrieves all subtasks for a given parent task
@@ -373,143 +539,228 @@ func (tf *taskFacet) GetParentTask(subtaskID string) (*TaskWithTimestamps, error
 // Helper methods
 
 func (tf *taskFacet) getTaskByID(taskID string) (*TaskWithTimestamps, error) {
-	allTasks, err := tf.loadAllTasks()
+	ref, err := tf.storage.locate(taskID)
 	if err != nil {
 		return nil, err
 	}
-
-	for _, task := range allTasks {
-		if task.Task.ID == taskID {
-			return task, nil
-		}
+	if ref == nil {
+		return nil, nil
 	}
-	return nil, nil
+	return tf.storage.read(*ref)
 }
 
 func (tf *taskFacet) loadAllTasks() ([]*TaskWithTimestamps, error) {
-	// Load from active tasks file
-	tasksFile := filepath.Join(tf.repository.Path(), "tasks.json")
+	return tf.storage.loadAll()
+}
 
-	data, err := os.ReadFile(tasksFile)
+// saveTaskToStorage writes a task and commits it with a message describing the operation
+func (tf *taskFacet) saveTaskToStorage(task *TaskWithTimestamps, operation string) error {
+	previous, err := tf.storage.locate(task.Task.ID)
 	if err != nil {
-		if os.IsNotExist(err) {
-			return []*TaskWithTimestamps{}, nil
-		}
-		return nil, err
+		return err
 	}
 
-	var tasks []*TaskWithTimestamps
-	if err := json.Unmarshal(data, &tasks); err != nil {
-		return nil, fmt.Errorf("failed to parse tasks file: %w", err)
+	paths, err := tf.writeTaskInternal(task)
+	if err != nil {
+		return err
 	}
 
-	return tasks, nil
+	current, _ := parseTaskPath(filepath.ToSlash(paths[0]))
+	return tf.commitPaths(paths, newTaskCommitMessage(operation, task, previous, current))
 }
 
-func (tf *taskFacet) saveTaskToStorage(task *TaskWithTimestamps) error {
-	// Load all existing tasks
-	allTasks, err := tf.loadAllTasks()
+// writeTaskInternal writes a task to its canonical path and drops its previous file without committing
+func (tf *taskFacet) writeTaskInternal(task *TaskWithTimestamps) ([]string, error) {
+	// Locate the current file first, a move changes the path of the task file
+	previous, err := tf.storage.locate(task.Task.ID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if previous != nil {
+		if err := tf.storage.verify(previous.RelPath); err != nil {
+			return nil, err
+		}
 	}
 
-	// Update or add the task
-	found := false
-	for i, existingTask := range allTasks {
-		if existingTask.Task.ID == task.Task.ID {
-			allTasks[i] = task
-			found = true
-			break
+	relPath, err := tf.storage.write(task)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := []string{relPath}
+	if previous != nil && previous.RelPath != relPath {
+		if err := tf.storage.remove(previous.RelPath); err != nil {
+			return nil, err
 		}
+		paths = append(paths, previous.RelPath)
 	}
-	if !found {
-		allTasks = append(allTasks, task)
+
+	return paths, nil
+}
+
+func (tf *taskFacet) removeTaskFromStorage(taskID string) ([]string, error) {
+	ref, err := tf.storage.locate(taskID)
+	if err != nil {
+		return nil, err
+	}
+	if ref == nil {
+		return nil, nil
 	}
 
-	// Save back to file
-	return tf.saveAllTasks(allTasks)
+	if err := tf.storage.remove(ref.RelPath); err != nil {
+		return nil, err
+	}
+
+	return []string{ref.RelPath}, nil
 }
 
-func (tf *taskFacet) saveAllTasks(tasks []*TaskWithTimestamps) error {
-	tasksFile := filepath.Join(tf.repository.Path(), "tasks.json")
+// archiveTaskInternal archives a task and applies the cascade policy without committing (for internal use)
+func (tf *taskFacet) archiveTaskInternal(task *TaskWithTimestamps, policy CascadePolicy, archivedAt time.Time) ([]string, error) {
+	paths, err := tf.handleCascadeOperation(task.Task.ID, policy, true, archivedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to handle cascade archival: %w", err)
+	}
 
-	data, err := json.MarshalIndent(tasks, "", "  ")
+	// Keep the task on the board until its archive copy is written
+	archivePath, err := tf.storage.archive(task, archivedAt)
 	if err != nil {
-		return fmt.Errorf("failed to marshal tasks: %w", err)
+		return nil, err
 	}
+	paths = append(paths, archivePath)
 
-	if err := os.WriteFile(tasksFile, data, 0644); err != nil {
-		return fmt.Errorf("failed to write tasks file: %w", err)
+	removedPaths, err := tf.removeTaskFromStorage(task.Task.ID)
+	if err != nil {
+		return nil, err
 	}
 
-	// Stage and commit changes
-	if err := tf.repository.Stage([]string{"tasks.json"}); err != nil {
-		return fmt.Errorf("failed to stage tasks file: %w", err)
+	return append(paths, removedPaths...), nil
+}
+
+// removeTaskInternal deletes a task and applies the cascade policy without committing (for internal use)
+func (tf *taskFacet) removeTaskInternal(taskID string, policy CascadePolicy) ([]string, error) {
+	paths, err := tf.handleCascadeOperation(taskID, policy, false, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to handle cascade removal: %w", err)
 	}
 
-	_, err = tf.repository.Commit("Update tasks")
-	return err
+	removedPaths, err := tf.removeTaskFromStorage(taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(paths, removedPaths...), nil
 }
 
-func (tf *taskFacet) removeTaskFromStorage(taskID string) error {
-	allTasks, err := tf.loadAllTasks()
+// restoreTaskInternal restores an archived task and applies the cascade policy to its archived subtasks
+func (tf *taskFacet) restoreTaskInternal(archived *ArchivedTask, policy CascadePolicy, allArchived []*ArchivedTask) ([]string, error) {
+	taskID := archived.Task.ID
+
+	existing, err := tf.storage.locate(taskID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("task %s already exists on the board", taskID)
 	}
 
-	// Remove the task
-	filteredTasks := make([]*TaskWithTimestamps, 0, len(allTasks))
-	for _, task := range allTasks {
-		if task.Task.ID != taskID {
-			filteredTasks = append(filteredTasks, task)
+	archived.UpdatedAt = time.Now()
+	relPath, err := tf.storage.write(archived.TaskWithTimestamps)
+	if err != nil {
+		return nil, err
+	}
+
+	archivePath := archivePathFor(taskID)
+	if err := tf.storage.remove(archivePath); err != nil {
+		return nil, err
+	}
+	paths := []string{relPath, archivePath}
+
+	for _, subtask := range allArchived {
+		if subtask.Task.ParentTaskID == nil || *subtask.Task.ParentTaskID != taskID {
+			continue
+		}
+
+		switch policy {
+		case NoAction:
+			// Subtasks stay in the archive
+		case ArchiveSubtasks:
+			subtaskPaths, err := tf.restoreTaskInternal(subtask, policy, allArchived)
+			if err != nil {
+				return nil, err
+			}
+			paths = append(paths, subtaskPaths...)
+		case DeleteSubtasks:
+			subtaskArchivePath := archivePathFor(subtask.Task.ID)
+			if err := tf.storage.remove(subtaskArchivePath); err != nil {
+				return nil, err
+			}
+			paths = append(paths, subtaskArchivePath)
+		case PromoteSubtasks:
+			subtask.Task.ParentTaskID = nil
+			subtaskPaths, err := tf.restoreTaskInternal(subtask, NoAction, allArchived)
+			if err != nil {
+				return nil, err
+			}
+			paths = append(paths, subtaskPaths...)
 		}
 	}
 
-	return tf.saveAllTasks(filteredTasks)
+	return paths, nil
 }
 
-func (tf *taskFacet) archiveTaskInStorage(task *TaskWithTimestamps) error {
-	// Remove from active tasks
-	if err := tf.removeTaskFromStorage(task.Task.ID); err != nil {
-		return err
+// commitPaths commits task files, naming every task among them in the message; while a transaction
+// is open the files are only staged and committed along with the other changes of the transaction
+func (tf *taskFacet) commitPaths(paths []string, message *utilities.CommitMessage) error {
+	if err := tf.repository.Stage(paths); err != nil {
+		return fmt.Errorf("failed to stage task files: %w", err)
 	}
 
-	// Add to archived tasks (simplified - would use separate file in practice)
-	tf.logger.LogMessage(utilities.Info, "TaskFacet", fmt.Sprintf("Task archived to archive storage: %s", task.Task.ID))
-	return nil
+	message = withAffectedTasks(message, paths)
+	if tf.transaction.active {
+		tf.transaction.add(paths, message)
+		return nil
+	}
+
+	_, err := tf.repository.Commit(message.String())
+	return err
 }
 
-func (tf *taskFacet) handleCascadeOperation(parentTaskID string, policy CascadePolicy, archive bool) error {
+func (tf *taskFacet) handleCascadeOperation(parentTaskID string, policy CascadePolicy, archive bool, archivedAt time.Time) ([]string, error) {
 	// Get subtasks (internal version without locking)
 	subtasks, err := tf.getSubtasksInternal(parentTaskID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	var paths []string
 	for _, subtask := range subtasks {
+		var subtaskPaths []string
 		switch policy {
 		case NoAction:
 			// Do nothing to subtasks
 		case ArchiveSubtasks:
 			if archive {
-				if err := tf.ArchiveTask(subtask.Task.ID, policy); err != nil {
-					return err
-				}
+				subtaskPaths, err = tf.archiveTaskInternal(subtask, policy, archivedAt)
 			}
 		case DeleteSubtasks:
-			if err := tf.RemoveTask(subtask.Task.ID, policy); err != nil {
-				return err
-			}
+			subtaskPaths, err = tf.removeTaskInternal(subtask.Task.ID, policy)
 		case PromoteSubtasks:
 			// Clear parent task ID to promote to top level
-			subtask.Task.ParentTaskID = nil
-			if err := tf.saveTaskToStorage(subtask); err != nil {
-				return err
-			}
+			subtaskPaths, err = tf.promoteSubtaskInternal(subtask)
+		}
+		if err != nil {
+			return nil, err
 		}
+		paths = append(paths, subtaskPaths...)
 	}
 
-	return nil
+	return paths, nil
+}
+
+// promoteSubtaskInternal turns a subtask into a top-level task, which moves its file out of the parent folder
+func (tf *taskFacet) promoteSubtaskInternal(subtask *TaskWithTimestamps) ([]string, error) {
+	subtask.Task.ParentTaskID = nil
+	return tf.writeTaskInternal(subtask)
 }
 
 func (tf *taskFacet) matchesCriteria(task *TaskWithTimestamps, criteria *QueryCriteria) bool {
@@ -0,0 +1,431 @@
+// Package board_access provides BoardAccess layer components implementing the iDesign methodology.
+// This file implements the merge driver that combines task changes made on two clones of a board.
+package board_access
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// Task document fields the merge derives from the task location or merges specially rather than field by field
+var specialTaskFields = map[string]bool{
+	"id":         true,
+	"parentId":   true,
+	"status":     true,
+	"tags":       true,
+	"updated_at": true,
+}
+
+// taskMergeDriver merges task files changed on both sides of a sync by task ID and field, so that edits of
+// different fields combine and a task moved on one side keeps the edits made on the other. Fields changed
+// differently on both sides take the value of the side that updated the task last.
+type taskMergeDriver struct{}
+
+// newTaskMergeDriver creates the merge driver used when synchronising boards
+func newTaskMergeDriver() utilities.MergeDriver {
+	return taskMergeDriver{}
+}
+
+// taskLocation is where a task lives apart from its position: its column, section and parent, or the archive
+type taskLocation struct {
+	archived bool
+	column   string
+	section  string
+	parentID string
+}
+
+// String describes the location in conflict reports
+func (l taskLocation) String() string {
+	if l.archived {
+		return archiveDirName
+	}
+	location := l.column
+	if l.section != "" {
+		location += "/" + l.section
+	}
+	if l.parentID != "" {
+		location += " under task " + l.parentID
+	}
+	return location
+}
+
+// taskVersion is one side's version of a task during a merge
+type taskVersion struct {
+	content   []byte
+	fields    map[string]json.RawMessage
+	location  taskLocation
+	position  int
+	updatedAt time.Time
+}
+
+// Merge implements utilities.MergeDriver for the task files of a board; other files are merged as a whole
+func (taskMergeDriver) Merge(files map[string]utilities.MergeVersions) (*utilities.MergeOutcome, error) {
+	groups := make(map[string][]string)
+	for path := range files {
+		if taskID, ok := taskIDForPath(path); ok {
+			groups[taskID] = append(groups[taskID], path)
+		}
+	}
+
+	taskIDs := make([]string, 0, len(groups))
+	for taskID := range groups {
+		taskIDs = append(taskIDs, taskID)
+	}
+	sort.Strings(taskIDs)
+
+	outcome := &utilities.MergeOutcome{Files: make(map[string][]byte)}
+	for _, taskID := range taskIDs {
+		paths := groups[taskID]
+		sort.Strings(paths)
+		if !changedOnBothSides(files, paths) {
+			continue
+		}
+
+		mergedPath, content, conflicts, ok := mergeTask(taskID, files, paths)
+		if !ok {
+			// Unreadable task files are left to whole-file merging, which reports them as conflicting
+			continue
+		}
+		for _, path := range paths {
+			outcome.Files[path] = nil
+		}
+		if mergedPath != "" {
+			outcome.Files[mergedPath] = content
+		}
+		outcome.Conflicts = append(outcome.Conflicts, conflicts...)
+	}
+	return outcome, nil
+}
+
+// taskIDForPath returns the ID of the task stored at a slash-separated path, active or archived
+func taskIDForPath(path string) (string, bool) {
+	if ref, ok := parseTaskPath(path); ok {
+		return ref.TaskID, true
+	}
+	return parseArchivedPath(path)
+}
+
+// changedOnBothSides reports whether both sides changed a task's files, and differently
+func changedOnBothSides(files map[string]utilities.MergeVersions, paths []string) bool {
+	var ours, theirs, differ bool
+	for _, path := range paths {
+		versions := files[path]
+		ours = ours || !bytes.Equal(versions.Base, versions.Ours)
+		theirs = theirs || !bytes.Equal(versions.Base, versions.Theirs)
+		differ = differ || !bytes.Equal(versions.Ours, versions.Theirs)
+	}
+	return ours && theirs && differ
+}
+
+// mergeTask merges the versions of one task, returning its merged path and content; an empty path removes the task
+func mergeTask(taskID string, files map[string]utilities.MergeVersions, paths []string) (string, []byte, []utilities.FieldConflict, bool) {
+	var versions [3]*taskVersion
+	var versionPaths [3]string
+	for _, path := range paths {
+		for side, content := range [3][]byte{files[path].Base, files[path].Ours, files[path].Theirs} {
+			if content == nil {
+				continue
+			}
+			version, ok := parseTaskVersion(path, content)
+			if !ok {
+				return "", nil, nil, false
+			}
+			versions[side], versionPaths[side] = version, path
+		}
+	}
+	base, ours, theirs := versions[0], versions[1], versions[2]
+
+	switch {
+	case ours == nil && theirs == nil:
+		return "", nil, nil, true
+	case ours == nil || theirs == nil:
+		return mergeDeletedTask(taskID, base, ours, theirs, versionPaths)
+	}
+
+	merged := make(map[string]json.RawMessage)
+	var conflicts []utilities.FieldConflict
+	resolution, reason := lastWriter(ours, theirs)
+	winner := ours
+	if resolution == utilities.ResolvedTheirs {
+		winner = theirs
+	}
+	conflict := func(field, oursValue, theirsValue string) {
+		conflicts = append(conflicts, utilities.FieldConflict{
+			Record:     taskID,
+			Field:      field,
+			Ours:       oursValue,
+			Theirs:     theirsValue,
+			Resolution: resolution,
+			Reason:     reason,
+		})
+	}
+
+	baseFields := map[string]json.RawMessage{}
+	if base != nil {
+		baseFields = base.fields
+	}
+	for _, key := range fieldNames(baseFields, ours.fields, theirs.fields) {
+		if specialTaskFields[key] {
+			continue
+		}
+		value, conflicting := mergeValue(baseFields[key], ours.fields[key], theirs.fields[key])
+		if conflicting {
+			conflict(key, displayValue(ours.fields[key]), displayValue(theirs.fields[key]))
+			value = winner.fields[key]
+		}
+		if value != nil {
+			merged[key] = value
+		}
+	}
+
+	if tags := mergeTags(baseFields["tags"], ours.fields["tags"], theirs.fields["tags"]); len(tags) > 0 {
+		merged["tags"], _ = json.Marshal(tags)
+	}
+
+	// Column, section and parent move together; the position only counts within the same location
+	placed := ours
+	switch {
+	case ours.location == theirs.location:
+		if ours.position != theirs.position {
+			basePosition := -1
+			if base != nil && base.location == ours.location {
+				basePosition = base.position
+			}
+			switch basePosition {
+			case ours.position:
+				placed = theirs
+			case theirs.position:
+			default:
+				conflict("position", displayPosition(ours.position), displayPosition(theirs.position))
+				placed = winner
+			}
+		}
+	case base != nil && ours.location == base.location:
+		placed = theirs
+	case base != nil && theirs.location == base.location:
+	default:
+		conflict("status", ours.location.String(), theirs.location.String())
+		placed = winner
+	}
+	for _, key := range []string{"status", "parentId"} {
+		if value, ok := placed.fields[key]; ok {
+			merged[key] = value
+		}
+	}
+	merged["id"] = ours.fields["id"]
+
+	latest := ours
+	if theirs.updatedAt.After(ours.updatedAt) {
+		latest = theirs
+	}
+	if value, ok := latest.fields["updated_at"]; ok {
+		merged["updated_at"] = value
+	}
+
+	path, content, err := encodeMergedTask(taskID, merged, placed)
+	if err != nil {
+		return "", nil, nil, false
+	}
+	return path, content, conflicts, true
+}
+
+// mergeDeletedTask settles a task removed on one side; changes made on the other side are never discarded
+func mergeDeletedTask(taskID string, base, ours, theirs *taskVersion, paths [3]string) (string, []byte, []utilities.FieldConflict, bool) {
+	kept, keptPath, oursValue, theirsValue := ours, paths[1], "changed", "deleted"
+	if ours == nil {
+		kept, keptPath, oursValue, theirsValue = theirs, paths[2], "deleted", "changed"
+	}
+
+	// A task the other side left untouched is simply deleted
+	if base != nil && keptPath == paths[0] && bytes.Equal(kept.content, base.content) {
+		return "", nil, nil, true
+	}
+
+	conflict := utilities.FieldConflict{
+		Record:     taskID,
+		Field:      "task",
+		Ours:       oursValue,
+		Theirs:     theirsValue,
+		Resolution: utilities.Unresolved,
+		Reason:     "kept the changed task",
+	}
+	return keptPath, kept.content, []utilities.FieldConflict{conflict}, true
+}
+
+// parseTaskVersion decodes one side's task file together with the location its path encodes
+func parseTaskVersion(path string, content []byte) (*taskVersion, bool) {
+	version := &taskVersion{content: content}
+	if err := json.Unmarshal(content, &version.fields); err != nil {
+		return nil, false
+	}
+
+	if ref, ok := parseTaskPath(path); ok {
+		version.location = taskLocation{column: ref.Column, section: ref.Section}
+		if ref.ParentID != nil {
+			version.location.parentID = *ref.ParentID
+		}
+		version.position = ref.Position
+	} else {
+		version.location = taskLocation{archived: true}
+	}
+
+	if raw, ok := version.fields["updated_at"]; ok {
+		json.Unmarshal(raw, &version.updatedAt)
+	}
+	return version, true
+}
+
+// lastWriter decides true conflicts in favour of the side that updated the task last
+func lastWriter(ours, theirs *taskVersion) (utilities.ConflictResolution, string) {
+	switch {
+	case theirs.updatedAt.After(ours.updatedAt):
+		return utilities.ResolvedTheirs, "remote changed later"
+	case ours.updatedAt.After(theirs.updatedAt):
+		return utilities.ResolvedOurs, "local changed later"
+	default:
+		return utilities.Unresolved, "changed at the same time, kept local"
+	}
+}
+
+// fieldNames returns the sorted union of the fields of all versions
+func fieldNames(versions ...map[string]json.RawMessage) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, fields := range versions {
+		for name := range fields {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// mergeValue merges one field three-way, reporting a conflict if both sides changed it differently
+func mergeValue(base, ours, theirs json.RawMessage) (json.RawMessage, bool) {
+	switch {
+	case sameJSON(ours, theirs), sameJSON(base, theirs):
+		return ours, false
+	case sameJSON(base, ours):
+		return theirs, false
+	default:
+		return nil, true
+	}
+}
+
+// sameJSON compares two JSON values ignoring formatting; absent values only equal absent values
+func sameJSON(a, b json.RawMessage) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	var compactA, compactB bytes.Buffer
+	if json.Compact(&compactA, a) != nil || json.Compact(&compactB, b) != nil {
+		return bytes.Equal(a, b)
+	}
+	return bytes.Equal(compactA.Bytes(), compactB.Bytes())
+}
+
+// mergeTags combines the tags added and removed on either side, keeping the local order
+func mergeTags(base, ours, theirs json.RawMessage) []string {
+	var baseTags, ourTags, theirTags []string
+	json.Unmarshal(base, &baseTags)
+	json.Unmarshal(ours, &ourTags)
+	json.Unmarshal(theirs, &theirTags)
+
+	inBase := stringSet(baseTags)
+	inTheirs := stringSet(theirTags)
+
+	var tags []string
+	seen := make(map[string]bool)
+	for _, tag := range ourTags {
+		if inBase[tag] && !inTheirs[tag] {
+			continue // removed remotely
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+	inOurs := stringSet(ourTags)
+	for _, tag := range theirTags {
+		if !seen[tag] && !inBase[tag] && !inOurs[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// stringSet returns the set of the given strings
+func stringSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		set[value] = true
+	}
+	return set
+}
+
+// displayValue renders a field value for conflict reports, without quotes around strings
+func displayValue(raw json.RawMessage) string {
+	if raw == nil {
+		return ""
+	}
+	var text string
+	if json.Unmarshal(raw, &text) == nil {
+		return text
+	}
+	var compact bytes.Buffer
+	if json.Compact(&compact, raw) != nil {
+		return string(raw)
+	}
+	return compact.String()
+}
+
+// displayPosition renders a task position for conflict reports
+func displayPosition(position int) string {
+	raw, _ := json.Marshal(position)
+	return string(raw)
+}
+
+// encodeMergedTask writes the merged fields in the task file format at the path of the chosen location
+func encodeMergedTask(taskID string, fields map[string]json.RawMessage, placed *taskVersion) (string, []byte, error) {
+	raw, err := json.Marshal(fields)
+	if err != nil {
+		return "", nil, err
+	}
+	var doc taskDocument
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return "", nil, err
+	}
+	content, err := json.MarshalIndent(&doc, "", "  ")
+	if err != nil {
+		return "", nil, err
+	}
+
+	if placed.location.archived {
+		return filepath.ToSlash(archivePathFor(taskID)), content, nil
+	}
+
+	task := &TaskWithTimestamps{
+		Task: &Task{ID: taskID},
+		Status: WorkflowStatus{
+			Column:   placed.location.column,
+			Section:  placed.location.section,
+			Position: placed.position,
+		},
+	}
+	if placed.location.parentID != "" {
+		parentID := placed.location.parentID
+		task.Task.ParentTaskID = &parentID
+	}
+	path, err := new(taskStorage).pathFor(task)
+	if err != nil {
+		return "", nil, err
+	}
+	return filepath.ToSlash(path), content, nil
+}
@@ -0,0 +1,146 @@
+package board_access
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// mergeTaskFile encodes a task document as stored on disk
+func mergeTaskFile(t *testing.T, title string, tags []string, updatedAt time.Time) []byte {
+	t.Helper()
+	data, err := json.MarshalIndent(&taskDocument{ID: "t1", Title: title, Priority: "high", Status: "todo", Tags: tags, UpdatedAt: &updatedAt}, "", "  ")
+	if err != nil {
+		t.Fatalf("Failed to encode task: %v", err)
+	}
+	return data
+}
+
+// decodeMergedTask parses the merged content of a task file
+func decodeMergedTask(t *testing.T, outcome *utilities.MergeOutcome, path string) taskDocument {
+	t.Helper()
+	content, ok := outcome.Files[path]
+	if !ok || content == nil {
+		t.Fatalf("Expected merged content at %s, got %v", path, outcome.Files)
+	}
+	var doc taskDocument
+	if err := json.Unmarshal(content, &doc); err != nil {
+		t.Fatalf("Failed to parse merged task: %v", err)
+	}
+	return doc
+}
+
+func TestUnit_TaskMergeDriver_CombinesFieldsAndMoves(t *testing.T) {
+	baseTime := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	base := mergeTaskFile(t, "Write report", []string{"work"}, baseTime)
+
+	// Locally the task got a tag and moved to doing; remotely it was renamed and lost its old tag
+	ours := mergeTaskFile(t, "Write report", []string{"work", "urgent"}, baseTime.Add(time.Hour))
+	theirs := mergeTaskFile(t, "Write annual report", []string{"q4"}, baseTime.Add(2*time.Hour))
+
+	files := map[string]utilities.MergeVersions{
+		"todo/urgent-important/001-task-t1.json": {Base: base, Theirs: theirs},
+		"doing/002-task-t1.json":                 {Ours: ours},
+		"todo/urgent-important/002-task-t2.json": {Theirs: []byte(`{"id":"t2","title":"Other"}`)},
+	}
+
+	outcome, err := newTaskMergeDriver().Merge(files)
+	if err != nil {
+		t.Fatalf("Merge failed: %v", err)
+	}
+	if len(outcome.Conflicts) != 0 {
+		t.Errorf("Expected no conflicts, got %+v", outcome.Conflicts)
+	}
+
+	if content, handled := outcome.Files["todo/urgent-important/001-task-t1.json"]; !handled || content != nil {
+		t.Errorf("Expected the old location to be removed, got %q", content)
+	}
+	if _, handled := outcome.Files["todo/urgent-important/002-task-t2.json"]; handled {
+		t.Errorf("Expected a task changed on one side only to be left to whole-file merging")
+	}
+
+	doc := decodeMergedTask(t, outcome, "doing/002-task-t1.json")
+	if doc.Title != "Write annual report" {
+		t.Errorf("Expected the remote title, got %q", doc.Title)
+	}
+	if !reflect.DeepEqual(doc.Tags, []string{"urgent", "q4"}) {
+		t.Errorf("Expected tags added on both sides without the removed one, got %v", doc.Tags)
+	}
+	if doc.UpdatedAt == nil || !doc.UpdatedAt.Equal(baseTime.Add(2*time.Hour)) {
+		t.Errorf("Expected the latest update time, got %v", doc.UpdatedAt)
+	}
+}
+
+func TestUnit_TaskMergeDriver_LastWriterWins(t *testing.T) {
+	baseTime := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	base := mergeTaskFile(t, "Plan", nil, baseTime)
+	path := "todo/001-task-t1.json"
+
+	// The remote renamed the task later than the local clone
+	outcome, err := newTaskMergeDriver().Merge(map[string]utilities.MergeVersions{
+		path: {
+			Base:   base,
+			Ours:   mergeTaskFile(t, "Plan locally", nil, baseTime.Add(time.Minute)),
+			Theirs: mergeTaskFile(t, "Plan remotely", nil, baseTime.Add(time.Hour)),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Merge failed: %v", err)
+	}
+	if doc := decodeMergedTask(t, outcome, path); doc.Title != "Plan remotely" {
+		t.Errorf("Expected the later title to win, got %q", doc.Title)
+	}
+	expected := []utilities.FieldConflict{{
+		Record: "t1", Field: "title", Ours: "Plan locally", Theirs: "Plan remotely",
+		Resolution: utilities.ResolvedTheirs, Reason: "remote changed later",
+	}}
+	if !reflect.DeepEqual(outcome.Conflicts, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, outcome.Conflicts)
+	}
+
+	// Equal update times leave the local value in place for review
+	sameTime := baseTime.Add(time.Hour)
+	outcome, _ = newTaskMergeDriver().Merge(map[string]utilities.MergeVersions{
+		path: {Base: base, Ours: mergeTaskFile(t, "A", nil, sameTime), Theirs: mergeTaskFile(t, "B", nil, sameTime)},
+	})
+	if doc := decodeMergedTask(t, outcome, path); doc.Title != "A" {
+		t.Errorf("Expected the local title to be kept, got %q", doc.Title)
+	}
+	if len(outcome.Conflicts) != 1 || outcome.Conflicts[0].Resolution != utilities.Unresolved {
+		t.Errorf("Expected an unresolved conflict, got %+v", outcome.Conflicts)
+	}
+}
+
+func TestUnit_TaskMergeDriver_KeepsChangedTaskDeletedElsewhere(t *testing.T) {
+	baseTime := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	base := mergeTaskFile(t, "Call back", nil, baseTime)
+	changed := mergeTaskFile(t, "Call back today", nil, baseTime.Add(time.Hour))
+	path := "todo/001-task-t1.json"
+
+	outcome, err := newTaskMergeDriver().Merge(map[string]utilities.MergeVersions{
+		path: {Base: base, Ours: nil, Theirs: changed},
+	})
+	if err != nil {
+		t.Fatalf("Merge failed: %v", err)
+	}
+	if doc := decodeMergedTask(t, outcome, path); doc.Title != "Call back today" {
+		t.Errorf("Expected the changed task to be kept, got %q", doc.Title)
+	}
+	if len(outcome.Conflicts) != 1 || outcome.Conflicts[0].Field != "task" || outcome.Conflicts[0].Resolution != utilities.Unresolved {
+		t.Errorf("Expected an unresolved deletion conflict, got %+v", outcome.Conflicts)
+	}
+
+	// Moving a task on one side and archiving it on the other conflicts on its status
+	archived := mergeTaskFile(t, "Call back", nil, baseTime)
+	outcome, _ = newTaskMergeDriver().Merge(map[string]utilities.MergeVersions{
+		path:                     {Base: base, Ours: nil, Theirs: nil},
+		"doing/001-task-t1.json": {Ours: base},
+		"archived/task-t1.json":  {Theirs: archived},
+	})
+	if len(outcome.Conflicts) != 1 || outcome.Conflicts[0].Field != "status" {
+		t.Errorf("Expected a status conflict between the move and the archival, got %+v", outcome.Conflicts)
+	}
+}
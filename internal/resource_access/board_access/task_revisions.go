@@ -0,0 +1,194 @@
+// Package board_access provides BoardAccess layer components implementing the iDesign methodology.
+// This file reconstructs the field-level change history of a task from the git history of its task files.
+package board_access
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// defaultTaskHistoryLimit is the number of task revisions returned when no limit is given
+const defaultTaskHistoryLimit = 100
+
+// taskRevisionFields lists the task fields compared between revisions, in the order changes are reported
+var taskRevisionFields = []string{
+	"title", "description", "priority", "parent", "column", "section", "position", "tags", "due_date", "priority_promotion_date",
+}
+
+// taskRevisionState is a task as a commit stored it
+type taskRevisionState struct {
+	doc taskDocument
+	ref *taskFileRef // location on the board, nil while the task is archived
+}
+
+// fieldValues returns the values of taskRevisionFields; a nil state has no values
+func (state *taskRevisionState) fieldValues() []string {
+	values := make([]string, len(taskRevisionFields))
+	if state == nil {
+		return values
+	}
+
+	doc := state.doc
+	values[0] = doc.Title
+	values[1] = doc.Description
+	values[2] = doc.Priority
+	if doc.ParentID != nil {
+		values[3] = *doc.ParentID
+	}
+	if state.ref != nil {
+		if state.ref.ParentID != nil {
+			values[3] = *state.ref.ParentID
+		}
+		values[4] = state.ref.Column
+		values[5] = state.ref.Section
+		values[6] = strconv.Itoa(state.ref.Position)
+	} else {
+		values[4] = archiveDirName
+	}
+	values[7] = strings.Join(doc.Tags, ", ")
+	values[8] = formatOptionalTime(doc.DueDate)
+	values[9] = formatOptionalTime(doc.PriorityPromotionDate)
+	return values
+}
+
+// diffTaskRevision names the operation that turned one state of a task into the next and lists the fields it changed;
+// an empty operation means no compared field changed
+func diffTaskRevision(before, after *taskRevisionState) (string, []TaskFieldChange) {
+	beforeValues := before.fieldValues()
+	afterValues := after.fieldValues()
+
+	var changes []TaskFieldChange
+	moved := false
+	for i, field := range taskRevisionFields {
+		if beforeValues[i] == afterValues[i] {
+			continue
+		}
+		changes = append(changes, TaskFieldChange{Field: field, Before: beforeValues[i], After: afterValues[i]})
+		if field == "parent" || field == "column" || field == "section" || field == "position" {
+			moved = true
+		}
+	}
+
+	switch {
+	case before == nil && after == nil:
+		return "", nil
+	case before == nil:
+		return "created", changes
+	case after == nil:
+		return "removed", changes
+	case before.ref != nil && after.ref == nil:
+		return "archived", changes
+	case before.ref == nil && after.ref != nil:
+		return "restored", changes
+	case len(changes) == 0:
+		return "", nil
+	case moved:
+		return "moved", changes
+	default:
+		return "updated", changes
+	}
+}
+
+// taskRevisionCommit is a commit touching the files of one task
+type taskRevisionCommit struct {
+	commit       utilities.CommitInfo
+	boardPath    string // task file on the board after the commit, if any
+	archivedPath string // archived task file after the commit, if any
+}
+
+// buildTaskRevisions returns the latest revisions of one task newest first, reading the task files of only the commits
+// touching the task, newest first as returned by the repository, until limit revisions are found; a limit of zero
+// returns all revisions. Commits changing none of the compared fields, e.g. only timestamps, are left out, and task
+// files that cannot be parsed leave the task as it was before.
+func buildTaskRevisions(history []utilities.CommitChanges, taskID string, limit int, readFileAt func(hash, filePath string) ([]byte, error)) ([]TaskRevision, error) {
+	var commits []taskRevisionCommit
+	for _, entry := range history {
+		touched := false
+		candidate := taskRevisionCommit{commit: entry.Commit}
+		for _, fileChange := range entry.Changes {
+			for _, path := range []string{fileChange.FromPath, fileChange.ToPath} {
+				if ref, ok := parseTaskPath(path); ok && ref.TaskID == taskID {
+					touched = true
+					if path == fileChange.ToPath {
+						candidate.boardPath = path
+					}
+				} else if archivedID, ok := parseArchivedPath(path); ok && archivedID == taskID {
+					touched = true
+					if path == fileChange.ToPath {
+						candidate.archivedPath = path
+					}
+				}
+			}
+		}
+		if touched {
+			commits = append(commits, candidate)
+		}
+	}
+
+	// States are read on demand, so a limited history reads only the commits it reports and their predecessors
+	states := make([]*taskRevisionState, len(commits))
+	loaded := make([]bool, len(commits))
+	parsed := make([]bool, len(commits))
+	stateAt := func(i int) (*taskRevisionState, bool, error) {
+		if loaded[i] {
+			return states[i], parsed[i], nil
+		}
+		loaded[i] = true
+
+		path := commits[i].boardPath
+		if path == "" {
+			path = commits[i].archivedPath
+		}
+		if path == "" {
+			parsed[i] = true
+			return nil, true, nil
+		}
+		data, err := readFileAt(commits[i].commit.ID, path)
+		if err != nil {
+			return nil, false, err
+		}
+		state := &taskRevisionState{}
+		if err := json.Unmarshal(data, &state.doc); err != nil {
+			return nil, false, nil
+		}
+		if commits[i].boardPath != "" {
+			state.ref, _ = parseTaskPath(commits[i].boardPath)
+		}
+		states[i], parsed[i] = state, true
+		return state, true, nil
+	}
+
+	var revisions []TaskRevision
+	for i := 0; i < len(commits) && (limit <= 0 || len(revisions) < limit); i++ {
+		current, ok, err := stateAt(i)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+
+		var previous *taskRevisionState
+		for j := i + 1; j < len(commits); j++ {
+			state, ok, err := stateAt(j)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				previous = state
+				break
+			}
+		}
+
+		operation, changes := diffTaskRevision(previous, current)
+		if operation == "" {
+			continue
+		}
+		revisions = append(revisions, TaskRevision{CommitInfo: commits[i].commit, Operation: operation, Changes: changes})
+	}
+
+	return revisions, nil
+}
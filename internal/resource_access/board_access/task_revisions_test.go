@@ -0,0 +1,111 @@
+package board_access
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+func TestUnit_BoardAccess_BuildTaskRevisions(t *testing.T) {
+	start := time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC)
+	commitAt := func(days int, id string, changes ...utilities.FileChange) utilities.CommitChanges {
+		return utilities.CommitChanges{
+			Commit:  utilities.CommitInfo{ID: id, Timestamp: start.AddDate(0, 0, days)},
+			Changes: changes,
+		}
+	}
+
+	// File content per commit and path
+	files := map[string]string{
+		"c1:todo/urgent-important/001-task-a.json":     `{"id":"a","title":"Draft","priority":"urgent-important","status":"todo"}`,
+		"c1:todo/urgent-important/002-task-b.json":     `{"id":"b","title":"Other","priority":"urgent-important","status":"todo"}`,
+		"c2:todo/urgent-important/001-task-a.json":     `{"id":"a","title":"Report","priority":"urgent-important","status":"todo","tags":["work"],"due_date":"2025-03-10T00:00:00Z"}`,
+		"c3:todo/urgent-important/001-task-a.json":     `{"id":"a","title":"Report","priority":"urgent-important","status":"todo","tags":["work"],"due_date":"2025-03-10T00:00:00Z","updated_at":"2025-03-06T09:00:00Z"}`,
+		"c4:doing/001-task-a.json":                     `{"id":"a","title":"Report","priority":"urgent-important","status":"doing","tags":["work"],"due_date":"2025-03-10T00:00:00Z"}`,
+		"c5:archived/task-a.json":                      `{"id":"a","title":"Report","priority":"urgent-important","status":"doing","tags":["work"],"due_date":"2025-03-10T00:00:00Z"}`,
+		"c6:todo/not-urgent-important/001-task-a.json": `not json`,
+	}
+	readFileAt := func(hash, filePath string) ([]byte, error) {
+		content, ok := files[hash+":"+filePath]
+		if !ok {
+			return nil, fmt.Errorf("%s not found in commit %s", filePath, hash)
+		}
+		return []byte(content), nil
+	}
+
+	// Repository history is returned newest first
+	history := []utilities.CommitChanges{
+		commitAt(6, "c6", utilities.FileChange{FromPath: "archived/task-a.json"}, utilities.FileChange{ToPath: "todo/not-urgent-important/001-task-a.json"}),
+		commitAt(5, "c5", utilities.FileChange{FromPath: "doing/001-task-a.json"}, utilities.FileChange{ToPath: "archived/task-a.json"}),
+		commitAt(4, "c4", utilities.FileChange{FromPath: "todo/urgent-important/001-task-a.json"}, utilities.FileChange{ToPath: "doing/001-task-a.json"}),
+		commitAt(3, "c3", utilities.FileChange{FromPath: "todo/urgent-important/001-task-a.json", ToPath: "todo/urgent-important/001-task-a.json"}),
+		commitAt(2, "c2", utilities.FileChange{FromPath: "todo/urgent-important/001-task-a.json", ToPath: "todo/urgent-important/001-task-a.json"}),
+		commitAt(1, "c1", utilities.FileChange{ToPath: "todo/urgent-important/001-task-a.json"}, utilities.FileChange{ToPath: "todo/urgent-important/002-task-b.json"}),
+	}
+
+	revisions, err := buildTaskRevisions(history, "a", 0, readFileAt)
+	if err != nil {
+		t.Fatalf("buildTaskRevisions failed: %v", err)
+	}
+
+	// The timestamp-only change in c3 and the unparsable restore in c6 are left out
+	expected := []struct {
+		commit    string
+		operation string
+		changes   []TaskFieldChange
+	}{
+		{"c5", "archived", []TaskFieldChange{
+			{Field: "column", Before: "doing", After: "archived"},
+			{Field: "position", Before: "1"},
+		}},
+		{"c4", "moved", []TaskFieldChange{
+			{Field: "column", Before: "todo", After: "doing"},
+			{Field: "section", Before: "urgent-important"},
+		}},
+		{"c2", "updated", []TaskFieldChange{
+			{Field: "title", Before: "Draft", After: "Report"},
+			{Field: "tags", After: "work"},
+			{Field: "due_date", After: "2025-03-10T00:00:00Z"},
+		}},
+		{"c1", "created", []TaskFieldChange{
+			{Field: "title", After: "Draft"},
+			{Field: "priority", After: "urgent-important"},
+			{Field: "column", After: "todo"},
+			{Field: "section", After: "urgent-important"},
+			{Field: "position", After: "1"},
+		}},
+	}
+	if len(revisions) != len(expected) {
+		t.Fatalf("Expected %d revisions, got %d: %+v", len(expected), len(revisions), revisions)
+	}
+	for i, want := range expected {
+		revision := revisions[i]
+		if revision.ID != want.commit || revision.Operation != want.operation {
+			t.Errorf("Revision %d: expected %s in %s, got %s in %s", i, want.operation, want.commit, revision.Operation, revision.ID)
+		}
+		if !reflect.DeepEqual(revision.Changes, want.changes) {
+			t.Errorf("Revision %d: expected changes %+v, got %+v", i, want.changes, revision.Changes)
+		}
+	}
+
+	if revisions, err := buildTaskRevisions(history, "b", 0, readFileAt); err != nil || len(revisions) != 1 || revisions[0].Operation != "created" {
+		t.Errorf("Expected task b to have its creation only, got %+v (%v)", revisions, err)
+	}
+
+	// A limited history reads only the files of the revisions it reports and their predecessors
+	reads := 0
+	countingReadFileAt := func(hash, filePath string) ([]byte, error) {
+		reads++
+		return readFileAt(hash, filePath)
+	}
+	revisions, err = buildTaskRevisions(history, "a", 1, countingReadFileAt)
+	if err != nil || len(revisions) != 1 || revisions[0].ID != "c5" {
+		t.Errorf("Expected only the archival in c5, got %+v (%v)", revisions, err)
+	}
+	if reads != 3 {
+		t.Errorf("Expected 3 task files to be read for the latest revision, got %d", reads)
+	}
+}
@@ -0,0 +1,693 @@
+// Package board_access provides BoardAccess layer components implementing the iDesign methodology.
+// This file implements the directory-per-column, file-per-task storage layout used by the ITask facet.
+package board_access
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// Task files are organized as <column>[/<section>]/NNN-task-<id>.json, with subtasks stored
+// parent-anchored as <column>[/<section>]/task-<parent-id>/NNN-subtask-<id>.json. Column, section
+// and position are encoded in the path only; the JSON content holds task attributes.
+var (
+	taskFileNamePattern   = regexp.MustCompile(`^(\d+)-(task|subtask)-(.+)\.json$`)
+	subtaskDirNamePattern = regexp.MustCompile(`^task-(.+)$`)
+)
+
+// Archived tasks are kept in a flat archive directory as archived/task-<id>.json, recording the
+// location they were archived from so they can be restored there.
+var archivedFileNamePattern = regexp.MustCompile(`^task-(.+)\.json$`)
+
+const (
+	// legacyTasksFileName is the single-file storage used by boards created before the per-task layout
+	legacyTasksFileName = "tasks.json"
+
+	// archiveDirName is the board directory holding archived tasks
+	archiveDirName = "archived"
+)
+
+// taskDocument is the on-disk JSON representation of a single task file.
+// Status mirrors the column for compatibility with the task file schema; the path stays authoritative.
+type taskDocument struct {
+	ID                    string            `json:"id"`
+	ParentID              *string           `json:"parentId,omitempty"`
+	Title                 string            `json:"title"`
+	Description           string            `json:"description,omitempty"`
+	Priority              string            `json:"priority"`
+	Status                string            `json:"status"`
+	Tags                  []string          `json:"tags,omitempty"`
+	DueDate               *time.Time        `json:"due_date,omitempty"`
+	PriorityPromotionDate *time.Time        `json:"priority_promotion_date,omitempty"`
+	Metadata              map[string]string `json:"metadata,omitempty"`
+	CreatedAt             *time.Time        `json:"created_at,omitempty"`
+	UpdatedAt             *time.Time        `json:"updated_at,omitempty"`
+	ArchivedAt            *time.Time        `json:"archived_at,omitempty"`
+	ArchivedFrom          *WorkflowStatus   `json:"archived_from,omitempty"`
+}
+
+// taskFileRef identifies a task file by its location, decoded from the path alone
+type taskFileRef struct {
+	RelPath  string
+	TaskID   string
+	ParentID *string
+	Column   string
+	Section  string
+	Position int
+}
+
+// taskStorage reads and writes task files below a board root directory
+type taskStorage struct {
+	root        string
+	journal     *fileJournal      // nil unless external modifications are tracked
+	transaction *boardTransaction // nil unless writes may belong to a transaction
+}
+
+// newTaskStorage creates a task storage rooted at the board directory
+func newTaskStorage(root string) *taskStorage {
+	return &taskStorage{root: root}
+}
+
+// scan walks the board directory and returns references to all task files without parsing them
+func (ts *taskStorage) scan() ([]taskFileRef, error) {
+	entries, err := os.ReadDir(ts.root)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []taskFileRef{}, nil
+		}
+		return nil, fmt.Errorf("failed to read board directory: %w", err)
+	}
+
+	var refs []taskFileRef
+	for _, entry := range entries {
+		if !entry.IsDir() || isReservedBoardDir(entry.Name()) {
+			continue
+		}
+		column := entry.Name()
+		columnRefs, err := ts.scanDirectory(column, column, "", nil, true)
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, columnRefs...)
+	}
+
+	sort.SliceStable(refs, func(i, j int) bool {
+		if refs[i].Column != refs[j].Column {
+			return refs[i].Column < refs[j].Column
+		}
+		if refs[i].Section != refs[j].Section {
+			return refs[i].Section < refs[j].Section
+		}
+		if refs[i].Position != refs[j].Position {
+			return refs[i].Position < refs[j].Position
+		}
+		return refs[i].RelPath < refs[j].RelPath
+	})
+
+	return refs, nil
+}
+
+// scanDirectory collects task files in a column, section or subtask directory
+func (ts *taskStorage) scanDirectory(relDir, column, section string, parentID *string, allowSections bool) ([]taskFileRef, error) {
+	entries, err := os.ReadDir(filepath.Join(ts.root, relDir))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read task directory %s: %w", relDir, err)
+	}
+
+	var refs []taskFileRef
+	for _, entry := range entries {
+		name := entry.Name()
+		relPath := filepath.Join(relDir, name)
+
+		if entry.IsDir() {
+			if match := subtaskDirNamePattern.FindStringSubmatch(name); match != nil && parentID == nil {
+				parent := match[1]
+				subRefs, err := ts.scanDirectory(relPath, column, section, &parent, false)
+				if err != nil {
+					return nil, err
+				}
+				refs = append(refs, subRefs...)
+			} else if allowSections && !strings.HasPrefix(name, ".") {
+				subRefs, err := ts.scanDirectory(relPath, column, name, nil, false)
+				if err != nil {
+					return nil, err
+				}
+				refs = append(refs, subRefs...)
+			}
+			continue
+		}
+
+		match := taskFileNamePattern.FindStringSubmatch(name)
+		if match == nil {
+			continue
+		}
+		position, _ := strconv.Atoi(match[1])
+		ref := taskFileRef{
+			RelPath:  relPath,
+			TaskID:   match[3],
+			Column:   column,
+			Section:  section,
+			Position: position,
+		}
+		if match[2] == "subtask" && parentID != nil {
+			parent := *parentID
+			ref.ParentID = &parent
+		}
+		refs = append(refs, ref)
+	}
+
+	return refs, nil
+}
+
+// locate finds the task file for a task ID, returning nil if no such file exists
+func (ts *taskStorage) locate(taskID string) (*taskFileRef, error) {
+	refs, err := ts.scan()
+	if err != nil {
+		return nil, err
+	}
+	for i := range refs {
+		if refs[i].TaskID == taskID {
+			return &refs[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// parseTaskPath decodes a slash-separated board-relative path, as recorded in git, into a task file reference.
+// It applies the same layout rules as scan and reports false for paths that are not task files.
+func parseTaskPath(relPath string) (*taskFileRef, bool) {
+	parts := strings.Split(relPath, "/")
+	if len(parts) < 2 || len(parts) > 4 || isReservedBoardDir(parts[0]) {
+		return nil, false
+	}
+
+	match := taskFileNamePattern.FindStringSubmatch(parts[len(parts)-1])
+	if match == nil {
+		return nil, false
+	}
+
+	ref := &taskFileRef{
+		RelPath: filepath.FromSlash(relPath),
+		TaskID:  match[3],
+		Column:  parts[0],
+	}
+	ref.Position, _ = strconv.Atoi(match[1])
+
+	dirs := parts[1 : len(parts)-1]
+	var parentID *string
+	if len(dirs) > 0 {
+		if dirMatch := subtaskDirNamePattern.FindStringSubmatch(dirs[len(dirs)-1]); dirMatch != nil {
+			parent := dirMatch[1]
+			parentID = &parent
+			dirs = dirs[:len(dirs)-1]
+		}
+	}
+	switch {
+	case len(dirs) == 1 && !strings.HasPrefix(dirs[0], "."):
+		ref.Section = dirs[0]
+	case len(dirs) > 0:
+		return nil, false
+	}
+
+	if match[2] == "subtask" && parentID != nil {
+		ref.ParentID = parentID
+	}
+	return ref, true
+}
+
+// parseArchivedPath returns the task ID of a slash-separated board-relative archive path
+func parseArchivedPath(relPath string) (string, bool) {
+	parts := strings.Split(relPath, "/")
+	if len(parts) != 2 || parts[0] != archiveDirName {
+		return "", false
+	}
+	match := archivedFileNamePattern.FindStringSubmatch(parts[1])
+	if match == nil {
+		return "", false
+	}
+	return match[1], true
+}
+
+// read parses a task file and combines it with the location information from its path
+func (ts *taskStorage) read(ref taskFileRef) (*TaskWithTimestamps, error) {
+	fullPath := filepath.Join(ts.root, ref.RelPath)
+	data, err := os.ReadFile(fullPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read task file %s: %w", ref.RelPath, err)
+	}
+	if ts.journal != nil {
+		ts.journal.observe(ref.RelPath, data)
+	}
+
+	return decodeTask(ref, data, fullPath)
+}
+
+// decodeTask parses task file content and combines it with the location information from its path;
+// fullPath supplies missing timestamps and may be empty for content not read from disk
+func decodeTask(ref taskFileRef, data []byte, fullPath string) (*TaskWithTimestamps, error) {
+	var doc taskDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse task file %s: %w", ref.RelPath, err)
+	}
+
+	if doc.ID == "" {
+		doc.ID = ref.TaskID
+	}
+	if doc.ID != ref.TaskID {
+		return nil, fmt.Errorf("task file %s contains task ID %s", ref.RelPath, doc.ID)
+	}
+
+	// The directory anchors the parent relationship; fall back to the content for first-class subtask files
+	parentID := ref.ParentID
+	if parentID == nil && doc.ParentID != nil && *doc.ParentID != "" {
+		parentID = doc.ParentID
+	}
+
+	status := WorkflowStatus{
+		Column:   ref.Column,
+		Section:  ref.Section,
+		Position: ref.Position,
+	}
+
+	return doc.toTask(status, parentID, fullPath), nil
+}
+
+// toTask converts a task document into a task at the given location
+func (doc *taskDocument) toTask(status WorkflowStatus, parentID *string, fullPath string) *TaskWithTimestamps {
+	task := &TaskWithTimestamps{
+		Task: &Task{
+			ID:                    doc.ID,
+			Title:                 doc.Title,
+			Description:           doc.Description,
+			Tags:                  doc.Tags,
+			DueDate:               doc.DueDate,
+			PriorityPromotionDate: doc.PriorityPromotionDate,
+			Metadata:              doc.Metadata,
+			ParentTaskID:          parentID,
+		},
+		Priority: priorityFromLabel(doc.Priority),
+		Status:   status,
+	}
+
+	// Files written by hand (e.g. fixtures) may lack timestamps; use the file modification time instead
+	if doc.CreatedAt != nil {
+		task.CreatedAt = *doc.CreatedAt
+	}
+	if doc.UpdatedAt != nil {
+		task.UpdatedAt = *doc.UpdatedAt
+	}
+	if task.CreatedAt.IsZero() || task.UpdatedAt.IsZero() {
+		if info, err := os.Stat(fullPath); err == nil {
+			if task.CreatedAt.IsZero() {
+				task.CreatedAt = info.ModTime()
+			}
+			if task.UpdatedAt.IsZero() {
+				task.UpdatedAt = info.ModTime()
+			}
+		}
+	}
+
+	return task
+}
+
+// newTaskDocument converts a task into its on-disk representation
+func newTaskDocument(task *TaskWithTimestamps) *taskDocument {
+	createdAt := task.CreatedAt
+	updatedAt := task.UpdatedAt
+	return &taskDocument{
+		ID:                    task.Task.ID,
+		ParentID:              task.Task.ParentTaskID,
+		Title:                 task.Task.Title,
+		Description:           task.Task.Description,
+		Priority:              priorityLabel(task.Priority),
+		Status:                task.Status.Column,
+		Tags:                  task.Task.Tags,
+		DueDate:               task.Task.DueDate,
+		PriorityPromotionDate: task.Task.PriorityPromotionDate,
+		Metadata:              task.Task.Metadata,
+		CreatedAt:             &createdAt,
+		UpdatedAt:             &updatedAt,
+	}
+}
+
+// loadAll reads every task file of the board
+func (ts *taskStorage) loadAll() ([]*TaskWithTimestamps, error) {
+	refs, err := ts.scan()
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*TaskWithTimestamps, 0, len(refs))
+	seen := make(map[string]string)
+	for _, ref := range refs {
+		if previous, exists := seen[ref.TaskID]; exists {
+			return nil, fmt.Errorf("duplicate task ID %s in %s and %s", ref.TaskID, previous, ref.RelPath)
+		}
+		seen[ref.TaskID] = ref.RelPath
+
+		task, err := ts.read(ref)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+
+	return tasks, nil
+}
+
+// pathFor returns the relative file path at which a task is stored
+func (ts *taskStorage) pathFor(task *TaskWithTimestamps) (string, error) {
+	column := task.Status.Column
+	section := task.Status.Section
+
+	if strings.TrimSpace(column) == "" {
+		return "", fmt.Errorf("task column cannot be empty")
+	}
+	if err := validatePathComponent("column", column); err != nil {
+		return "", err
+	}
+	if err := validateColumnName(column); err != nil {
+		return "", err
+	}
+	if section != "" {
+		if err := validatePathComponent("section", section); err != nil {
+			return "", err
+		}
+	}
+	if err := validatePathComponent("task ID", task.Task.ID); err != nil {
+		return "", err
+	}
+
+	position := task.Status.Position
+	if position < 0 {
+		position = 0
+	}
+
+	dir := column
+	if section != "" {
+		dir = filepath.Join(dir, section)
+	}
+
+	if task.Task.ParentTaskID != nil {
+		if err := validatePathComponent("parent task ID", *task.Task.ParentTaskID); err != nil {
+			return "", err
+		}
+		dir = filepath.Join(dir, "task-"+*task.Task.ParentTaskID)
+		return filepath.Join(dir, fmt.Sprintf("%03d-subtask-%s.json", position, task.Task.ID)), nil
+	}
+
+	return filepath.Join(dir, fmt.Sprintf("%03d-task-%s.json", position, task.Task.ID)), nil
+}
+
+// write stores a task at its canonical path and returns that relative path
+func (ts *taskStorage) write(task *TaskWithTimestamps) (string, error) {
+	relPath, err := ts.pathFor(task)
+	if err != nil {
+		return "", err
+	}
+
+	if err := ts.writeDocument(relPath, newTaskDocument(task)); err != nil {
+		return "", err
+	}
+
+	return relPath, nil
+}
+
+// writeDocument serializes a task document to a path relative to the board root
+func (ts *taskStorage) writeDocument(relPath string, doc *taskDocument) error {
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal task %s: %w", doc.ID, err)
+	}
+
+	if err := ts.verify(relPath); err != nil {
+		return err
+	}
+	ts.touch(relPath)
+
+	fullPath := filepath.Join(ts.root, relPath)
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+		return fmt.Errorf("failed to create task directory for %s: %w", relPath, err)
+	}
+	if err := utilities.WriteFileAtomic(fullPath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write task file %s: %w", relPath, err)
+	}
+	if ts.journal != nil {
+		ts.journal.record(relPath, contentHash(data))
+	}
+
+	return nil
+}
+
+// remove deletes a task file and prunes the subtask directory it leaves empty
+func (ts *taskStorage) remove(relPath string) error {
+	if err := ts.verify(relPath); err != nil {
+		return err
+	}
+	ts.touch(relPath)
+
+	fullPath := filepath.Join(ts.root, relPath)
+	if err := os.Remove(fullPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove task file %s: %w", relPath, err)
+	}
+	if ts.journal != nil {
+		ts.journal.record(relPath, absentContent)
+	}
+
+	dir := filepath.Dir(fullPath)
+	if subtaskDirNamePattern.MatchString(filepath.Base(dir)) {
+		if entries, err := os.ReadDir(dir); err == nil && len(entries) == 0 {
+			os.Remove(dir)
+		}
+	}
+
+	return nil
+}
+
+// verify refuses to overwrite or remove a task file that was changed outside the application
+func (ts *taskStorage) verify(relPath string) error {
+	if ts.journal == nil {
+		return nil
+	}
+	return ts.journal.verify(ts.root, relPath)
+}
+
+// touch tells an open transaction about a file before it is written or removed
+func (ts *taskStorage) touch(relPath string) {
+	if ts.transaction != nil {
+		ts.transaction.touch(relPath)
+	}
+}
+
+// archivePathFor returns the relative path of a task in the archive
+func archivePathFor(taskID string) string {
+	return filepath.Join(archiveDirName, "task-"+taskID+".json")
+}
+
+// archive stores a task in the archive together with the location it was archived from
+func (ts *taskStorage) archive(task *TaskWithTimestamps, archivedAt time.Time) (string, error) {
+	if err := validatePathComponent("task ID", task.Task.ID); err != nil {
+		return "", err
+	}
+
+	from := task.Status
+	doc := newTaskDocument(task)
+	doc.ArchivedAt = &archivedAt
+	doc.ArchivedFrom = &from
+
+	relPath := archivePathFor(task.Task.ID)
+	if err := ts.writeDocument(relPath, doc); err != nil {
+		return "", err
+	}
+
+	return relPath, nil
+}
+
+// readArchived reads a single archived task, returning nil if it is not in the archive
+func (ts *taskStorage) readArchived(taskID string) (*ArchivedTask, error) {
+	if err := validatePathComponent("task ID", taskID); err != nil {
+		return nil, err
+	}
+
+	relPath := archivePathFor(taskID)
+	fullPath := filepath.Join(ts.root, relPath)
+	data, err := os.ReadFile(fullPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read archived task file %s: %w", relPath, err)
+	}
+	if ts.journal != nil {
+		ts.journal.observe(relPath, data)
+	}
+
+	return decodeArchivedTask(taskID, data, fullPath)
+}
+
+// decodeArchivedTask parses archived task file content; fullPath supplies missing timestamps and may be empty
+func decodeArchivedTask(taskID string, data []byte, fullPath string) (*ArchivedTask, error) {
+	var doc taskDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse archived task file %s: %w", archivePathFor(taskID), err)
+	}
+	if doc.ID == "" {
+		doc.ID = taskID
+	}
+
+	var from WorkflowStatus
+	if doc.ArchivedFrom != nil {
+		from = *doc.ArchivedFrom
+	} else {
+		from = WorkflowStatus{Column: doc.Status}
+	}
+
+	var parentID *string
+	if doc.ParentID != nil && *doc.ParentID != "" {
+		parentID = doc.ParentID
+	}
+
+	archived := &ArchivedTask{TaskWithTimestamps: doc.toTask(from, parentID, fullPath)}
+	if doc.ArchivedAt != nil {
+		archived.ArchivedAt = *doc.ArchivedAt
+	} else {
+		archived.ArchivedAt = archived.UpdatedAt
+	}
+
+	return archived, nil
+}
+
+// loadArchive reads all archived tasks ordered by archival time
+func (ts *taskStorage) loadArchive() ([]*ArchivedTask, error) {
+	entries, err := os.ReadDir(filepath.Join(ts.root, archiveDirName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []*ArchivedTask{}, nil
+		}
+		return nil, fmt.Errorf("failed to read archive directory: %w", err)
+	}
+
+	archived := make([]*ArchivedTask, 0, len(entries))
+	for _, entry := range entries {
+		match := archivedFileNamePattern.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+		task, err := ts.readArchived(match[1])
+		if err != nil {
+			return nil, err
+		}
+		if task != nil {
+			archived = append(archived, task)
+		}
+	}
+
+	sort.SliceStable(archived, func(i, j int) bool {
+		return archived[i].ArchivedAt.Before(archived[j].ArchivedAt)
+	})
+
+	return archived, nil
+}
+
+// isReservedBoardDir reports whether a top-level directory holds board infrastructure rather than a column
+func isReservedBoardDir(name string) bool {
+	return strings.HasPrefix(name, ".") || name == archiveDirName
+}
+
+// validateColumnName rejects column names of directories the board reserves, whose tasks would never be listed
+func validateColumnName(column string) error {
+	if isReservedBoardDir(column) {
+		return fmt.Errorf("column name %q is reserved", column)
+	}
+	return nil
+}
+
+// validatePathComponent ensures a value can safely be used as a single path element
+func validatePathComponent(kind, value string) error {
+	if value == "" || value == "." || value == ".." || strings.ContainsAny(value, `/\`) {
+		return fmt.Errorf("invalid %s for task storage: %q", kind, value)
+	}
+	return nil
+}
+
+// priorityLabel returns the Eisenhower label for a priority
+func priorityLabel(priority Priority) string {
+	switch {
+	case priority.Urgent && priority.Important:
+		return "urgent-important"
+	case priority.Urgent:
+		return "urgent-not-important"
+	case priority.Important:
+		return "not-urgent-important"
+	default:
+		return "not-urgent-not-important"
+	}
+}
+
+// priorityFromLabel converts an Eisenhower label back into a priority
+func priorityFromLabel(label string) Priority {
+	switch label {
+	case "urgent-important":
+		return Priority{Urgent: true, Important: true, Label: label}
+	case "urgent-not-important":
+		return Priority{Urgent: true, Important: false, Label: label}
+	case "not-urgent-important":
+		return Priority{Urgent: false, Important: true, Label: label}
+	default:
+		return Priority{Urgent: false, Important: false, Label: "not-urgent-not-important"}
+	}
+}
+
+// migrateLegacyTasks converts a board using the single tasks.json file into the per-task layout.
+// It returns the relative paths it touched, or nil if the board has no legacy task file.
+func (ts *taskStorage) migrateLegacyTasks() ([]string, error) {
+	legacyPath := filepath.Join(ts.root, legacyTasksFileName)
+	data, err := os.ReadFile(legacyPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read legacy tasks file: %w", err)
+	}
+
+	var tasks []*TaskWithTimestamps
+	if err := json.Unmarshal(data, &tasks); err != nil {
+		return nil, fmt.Errorf("failed to parse legacy tasks file: %w", err)
+	}
+
+	// Validate all paths before writing anything, so a bad entry leaves the board untouched
+	for _, task := range tasks {
+		if task == nil || task.Task == nil {
+			return nil, fmt.Errorf("legacy tasks file contains an empty task entry")
+		}
+		if _, err := ts.pathFor(task); err != nil {
+			return nil, fmt.Errorf("cannot migrate task %s: %w", task.Task.ID, err)
+		}
+	}
+
+	paths := make([]string, 0, len(tasks)+1)
+	for _, task := range tasks {
+		task.Priority = priorityFromLabel(priorityLabel(task.Priority))
+		relPath, err := ts.write(task)
+		if err != nil {
+			return nil, fmt.Errorf("failed to migrate task %s: %w", task.Task.ID, err)
+		}
+		paths = append(paths, relPath)
+	}
+
+	if err := os.Remove(legacyPath); err != nil {
+		return nil, fmt.Errorf("failed to remove legacy tasks file: %w", err)
+	}
+	paths = append(paths, legacyTasksFileName)
+
+	return paths, nil
+}
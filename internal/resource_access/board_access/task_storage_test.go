@@ -0,0 +1,276 @@
+package board_access
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// copyFixtureBoard copies a system-test fixture board into a fresh temporary directory
+func copyFixtureBoard(t *testing.T, name string) string {
+	t.Helper()
+
+	source := filepath.Join("..", "..", "..", "client", "ui", "systemtest", "fixtures", name)
+	target := t.TempDir()
+
+	err := filepath.Walk(source, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(source, path)
+		if err != nil {
+			return err
+		}
+		targetPath := filepath.Join(target, relPath)
+		if info.IsDir() {
+			return os.MkdirAll(targetPath, 0755)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(targetPath, data, 0644)
+	})
+	if err != nil {
+		t.Fatalf("Failed to copy fixture %s: %v", name, err)
+	}
+
+	return target
+}
+
+func TestUnit_BoardAccess_LoadsFixtureBoard(t *testing.T) {
+	boardDir := copyFixtureBoard(t, "board_eisenhower_populated")
+
+	ba, err := NewBoardAccess(boardDir)
+	if err != nil {
+		t.Fatalf("Failed to create BoardAccess: %v", err)
+	}
+	defer ba.Close()
+
+	taskIDs, err := ba.ListTaskIdentifiers(AllTasks)
+	if err != nil {
+		t.Fatalf("Failed to list tasks: %v", err)
+	}
+	if len(taskIDs) != 5 {
+		t.Fatalf("Expected 5 tasks in fixture, got %d: %v", len(taskIDs), taskIDs)
+	}
+
+	tasks, err := ba.GetTasksData([]string{"u1", "nn1"}, false)
+	if err != nil {
+		t.Fatalf("Failed to get tasks: %v", err)
+	}
+	if len(tasks) != 2 {
+		t.Fatalf("Expected 2 tasks, got %d", len(tasks))
+	}
+
+	u1 := tasks[0]
+	if u1.Task.Title != "Fix critical bug" {
+		t.Errorf("Expected title 'Fix critical bug', got %s", u1.Task.Title)
+	}
+	if u1.Status.Column != "todo" || u1.Status.Section != "urgent-important" || u1.Status.Position != 1 {
+		t.Errorf("Expected todo/urgent-important/1, got %+v", u1.Status)
+	}
+	if !u1.Priority.Urgent || !u1.Priority.Important {
+		t.Errorf("Expected urgent-important priority, got %+v", u1.Priority)
+	}
+	if u1.CreatedAt.IsZero() {
+		t.Error("CreatedAt should fall back to the file modification time")
+	}
+
+	nn1 := tasks[1]
+	if nn1.Priority.Label != "not-urgent-not-important" {
+		t.Errorf("Expected not-urgent-not-important label, got %s", nn1.Priority.Label)
+	}
+
+	subtasks, err := ba.GetSubtasks("u1")
+	if err != nil {
+		t.Fatalf("Failed to get subtasks: %v", err)
+	}
+	if len(subtasks) != 1 || subtasks[0].Task.ID != "u1a" {
+		t.Fatalf("Expected subtask u1a, got %d subtasks", len(subtasks))
+	}
+	if subtasks[0].Status.Section != "urgent-important" {
+		t.Errorf("Expected subtask in urgent-important section, got %s", subtasks[0].Status.Section)
+	}
+}
+
+func TestUnit_BoardAccess_PerTaskFileOperations(t *testing.T) {
+	boardDir := t.TempDir()
+
+	ba, err := NewBoardAccess(boardDir)
+	if err != nil {
+		t.Fatalf("Failed to create BoardAccess: %v", err)
+	}
+	defer ba.Close()
+
+	parentID, err := ba.CreateTask(&Task{Title: "Parent"}, Priority{Urgent: true, Important: true},
+		WorkflowStatus{Column: "todo", Section: "urgent-important", Position: 2}, nil)
+	if err != nil {
+		t.Fatalf("Failed to create parent task: %v", err)
+	}
+
+	subtaskID, err := ba.CreateTask(&Task{Title: "Child"}, Priority{Urgent: true, Important: true},
+		WorkflowStatus{Column: "todo", Section: "urgent-important", Position: 1}, &parentID)
+	if err != nil {
+		t.Fatalf("Failed to create subtask: %v", err)
+	}
+
+	subtaskPath := filepath.Join(boardDir, "todo", "urgent-important", "task-"+parentID, "001-subtask-"+subtaskID+".json")
+	if _, err := os.Stat(subtaskPath); err != nil {
+		t.Fatalf("Expected subtask file at %s: %v", subtaskPath, err)
+	}
+
+	// Moving a task relocates its file and leaves no stale copy behind
+	if err := ba.MoveTask(parentID, Priority{Urgent: true, Important: true}, WorkflowStatus{Column: "doing", Position: 3}); err != nil {
+		t.Fatalf("Failed to move task: %v", err)
+	}
+
+	oldPath := filepath.Join(boardDir, "todo", "urgent-important", "002-task-"+parentID+".json")
+	if _, err := os.Stat(oldPath); !os.IsNotExist(err) {
+		t.Errorf("Expected old task file %s to be removed", oldPath)
+	}
+
+	newPath := filepath.Join(boardDir, "doing", "003-task-"+parentID+".json")
+	data, err := os.ReadFile(newPath)
+	if err != nil {
+		t.Fatalf("Expected moved task file at %s: %v", newPath, err)
+	}
+
+	var doc map[string]interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("Task file is not valid JSON: %v", err)
+	}
+	if doc["title"] != "Parent" || doc["priority"] != "urgent-important" {
+		t.Errorf("Unexpected task file content: %s", string(data))
+	}
+
+	// Each change is its own commit naming the task
+	history, err := ba.GetTaskHistory(parentID, 10)
+	if err != nil {
+		t.Fatalf("Failed to get task history: %v", err)
+	}
+	foundMove := false
+	for _, commit := range history {
+		message := utilities.ParseCommitInfo(commit.CommitInfo)
+		if taskID, _ := message.Trailer(utilities.TrailerTaskID); message.Operation() == OperationMove && taskID == parentID {
+			foundMove = true
+			if message.Summary != "Move task 'Parent' todo/urgent-important → doing" {
+				t.Errorf("Unexpected move summary %q", message.Summary)
+			}
+		}
+	}
+	if !foundMove {
+		t.Error("Expected a commit for the task move")
+	}
+
+	// Removing the last subtask also removes its parent-anchored directory
+	if err := ba.RemoveTask(subtaskID, NoAction); err != nil {
+		t.Fatalf("Failed to remove subtask: %v", err)
+	}
+	if _, err := os.Stat(filepath.Dir(subtaskPath)); !os.IsNotExist(err) {
+		t.Errorf("Expected empty subtask directory to be removed")
+	}
+}
+
+func TestUnit_BoardAccess_MigratesLegacyTasksFile(t *testing.T) {
+	boardDir := t.TempDir()
+
+	parentID := "legacy-parent"
+	now := time.Now().Truncate(time.Second)
+	legacyTasks := []*TaskWithTimestamps{
+		{
+			Task:      &Task{ID: parentID, Title: "Legacy parent", Tags: []string{"old"}},
+			Priority:  Priority{Urgent: true, Important: true, Label: "urgent-important"},
+			Status:    WorkflowStatus{Column: "todo", Section: "urgent-important", Position: 1},
+			CreatedAt: now.Add(-time.Hour),
+			UpdatedAt: now,
+		},
+		{
+			Task:      &Task{ID: "legacy-child", Title: "Legacy child", ParentTaskID: &parentID},
+			Priority:  Priority{Urgent: false, Important: true, Label: "not-urgent-important"},
+			Status:    WorkflowStatus{Column: "doing", Position: 2},
+			CreatedAt: now.Add(-time.Hour),
+			UpdatedAt: now,
+		},
+	}
+	data, err := json.MarshalIndent(legacyTasks, "", "  ")
+	if err != nil {
+		t.Fatalf("Failed to marshal legacy tasks: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(boardDir, "tasks.json"), data, 0644); err != nil {
+		t.Fatalf("Failed to write legacy tasks file: %v", err)
+	}
+
+	ba, err := NewBoardAccess(boardDir)
+	if err != nil {
+		t.Fatalf("Failed to create BoardAccess: %v", err)
+	}
+	defer ba.Close()
+
+	if _, err := os.Stat(filepath.Join(boardDir, "tasks.json")); !os.IsNotExist(err) {
+		t.Error("Expected legacy tasks.json to be removed after migration")
+	}
+
+	expectedFiles := []string{
+		filepath.Join(boardDir, "todo", "urgent-important", "001-task-legacy-parent.json"),
+		filepath.Join(boardDir, "doing", "task-legacy-parent", "002-subtask-legacy-child.json"),
+	}
+	for _, path := range expectedFiles {
+		if _, err := os.Stat(path); err != nil {
+			t.Errorf("Expected migrated task file %s: %v", path, err)
+		}
+	}
+
+	tasks, err := ba.GetTasksData([]string{parentID}, false)
+	if err != nil || len(tasks) != 1 {
+		t.Fatalf("Failed to read migrated task: %v", err)
+	}
+	if !tasks[0].CreatedAt.Equal(now.Add(-time.Hour)) {
+		t.Errorf("Expected CreatedAt to survive migration, got %v", tasks[0].CreatedAt)
+	}
+	if len(tasks[0].Task.Tags) != 1 || tasks[0].Task.Tags[0] != "old" {
+		t.Errorf("Expected tags to survive migration, got %v", tasks[0].Task.Tags)
+	}
+
+	subtasks, err := ba.GetSubtasks(parentID)
+	if err != nil || len(subtasks) != 1 {
+		t.Fatalf("Expected migrated subtask, got %d (err %v)", len(subtasks), err)
+	}
+
+	// A second start must not migrate again
+	ba.Close()
+	ba2, err := NewBoardAccess(boardDir)
+	if err != nil {
+		t.Fatalf("Failed to reopen migrated board: %v", err)
+	}
+	defer ba2.Close()
+
+	taskIDs, err := ba2.ListTaskIdentifiers(AllTasks)
+	if err != nil || len(taskIDs) != 2 {
+		t.Errorf("Expected 2 tasks after reopening, got %d (err %v)", len(taskIDs), err)
+	}
+}
+
+func TestUnit_BoardAccess_RejectsReservedColumnNames(t *testing.T) {
+	ba, err := NewBoardAccess(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create BoardAccess: %v", err)
+	}
+	defer ba.Close()
+
+	// Tasks stored in reserved directories would never be listed
+	for _, column := range []string{"archived", ".hidden"} {
+		if _, err := ba.CreateTask(&Task{Title: "Hidden"}, Priority{}, WorkflowStatus{Column: column}, nil); err == nil {
+			t.Errorf("Expected creating a task in column %q to fail", column)
+		}
+		config := &BoardConfiguration{Name: "Board", Columns: []string{"todo", column, "done"}}
+		if err := ba.UpdateBoardConfiguration(config); err == nil || !strings.Contains(err.Error(), "reserved") {
+			t.Errorf("Expected configuring column %q to fail as reserved, got %v", column, err)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+// Package board_access provides BoardAccess layer components implementing the iDesign methodology.
+// This file reconstructs task column timelines from the git history of the per-task files.
+package board_access
+
+import (
+	"time"
+
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// taskLocationChange is the effect of a single commit on the location of one task
+type taskLocationChange struct {
+	ref     *taskFileRef // Location after the commit, nil if the task left the board
+	removed bool         // Whether a file of the task was deleted or moved away
+}
+
+// buildTaskTimelines replays commit history, newest first as returned by the repository, and returns
+// the column timeline of every task that ever had a task file. Position-only changes do not start a
+// new entry; archiving or removing a task closes its open entry.
+func buildTaskTimelines(history []utilities.CommitChanges) map[string][]TaskTimelineEntry {
+	timelines := make(map[string][]TaskTimelineEntry)
+	replayTaskTimelines(timelines, history)
+	return timelines
+}
+
+// replayTaskTimelines replays commits, newest first, onto timelines built from the commits preceding them
+func replayTaskTimelines(timelines map[string][]TaskTimelineEntry, history []utilities.CommitChanges) {
+	for i := len(history) - 1; i >= 0; i-- {
+		commit := history[i].Commit
+		enteredAt := commit.Timestamp
+
+		changes := make(map[string]*taskLocationChange)
+		changeFor := func(taskID string) *taskLocationChange {
+			if changes[taskID] == nil {
+				changes[taskID] = &taskLocationChange{}
+			}
+			return changes[taskID]
+		}
+
+		for _, fileChange := range history[i].Changes {
+			if fileChange.FromPath != "" && fileChange.FromPath != fileChange.ToPath {
+				if ref, ok := parseTaskPath(fileChange.FromPath); ok {
+					changeFor(ref.TaskID).removed = true
+				}
+			}
+			if fileChange.ToPath != "" {
+				if ref, ok := parseTaskPath(fileChange.ToPath); ok {
+					changeFor(ref.TaskID).ref = ref
+				} else if taskID, ok := parseArchivedPath(fileChange.ToPath); ok {
+					changeFor(taskID).removed = true
+				}
+			}
+		}
+
+		for taskID, change := range changes {
+			timeline := timelines[taskID]
+			var open *TaskTimelineEntry
+			if n := len(timeline); n > 0 && timeline[n-1].LeftAt == nil {
+				open = &timeline[n-1]
+			}
+
+			if change.ref == nil {
+				if change.removed && open != nil {
+					leftAt := enteredAt
+					open.LeftAt = &leftAt
+				}
+				continue
+			}
+
+			if open != nil {
+				if open.Column == change.ref.Column && open.Section == change.ref.Section {
+					continue
+				}
+				leftAt := enteredAt
+				open.LeftAt = &leftAt
+			}
+
+			timelines[taskID] = append(timeline, TaskTimelineEntry{
+				Column:    change.ref.Column,
+				Section:   change.ref.Section,
+				EnteredAt: enteredAt,
+				CommitID:  commit.ID,
+			})
+		}
+	}
+}
+
+// columnEnterTimes returns, per column, when the task last entered it. Consecutive entries in
+// different sections of the same column count as a single stay in that column.
+func columnEnterTimes(timeline []TaskTimelineEntry) map[string]time.Time {
+	enterTimes := make(map[string]time.Time)
+
+	for i, entry := range timeline {
+		if i > 0 {
+			previous := timeline[i-1]
+			if previous.Column == entry.Column && previous.LeftAt != nil && previous.LeftAt.Equal(entry.EnteredAt) {
+				continue
+			}
+		}
+		enterTimes[entry.Column] = entry.EnteredAt
+	}
+
+	return enterTimes
+}
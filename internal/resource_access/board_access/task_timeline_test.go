@@ -0,0 +1,184 @@
+package board_access
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+func TestUnit_BoardAccess_BuildTaskTimelines(t *testing.T) {
+	start := time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC)
+	commitAt := func(days int, id string, changes ...utilities.FileChange) utilities.CommitChanges {
+		return utilities.CommitChanges{
+			Commit:  utilities.CommitInfo{ID: id, Timestamp: start.AddDate(0, 0, days)},
+			Changes: changes,
+		}
+	}
+
+	// Repository history is returned newest first
+	history := []utilities.CommitChanges{
+		commitAt(9, "c6", utilities.FileChange{FromPath: "doing/001-task-a.json"}, utilities.FileChange{ToPath: "archived/task-a.json"}),
+		commitAt(7, "c5", utilities.FileChange{FromPath: "doing/002-task-a.json", ToPath: "doing/002-task-a.json"}),
+		commitAt(6, "c4", utilities.FileChange{FromPath: "doing/002-task-a.json"}, utilities.FileChange{ToPath: "doing/001-task-a.json"}),
+		commitAt(4, "c3", utilities.FileChange{FromPath: "todo/not-urgent-important/001-task-a.json"}, utilities.FileChange{ToPath: "doing/002-task-a.json"}),
+		commitAt(2, "c2", utilities.FileChange{FromPath: "todo/urgent-important/001-task-a.json"}, utilities.FileChange{ToPath: "todo/not-urgent-important/001-task-a.json"}),
+		commitAt(1, "c1b", utilities.FileChange{ToPath: "todo/urgent-important/task-a/001-subtask-b.json"}),
+		commitAt(0, "c1", utilities.FileChange{ToPath: "todo/urgent-important/001-task-a.json"}, utilities.FileChange{ToPath: "board.json"}),
+	}
+
+	timelines := buildTaskTimelines(history)
+
+	timeline := timelines["a"]
+	if len(timeline) != 3 {
+		t.Fatalf("Expected 3 timeline entries for task a, got %d: %+v", len(timeline), timeline)
+	}
+
+	expected := []struct {
+		column, section string
+		entered, left   int
+	}{
+		{"todo", "urgent-important", 0, 2},
+		{"todo", "not-urgent-important", 2, 4},
+		{"doing", "", 4, 9},
+	}
+	for i, want := range expected {
+		entry := timeline[i]
+		if entry.Column != want.column || entry.Section != want.section {
+			t.Errorf("Entry %d: expected %s/%s, got %s/%s", i, want.column, want.section, entry.Column, entry.Section)
+		}
+		if !entry.EnteredAt.Equal(start.AddDate(0, 0, want.entered)) {
+			t.Errorf("Entry %d: expected entered on day %d, got %v", i, want.entered, entry.EnteredAt)
+		}
+		if entry.LeftAt == nil || !entry.LeftAt.Equal(start.AddDate(0, 0, want.left)) {
+			t.Errorf("Entry %d: expected left on day %d, got %v", i, want.left, entry.LeftAt)
+		}
+	}
+	if timeline[2].CommitID != "c3" {
+		t.Errorf("Expected doing entry to reference commit c3, got %s", timeline[2].CommitID)
+	}
+
+	subtaskTimeline := timelines["b"]
+	if len(subtaskTimeline) != 1 || subtaskTimeline[0].LeftAt != nil || subtaskTimeline[0].Section != "urgent-important" {
+		t.Errorf("Expected one open entry for subtask b, got %+v", subtaskTimeline)
+	}
+
+	// Section changes within a column count as one stay in that column
+	enterTimes := columnEnterTimes(timeline)
+	if !enterTimes["todo"].Equal(start) {
+		t.Errorf("Expected todo enter time %v, got %v", start, enterTimes["todo"])
+	}
+	if !enterTimes["doing"].Equal(start.AddDate(0, 0, 4)) {
+		t.Errorf("Expected doing enter time on day 4, got %v", enterTimes["doing"])
+	}
+}
+
+func TestUnit_BoardAccess_ParseTaskPath(t *testing.T) {
+	testCases := []struct {
+		path     string
+		valid    bool
+		column   string
+		section  string
+		parentID string
+		position int
+	}{
+		{path: "todo/urgent-important/003-task-x.json", valid: true, column: "todo", section: "urgent-important", position: 3},
+		{path: "doing/001-task-x.json", valid: true, column: "doing", position: 1},
+		{path: "doing/task-p/002-subtask-x.json", valid: true, column: "doing", parentID: "p", position: 2},
+		{path: "todo/urgent-important/task-p/001-subtask-x.json", valid: true, column: "todo", section: "urgent-important", parentID: "p", position: 1},
+		{path: "archived/task-x.json", valid: false},
+		{path: ".eisenkan/001-task-x.json", valid: false},
+		{path: "board.json", valid: false},
+		{path: "todo/notes.json", valid: false},
+		{path: "todo/a/b/c/001-task-x.json", valid: false},
+	}
+
+	for _, tc := range testCases {
+		ref, ok := parseTaskPath(tc.path)
+		if ok != tc.valid {
+			t.Errorf("%s: expected valid=%v, got %v", tc.path, tc.valid, ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if ref.TaskID != "x" || ref.Column != tc.column || ref.Section != tc.section || ref.Position != tc.position {
+			t.Errorf("%s: unexpected reference %+v", tc.path, ref)
+		}
+		parentID := ""
+		if ref.ParentID != nil {
+			parentID = *ref.ParentID
+		}
+		if parentID != tc.parentID {
+			t.Errorf("%s: expected parent %q, got %q", tc.path, tc.parentID, parentID)
+		}
+	}
+
+	if taskID, ok := parseArchivedPath("archived/task-x.json"); !ok || taskID != "x" {
+		t.Errorf("Expected archived path to yield task x, got %q (%v)", taskID, ok)
+	}
+}
+
+func TestIntegration_BoardAccess_TaskTimelineFromHistory(t *testing.T) {
+	ba, err := NewBoardAccess(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create BoardAccess: %v", err)
+	}
+	defer ba.Close()
+
+	priority := Priority{Urgent: true, Important: true}
+	taskID, err := ba.CreateTask(&Task{Title: "Timeline"}, priority,
+		WorkflowStatus{Column: "todo", Section: "urgent-important", Position: 1}, nil)
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+
+	if err := ba.MoveTask(taskID, priority, WorkflowStatus{Column: "doing", Position: 1}); err != nil {
+		t.Fatalf("Failed to move task: %v", err)
+	}
+	// Reordering within the column does not start a new entry
+	if err := ba.MoveTask(taskID, priority, WorkflowStatus{Column: "doing", Position: 2}); err != nil {
+		t.Fatalf("Failed to reorder task: %v", err)
+	}
+
+	timeline, err := ba.GetTaskTimeline(taskID)
+	if err != nil {
+		t.Fatalf("Failed to get task timeline: %v", err)
+	}
+	if len(timeline) != 2 {
+		t.Fatalf("Expected 2 timeline entries, got %d: %+v", len(timeline), timeline)
+	}
+	if timeline[0].Column != "todo" || timeline[0].LeftAt == nil {
+		t.Errorf("Expected closed todo entry, got %+v", timeline[0])
+	}
+	if timeline[1].Column != "doing" || timeline[1].LeftAt != nil {
+		t.Errorf("Expected open doing entry, got %+v", timeline[1])
+	}
+
+	// Rules data carries the enter time of the current column
+	rulesData, err := ba.GetRulesData(taskID, []string{"doing"})
+	if err != nil {
+		t.Fatalf("Failed to get rules data: %v", err)
+	}
+	if enteredAt, exists := rulesData.ColumnEnterTimes["doing"]; !exists || !enteredAt.Equal(timeline[1].EnteredAt) {
+		t.Errorf("Expected doing enter time %v, got %v", timeline[1].EnteredAt, enteredAt)
+	}
+
+	// Archiving closes the open entry
+	if err := ba.ArchiveTask(taskID, NoAction); err != nil {
+		t.Fatalf("Failed to archive task: %v", err)
+	}
+	timeline, err = ba.GetTaskTimeline(taskID)
+	if err != nil {
+		t.Fatalf("Failed to get task timeline after archive: %v", err)
+	}
+	if len(timeline) != 2 || timeline[1].LeftAt == nil {
+		t.Errorf("Expected archived task's last entry to be closed, got %+v", timeline)
+	}
+
+	// Unknown tasks have an empty timeline
+	unknown, err := ba.GetTaskTimeline("does-not-exist")
+	if err != nil || len(unknown) != 0 {
+		t.Errorf("Expected empty timeline for unknown task, got %d entries (err %v)", len(unknown), err)
+	}
+}
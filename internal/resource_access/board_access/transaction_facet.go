@@ -0,0 +1,102 @@
+// Package board_access provides BoardAccess layer components implementing the iDesign methodology.
+// This file implements the ITransaction facet for applying several task changes as a single commit.
+package board_access
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// ErrTransactionActive reports an operation that cannot run while a transaction is open
+var ErrTransactionActive = errors.New("a transaction is in progress")
+
+// ErrNoTransaction reports committing or rolling back without an open transaction
+var ErrNoTransaction = errors.New("no transaction in progress")
+
+// ITransaction defines the interface for grouping task changes into one commit
+type ITransaction interface {
+	// Begin starts collecting the changes of ITask operations instead of committing each of them;
+	// the description becomes the summary of the commit
+	Begin(description string) error
+
+	// Commit records the changes made since Begin in a single commit and returns its hash,
+	// which is empty if nothing changed
+	Commit() (string, error)
+
+	// Rollback discards the changes made since Begin, restoring the task files as of the last commit
+	Rollback() error
+
+	// Annotate records why changes of the open transaction are made: the note is listed in the commit body
+	// and the trailers are added to the commit message, e.g. the rules applied by an automation
+	Annotate(note string, trailers ...utilities.CommitTrailer) error
+}
+
+// boardTransaction collects the changes of an open transaction; guarded by the shared board mutex
+type boardTransaction struct {
+	active      bool
+	description string
+	paths       []string
+	messages    []*utilities.CommitMessage
+	notes       []string
+	trailers    []utilities.CommitTrailer
+}
+
+// checkIdle refuses operations that commit on their own while a transaction is open
+func (tx *boardTransaction) checkIdle() error {
+	if tx.active {
+		return fmt.Errorf("%w: %s", ErrTransactionActive, tx.description)
+	}
+	return nil
+}
+
+// add records the files and message of an operation in the transaction
+func (tx *boardTransaction) add(paths []string, message *utilities.CommitMessage) {
+	tx.paths = append(tx.paths, paths...)
+	tx.messages = append(tx.messages, message)
+}
+
+// touch records a file an operation is about to write or remove while the transaction is open, so a
+// rollback restores it even if the operation fails halfway
+func (tx *boardTransaction) touch(relPath string) {
+	if tx.active {
+		tx.paths = append(tx.paths, relPath)
+	}
+}
+
+// message combines the messages of the operations into the message of the transaction commit
+func (tx *boardTransaction) message() *utilities.CommitMessage {
+	summaries := make([]string, 0, len(tx.messages))
+	for _, message := range tx.messages {
+		summaries = append(summaries, "- "+message.Summary)
+	}
+
+	combined := utilities.NewCommitMessage(OperationBatch, tx.description)
+	combined.Body = strings.Join(summaries, "\n")
+	if len(tx.notes) > 0 {
+		combined.Body += "\n\n" + strings.Join(tx.notes, "\n")
+	}
+	seen := make(map[string]bool)
+	for _, message := range tx.messages {
+		for _, taskID := range message.TrailerValues(utilities.TrailerTaskID) {
+			if !seen[taskID] {
+				seen[taskID] = true
+				combined.With(utilities.TrailerTaskID, taskID)
+			}
+		}
+	}
+	for _, trailer := range tx.trailers {
+		if !seen[trailer.Key+": "+trailer.Value] {
+			seen[trailer.Key+": "+trailer.Value] = true
+			combined.With(trailer.Key, trailer.Value)
+		}
+	}
+	return combined
+}
+
+// reset closes the transaction
+func (tx *boardTransaction) reset() {
+	*tx = boardTransaction{}
+}
@@ -0,0 +1,119 @@
+// Package board_access provides BoardAccess layer components implementing the iDesign methodology.
+// This file implements the ITransaction facet on top of the staging area of the repository.
+package board_access
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// transactionFacet implements the ITransaction interface
+type transactionFacet struct {
+	repository  utilities.Repository
+	logger      utilities.ILoggingUtility
+	mutex       *sync.RWMutex
+	journal     *fileJournal
+	lock        *boardLock
+	transaction *boardTransaction
+}
+
+// newTransactionFacet creates a transaction facet sharing the open transaction with the task facet
+func newTransactionFacet(repository utilities.Repository, logger utilities.ILoggingUtility, mutex *sync.RWMutex, journal *fileJournal, lock *boardLock, transaction *boardTransaction) ITransaction {
+	return &transactionFacet{
+		repository:  repository,
+		logger:      logger,
+		mutex:       mutex,
+		journal:     journal,
+		lock:        lock,
+		transaction: transaction,
+	}
+}
+
+// Begin opens a transaction; only one transaction can be open at a time
+func (xf *transactionFacet) Begin(description string) error {
+	xf.mutex.Lock()
+	defer xf.mutex.Unlock()
+
+	if err := xf.lock.checkWritable(); err != nil {
+		return err
+	}
+	if err := xf.transaction.checkIdle(); err != nil {
+		return err
+	}
+
+	description = strings.TrimSpace(description)
+	if description == "" {
+		description = "Batch update"
+	}
+	xf.transaction.active = true
+	xf.transaction.description = description
+
+	xf.logger.LogMessage(utilities.Debug, "TransactionFacet", fmt.Sprintf("Transaction started: %s", description))
+	return nil
+}
+
+// Commit commits the staged changes of the transaction and closes it
+func (xf *transactionFacet) Commit() (string, error) {
+	xf.mutex.Lock()
+	defer xf.mutex.Unlock()
+
+	if !xf.transaction.active {
+		return "", ErrNoTransaction
+	}
+	if len(xf.transaction.messages) == 0 {
+		xf.transaction.reset()
+		return "", nil
+	}
+
+	commit, err := xf.repository.Commit(xf.transaction.message().String())
+	if err != nil {
+		// The transaction stays open so that the caller can roll it back
+		return "", fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	xf.logger.LogMessage(utilities.Info, "TransactionFacet", fmt.Sprintf("Transaction committed with %d operations: %s", len(xf.transaction.messages), xf.transaction.description))
+	xf.transaction.reset()
+	return commit, nil
+}
+
+// Annotate adds a note and trailers to the commit of the open transaction
+func (xf *transactionFacet) Annotate(note string, trailers ...utilities.CommitTrailer) error {
+	xf.mutex.Lock()
+	defer xf.mutex.Unlock()
+
+	if !xf.transaction.active {
+		return ErrNoTransaction
+	}
+	if note = strings.TrimSpace(note); note != "" {
+		xf.transaction.notes = append(xf.transaction.notes, note)
+	}
+	xf.transaction.trailers = append(xf.transaction.trailers, trailers...)
+	return nil
+}
+
+// Rollback restores the files changed by the transaction and closes it
+func (xf *transactionFacet) Rollback() error {
+	xf.mutex.Lock()
+	defer xf.mutex.Unlock()
+
+	if !xf.transaction.active {
+		return ErrNoTransaction
+	}
+
+	discarded, err := xf.repository.DiscardChanges(xf.transaction.paths)
+	if err != nil {
+		return fmt.Errorf("failed to roll back transaction: %w", err)
+	}
+
+	// Restored files are our own writes; the watcher must not report them and later writes must not be refused
+	if _, err := xf.journal.accept(xf.repository.Path(), discarded); err != nil {
+		return fmt.Errorf("failed to accept restored files: %w", err)
+	}
+
+	xf.logger.LogMessage(utilities.Info, "TransactionFacet", fmt.Sprintf("Transaction rolled back, restored %d files: %s", len(discarded), xf.transaction.description))
+	xf.transaction.reset()
+	return nil
+}
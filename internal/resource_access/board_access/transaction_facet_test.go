@@ -0,0 +1,255 @@
+package board_access
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+func TestIntegration_BoardAccess_TransactionCommit(t *testing.T) {
+	ba, err := NewBoardAccess(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create BoardAccess: %v", err)
+	}
+	defer ba.Close()
+
+	priority := Priority{Urgent: true, Important: true}
+	todo := WorkflowStatus{Column: "todo", Section: "urgent-important", Position: 1}
+	firstID, err := ba.CreateTask(&Task{Title: "First"}, priority, todo, nil)
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	secondID, err := ba.CreateTask(&Task{Title: "Second"}, priority, todo, nil)
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	before, err := ba.CurrentRevision()
+	if err != nil {
+		t.Fatalf("Failed to read revision: %v", err)
+	}
+
+	if err := ba.Begin("Move 2 tasks to doing"); err != nil {
+		t.Fatalf("Begin failed: %v", err)
+	}
+	if err := ba.Begin("Nested"); !errors.Is(err, ErrTransactionActive) {
+		t.Errorf("Expected a second transaction to be refused, got %v", err)
+	}
+	for _, taskID := range []string{firstID, secondID} {
+		if err := ba.MoveTask(taskID, priority, WorkflowStatus{Column: "doing", Position: 1}); err != nil {
+			t.Fatalf("Failed to move task %s: %v", taskID, err)
+		}
+	}
+	if revision, _ := ba.CurrentRevision(); revision != before {
+		t.Error("Expected no commit before the transaction is committed")
+	}
+	if _, err := ba.RevertChanges("", before, "Undo"); !errors.Is(err, ErrTransactionActive) {
+		t.Errorf("Expected reverts to be refused during a transaction, got %v", err)
+	}
+
+	commit, err := ba.Commit()
+	if err != nil || commit == "" {
+		t.Fatalf("Commit failed: %q (%v)", commit, err)
+	}
+
+	revisions, err := ba.ListRevisions(2)
+	if err != nil || len(revisions) != 2 || revisions[0].ID != commit || revisions[1].ID != before {
+		t.Fatalf("Expected a single commit on top of %s, got %+v (%v)", before, revisions, err)
+	}
+	message := utilities.ParseCommitInfo(revisions[0])
+	if message.Summary != "Move 2 tasks to doing" || message.Operation() != OperationBatch {
+		t.Errorf("Unexpected transaction commit %+v", message)
+	}
+	if taskIDs := message.TrailerValues(utilities.TrailerTaskID); len(taskIDs) != 2 || taskIDs[0] != firstID || taskIDs[1] != secondID {
+		t.Errorf("Expected both tasks in the trailers, got %v", taskIDs)
+	}
+	if message.Body != "- Move task 'First' todo/urgent-important → doing\n- Move task 'Second' todo/urgent-important → doing" {
+		t.Errorf("Expected the operations in the body, got %q", message.Body)
+	}
+
+	// Without an open transaction there is nothing to commit or roll back; an empty transaction adds no commit
+	if _, err := ba.Commit(); !errors.Is(err, ErrNoTransaction) {
+		t.Errorf("Expected ErrNoTransaction, got %v", err)
+	}
+	if err := ba.Rollback(); !errors.Is(err, ErrNoTransaction) {
+		t.Errorf("Expected ErrNoTransaction, got %v", err)
+	}
+	if err := ba.Begin("Nothing"); err != nil {
+		t.Fatalf("Begin failed: %v", err)
+	}
+	if commit, err := ba.Commit(); err != nil || commit != "" {
+		t.Errorf("Expected no commit for an empty transaction, got %q (%v)", commit, err)
+	}
+}
+
+func TestIntegration_BoardAccess_TransactionRollback(t *testing.T) {
+	ba, err := NewBoardAccess(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create BoardAccess: %v", err)
+	}
+	defer ba.Close()
+
+	priority := Priority{Urgent: true, Important: true}
+	parentID, err := ba.CreateTask(&Task{Title: "Parent"}, priority, WorkflowStatus{Column: "doing", Position: 1}, nil)
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	subtaskID, err := ba.CreateTask(&Task{Title: "Child"}, priority, WorkflowStatus{Column: "doing", Position: 1}, &parentID)
+	if err != nil {
+		t.Fatalf("Failed to create subtask: %v", err)
+	}
+	otherID, err := ba.CreateTask(&Task{Title: "Other"}, priority, WorkflowStatus{Column: "todo", Section: "urgent-important", Position: 1}, nil)
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	before, _ := ba.CurrentRevision()
+
+	if err := ba.Begin("Batch archive"); err != nil {
+		t.Fatalf("Begin failed: %v", err)
+	}
+	if err := ba.MoveTask(otherID, priority, WorkflowStatus{Column: "done", Position: 1}); err != nil {
+		t.Fatalf("Failed to move task: %v", err)
+	}
+	if err := ba.ArchiveTask(parentID, ArchiveSubtasks); err != nil {
+		t.Fatalf("Failed to archive task: %v", err)
+	}
+	newID, err := ba.CreateTask(&Task{Title: "New"}, priority, WorkflowStatus{Column: "doing", Position: 2}, nil)
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+
+	if err := ba.Rollback(); err != nil {
+		t.Fatalf("Rollback failed: %v", err)
+	}
+
+	if revision, _ := ba.CurrentRevision(); revision != before {
+		t.Error("Expected no commit after a rollback")
+	}
+	tasks, err := ba.GetTasksData([]string{parentID, subtaskID, otherID, newID}, false)
+	if err != nil {
+		t.Fatalf("Failed to read tasks: %v", err)
+	}
+	columns := make(map[string]string)
+	for _, task := range tasks {
+		columns[task.Task.ID] = task.Status.Column
+	}
+	if len(columns) != 3 || columns[parentID] != "doing" || columns[subtaskID] != "doing" || columns[otherID] != "todo" {
+		t.Errorf("Expected the tasks as before the transaction, got %v", columns)
+	}
+	if archived, err := ba.ListArchivedTasks(); err != nil || len(archived) != 0 {
+		t.Errorf("Expected an empty archive, got %d (%v)", len(archived), err)
+	}
+
+	// Restored files count as our own writes
+	if err := ba.MoveTask(otherID, priority, WorkflowStatus{Column: "doing", Position: 3}); err != nil {
+		t.Errorf("Expected tasks to be writable after a rollback, got %v", err)
+	}
+}
+
+func TestIntegration_BoardAccess_TransactionRollbackAfterPartialCascade(t *testing.T) {
+	dir := t.TempDir()
+	ba, err := NewBoardAccess(dir)
+	if err != nil {
+		t.Fatalf("Failed to create BoardAccess: %v", err)
+	}
+	defer ba.Close()
+
+	priority := Priority{Urgent: true, Important: true}
+	parentID, err := ba.CreateTask(&Task{Title: "Parent"}, priority, WorkflowStatus{Column: "doing", Position: 1}, nil)
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	var subtaskIDs []string
+	for position := 1; position <= 3; position++ {
+		subtaskID, err := ba.CreateTask(&Task{Title: "Child"}, priority, WorkflowStatus{Column: "doing", Position: position}, &parentID)
+		if err != nil {
+			t.Fatalf("Failed to create subtask: %v", err)
+		}
+		subtaskIDs = append(subtaskIDs, subtaskID)
+	}
+
+	// A hand edit to the last subtask makes the cascade fail after the first subtasks were archived
+	matches, err := filepath.Glob(filepath.Join(dir, "*", "*", "*-subtask-"+subtaskIDs[2]+".json"))
+	if err != nil || len(matches) != 1 {
+		t.Fatalf("Expected one file for the last subtask, got %v (%v)", matches, err)
+	}
+	edited := []byte(`{"edited": "by hand"}`)
+	if err := os.WriteFile(matches[0], edited, 0644); err != nil {
+		t.Fatalf("Failed to edit subtask file: %v", err)
+	}
+
+	if err := ba.Begin("Batch archive"); err != nil {
+		t.Fatalf("Begin failed: %v", err)
+	}
+	if err := ba.ArchiveTask(parentID, ArchiveSubtasks); !errors.Is(err, ErrModifiedExternally) {
+		t.Fatalf("Expected ErrModifiedExternally, got %v", err)
+	}
+	if err := ba.Rollback(); err != nil {
+		t.Fatalf("Rollback failed: %v", err)
+	}
+
+	tasks, err := ba.GetTasksData([]string{parentID, subtaskIDs[0], subtaskIDs[1]}, false)
+	if err != nil {
+		t.Fatalf("Failed to read tasks: %v", err)
+	}
+	if len(tasks) != 3 {
+		t.Errorf("Expected the parent and the first subtasks back on the board, got %d tasks", len(tasks))
+	}
+	if archived, err := ba.ListArchivedTasks(); err != nil || len(archived) != 0 {
+		t.Errorf("Expected an empty archive, got %d (%v)", len(archived), err)
+	}
+	if content, err := os.ReadFile(matches[0]); err != nil || string(content) != string(edited) {
+		t.Errorf("Expected the hand edit to survive the rollback, got %q (%v)", content, err)
+	}
+}
+
+func TestIntegration_BoardAccess_TransactionAnnotate(t *testing.T) {
+	ba, err := NewBoardAccess(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create BoardAccess: %v", err)
+	}
+	defer ba.Close()
+
+	if err := ba.Annotate("Outside"); !errors.Is(err, ErrNoTransaction) {
+		t.Errorf("Expected ErrNoTransaction, got %v", err)
+	}
+
+	taskID, err := ba.CreateTask(&Task{Title: "Report"}, Priority{Important: true}, WorkflowStatus{Column: "todo", Section: "not-urgent-important"}, nil)
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+	if err := ba.Begin("Apply automation rules to task 'Report'"); err != nil {
+		t.Fatalf("Begin failed: %v", err)
+	}
+	if err := ba.MoveTask(taskID, Priority{Important: true}, WorkflowStatus{Column: "doing"}); err != nil {
+		t.Fatalf("Failed to move task: %v", err)
+	}
+	ruleTrailer := utilities.CommitTrailer{Key: utilities.TrailerRuleID, Value: "start-important"}
+	for i := 0; i < 2; i++ {
+		if err := ba.Annotate("Rule 'Start important work' (start-important): move to doing", ruleTrailer); err != nil {
+			t.Fatalf("Annotate failed: %v", err)
+		}
+	}
+	if _, err := ba.Commit(); err != nil {
+		t.Fatalf("Commit failed: %v", err)
+	}
+
+	revisions, err := ba.ListRevisions(1)
+	if err != nil || len(revisions) != 1 {
+		t.Fatalf("Failed to list revisions: %v", err)
+	}
+	message := utilities.ParseCommitInfo(revisions[0])
+	expectedBody := "- Move task 'Report' todo/not-urgent-important → doing\n\n" +
+		"Rule 'Start important work' (start-important): move to doing\nRule 'Start important work' (start-important): move to doing"
+	if message.Body != expectedBody {
+		t.Errorf("Expected the notes in the body, got %q", message.Body)
+	}
+	if rules := message.TrailerValues(utilities.TrailerRuleID); len(rules) != 1 || rules[0] != "start-important" {
+		t.Errorf("Expected one Rule-ID trailer, got %v", rules)
+	}
+	if taskIDs := message.TrailerValues(utilities.TrailerTaskID); len(taskIDs) != 1 || taskIDs[0] != taskID {
+		t.Errorf("Expected the task in the trailers, got %v", taskIDs)
+	}
+}
@@ -0,0 +1,31 @@
+// Package board_access provides BoardAccess layer components implementing the iDesign methodology.
+// This file implements the IWatch facet for following changes made to the board outside the application.
+package board_access
+
+import (
+	"context"
+	"time"
+)
+
+// IWatch defines the interface for observing external board modifications
+type IWatch interface {
+	// WatchBoard reports edits to task files and board.json made by other programs, including
+	// git operations such as pull or checkout, until ctx is done or BoardAccess is closed
+	WatchBoard(ctx context.Context) (<-chan BoardChange, error)
+}
+
+// BoardChangeType names the kind of external modification a BoardChange reports
+type BoardChangeType string
+
+const (
+	TaskFileChanged          BoardChangeType = "task_file_changed"          // a task file was created, edited, moved, archived or removed
+	ConfigurationFileChanged BoardChangeType = "configuration_file_changed" // board.json was edited
+)
+
+// BoardChange describes a modification made to the board outside the application
+type BoardChange struct {
+	Type       BoardChangeType `json:"type"`
+	TaskID     string          `json:"task_id,omitempty"` // empty for configuration changes
+	Paths      []string        `json:"paths"`             // board-relative paths of the changed files
+	OccurredAt time.Time       `json:"occurred_at"`
+}
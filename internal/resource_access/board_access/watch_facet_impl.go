@@ -0,0 +1,348 @@
+// Package board_access provides BoardAccess layer components implementing the iDesign methodology.
+// This file implements the IWatch facet on top of file system notifications.
+package board_access
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+const (
+	// defaultWatchDebounce is how long a burst of file events, e.g. from a git pull, is given to settle
+	defaultWatchDebounce = 200 * time.Millisecond
+
+	// boardChangeBufferSize is the number of changes a subscriber may fall behind before changes are dropped
+	boardChangeBufferSize = 64
+)
+
+// watchFacet implements the IWatch interface. A single file system watcher is shared by all
+// subscribers, started with the first subscription and stopped when BoardAccess is closed.
+// Git operations are observed through the working tree files they change; .git is not watched.
+type watchFacet struct {
+	root     string
+	journal  *fileJournal
+	logger   utilities.ILoggingUtility
+	mutex    *sync.RWMutex
+	debounce time.Duration
+
+	mu          sync.Mutex
+	watcher     *fsnotify.Watcher
+	subscribers map[chan BoardChange]struct{}
+	done        chan struct{}
+	closed      bool
+}
+
+// newWatchFacet creates a watch facet sharing the journal of the task facet
+func newWatchFacet(root string, journal *fileJournal, logger utilities.ILoggingUtility, mutex *sync.RWMutex) *watchFacet {
+	return &watchFacet{
+		root:        root,
+		journal:     journal,
+		logger:      logger,
+		mutex:       mutex,
+		debounce:    defaultWatchDebounce,
+		subscribers: make(map[chan BoardChange]struct{}),
+		done:        make(chan struct{}),
+	}
+}
+
+// WatchBoard returns a channel of external board changes that is closed when ctx is done
+func (wf *watchFacet) WatchBoard(ctx context.Context) (<-chan BoardChange, error) {
+	wf.mu.Lock()
+	defer wf.mu.Unlock()
+
+	if wf.closed {
+		return nil, fmt.Errorf("board access is closed")
+	}
+	if wf.watcher == nil {
+		if err := wf.start(); err != nil {
+			return nil, err
+		}
+	}
+
+	changes := make(chan BoardChange, boardChangeBufferSize)
+	wf.subscribers[changes] = struct{}{}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-wf.done:
+		}
+		wf.unsubscribe(changes)
+	}()
+
+	return changes, nil
+}
+
+// start creates the file system watcher and remembers the current content of the board (caller holds wf.mu)
+func (wf *watchFacet) start() error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create file watcher: %w", err)
+	}
+
+	wf.mutex.RLock()
+	_, err = wf.watchTree(watcher, ".", true)
+	wf.mutex.RUnlock()
+	if err != nil {
+		watcher.Close()
+		return err
+	}
+
+	wf.watcher = watcher
+	go wf.run(watcher)
+
+	wf.logger.LogMessage(utilities.Debug, "WatchFacet", fmt.Sprintf("Watching board %s for external changes", wf.root))
+	return nil
+}
+
+// watchTree adds watches for a directory and its subdirectories, optionally observing the board files in them.
+// It returns the files found, which may have been created before the watch was in place.
+func (wf *watchFacet) watchTree(watcher *fsnotify.Watcher, relDir string, observe bool) ([]string, error) {
+	var files []string
+	err := filepath.WalkDir(filepath.Join(wf.root, relDir), func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			// Directories may vanish while a git operation is in progress
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		relPath, err := filepath.Rel(wf.root, path)
+		if err != nil {
+			return err
+		}
+		if wf.ignored(relPath) {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if entry.IsDir() {
+			if err := watcher.Add(path); err != nil {
+				return fmt.Errorf("failed to watch %s: %w", relPath, err)
+			}
+			return nil
+		}
+
+		if observe {
+			if data, err := os.ReadFile(path); err == nil {
+				wf.journal.observe(relPath, data)
+			}
+		}
+		files = append(files, relPath)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch board directory: %w", err)
+	}
+
+	return files, nil
+}
+
+// ignored reports whether a board-relative path lies in a hidden directory such as .git or .eisenkan
+func (wf *watchFacet) ignored(relPath string) bool {
+	first := strings.SplitN(filepath.ToSlash(relPath), "/", 2)[0]
+	return first != "." && strings.HasPrefix(first, ".")
+}
+
+// run collects file events until they settle and then reports the external changes among them
+func (wf *watchFacet) run(watcher *fsnotify.Watcher) {
+	pending := make(map[string]struct{})
+	settle := time.NewTimer(wf.debounce)
+	settle.Stop()
+	defer settle.Stop()
+
+	for {
+		select {
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			relPath, err := filepath.Rel(wf.root, event.Name)
+			if err != nil || wf.ignored(relPath) {
+				continue
+			}
+			pending[relPath] = struct{}{}
+
+			if event.Has(fsnotify.Create) {
+				if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+					files, err := wf.watchTree(watcher, relPath, false)
+					if err != nil {
+						wf.logger.LogMessage(utilities.Warning, "WatchFacet", err.Error())
+					}
+					for _, file := range files {
+						pending[file] = struct{}{}
+					}
+				}
+			}
+			settle.Reset(wf.debounce)
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			wf.logger.LogMessage(utilities.Warning, "WatchFacet", fmt.Sprintf("File watcher error: %v", err))
+
+		case <-settle.C:
+			changes := wf.detectChanges(pending)
+			pending = make(map[string]struct{})
+			wf.publish(changes)
+		}
+	}
+}
+
+// detectChanges compares the touched files against the journal and returns the external changes, one per task
+func (wf *watchFacet) detectChanges(pending map[string]struct{}) []BoardChange {
+	// Hold the board lock so that our own writes are recorded in the journal before being compared
+	wf.mutex.RLock()
+	defer wf.mutex.RUnlock()
+
+	candidates := make(map[string]struct{}, len(pending))
+	for relPath := range pending {
+		candidates[relPath] = struct{}{}
+		// A removed or renamed directory only reports itself, not the files it contained
+		if _, err := os.Stat(filepath.Join(wf.root, relPath)); os.IsNotExist(err) {
+			for _, file := range wf.journal.knownBelow(relPath) {
+				candidates[file] = struct{}{}
+			}
+		}
+	}
+
+	paths := make([]string, 0, len(candidates))
+	for relPath := range candidates {
+		paths = append(paths, relPath)
+	}
+	sort.Strings(paths)
+
+	var changed []string
+	for _, relPath := range paths {
+		if _, ok := boardChangeFor(relPath); !ok {
+			continue
+		}
+
+		actual, err := currentContent(filepath.Join(wf.root, relPath))
+		if err != nil {
+			if info, statErr := os.Stat(filepath.Join(wf.root, relPath)); statErr == nil && info.IsDir() {
+				continue
+			}
+			wf.logger.LogMessage(utilities.Warning, "WatchFacet", fmt.Sprintf("Failed to read %s: %v", relPath, err))
+			continue
+		}
+		expected, known := wf.journal.known(relPath)
+		if (known && expected == actual) || (!known && actual == absentContent) {
+			// Our own write, or a file that came and went unseen
+			continue
+		}
+		wf.journal.record(relPath, actual)
+		changed = append(changed, relPath)
+	}
+
+	return groupBoardChanges(changed, time.Now())
+}
+
+// boardChangeFor classifies a board-relative path, reporting false for files that are neither tasks nor board.json
+func boardChangeFor(relPath string) (BoardChange, bool) {
+	change := BoardChange{Type: TaskFileChanged}
+	slashPath := filepath.ToSlash(relPath)
+	if slashPath == "board.json" {
+		change.Type = ConfigurationFileChanged
+	} else if ref, ok := parseTaskPath(slashPath); ok {
+		change.TaskID = ref.TaskID
+	} else if taskID, ok := parseArchivedPath(slashPath); ok {
+		change.TaskID = taskID
+	} else {
+		return BoardChange{}, false
+	}
+	return change, true
+}
+
+// groupBoardChanges turns changed board-relative paths into one change per task, in path order
+func groupBoardChanges(relPaths []string, occurredAt time.Time) []BoardChange {
+	var changes []BoardChange
+	byTask := make(map[string]int)
+	for _, relPath := range relPaths {
+		change, ok := boardChangeFor(relPath)
+		if !ok {
+			continue
+		}
+
+		key := string(change.Type) + "/" + change.TaskID
+		if index, exists := byTask[key]; exists {
+			changes[index].Paths = append(changes[index].Paths, relPath)
+			continue
+		}
+		change.Paths = []string{relPath}
+		change.OccurredAt = occurredAt
+		byTask[key] = len(changes)
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+// publish delivers changes to every subscriber without blocking; slow subscribers miss changes
+func (wf *watchFacet) publish(changes []BoardChange) {
+	if len(changes) == 0 {
+		return
+	}
+
+	wf.mu.Lock()
+	defer wf.mu.Unlock()
+
+	for _, change := range changes {
+		wf.logger.LogMessage(utilities.Info, "WatchFacet", fmt.Sprintf("External change detected: %s %s", change.Type, strings.Join(change.Paths, ", ")))
+		for subscriber := range wf.subscribers {
+			select {
+			case subscriber <- change:
+			default:
+				wf.logger.LogMessage(utilities.Warning, "WatchFacet", fmt.Sprintf("Dropping %s change for %s, subscriber is not keeping up", change.Type, change.TaskID))
+			}
+		}
+	}
+}
+
+// unsubscribe removes a subscriber and closes its channel
+func (wf *watchFacet) unsubscribe(changes chan BoardChange) {
+	wf.mu.Lock()
+	defer wf.mu.Unlock()
+
+	if _, ok := wf.subscribers[changes]; ok {
+		delete(wf.subscribers, changes)
+		close(changes)
+	}
+}
+
+// close stops the file system watcher and ends all subscriptions
+func (wf *watchFacet) close() error {
+	wf.mu.Lock()
+	defer wf.mu.Unlock()
+
+	if wf.closed {
+		return nil
+	}
+	wf.closed = true
+	close(wf.done)
+
+	for changes := range wf.subscribers {
+		delete(wf.subscribers, changes)
+		close(changes)
+	}
+
+	if wf.watcher != nil {
+		if err := wf.watcher.Close(); err != nil {
+			return fmt.Errorf("failed to close file watcher: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+package board_access
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newWatchedTask creates a board with one task and returns the board access, its directory and the task file path
+func newWatchedTask(t *testing.T) (IBoardAccess, string, string, string) {
+	t.Helper()
+	boardDir := t.TempDir()
+
+	ba, err := NewBoardAccess(boardDir)
+	if err != nil {
+		t.Fatalf("Failed to create BoardAccess: %v", err)
+	}
+	t.Cleanup(func() { ba.Close() })
+
+	taskID, err := ba.CreateTask(&Task{Title: "Watched task"}, Priority{Urgent: true, Important: true}, WorkflowStatus{Column: "todo", Section: "urgent-important"}, nil)
+	if err != nil {
+		t.Fatalf("Failed to create task: %v", err)
+	}
+
+	ref, err := newTaskStorage(boardDir).locate(taskID)
+	if err != nil || ref == nil {
+		t.Fatalf("Failed to locate task file: %v", err)
+	}
+	return ba, boardDir, taskID, ref.RelPath
+}
+
+// nextChange waits for the next board change
+func nextChange(t *testing.T, changes <-chan BoardChange) BoardChange {
+	t.Helper()
+	select {
+	case change := <-changes:
+		return change
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for a board change")
+		return BoardChange{}
+	}
+}
+
+// editTaskTitle rewrites the title in a task file the way a text editor would
+func editTaskTitle(t *testing.T, fullPath, oldTitle, newTitle string) {
+	t.Helper()
+	data, err := os.ReadFile(fullPath)
+	if err != nil {
+		t.Fatalf("Failed to read task file: %v", err)
+	}
+	if err := os.WriteFile(fullPath, []byte(strings.Replace(string(data), oldTitle, newTitle, 1)), 0644); err != nil {
+		t.Fatalf("Failed to edit task file: %v", err)
+	}
+}
+
+func TestIntegration_BoardAccess_WatchBoard_ReportsExternalChanges(t *testing.T) {
+	ba, boardDir, taskID, relPath := newWatchedTask(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	changes, err := ba.WatchBoard(ctx)
+	if err != nil {
+		t.Fatalf("WatchBoard failed: %v", err)
+	}
+
+	// Our own writes are not reported; the external edit following them is
+	if err := ba.MoveTask(taskID, Priority{Urgent: true, Important: true}, WorkflowStatus{Column: "todo", Section: "urgent-important", Position: 1}); err != nil {
+		t.Fatalf("MoveTask failed: %v", err)
+	}
+	ref, _ := newTaskStorage(boardDir).locate(taskID)
+	relPath = ref.RelPath
+	editTaskTitle(t, filepath.Join(boardDir, relPath), "Watched task", "Edited by hand")
+
+	change := nextChange(t, changes)
+	if change.Type != TaskFileChanged || change.TaskID != taskID || len(change.Paths) != 1 || change.Paths[0] != relPath {
+		t.Fatalf("Expected a change of %s, got %+v", relPath, change)
+	}
+	tasks, err := ba.GetTasksData([]string{taskID}, false)
+	if err != nil || len(tasks) != 1 || tasks[0].Task.Title != "Edited by hand" {
+		t.Errorf("Expected the edited task to be read back, got %+v (%v)", tasks, err)
+	}
+
+	// Moving a file between columns, as git checkout does, is one change touching both paths
+	movedPath := filepath.Join("doing", filepath.Base(relPath))
+	if err := os.MkdirAll(filepath.Join(boardDir, "doing"), 0755); err != nil {
+		t.Fatalf("Failed to create column: %v", err)
+	}
+	if err := os.Rename(filepath.Join(boardDir, relPath), filepath.Join(boardDir, movedPath)); err != nil {
+		t.Fatalf("Failed to move task file: %v", err)
+	}
+
+	change = nextChange(t, changes)
+	if change.TaskID != taskID || len(change.Paths) != 2 {
+		t.Errorf("Expected one change for both paths of the moved task, got %+v", change)
+	}
+
+	if err := os.WriteFile(filepath.Join(boardDir, "board.json"), []byte(`{"name":"Renamed","columns":["todo","doing","done"]}`), 0644); err != nil {
+		t.Fatalf("Failed to edit board.json: %v", err)
+	}
+	if change := nextChange(t, changes); change.Type != ConfigurationFileChanged || change.TaskID != "" {
+		t.Errorf("Expected a configuration change, got %+v", change)
+	}
+
+	cancel()
+	for range changes {
+		// Drain until the subscription is closed
+	}
+}
+
+func TestIntegration_BoardAccess_RefusesToOverwriteExternalEdits(t *testing.T) {
+	ba, boardDir, taskID, relPath := newWatchedTask(t)
+
+	tasks, err := ba.GetTasksData([]string{taskID}, false)
+	if err != nil || len(tasks) != 1 {
+		t.Fatalf("Failed to read task: %v", err)
+	}
+	editTaskTitle(t, filepath.Join(boardDir, relPath), "Watched task", "Edited by hand")
+
+	err = ba.ChangeTaskData(taskID, &Task{Title: "Edited in the app"}, tasks[0].Priority, tasks[0].Status)
+	if !errors.Is(err, ErrModifiedExternally) {
+		t.Fatalf("Expected ErrModifiedExternally, got %v", err)
+	}
+	if err := ba.RemoveTask(taskID, NoAction); !errors.Is(err, ErrModifiedExternally) {
+		t.Errorf("Expected removal to be refused as well, got %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(boardDir, relPath))
+	if err != nil || !strings.Contains(string(data), "Edited by hand") {
+		t.Errorf("Expected the external edit to be kept, got %q (%v)", data, err)
+	}
+}
+
+func TestIntegration_BoardAccess_WatchBoard_AcceptsReportedChanges(t *testing.T) {
+	ba, boardDir, taskID, relPath := newWatchedTask(t)
+
+	changes, err := ba.WatchBoard(context.Background())
+	if err != nil {
+		t.Fatalf("WatchBoard failed: %v", err)
+	}
+	editTaskTitle(t, filepath.Join(boardDir, relPath), "Watched task", "Edited by hand")
+	nextChange(t, changes)
+
+	// Once reported, the external content is the known state and may be changed again
+	tasks, err := ba.GetTasksData([]string{taskID}, false)
+	if err != nil || len(tasks) != 1 {
+		t.Fatalf("Failed to read task: %v", err)
+	}
+	if err := ba.ChangeTaskData(taskID, &Task{Title: "Edited in the app"}, tasks[0].Priority, tasks[0].Status); err != nil {
+		t.Errorf("Expected the update to succeed after the change was reported, got %v", err)
+	}
+
+	if err := ba.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	select {
+	case _, ok := <-changes:
+		if ok {
+			t.Error("Expected no further changes after Close")
+		}
+	case <-time.After(5 * time.Second):
+		t.Error("Expected Close to end the subscription")
+	}
+}
+
+func TestIntegration_BoardAccess_ConfigurationWritesUseTheJournal(t *testing.T) {
+	ba, boardDir, taskID, relPath := newWatchedTask(t)
+	configuration := map[string]interface{}{"name": "Renamed", "columns": []string{"todo", "doing", "done"}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	changes, err := ba.WatchBoard(ctx)
+	if err != nil {
+		t.Fatalf("WatchBoard failed: %v", err)
+	}
+
+	// Our own board.json write is not reported; the external task edit following it is
+	if err := ba.StoreConfiguration(ctx, boardDir, "board", configuration); err != nil {
+		t.Fatalf("StoreConfiguration failed: %v", err)
+	}
+	editTaskTitle(t, filepath.Join(boardDir, relPath), "Watched task", "Edited by hand")
+	if change := nextChange(t, changes); change.Type != TaskFileChanged || change.TaskID != taskID {
+		t.Errorf("Expected only the task change, got %+v", change)
+	}
+	cancel()
+	for range changes {
+		// Drain until the subscription is closed
+	}
+
+	// Without a watch to report it, a hand edit of board.json is not overwritten
+	edited := []byte(`{"name":"Edited by hand","columns":["todo","doing","done"]}`)
+	if err := os.WriteFile(filepath.Join(boardDir, "board.json"), edited, 0644); err != nil {
+		t.Fatalf("Failed to edit board.json: %v", err)
+	}
+	if err := ba.StoreConfiguration(context.Background(), boardDir, "board", configuration); !errors.Is(err, ErrModifiedExternally) {
+		t.Fatalf("Expected ErrModifiedExternally, got %v", err)
+	}
+	if data, err := os.ReadFile(filepath.Join(boardDir, "board.json")); err != nil || string(data) != string(edited) {
+		t.Errorf("Expected the external edit to be kept, got %q (%v)", data, err)
+	}
+}
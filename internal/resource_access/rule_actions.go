@@ -0,0 +1,115 @@
+// Package resource_access provides ResourceAccess layer components implementing the iDesign methodology.
+// This file defines the actions automation rules can apply and their validation.
+package resource_access
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Rule.Actions entries of automation rules that change the board when the condition expression holds
+const (
+	ActionAddTags              = "add_tags"                // tags added to the task
+	ActionMoveTo               = "move_to"                 // column the task moves to
+	ActionSetPriority          = "set_priority"            // Eisenhower quadrant, e.g. "urgent-important"
+	ActionSetDueInDays         = "set_due_in_days"         // due date set the given number of days after the change, unless the task has one
+	ActionArchiveDoneAfterDays = "archive_done_after_days" // archives tasks in done columns not updated for the given number of days
+	ActionCreateSubtasks       = "create_subtasks"         // titles of follow-up subtasks created for the task
+)
+
+// RuleActionKinds lists the automation actions in the order they are applied
+var RuleActionKinds = []string{
+	ActionAddTags,
+	ActionSetPriority,
+	ActionSetDueInDays,
+	ActionMoveTo,
+	ActionCreateSubtasks,
+	ActionArchiveDoneAfterDays,
+}
+
+// rulePriorityLabels lists the quadrants ActionSetPriority accepts
+var rulePriorityLabels = map[string]bool{
+	"urgent-important":         true,
+	"urgent-not-important":     true,
+	"not-urgent-important":     true,
+	"not-urgent-not-important": true,
+}
+
+// HasRuleActions reports whether actions contain any automation action
+func HasRuleActions(actions map[string]interface{}) bool {
+	for _, kind := range RuleActionKinds {
+		if _, exists := actions[kind]; exists {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateRuleActions returns the problems of the automation actions in actions; other entries, like a
+// message, are left alone
+func ValidateRuleActions(actions map[string]interface{}) []string {
+	var problems []string
+	for _, kind := range RuleActionKinds {
+		value, exists := actions[kind]
+		if !exists {
+			continue
+		}
+
+		switch kind {
+		case ActionAddTags, ActionCreateSubtasks:
+			if values, ok := RuleActionStrings(value); !ok || len(values) == 0 {
+				problems = append(problems, fmt.Sprintf("action %s must be a non-empty list of strings", kind))
+			}
+		case ActionMoveTo:
+			if column, ok := value.(string); !ok || column == "" {
+				problems = append(problems, fmt.Sprintf("action %s must be a column ID", kind))
+			}
+		case ActionSetPriority:
+			if label, ok := value.(string); !ok || !rulePriorityLabels[label] {
+				labels := make([]string, 0, len(rulePriorityLabels))
+				for label := range rulePriorityLabels {
+					labels = append(labels, label)
+				}
+				sort.Strings(labels)
+				problems = append(problems, fmt.Sprintf("action %s must be one of %v", kind, labels))
+			}
+		case ActionSetDueInDays, ActionArchiveDoneAfterDays:
+			if days, ok := RuleActionDays(value); !ok || days < 0 {
+				problems = append(problems, fmt.Sprintf("action %s must be a whole number of days", kind))
+			}
+		}
+	}
+	return problems
+}
+
+// RuleActionStrings reads a list of strings, as decoded from JSON or given directly
+func RuleActionStrings(value interface{}) ([]string, bool) {
+	switch v := value.(type) {
+	case []string:
+		return v, true
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, element := range v {
+			s, ok := element.(string)
+			if !ok || s == "" {
+				return nil, false
+			}
+			values = append(values, s)
+		}
+		return values, true
+	}
+	return nil, false
+}
+
+// RuleActionDays reads a whole number of days, as decoded from JSON or given directly
+func RuleActionDays(value interface{}) (int, bool) {
+	switch v := value.(type) {
+	case int:
+		return v, true
+	case float64:
+		if v == float64(int(v)) {
+			return int(v), true
+		}
+	}
+	return 0, false
+}
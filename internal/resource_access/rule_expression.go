@@ -0,0 +1,508 @@
+// Package resource_access provides ResourceAccess layer components implementing the iDesign methodology.
+// This file implements the parser of condition expressions stored in Rule.Conditions.
+package resource_access
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ConditionExpressionKey is the Rule.Conditions entry holding a condition expression, e.g.
+// `task.priority == "urgent-important" && column("doing").wip >= 3`
+const ConditionExpressionKey = "expression"
+
+// RuleExpressionVariables lists the variables condition expressions can refer to
+var RuleExpressionVariables = map[string]string{
+	"task":     "the task as it is about to be stored",
+	"previous": "the task as it is stored, null when it is created",
+	"event":    "the change being evaluated, with type and timestamp",
+	"subtasks": "the subtasks of the task",
+	"board":    "the board metadata",
+	"now":      "the time of the evaluation",
+}
+
+// RuleExpressionFunctions maps the functions condition expressions can call to their number of arguments
+var RuleExpressionFunctions = map[string]int{
+	"column":     1, // column(id) with wip, subtask_wip, wip_limit, done and tasks
+	"days_in":    1, // days the task has been in a column, null if unknown
+	"days_since": 1, // days since a time, null for null
+	"days_until": 1, // days until a time, null for null
+	"len":        1, // length of a string or list
+}
+
+// ExpressionNode is a node of a parsed condition expression
+type ExpressionNode interface {
+	// Offset returns the byte offset of the node in the expression source
+	Offset() int
+}
+
+// LiteralNode is a number, string, boolean or null literal; numbers are float64
+type LiteralNode struct {
+	Position int
+	Value    interface{}
+}
+
+// IdentifierNode refers to a variable
+type IdentifierNode struct {
+	Position int
+	Name     string
+}
+
+// MemberNode accesses a field of an object, e.g. task.priority
+type MemberNode struct {
+	Position int
+	Object   ExpressionNode
+	Name     string
+}
+
+// IndexNode accesses a list element or object field by value, e.g. task.tags[0]
+type IndexNode struct {
+	Position int
+	Object   ExpressionNode
+	Index    ExpressionNode
+}
+
+// CallNode calls a function, e.g. column("doing")
+type CallNode struct {
+	Position  int
+	Function  string
+	Arguments []ExpressionNode
+}
+
+// UnaryNode applies "!" or "-" to its operand
+type UnaryNode struct {
+	Position int
+	Operator string
+	Operand  ExpressionNode
+}
+
+// BinaryNode applies a logical, comparison, "in" or arithmetic operator to its operands
+type BinaryNode struct {
+	Position int
+	Operator string
+	Left     ExpressionNode
+	Right    ExpressionNode
+}
+
+// ListNode is a list literal, e.g. ["todo", "doing"]
+type ListNode struct {
+	Position int
+	Elements []ExpressionNode
+}
+
+func (n *LiteralNode) Offset() int    { return n.Position }
+func (n *IdentifierNode) Offset() int { return n.Position }
+func (n *MemberNode) Offset() int     { return n.Position }
+func (n *IndexNode) Offset() int      { return n.Position }
+func (n *CallNode) Offset() int       { return n.Position }
+func (n *UnaryNode) Offset() int      { return n.Position }
+func (n *BinaryNode) Offset() int     { return n.Position }
+func (n *ListNode) Offset() int       { return n.Position }
+
+// RuleExpression is a parsed condition expression
+type RuleExpression struct {
+	Source string
+	Root   ExpressionNode
+}
+
+// RuleExpressionError reports a problem at a position of a condition expression
+type RuleExpressionError struct {
+	Offset  int // byte offset in the source
+	Line    int // 1-based
+	Column  int // 1-based, in characters
+	Message string
+}
+
+// Error implements the error interface
+func (e *RuleExpressionError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Message)
+}
+
+// NewRuleExpressionError creates an error for a byte offset of the expression source
+func NewRuleExpressionError(source string, offset int, format string, args ...interface{}) *RuleExpressionError {
+	if offset > len(source) {
+		offset = len(source)
+	}
+	line, column := 1, 1
+	for _, r := range source[:offset] {
+		if r == '\n' {
+			line++
+			column = 1
+		} else {
+			column++
+		}
+	}
+	return &RuleExpressionError{Offset: offset, Line: line, Column: column, Message: fmt.Sprintf(format, args...)}
+}
+
+// ParseRuleExpression parses a condition expression; errors are *RuleExpressionError
+func ParseRuleExpression(source string) (*RuleExpression, error) {
+	tokens, err := tokenizeExpression(source)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &expressionParser{source: source, tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if token := p.peek(); token.kind != tokenEnd {
+		return nil, p.errorAt(token, "unexpected %s after the end of the expression", token)
+	}
+	return &RuleExpression{Source: source, Root: root}, nil
+}
+
+type tokenKind int
+
+const (
+	tokenEnd tokenKind = iota
+	tokenNumber
+	tokenString
+	tokenIdentifier
+	tokenOperator
+)
+
+type expressionToken struct {
+	kind     tokenKind
+	text     string      // operator or identifier
+	value    interface{} // number or string value
+	position int
+}
+
+// String describes the token for error messages
+func (t expressionToken) String() string {
+	switch t.kind {
+	case tokenEnd:
+		return "end of expression"
+	case tokenNumber:
+		return fmt.Sprintf("number %v", t.value)
+	case tokenString:
+		return fmt.Sprintf("string %q", t.value)
+	default:
+		return fmt.Sprintf("%q", t.text)
+	}
+}
+
+// expressionOperators lists the operators, longer ones first
+var expressionOperators = []string{"==", "!=", "<=", ">=", "&&", "||", "<", ">", "!", "+", "-", "*", "/", "%", "(", ")", "[", "]", ",", "."}
+
+// tokenizeExpression splits an expression into tokens
+func tokenizeExpression(source string) ([]expressionToken, error) {
+	var tokens []expressionToken
+	for i := 0; i < len(source); {
+		c := source[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+
+		case c >= '0' && c <= '9':
+			start := i
+			for i < len(source) && (source[i] >= '0' && source[i] <= '9' || source[i] == '.') {
+				i++
+			}
+			value, err := strconv.ParseFloat(source[start:i], 64)
+			if err != nil {
+				return nil, NewRuleExpressionError(source, start, "invalid number %q", source[start:i])
+			}
+			tokens = append(tokens, expressionToken{kind: tokenNumber, value: value, position: start})
+
+		case c == '"' || c == '\'':
+			start := i
+			value, end, err := scanString(source, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, expressionToken{kind: tokenString, value: value, position: start})
+			i = end
+
+		case c == '_' || unicode.IsLetter(rune(c)):
+			start := i
+			for i < len(source) && (source[i] == '_' || unicode.IsLetter(rune(source[i])) || unicode.IsDigit(rune(source[i]))) {
+				i++
+			}
+			tokens = append(tokens, expressionToken{kind: tokenIdentifier, text: source[start:i], position: start})
+
+		default:
+			operator := ""
+			for _, candidate := range expressionOperators {
+				if strings.HasPrefix(source[i:], candidate) {
+					operator = candidate
+					break
+				}
+			}
+			if operator == "" {
+				return nil, NewRuleExpressionError(source, i, "unexpected character %q", source[i:i+1])
+			}
+			tokens = append(tokens, expressionToken{kind: tokenOperator, text: operator, position: i})
+			i += len(operator)
+		}
+	}
+	return append(tokens, expressionToken{kind: tokenEnd, position: len(source)}), nil
+}
+
+// scanString reads a quoted string starting at start and returns its value and the offset after it
+func scanString(source string, start int) (string, int, error) {
+	quote := source[start]
+	var value strings.Builder
+	for i := start + 1; i < len(source); i++ {
+		switch c := source[i]; {
+		case c == quote:
+			return value.String(), i + 1, nil
+		case c == '\\' && i+1 < len(source):
+			i++
+			switch source[i] {
+			case 'n':
+				value.WriteByte('\n')
+			case 't':
+				value.WriteByte('\t')
+			case '\\', '"', '\'':
+				value.WriteByte(source[i])
+			default:
+				return "", 0, NewRuleExpressionError(source, i-1, "unknown escape sequence \\%c", source[i])
+			}
+		default:
+			value.WriteByte(c)
+		}
+	}
+	return "", 0, NewRuleExpressionError(source, start, "unterminated string")
+}
+
+// expressionParser is a recursive descent parser over the tokens of an expression; precedence from
+// lowest to highest: ||, &&, comparisons and in, + and -, *, / and %, unary ! and -, member access,
+// indexing and calls
+type expressionParser struct {
+	source string
+	tokens []expressionToken
+	next   int
+}
+
+func (p *expressionParser) peek() expressionToken {
+	return p.tokens[p.next]
+}
+
+func (p *expressionParser) advance() expressionToken {
+	token := p.tokens[p.next]
+	if token.kind != tokenEnd {
+		p.next++
+	}
+	return token
+}
+
+// accept consumes the next token if it is one of the operators or keywords
+func (p *expressionParser) accept(texts ...string) (expressionToken, bool) {
+	token := p.peek()
+	if token.kind != tokenOperator && token.kind != tokenIdentifier {
+		return token, false
+	}
+	for _, text := range texts {
+		if token.text == text {
+			return p.advance(), true
+		}
+	}
+	return token, false
+}
+
+// expect consumes the operator or reports what was found instead
+func (p *expressionParser) expect(text string) error {
+	if token, ok := p.accept(text); !ok {
+		return p.errorAt(token, "expected %q but found %s", text, token)
+	}
+	return nil
+}
+
+func (p *expressionParser) errorAt(token expressionToken, format string, args ...interface{}) error {
+	return NewRuleExpressionError(p.source, token.position, format, args...)
+}
+
+// parseBinary parses a left-associative chain of the operators over operands parsed by next
+func (p *expressionParser) parseBinary(next func() (ExpressionNode, error), operators ...string) (ExpressionNode, error) {
+	left, err := next()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		token, ok := p.accept(operators...)
+		if !ok {
+			return left, nil
+		}
+		right, err := next()
+		if err != nil {
+			return nil, err
+		}
+		left = &BinaryNode{Position: token.position, Operator: token.text, Left: left, Right: right}
+	}
+}
+
+func (p *expressionParser) parseOr() (ExpressionNode, error) {
+	return p.parseBinary(p.parseAnd, "||")
+}
+
+func (p *expressionParser) parseAnd() (ExpressionNode, error) {
+	return p.parseBinary(p.parseComparison, "&&")
+}
+
+// parseComparison parses a single comparison; chains like a < b < c are rejected
+func (p *expressionParser) parseComparison() (ExpressionNode, error) {
+	left, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	token, ok := p.accept("==", "!=", "<", "<=", ">", ">=", "in")
+	if !ok {
+		return left, nil
+	}
+	right, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	if next, chained := p.accept("==", "!=", "<", "<=", ">", ">=", "in"); chained {
+		return nil, p.errorAt(next, "comparisons cannot be chained, combine them with &&")
+	}
+	return &BinaryNode{Position: token.position, Operator: token.text, Left: left, Right: right}, nil
+}
+
+func (p *expressionParser) parseAdditive() (ExpressionNode, error) {
+	return p.parseBinary(p.parseMultiplicative, "+", "-")
+}
+
+func (p *expressionParser) parseMultiplicative() (ExpressionNode, error) {
+	return p.parseBinary(p.parseUnary, "*", "/", "%")
+}
+
+func (p *expressionParser) parseUnary() (ExpressionNode, error) {
+	if token, ok := p.accept("!", "-"); ok {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &UnaryNode{Position: token.position, Operator: token.text, Operand: operand}, nil
+	}
+	return p.parsePostfix()
+}
+
+// parsePostfix parses member access and indexing following a primary expression
+func (p *expressionParser) parsePostfix() (ExpressionNode, error) {
+	node, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if token, ok := p.accept("."); ok {
+			name := p.advance()
+			if name.kind != tokenIdentifier {
+				return nil, p.errorAt(name, "expected a field name after \".\" but found %s", name)
+			}
+			node = &MemberNode{Position: token.position, Object: node, Name: name.text}
+			continue
+		}
+		if token, ok := p.accept("["); ok {
+			index, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect("]"); err != nil {
+				return nil, err
+			}
+			node = &IndexNode{Position: token.position, Object: node, Index: index}
+			continue
+		}
+		return node, nil
+	}
+}
+
+func (p *expressionParser) parsePrimary() (ExpressionNode, error) {
+	token := p.advance()
+	switch token.kind {
+	case tokenNumber, tokenString:
+		return &LiteralNode{Position: token.position, Value: token.value}, nil
+
+	case tokenIdentifier:
+		switch token.text {
+		case "true":
+			return &LiteralNode{Position: token.position, Value: true}, nil
+		case "false":
+			return &LiteralNode{Position: token.position, Value: false}, nil
+		case "null":
+			return &LiteralNode{Position: token.position, Value: nil}, nil
+		case "in":
+			return nil, p.errorAt(token, "expected a value but found %s", token)
+		}
+		if _, ok := p.accept("("); ok {
+			return p.parseCall(token)
+		}
+		if _, known := RuleExpressionVariables[token.text]; !known {
+			return nil, p.errorAt(token, "unknown variable %q", token.text)
+		}
+		return &IdentifierNode{Position: token.position, Name: token.text}, nil
+
+	case tokenOperator:
+		switch token.text {
+		case "(":
+			node, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			return node, nil
+		case "[":
+			return p.parseList(token)
+		}
+	}
+	return nil, p.errorAt(token, "expected a value but found %s", token)
+}
+
+// parseCall parses the arguments of a function call after its opening parenthesis
+func (p *expressionParser) parseCall(name expressionToken) (ExpressionNode, error) {
+	arity, known := RuleExpressionFunctions[name.text]
+	if !known {
+		return nil, p.errorAt(name, "unknown function %q", name.text)
+	}
+
+	call := &CallNode{Position: name.position, Function: name.text}
+	if _, ok := p.accept(")"); !ok {
+		for {
+			argument, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			call.Arguments = append(call.Arguments, argument)
+			if _, ok := p.accept(","); !ok {
+				break
+			}
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+	}
+	if len(call.Arguments) != arity {
+		return nil, p.errorAt(name, "function %s expects %d argument(s), got %d", name.text, arity, len(call.Arguments))
+	}
+	return call, nil
+}
+
+// parseList parses the elements of a list literal after its opening bracket
+func (p *expressionParser) parseList(open expressionToken) (ExpressionNode, error) {
+	list := &ListNode{Position: open.position}
+	if _, ok := p.accept("]"); ok {
+		return list, nil
+	}
+	for {
+		element, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		list.Elements = append(list.Elements, element)
+		if _, ok := p.accept(","); !ok {
+			break
+		}
+	}
+	if err := p.expect("]"); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
@@ -0,0 +1,86 @@
+package resource_access
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestUnit_RuleExpression_Parse(t *testing.T) {
+	expression, err := ParseRuleExpression(`task.priority == "urgent-important" && column("doing").wip >= 3 || !(len(task.tags) > 0)`)
+	if err != nil {
+		t.Fatalf("Failed to parse expression: %v", err)
+	}
+
+	// || binds weaker than &&, which binds weaker than comparisons
+	or, ok := expression.Root.(*BinaryNode)
+	if !ok || or.Operator != "||" {
+		t.Fatalf("Expected || at the root, got %#v", expression.Root)
+	}
+	and, ok := or.Left.(*BinaryNode)
+	if !ok || and.Operator != "&&" {
+		t.Fatalf("Expected && on the left, got %#v", or.Left)
+	}
+	wip, ok := and.Right.(*BinaryNode)
+	if !ok || wip.Operator != ">=" {
+		t.Fatalf("Expected >= on the right of &&, got %#v", and.Right)
+	}
+	member, ok := wip.Left.(*MemberNode)
+	if !ok || member.Name != "wip" {
+		t.Fatalf("Expected .wip, got %#v", wip.Left)
+	}
+	if call, ok := member.Object.(*CallNode); !ok || call.Function != "column" || len(call.Arguments) != 1 {
+		t.Errorf("Expected column(\"doing\"), got %#v", member.Object)
+	}
+	if not, ok := or.Right.(*UnaryNode); !ok || not.Operator != "!" {
+		t.Errorf("Expected ! on the right of ||, got %#v", or.Right)
+	}
+	if and.Offset() != 36 {
+		t.Errorf("Expected && at offset 36, got %d", and.Offset())
+	}
+
+	// Arithmetic, lists, indexing and single-quoted strings
+	for _, source := range []string{
+		`days_in("doing") * 2 + 1 > 10 % 3`,
+		`'blocked' in task.tags && task.tags[0] != "x\"y"`,
+		`task.column in ["todo", "doing"] && -1 < 0`,
+		`task.due_date != null && days_until(task.due_date) <= 2`,
+		`board.name == 'Kanban' && event.type == "task_update" && len(subtasks) == 0 && now != null`,
+		"previous == null\n&& true",
+	} {
+		if _, err := ParseRuleExpression(source); err != nil {
+			t.Errorf("Failed to parse %q: %v", source, err)
+		}
+	}
+}
+
+func TestUnit_RuleExpression_ParseErrors(t *testing.T) {
+	testCases := []struct {
+		source  string
+		message string
+	}{
+		{`task.priority ==`, `line 1, column 17: expected a value but found end of expression`},
+		{`task.priority = "x"`, `line 1, column 15: unexpected character "="`},
+		{`tsk.priority == "x"`, `line 1, column 1: unknown variable "tsk"`},
+		{`columns("doing").wip > 1`, `line 1, column 1: unknown function "columns"`},
+		{`column().wip > 1`, `line 1, column 1: function column expects 1 argument(s), got 0`},
+		{`task.title == "open`, `line 1, column 15: unterminated string`},
+		{`(task.urgent`, `line 1, column 13: expected ")" but found end of expression`},
+		{`1 < 2 < 3`, `line 1, column 7: comparisons cannot be chained, combine them with &&`},
+		{`task.urgent true`, `line 1, column 13: unexpected "true" after the end of the expression`},
+		{"task.urgent &&\n  task. == 1", `line 2, column 9: expected a field name after "." but found "=="`},
+		{`task.tags[0 == "x"`, `line 1, column 19: expected "]" but found end of expression`},
+		{`"\q"`, `line 1, column 2: unknown escape sequence \q`},
+	}
+
+	for _, tc := range testCases {
+		_, err := ParseRuleExpression(tc.source)
+		var expressionErr *RuleExpressionError
+		if !errors.As(err, &expressionErr) {
+			t.Errorf("Expected a RuleExpressionError for %q, got %v", tc.source, err)
+			continue
+		}
+		if err.Error() != tc.message {
+			t.Errorf("Expected %q for %q, got %q", tc.message, tc.source, err.Error())
+		}
+	}
+}
@@ -0,0 +1,55 @@
+// Package resource_access provides ResourceAccess layer components implementing the iDesign methodology.
+// This file defines the trigger types of rules evaluated on a schedule instead of on task changes.
+package resource_access
+
+import "fmt"
+
+// Rule.TriggerType values of rules evaluated periodically across all tasks
+const (
+	TriggerDueDate       = "due_date"       // a task's due date is approaching or passed
+	TriggerPromotionDate = "promotion_date" // a task's priority promotion date is reached
+	TriggerColumnAge     = "column_age"     // a task stays in its column for too long
+)
+
+// Rule.Conditions entries of time-triggered rules
+const (
+	ConditionDueWithinDays = "due_within_days" // due_date: days before the due date the rule starts to hold, 0 by default
+	ConditionMaxAgeDays    = "max_age_days"    // column_age: days a task may stay in its column
+	ConditionColumns       = "columns"         // column_age: columns watched, all but the done columns by default
+)
+
+// IsTimeTrigger reports whether rules with the trigger type are evaluated on a schedule
+func IsTimeTrigger(triggerType string) bool {
+	switch triggerType {
+	case TriggerDueDate, TriggerPromotionDate, TriggerColumnAge:
+		return true
+	}
+	return false
+}
+
+// ValidateTimeTrigger returns the problems of a time-triggered rule
+func ValidateTimeTrigger(rule Rule) []string {
+	var problems []string
+	switch rule.TriggerType {
+	case TriggerDueDate:
+		if value, exists := rule.Conditions[ConditionDueWithinDays]; exists {
+			if days, ok := RuleActionDays(value); !ok || days < 0 {
+				problems = append(problems, fmt.Sprintf("condition %s must be a whole number of days", ConditionDueWithinDays))
+			}
+		}
+	case TriggerColumnAge:
+		value, exists := rule.Conditions[ConditionMaxAgeDays]
+		if days, ok := RuleActionDays(value); !exists || !ok || days < 0 {
+			problems = append(problems, fmt.Sprintf("condition %s must be a whole number of days", ConditionMaxAgeDays))
+		}
+		if value, exists := rule.Conditions[ConditionColumns]; exists {
+			if columns, ok := RuleActionStrings(value); !ok || len(columns) == 0 {
+				problems = append(problems, fmt.Sprintf("condition %s must be a non-empty list of column IDs", ConditionColumns))
+			}
+		}
+	}
+	if rule.Category != "automation" && rule.Category != "notification" {
+		problems = append(problems, fmt.Sprintf("trigger type %s requires an automation or notification rule", rule.TriggerType))
+	}
+	return problems
+}
@@ -4,18 +4,23 @@
 package resource_access
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
 	"sync"
 
+	"github.com/rknuus/eisenkan/internal/resource_access/board_access"
 	"github.com/rknuus/eisenkan/internal/utilities"
 )
 
 const (
 	// rulesFileName defines the standard filename for rule sets
 	rulesFileName = "rules.json"
+
+	// operationUpdateRules is the Operation trailer of rule set commits
+	operationUpdateRules = "update-rules"
 )
 
 // Rule represents a single business rule in the rule set
@@ -66,6 +71,9 @@ type RulesAccess struct {
 	repository utilities.Repository
 	logger     utilities.ILoggingUtility
 	mutex      sync.RWMutex
+
+	seenMutex sync.Mutex
+	seen      map[string][]byte // rules file path -> content last read or written, nil if the file was absent
 }
 
 // NewRulesAccess creates a new RulesAccess instance
@@ -110,6 +118,7 @@ func NewRulesAccess(repositoryPath string) (*RulesAccess, error) {
 	ra := &RulesAccess{
 		repository: repository,
 		logger:     logger,
+		seen:       make(map[string][]byte),
 	}
 
 	ra.logger.LogMessage(utilities.Info, "RulesAccess", "RulesAccess initialized successfully")
@@ -125,6 +134,8 @@ func (ra *RulesAccess) ReadRules(boardDirPath string) (*RuleSet, error) {
 
 	// Check if rules file exists
 	if _, err := os.Stat(rulesFilePath); os.IsNotExist(err) {
+		ra.remember(rulesFilePath, nil)
+
 		// Return empty rule set if no rules are configured
 		ra.logger.LogMessage(utilities.Info, "RulesAccess", fmt.Sprintf("No rules file found, returning empty rule set for %s", boardDirPath))
 		return &RuleSet{
@@ -138,6 +149,7 @@ func (ra *RulesAccess) ReadRules(boardDirPath string) (*RuleSet, error) {
 	if err != nil {
 		return nil, fmt.Errorf("RulesAccess.ReadRules failed to read rules file %s: %w", rulesFilePath, err)
 	}
+	ra.remember(rulesFilePath, data)
 
 	// Parse JSON
 	var ruleSet RuleSet
@@ -216,10 +228,42 @@ func (ra *RulesAccess) ValidateRuleChanges(ruleSet *RuleSet) (*ValidationResult,
 			result.Errors = append(result.Errors, fmt.Sprintf("rule %s missing conditions", rule.ID))
 		}
 
+		// Validate the condition expression
+		if expression, exists := rule.Conditions[ConditionExpressionKey]; exists {
+			source, ok := expression.(string)
+			if !ok {
+				result.Valid = false
+				result.Errors = append(result.Errors, fmt.Sprintf("rule %s condition expression must be a string", rule.ID))
+			} else if _, err := ParseRuleExpression(source); err != nil {
+				result.Valid = false
+				result.Errors = append(result.Errors, fmt.Sprintf("rule %s condition expression: %v", rule.ID, err))
+			}
+		}
+
 		if len(rule.Actions) == 0 {
 			result.Valid = false
 			result.Errors = append(result.Errors, fmt.Sprintf("rule %s missing actions", rule.ID))
 		}
+
+		// Validate the automation actions
+		for _, problem := range ValidateRuleActions(rule.Actions) {
+			result.Valid = false
+			result.Errors = append(result.Errors, fmt.Sprintf("rule %s %s", rule.ID, problem))
+		}
+		if HasRuleActions(rule.Actions) && rule.Category != "automation" {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("rule %s has automation actions but is not an automation rule, they are not applied", rule.ID))
+		}
+		if HasRuleActions(rule.Actions) && rule.Category == "automation" && rule.Conditions[ConditionExpressionKey] == nil && !IsTimeTrigger(rule.TriggerType) {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("rule %s has automation actions but no condition expression, they are not applied", rule.ID))
+		}
+
+		// Validate the conditions of time-triggered rules
+		if IsTimeTrigger(rule.TriggerType) {
+			for _, problem := range ValidateTimeTrigger(rule) {
+				result.Valid = false
+				result.Errors = append(result.Errors, fmt.Sprintf("rule %s %s", rule.ID, problem))
+			}
+		}
 	}
 
 	// Validate dependencies
@@ -317,19 +361,23 @@ func (ra *RulesAccess) ChangeRules(boardDirPath string, ruleSet *RuleSet) error
 		return fmt.Errorf("RulesAccess.ChangeRules failed to marshal rule set to JSON: %w", err)
 	}
 
-	// Write to rules file
+	// Write to rules file, unless it was edited since we last read it
 	rulesFilePath := filepath.Join(boardDirPath, rulesFileName)
-	if err := os.WriteFile(rulesFilePath, data, 0644); err != nil {
+	if err := ra.verify(rulesFilePath); err != nil {
+		return fmt.Errorf("RulesAccess.ChangeRules refused to overwrite %s: %w", rulesFilePath, err)
+	}
+	if err := utilities.WriteFileAtomic(rulesFilePath, data, 0644); err != nil {
 		return fmt.Errorf("RulesAccess.ChangeRules failed to write rules file %s: %w", rulesFilePath, err)
 	}
+	ra.remember(rulesFilePath, data)
 
 	// Stage and commit changes via Repository
 	if err := ra.repository.Stage([]string{rulesFileName}); err != nil {
 		return fmt.Errorf("RulesAccess.ChangeRules failed to stage changes: %w", err)
 	}
 
-	commitMessage := fmt.Sprintf("Update rule set with %d rules", len(ruleSet.Rules))
-	if _, err := ra.repository.Commit(commitMessage); err != nil {
+	commitMessage := utilities.NewCommitMessage(operationUpdateRules, fmt.Sprintf("Update rule set with %d rules", len(ruleSet.Rules)))
+	if _, err := ra.repository.Commit(commitMessage.String()); err != nil {
 		return fmt.Errorf("RulesAccess.ChangeRules failed to commit changes: %w", err)
 	}
 
@@ -337,6 +385,33 @@ func (ra *RulesAccess) ChangeRules(boardDirPath string, ruleSet *RuleSet) error
 	return nil
 }
 
+// remember records the content of a rules file as last read or written
+func (ra *RulesAccess) remember(rulesFilePath string, data []byte) {
+	ra.seenMutex.Lock()
+	defer ra.seenMutex.Unlock()
+
+	ra.seen[rulesFilePath] = data
+}
+
+// verify fails with board_access.ErrModifiedExternally if a rules file no longer has the content last read or written
+func (ra *RulesAccess) verify(rulesFilePath string) error {
+	ra.seenMutex.Lock()
+	expected, known := ra.seen[rulesFilePath]
+	ra.seenMutex.Unlock()
+	if !known {
+		return nil
+	}
+
+	actual, err := os.ReadFile(rulesFilePath)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to check rules file: %w", err)
+	}
+	if (expected == nil) != os.IsNotExist(err) || !bytes.Equal(actual, expected) {
+		return fmt.Errorf("rules file was %w since it was last read", board_access.ErrModifiedExternally)
+	}
+	return nil
+}
+
 // Close releases any resources held by the service
 func (ra *RulesAccess) Close() error {
 	ra.mutex.Lock()
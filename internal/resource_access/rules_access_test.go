@@ -1,9 +1,13 @@
 package resource_access
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
+	"reflect"
 	"testing"
+
+	"github.com/rknuus/eisenkan/internal/resource_access/board_access"
 )
 
 func TestUnit_RulesAccess_NewRulesAccess(t *testing.T) {
@@ -144,6 +148,43 @@ func TestUnit_RulesAccess_ValidateAndChangeRules(t *testing.T) {
 	}
 }
 
+func TestUnit_RulesAccess_RefusesToOverwriteExternalEdits(t *testing.T) {
+	tempDir := t.TempDir()
+	ra, err := NewRulesAccess(tempDir)
+	if err != nil {
+		t.Fatalf("Failed to create RulesAccess: %v", err)
+	}
+	defer ra.Close()
+
+	ruleSet := &RuleSet{Version: "1.0", Rules: []Rule{{ID: "stale", Name: "Stale", Category: "automation", TriggerType: TriggerColumnAge, Enabled: true,
+		Conditions: map[string]interface{}{ConditionMaxAgeDays: 7}, Actions: map[string]interface{}{ActionAddTags: []interface{}{"stale"}}}}}
+	if err := ra.ChangeRules(tempDir, ruleSet); err != nil {
+		t.Fatalf("Failed to store rule set: %v", err)
+	}
+
+	// A hand edit since our last write is kept
+	rulesFilePath := filepath.Join(tempDir, "rules.json")
+	edited := []byte(`{"version": "1.0", "rules": []}`)
+	if err := os.WriteFile(rulesFilePath, edited, 0644); err != nil {
+		t.Fatalf("Failed to edit rules file: %v", err)
+	}
+	if err := ra.ChangeRules(tempDir, ruleSet); !errors.Is(err, board_access.ErrModifiedExternally) {
+		t.Fatalf("Expected ErrModifiedExternally, got %v", err)
+	}
+	if data, err := os.ReadFile(rulesFilePath); err != nil || string(data) != string(edited) {
+		t.Errorf("Expected the external edit to be kept, got %q (%v)", data, err)
+	}
+
+	// Once read, the edited rules may be changed again
+	if _, err := ra.ReadRules(tempDir); err != nil {
+		t.Fatalf("Failed to read rules: %v", err)
+	}
+	ruleSet.Rules[0].Enabled = false
+	if err := ra.ChangeRules(tempDir, ruleSet); err != nil {
+		t.Errorf("Expected the change to succeed after reading the edit, got %v", err)
+	}
+}
+
 func TestUnit_RulesAccess_InvalidRules(t *testing.T) {
 	// Create temporary directory for test
 	tempDir, err := os.MkdirTemp("", "rulesaccess_test_")
@@ -238,6 +279,117 @@ func TestUnit_RulesAccess_InvalidRules(t *testing.T) {
 			t.Error("Rule set with invalid category should be invalid")
 		}
 	})
+
+	t.Run("InvalidConditionExpression", func(t *testing.T) {
+		ruleSet := &RuleSet{
+			Version: "1.0",
+			Rules: []Rule{
+				{
+					ID:          "test-rule",
+					Name:        "Test Rule",
+					Category:    "validation",
+					TriggerType: "task_transition",
+					Conditions:  map[string]interface{}{ConditionExpressionKey: `task.priority == "urgent-important" && column("doing").wip >=`},
+					Actions:     map[string]interface{}{"test": "action"},
+				},
+			},
+		}
+		validation, err := ra.ValidateRuleChanges(ruleSet)
+		if err != nil {
+			t.Fatalf("Validation failed: %v", err)
+		}
+		expected := "rule test-rule condition expression: line 1, column 62: expected a value but found end of expression"
+		if validation.Valid || len(validation.Errors) != 1 || validation.Errors[0] != expected {
+			t.Errorf("Expected %q, got %v", expected, validation.Errors)
+		}
+
+		ruleSet.Rules[0].Conditions[ConditionExpressionKey] = `task.priority == "urgent-important" && column("doing").wip >= 3`
+		validation, err = ra.ValidateRuleChanges(ruleSet)
+		if err != nil || !validation.Valid {
+			t.Errorf("Expected a valid expression, got %v (%v)", validation.Errors, err)
+		}
+	})
+
+	t.Run("InvalidAutomationActions", func(t *testing.T) {
+		ruleSet := &RuleSet{
+			Version: "1.0",
+			Rules: []Rule{
+				{
+					ID:          "auto-rule",
+					Name:        "Auto Rule",
+					Category:    "automation",
+					TriggerType: "task_transition",
+					Conditions:  map[string]interface{}{ConditionExpressionKey: `task.column == "done"`},
+					Actions: map[string]interface{}{
+						ActionAddTags:              []interface{}{"finished", 3},
+						ActionSetPriority:          "urgent",
+						ActionArchiveDoneAfterDays: 1.5,
+						"message":                  "Not an action",
+					},
+				},
+			},
+		}
+		validation, err := ra.ValidateRuleChanges(ruleSet)
+		if err != nil {
+			t.Fatalf("Validation failed: %v", err)
+		}
+		expected := []string{
+			"rule auto-rule action add_tags must be a non-empty list of strings",
+			"rule auto-rule action set_priority must be one of [not-urgent-important not-urgent-not-important urgent-important urgent-not-important]",
+			"rule auto-rule action archive_done_after_days must be a whole number of days",
+		}
+		if validation.Valid || !reflect.DeepEqual(validation.Errors, expected) {
+			t.Errorf("Expected %v, got %v", expected, validation.Errors)
+		}
+
+		// Actions of other rule categories, or without a condition expression, are not applied
+		ruleSet.Rules[0].Actions = map[string]interface{}{ActionMoveTo: "doing", ActionSetDueInDays: 3}
+		ruleSet.Rules[0].Category = "validation"
+		validation, err = ra.ValidateRuleChanges(ruleSet)
+		if err != nil || !validation.Valid || len(validation.Warnings) != 1 {
+			t.Errorf("Expected a valid rule set with a warning, got %+v (%v)", validation, err)
+		}
+		ruleSet.Rules[0].Category = "automation"
+		ruleSet.Rules[0].Conditions = map[string]interface{}{"max_age_days": 3}
+		validation, err = ra.ValidateRuleChanges(ruleSet)
+		if err != nil || !validation.Valid || len(validation.Warnings) != 1 {
+			t.Errorf("Expected a valid rule set with a warning, got %+v (%v)", validation, err)
+		}
+
+		// Time-triggered rules apply their actions without a condition expression
+		ruleSet.Rules[0].TriggerType = TriggerColumnAge
+		validation, err = ra.ValidateRuleChanges(ruleSet)
+		if err != nil || !validation.Valid || len(validation.Warnings) != 0 {
+			t.Errorf("Expected a valid rule set without warnings, got %+v (%v)", validation, err)
+		}
+	})
+
+	t.Run("InvalidTimeTriggers", func(t *testing.T) {
+		ruleSet := &RuleSet{
+			Version: "1.0",
+			Rules: []Rule{
+				{ID: "due", Name: "Due", Category: "notification", TriggerType: TriggerDueDate,
+					Conditions: map[string]interface{}{ConditionDueWithinDays: "soon"}, Actions: map[string]interface{}{"message": "Due soon"}},
+				{ID: "stale", Name: "Stale", Category: "automation", TriggerType: TriggerColumnAge,
+					Conditions: map[string]interface{}{ConditionColumns: []interface{}{}}, Actions: map[string]interface{}{ActionAddTags: []interface{}{"stale"}}},
+				{ID: "promote", Name: "Promote", Category: "validation", TriggerType: TriggerPromotionDate,
+					Conditions: map[string]interface{}{ConditionExpressionKey: "true"}, Actions: map[string]interface{}{"message": "Promoted"}},
+			},
+		}
+		validation, err := ra.ValidateRuleChanges(ruleSet)
+		if err != nil {
+			t.Fatalf("Validation failed: %v", err)
+		}
+		expected := []string{
+			"rule due condition due_within_days must be a whole number of days",
+			"rule stale condition max_age_days must be a whole number of days",
+			"rule stale condition columns must be a non-empty list of column IDs",
+			"rule promote trigger type promotion_date requires an automation or notification rule",
+		}
+		if validation.Valid || !reflect.DeepEqual(validation.Errors, expected) {
+			t.Errorf("Expected %v, got %v", expected, validation.Errors)
+		}
+	})
 }
 
 func TestUnit_RulesAccess_CircularDependencies(t *testing.T) {
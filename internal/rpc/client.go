@@ -0,0 +1,558 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/rknuus/eisenkan/api"
+	"github.com/rknuus/eisenkan/internal/engines"
+	"github.com/rknuus/eisenkan/internal/managers/task_manager"
+	"github.com/rknuus/eisenkan/internal/resource_access"
+	"github.com/rknuus/eisenkan/internal/resource_access/board_access"
+)
+
+// DefaultCallTimeout bounds each remote call, since TaskManager methods carry no context
+const DefaultCallTimeout = 30 * time.Second
+
+// taskEventBufferSize is the number of received task events buffered for a slow subscriber
+const taskEventBufferSize = 64
+
+// taskManagerClient implements task_manager.TaskManager by calling a remote TaskManagerService
+type taskManagerClient struct {
+	client  api.TaskManagerServiceClient
+	timeout time.Duration
+}
+
+// NewTaskManagerClient creates a TaskManager that forwards all operations over a gRPC connection
+func NewTaskManagerClient(conn grpc.ClientConnInterface) task_manager.TaskManager {
+	return &taskManagerClient{client: api.NewTaskManagerServiceClient(conn), timeout: DefaultCallTimeout}
+}
+
+// ErrLocalBoardOperation is returned for board operations the service does not offer, as they would reach
+// directories of the server other than the served board
+var ErrLocalBoardOperation = errors.New("boards can only be created and deleted locally, not through the task manager service")
+
+// remoteError carries a failed remote call's message and keeps TaskManager sentinel errors matchable
+type remoteError struct {
+	code     codes.Code
+	message  string
+	modified bool // the status carried a TaskModified detail
+}
+
+// Error implements the error interface
+func (e *remoteError) Error() string {
+	return e.message
+}
+
+// Is reports NOT_FOUND errors as task_manager.ErrTaskNotFound, ABORTED errors as task_manager.ErrTaskModified with a
+// TaskModified detail and as board_access.ErrModifiedExternally otherwise, and FAILED_PRECONDITION errors as
+// board_access.ErrBoardReadOnly
+func (e *remoteError) Is(target error) bool {
+	switch target {
+	case task_manager.ErrTaskNotFound:
+		return e.code == codes.NotFound
+	case task_manager.ErrTaskModified:
+		return e.code == codes.Aborted && e.modified
+	case board_access.ErrModifiedExternally:
+		return e.code == codes.Aborted && !e.modified
+	case board_access.ErrBoardReadOnly:
+		return e.code == codes.FailedPrecondition
+	}
+	return false
+}
+
+// fromStatus converts a gRPC status error back into the error TaskManager would have returned
+func fromStatus(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	if failed, ok := batchFailure(st); ok {
+		return failed
+	}
+	if st.Code() == codes.FailedPrecondition {
+		for _, detail := range st.Details() {
+			if violations, ok := detail.(*api.RuleViolations); ok {
+				return &task_manager.RuleViolationError{
+					Operation:  violations.GetOperation(),
+					Violations: violationsFromProto(violations.GetViolations()),
+				}
+			}
+		}
+	}
+	if st.Code() == codes.Unavailable || st.Code() == codes.DeadlineExceeded {
+		return fmt.Errorf("task manager service unavailable: %s", st.Message())
+	}
+	remote := &remoteError{code: st.Code(), message: st.Message()}
+	for _, detail := range st.Details() {
+		if _, ok := detail.(*api.TaskModified); ok {
+			remote.modified = true
+		}
+	}
+	return remote
+}
+
+// batchFailure converts the status of a failed batch into a task_manager.BatchError wrapping the error of the failed task
+func batchFailure(st *status.Status) (error, bool) {
+	for _, detail := range st.Details() {
+		failure, ok := detail.(*api.BatchFailure)
+		if !ok {
+			continue
+		}
+		failed := st.Proto()
+		failed.Message = failure.GetMessage()
+		for i, packed := range failed.Details {
+			if packed.MessageIs(failure) {
+				failed.Details = append(failed.Details[:i], failed.Details[i+1:]...)
+				break
+			}
+		}
+		return &task_manager.BatchError{TaskID: failure.GetTaskId(), Err: fromStatus(status.FromProto(failed).Err())}, true
+	}
+	return nil, false
+}
+
+// call runs a remote operation with the call timeout and converts its error
+func call[T any](c *taskManagerClient, operation func(ctx context.Context) (T, error)) (T, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	result, err := operation(ctx)
+	if err != nil {
+		var zero T
+		return zero, fromStatus(err)
+	}
+	return result, nil
+}
+
+// CreateTask implements task_manager.TaskManager
+func (c *taskManagerClient) CreateTask(request task_manager.TaskRequest) (task_manager.TaskResponse, error) {
+	response, err := call(c, func(ctx context.Context) (*api.TaskResponse, error) {
+		return c.client.CreateTask(ctx, taskRequestToProto(request))
+	})
+	if err != nil {
+		return task_manager.TaskResponse{}, err
+	}
+	return taskResponseFromProto(response), nil
+}
+
+// UpdateTask implements task_manager.TaskManager
+func (c *taskManagerClient) UpdateTask(taskID string, request task_manager.TaskRequest, expectedUpdatedAt *time.Time) (task_manager.TaskResponse, error) {
+	response, err := call(c, func(ctx context.Context) (*api.TaskResponse, error) {
+		return c.client.UpdateTask(ctx, &api.UpdateTaskRequest{TaskId: taskID, Task: taskRequestToProto(request), ExpectedUpdatedAt: optionalTimestampToProto(expectedUpdatedAt)})
+	})
+	if err != nil {
+		return task_manager.TaskResponse{}, err
+	}
+	return taskResponseFromProto(response), nil
+}
+
+// GetTask implements task_manager.TaskManager
+func (c *taskManagerClient) GetTask(taskID string) (task_manager.TaskResponse, error) {
+	response, err := call(c, func(ctx context.Context) (*api.TaskResponse, error) {
+		return c.client.GetTask(ctx, &api.TaskIdentifier{TaskId: taskID})
+	})
+	if err != nil {
+		return task_manager.TaskResponse{}, err
+	}
+	return taskResponseFromProto(response), nil
+}
+
+// DeleteTask implements task_manager.TaskManager
+func (c *taskManagerClient) DeleteTask(taskID string, expectedUpdatedAt *time.Time) error {
+	_, err := call(c, func(ctx context.Context) (*emptypb.Empty, error) {
+		return c.client.DeleteTask(ctx, &api.DeleteTaskRequest{TaskId: taskID, ExpectedUpdatedAt: optionalTimestampToProto(expectedUpdatedAt)})
+	})
+	return err
+}
+
+// ListTasks implements task_manager.TaskManager
+func (c *taskManagerClient) ListTasks(criteria task_manager.QueryCriteria) ([]task_manager.TaskResponse, error) {
+	response, err := call(c, func(ctx context.Context) (*api.TaskList, error) {
+		return c.client.ListTasks(ctx, queryCriteriaToProto(criteria))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return taskListFromProto(response), nil
+}
+
+// ChangeTaskStatus implements task_manager.TaskManager
+func (c *taskManagerClient) ChangeTaskStatus(taskID string, workflowStatus task_manager.WorkflowStatus, expectedUpdatedAt *time.Time) (task_manager.TaskResponse, error) {
+	response, err := call(c, func(ctx context.Context) (*api.TaskResponse, error) {
+		return c.client.ChangeTaskStatus(ctx, &api.ChangeTaskStatusRequest{TaskId: taskID, WorkflowStatus: string(workflowStatus), ExpectedUpdatedAt: optionalTimestampToProto(expectedUpdatedAt)})
+	})
+	if err != nil {
+		return task_manager.TaskResponse{}, err
+	}
+	return taskResponseFromProto(response), nil
+}
+
+// ValidateTask implements task_manager.TaskManager
+func (c *taskManagerClient) ValidateTask(request task_manager.TaskRequest) (task_manager.ValidationResult, error) {
+	response, err := call(c, func(ctx context.Context) (*api.ValidationResult, error) {
+		return c.client.ValidateTask(ctx, taskRequestToProto(request))
+	})
+	if err != nil {
+		return task_manager.ValidationResult{}, err
+	}
+	return validationResultFromProto(response), nil
+}
+
+// SimulateRules implements task_manager.TaskManager
+func (c *taskManagerClient) SimulateRules(ruleSet *resource_access.RuleSet, since time.Time) (*engines.SimulationReport, error) {
+	message, err := ruleSetToProto(ruleSet)
+	if err != nil {
+		return nil, err
+	}
+	response, err := call(c, func(ctx context.Context) (*api.SimulationReport, error) {
+		return c.client.SimulateRules(ctx, &api.SimulateRulesRequest{RuleSet: message, Since: timestampToProto(since)})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return simulationReportFromProto(response), nil
+}
+
+// ProcessPriorityPromotions implements task_manager.TaskManager
+func (c *taskManagerClient) ProcessPriorityPromotions() ([]task_manager.TaskResponse, error) {
+	response, err := call(c, func(ctx context.Context) (*api.TaskList, error) {
+		return c.client.ProcessPriorityPromotions(ctx, &emptypb.Empty{})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return taskListFromProto(response), nil
+}
+
+// RunScheduledRules implements task_manager.TaskManager
+func (c *taskManagerClient) RunScheduledRules(now time.Time) (task_manager.ScheduledRulesResponse, error) {
+	response, err := call(c, func(ctx context.Context) (*api.ScheduledRulesResponse, error) {
+		return c.client.RunScheduledRules(ctx, &api.RunScheduledRulesRequest{Now: timestampToProto(now)})
+	})
+	if err != nil {
+		return task_manager.ScheduledRulesResponse{}, err
+	}
+	return scheduledRulesResponseFromProto(response), nil
+}
+
+// ArchiveTask implements task_manager.TaskManager
+func (c *taskManagerClient) ArchiveTask(taskID string, policy board_access.CascadePolicy, expectedUpdatedAt *time.Time) (task_manager.TaskResponse, error) {
+	response, err := call(c, func(ctx context.Context) (*api.TaskResponse, error) {
+		return c.client.ArchiveTask(ctx, &api.ArchiveTaskRequest{TaskId: taskID, CascadePolicy: string(policy), ExpectedUpdatedAt: optionalTimestampToProto(expectedUpdatedAt)})
+	})
+	if err != nil {
+		return task_manager.TaskResponse{}, err
+	}
+	return taskResponseFromProto(response), nil
+}
+
+// ListArchivedTasks implements task_manager.TaskManager
+func (c *taskManagerClient) ListArchivedTasks() ([]task_manager.ArchivedTaskResponse, error) {
+	response, err := call(c, func(ctx context.Context) (*api.ArchivedTaskList, error) {
+		return c.client.ListArchivedTasks(ctx, &emptypb.Empty{})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return archivedTaskListFromProto(response), nil
+}
+
+// RestoreTask implements task_manager.TaskManager
+func (c *taskManagerClient) RestoreTask(taskID string, policy board_access.CascadePolicy) (task_manager.TaskResponse, error) {
+	response, err := call(c, func(ctx context.Context) (*api.TaskResponse, error) {
+		return c.client.RestoreTask(ctx, &api.CascadeTaskRequest{TaskId: taskID, CascadePolicy: string(policy)})
+	})
+	if err != nil {
+		return task_manager.TaskResponse{}, err
+	}
+	return taskResponseFromProto(response), nil
+}
+
+// PurgeArchive implements task_manager.TaskManager
+func (c *taskManagerClient) PurgeArchive(olderThan time.Duration) ([]string, error) {
+	response, err := call(c, func(ctx context.Context) (*api.PurgeArchiveResponse, error) {
+		return c.client.PurgeArchive(ctx, &api.PurgeArchiveRequest{OlderThan: durationpb.New(olderThan)})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.GetTaskIds(), nil
+}
+
+// ValidateBoardDirectory implements task_manager.TaskManager
+func (c *taskManagerClient) ValidateBoardDirectory(directoryPath string) (task_manager.BoardValidationResponse, error) {
+	response, err := call(c, func(ctx context.Context) (*api.BoardValidationResponse, error) {
+		return c.client.ValidateBoardDirectory(ctx, &api.BoardPath{BoardPath: directoryPath})
+	})
+	if err != nil {
+		return task_manager.BoardValidationResponse{}, err
+	}
+	return boardValidationFromProto(response), nil
+}
+
+// RepairBoard implements task_manager.TaskManager
+func (c *taskManagerClient) RepairBoard() (task_manager.BoardRepairResponse, error) {
+	response, err := call(c, func(ctx context.Context) (*api.BoardRepairResponse, error) {
+		return c.client.RepairBoard(ctx, &emptypb.Empty{})
+	})
+	if err != nil {
+		return task_manager.BoardRepairResponse{}, err
+	}
+	return boardRepairFromProto(response), nil
+}
+
+// GetBoardMetadata implements task_manager.TaskManager
+func (c *taskManagerClient) GetBoardMetadata(boardPath string) (task_manager.BoardMetadataResponse, error) {
+	response, err := call(c, func(ctx context.Context) (*api.BoardMetadataResponse, error) {
+		return c.client.GetBoardMetadata(ctx, &api.BoardPath{BoardPath: boardPath})
+	})
+	if err != nil {
+		return task_manager.BoardMetadataResponse{}, err
+	}
+	return boardMetadataFromProto(response), nil
+}
+
+// GetBoardStatistics implements task_manager.TaskManager
+func (c *taskManagerClient) GetBoardStatistics(boardPath string) (*board_access.BoardStatistics, error) {
+	response, err := call(c, func(ctx context.Context) (*api.BoardStatistics, error) {
+		return c.client.GetBoardStatistics(ctx, &api.BoardPath{BoardPath: boardPath})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return boardStatisticsFromProto(response), nil
+}
+
+// GetFlowMetrics implements task_manager.TaskManager
+func (c *taskManagerClient) GetFlowMetrics(boardPath string, dateRange *board_access.DateRange) (*board_access.FlowMetrics, error) {
+	response, err := call(c, func(ctx context.Context) (*api.FlowMetrics, error) {
+		return c.client.GetFlowMetrics(ctx, &api.FlowMetricsRequest{BoardPath: boardPath, DateRange: dateRangeToProto(dateRange)})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return flowMetricsFromProto(response), nil
+}
+
+// CreateBoard implements task_manager.TaskManager; boards are not created through the service
+func (c *taskManagerClient) CreateBoard(request task_manager.BoardCreationRequest) (task_manager.BoardCreationResponse, error) {
+	return task_manager.BoardCreationResponse{}, ErrLocalBoardOperation
+}
+
+// UpdateBoardMetadata implements task_manager.TaskManager
+func (c *taskManagerClient) UpdateBoardMetadata(boardPath string, metadata task_manager.BoardMetadataRequest) (task_manager.BoardMetadataResponse, error) {
+	response, err := call(c, func(ctx context.Context) (*api.BoardMetadataResponse, error) {
+		return c.client.UpdateBoardMetadata(ctx, &api.UpdateBoardMetadataRequest{
+			BoardPath: boardPath,
+			Metadata: &api.BoardMetadataRequest{
+				Title:       metadata.Title,
+				Description: metadata.Description,
+				Metadata:    metadata.Metadata,
+			},
+		})
+	})
+	if err != nil {
+		return task_manager.BoardMetadataResponse{}, err
+	}
+	return boardMetadataFromProto(response), nil
+}
+
+// DeleteBoard implements task_manager.TaskManager; boards are not deleted through the service
+func (c *taskManagerClient) DeleteBoard(request task_manager.BoardDeletionRequest) (task_manager.BoardDeletionResponse, error) {
+	return task_manager.BoardDeletionResponse{}, ErrLocalBoardOperation
+}
+
+// ListBoardRemotes implements task_manager.TaskManager
+func (c *taskManagerClient) ListBoardRemotes() ([]task_manager.BoardRemote, error) {
+	response, err := call(c, func(ctx context.Context) (*api.BoardRemoteList, error) {
+		return c.client.ListBoardRemotes(ctx, &emptypb.Empty{})
+	})
+	if err != nil {
+		return nil, err
+	}
+	remotes := make([]task_manager.BoardRemote, 0, len(response.GetRemotes()))
+	for _, remote := range response.GetRemotes() {
+		remotes = append(remotes, task_manager.BoardRemote{Name: remote.GetName(), URL: remote.GetUrl()})
+	}
+	return remotes, nil
+}
+
+// AddBoardRemote implements task_manager.TaskManager
+func (c *taskManagerClient) AddBoardRemote(name, url string) error {
+	_, err := call(c, func(ctx context.Context) (*emptypb.Empty, error) {
+		return c.client.AddBoardRemote(ctx, &api.BoardRemote{Name: name, Url: url})
+	})
+	return err
+}
+
+// RemoveBoardRemote implements task_manager.TaskManager
+func (c *taskManagerClient) RemoveBoardRemote(name string) error {
+	_, err := call(c, func(ctx context.Context) (*emptypb.Empty, error) {
+		return c.client.RemoveBoardRemote(ctx, &api.BoardRemote{Name: name})
+	})
+	return err
+}
+
+// SyncBoard implements task_manager.TaskManager
+func (c *taskManagerClient) SyncBoard(remote string) (task_manager.SyncResponse, error) {
+	response, err := call(c, func(ctx context.Context) (*api.SyncResponse, error) {
+		return c.client.SyncBoard(ctx, &api.SyncBoardRequest{Remote: remote})
+	})
+	if err != nil {
+		return task_manager.SyncResponse{}, err
+	}
+	return task_manager.SyncResponse{
+		Remote:               response.GetRemote(),
+		UpToDate:             response.GetUpToDate(),
+		FastForward:          response.GetFastForward(),
+		MergeCommit:          response.GetMergeCommit(),
+		ChangedTaskIDs:       response.GetChangedTaskIds(),
+		ConfigurationChanged: response.GetConfigurationChanged(),
+		Conflicts:            fieldConflictsFromProto(response.GetConflicts()),
+	}, nil
+}
+
+// Undo implements task_manager.TaskManager
+func (c *taskManagerClient) Undo() (task_manager.UndoResponse, error) {
+	return c.revertOperation(c.client.Undo, task_manager.ErrNothingToUndo)
+}
+
+// Redo implements task_manager.TaskManager
+func (c *taskManagerClient) Redo() (task_manager.UndoResponse, error) {
+	return c.revertOperation(c.client.Redo, task_manager.ErrNothingToRedo)
+}
+
+// revertOperation runs a remote undo or redo; OUT_OF_RANGE errors report an empty stack
+func (c *taskManagerClient) revertOperation(operation func(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*api.UndoResponse, error), empty error) (task_manager.UndoResponse, error) {
+	response, err := call(c, func(ctx context.Context) (*api.UndoResponse, error) {
+		return operation(ctx, &emptypb.Empty{})
+	})
+	var remote *remoteError
+	if errors.As(err, &remote) && remote.code == codes.OutOfRange {
+		return task_manager.UndoResponse{}, empty
+	}
+	if err != nil {
+		return task_manager.UndoResponse{}, err
+	}
+	return undoResponseFromProto(response), nil
+}
+
+// ListBoardRevisions implements task_manager.TaskManager
+func (c *taskManagerClient) ListBoardRevisions(limit int) ([]task_manager.BoardRevision, error) {
+	response, err := call(c, func(ctx context.Context) (*api.BoardRevisionList, error) {
+		return c.client.ListBoardRevisions(ctx, &api.ListBoardRevisionsRequest{Limit: int32(limit)})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return boardRevisionListFromProto(response), nil
+}
+
+// LoadBoardAt implements task_manager.TaskManager
+func (c *taskManagerClient) LoadBoardAt(commitID string, at time.Time) (task_manager.BoardSnapshotResponse, error) {
+	response, err := call(c, func(ctx context.Context) (*api.BoardSnapshot, error) {
+		return c.client.LoadBoardAt(ctx, &api.LoadBoardAtRequest{Commit: commitID, At: timestampToProto(at)})
+	})
+	if err != nil {
+		return task_manager.BoardSnapshotResponse{}, err
+	}
+	return boardSnapshotFromProto(response), nil
+}
+
+// RestoreTaskFrom implements task_manager.TaskManager
+func (c *taskManagerClient) RestoreTaskFrom(commitID, taskID string) (task_manager.TaskResponse, error) {
+	response, err := call(c, func(ctx context.Context) (*api.TaskResponse, error) {
+		return c.client.RestoreTaskFrom(ctx, &api.RestoreTaskFromRequest{Commit: commitID, TaskId: taskID})
+	})
+	if err != nil {
+		return task_manager.TaskResponse{}, err
+	}
+	return taskResponseFromProto(response), nil
+}
+
+// GetTaskHistory implements task_manager.TaskManager
+func (c *taskManagerClient) GetTaskHistory(taskID string, limit int) ([]task_manager.TaskRevision, error) {
+	response, err := call(c, func(ctx context.Context) (*api.TaskRevisionList, error) {
+		return c.client.GetTaskHistory(ctx, &api.GetTaskHistoryRequest{TaskId: taskID, Limit: int32(limit)})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return taskRevisionListFromProto(response), nil
+}
+
+// ExecuteBatch implements task_manager.TaskManager
+func (c *taskManagerClient) ExecuteBatch(request task_manager.BatchRequest) (task_manager.BatchResponse, error) {
+	response, err := call(c, func(ctx context.Context) (*api.BatchResponse, error) {
+		return c.client.ExecuteBatch(ctx, batchRequestToProto(request))
+	})
+	if err != nil {
+		return task_manager.BatchResponse{}, err
+	}
+	return batchResponseFromProto(response), nil
+}
+
+// Load implements task_manager.IContext
+func (c *taskManagerClient) Load(contextType string) (task_manager.ContextData, error) {
+	response, err := call(c, func(ctx context.Context) (*api.ContextData, error) {
+		return c.client.LoadContext(ctx, &api.LoadContextRequest{Type: contextType})
+	})
+	if err != nil {
+		return task_manager.ContextData{}, err
+	}
+	return contextDataFromProto(response), nil
+}
+
+// Store implements task_manager.IContext
+func (c *taskManagerClient) Store(contextType string, data task_manager.ContextData) error {
+	request, err := contextDataToProto(data)
+	if err != nil {
+		return err
+	}
+	request.Type = contextType
+	_, err = call(c, func(ctx context.Context) (*emptypb.Empty, error) {
+		return c.client.StoreContext(ctx, request)
+	})
+	return err
+}
+
+// SubscribeRuleNotifications implements task_manager.ITaskEvents with the notified events of the event stream, queued
+// so slow notifiers do not hold up the stream
+func (c *taskManagerClient) SubscribeRuleNotifications(ctx context.Context) <-chan task_manager.TaskEvent {
+	return task_manager.QueueRuleNotifications(ctx, c.SubscribeTaskEvents(ctx))
+}
+
+// SubscribeTaskEvents implements task_manager.ITaskEvents; the channel is closed when ctx is done or the stream breaks
+func (c *taskManagerClient) SubscribeTaskEvents(ctx context.Context) <-chan task_manager.TaskEvent {
+	events := make(chan task_manager.TaskEvent, taskEventBufferSize)
+
+	go func() {
+		defer close(events)
+
+		stream, err := c.client.SubscribeTaskEvents(ctx, &emptypb.Empty{})
+		if err != nil {
+			return
+		}
+		for {
+			message, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			select {
+			case events <- taskEventFromProto(message):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events
+}
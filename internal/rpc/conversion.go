@@ -0,0 +1,1002 @@
+// Package rpc serves TaskManager over gRPC and provides a TaskManager client for
+// remote or separate-process backends. Messages are defined in api/task_manager.proto.
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/rknuus/eisenkan/api"
+	"github.com/rknuus/eisenkan/internal/engines"
+	"github.com/rknuus/eisenkan/internal/managers/task_manager"
+	"github.com/rknuus/eisenkan/internal/resource_access"
+	"github.com/rknuus/eisenkan/internal/resource_access/board_access"
+)
+
+// timestampToProto converts a timestamp to its protobuf message
+func timestampToProto(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// optionalTimestampToProto converts an optional timestamp to its protobuf message
+func optionalTimestampToProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// timestampFromProto converts a protobuf timestamp message to its Go type
+func timestampFromProto(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime().Local()
+}
+
+// optionalTimestampFromProto converts a protobuf optional timestamp message to its Go type
+func optionalTimestampFromProto(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime().Local()
+	return &t
+}
+
+// countsToProto converts a count map to its protobuf representation
+func countsToProto(counts map[string]int) map[string]int32 {
+	if counts == nil {
+		return nil
+	}
+	result := make(map[string]int32, len(counts))
+	for key, count := range counts {
+		result[key] = int32(count)
+	}
+	return result
+}
+
+// countsFromProto converts a protobuf count map to its Go representation
+func countsFromProto(counts map[string]int32) map[string]int {
+	result := make(map[string]int, len(counts))
+	for key, count := range counts {
+		result[key] = int(count)
+	}
+	return result
+}
+
+// priorityToProto converts a priority to its protobuf message
+func priorityToProto(priority board_access.Priority) *api.Priority {
+	return &api.Priority{Urgent: priority.Urgent, Important: priority.Important, Label: priority.Label}
+}
+
+// priorityFromProto converts a protobuf priority message to its Go type
+func priorityFromProto(priority *api.Priority) board_access.Priority {
+	if priority == nil {
+		return board_access.Priority{}
+	}
+	return board_access.Priority{Urgent: priority.Urgent, Important: priority.Important, Label: priority.Label}
+}
+
+// taskRequestToProto converts a task request to its protobuf message
+func taskRequestToProto(request task_manager.TaskRequest) *api.TaskRequest {
+	return &api.TaskRequest{
+		Description:           request.Description,
+		Priority:              priorityToProto(request.Priority),
+		WorkflowStatus:        string(request.WorkflowStatus),
+		Tags:                  request.Tags,
+		Deadline:              optionalTimestampToProto(request.Deadline),
+		PriorityPromotionDate: optionalTimestampToProto(request.PriorityPromotionDate),
+		ParentTaskId:          request.ParentTaskID,
+	}
+}
+
+// taskRequestFromProto converts a protobuf task request message to its Go type
+func taskRequestFromProto(request *api.TaskRequest) task_manager.TaskRequest {
+	return task_manager.TaskRequest{
+		Description:           request.GetDescription(),
+		Priority:              priorityFromProto(request.GetPriority()),
+		WorkflowStatus:        task_manager.WorkflowStatus(request.GetWorkflowStatus()),
+		Tags:                  request.GetTags(),
+		Deadline:              optionalTimestampFromProto(request.GetDeadline()),
+		PriorityPromotionDate: optionalTimestampFromProto(request.GetPriorityPromotionDate()),
+		ParentTaskID:          request.ParentTaskId,
+	}
+}
+
+// taskResponseToProto converts a task response to its protobuf message
+func taskResponseToProto(response task_manager.TaskResponse) *api.TaskResponse {
+	return &api.TaskResponse{
+		Id:                    response.ID,
+		Description:           response.Description,
+		Priority:              priorityToProto(response.Priority),
+		WorkflowStatus:        string(response.WorkflowStatus),
+		Tags:                  response.Tags,
+		Deadline:              optionalTimestampToProto(response.Deadline),
+		PriorityPromotionDate: optionalTimestampToProto(response.PriorityPromotionDate),
+		ParentTaskId:          response.ParentTaskID,
+		SubtaskIds:            response.SubtaskIDs,
+		CreatedAt:             timestampToProto(response.CreatedAt),
+		UpdatedAt:             timestampToProto(response.UpdatedAt),
+	}
+}
+
+// taskResponseFromProto converts a protobuf task response message to its Go type
+func taskResponseFromProto(response *api.TaskResponse) task_manager.TaskResponse {
+	return task_manager.TaskResponse{
+		ID:                    response.GetId(),
+		Description:           response.GetDescription(),
+		Priority:              priorityFromProto(response.GetPriority()),
+		WorkflowStatus:        task_manager.WorkflowStatus(response.GetWorkflowStatus()),
+		Tags:                  response.GetTags(),
+		Deadline:              optionalTimestampFromProto(response.GetDeadline()),
+		PriorityPromotionDate: optionalTimestampFromProto(response.GetPriorityPromotionDate()),
+		ParentTaskID:          response.ParentTaskId,
+		SubtaskIDs:            response.GetSubtaskIds(),
+		CreatedAt:             timestampFromProto(response.GetCreatedAt()),
+		UpdatedAt:             timestampFromProto(response.GetUpdatedAt()),
+	}
+}
+
+// taskListToProto converts a task list to its protobuf message
+func taskListToProto(tasks []task_manager.TaskResponse) *api.TaskList {
+	list := &api.TaskList{Tasks: make([]*api.TaskResponse, 0, len(tasks))}
+	for _, task := range tasks {
+		list.Tasks = append(list.Tasks, taskResponseToProto(task))
+	}
+	return list
+}
+
+// taskListFromProto converts a protobuf task list message to its Go type
+func taskListFromProto(list *api.TaskList) []task_manager.TaskResponse {
+	tasks := make([]task_manager.TaskResponse, 0, len(list.GetTasks()))
+	for _, task := range list.GetTasks() {
+		tasks = append(tasks, taskResponseFromProto(task))
+	}
+	return tasks
+}
+
+// batchRequestToProto converts a batch request to its protobuf message
+func batchRequestToProto(request task_manager.BatchRequest) *api.BatchRequest {
+	return &api.BatchRequest{
+		Operation:      string(request.Operation),
+		TaskIds:        request.TaskIDs,
+		WorkflowStatus: string(request.WorkflowStatus),
+		Priority:       priorityToProto(request.Priority),
+		CascadePolicy:  string(request.CascadePolicy),
+	}
+}
+
+// batchRequestFromProto converts a protobuf batch request message to its Go type
+func batchRequestFromProto(request *api.BatchRequest) task_manager.BatchRequest {
+	return task_manager.BatchRequest{
+		Operation:      task_manager.BatchOperation(request.GetOperation()),
+		TaskIDs:        request.GetTaskIds(),
+		WorkflowStatus: task_manager.WorkflowStatus(request.GetWorkflowStatus()),
+		Priority:       priorityFromProto(request.GetPriority()),
+		CascadePolicy:  board_access.CascadePolicy(request.GetCascadePolicy()),
+	}
+}
+
+// batchResponseToProto converts a batch response to its protobuf message
+func batchResponseToProto(response task_manager.BatchResponse) *api.BatchResponse {
+	return &api.BatchResponse{Tasks: taskListToProto(response.Tasks).Tasks, Commit: response.Commit}
+}
+
+// batchResponseFromProto converts a protobuf batch response message to its Go type
+func batchResponseFromProto(response *api.BatchResponse) task_manager.BatchResponse {
+	return task_manager.BatchResponse{
+		Tasks:  taskListFromProto(&api.TaskList{Tasks: response.GetTasks()}),
+		Commit: response.GetCommit(),
+	}
+}
+
+// scheduledRulesResponseToProto converts the outcome of a scheduled rules run to its protobuf message
+func scheduledRulesResponseToProto(response task_manager.ScheduledRulesResponse) *api.ScheduledRulesResponse {
+	message := &api.ScheduledRulesResponse{
+		RunAt:    timestampToProto(response.RunAt),
+		Promoted: taskListToProto(response.Promoted).Tasks,
+	}
+	for _, trigger := range response.Triggered {
+		message.Triggered = append(message.Triggered, &api.ScheduledTrigger{
+			RuleId:      trigger.RuleID,
+			RuleName:    trigger.RuleName,
+			Category:    trigger.Category,
+			TriggerType: trigger.TriggerType,
+			TaskId:      trigger.TaskID,
+			Message:     trigger.Message,
+		})
+	}
+	return message
+}
+
+// scheduledRulesResponseFromProto converts a protobuf scheduled rules response message to its Go type
+func scheduledRulesResponseFromProto(message *api.ScheduledRulesResponse) task_manager.ScheduledRulesResponse {
+	response := task_manager.ScheduledRulesResponse{
+		RunAt:    timestampFromProto(message.GetRunAt()),
+		Promoted: taskListFromProto(&api.TaskList{Tasks: message.GetPromoted()}),
+	}
+	for _, trigger := range message.GetTriggered() {
+		response.Triggered = append(response.Triggered, task_manager.ScheduledTrigger{
+			RuleID:      trigger.GetRuleId(),
+			RuleName:    trigger.GetRuleName(),
+			Category:    trigger.GetCategory(),
+			TriggerType: trigger.GetTriggerType(),
+			TaskID:      trigger.GetTaskId(),
+			Message:     trigger.GetMessage(),
+		})
+	}
+	return response
+}
+
+// taskEventToProto converts a task event to its protobuf message
+func taskEventToProto(event task_manager.TaskEvent) *api.TaskEvent {
+	message := &api.TaskEvent{
+		Type:           string(event.Type),
+		TaskId:         event.TaskID,
+		PreviousStatus: string(event.PreviousStatus),
+		OccurredAt:     timestampToProto(event.OccurredAt),
+	}
+	if event.Task != nil {
+		message.Task = taskResponseToProto(*event.Task)
+	}
+	message.Conflicts = fieldConflictsToProto(event.Conflicts)
+	if event.Notification != nil {
+		message.Notification = &api.RuleNotification{
+			RuleId:      event.Notification.RuleID,
+			RuleName:    event.Notification.RuleName,
+			TriggerType: event.Notification.TriggerType,
+			Message:     event.Notification.Message,
+		}
+	}
+	return message
+}
+
+// taskEventFromProto converts a protobuf task event message to its Go type
+func taskEventFromProto(message *api.TaskEvent) task_manager.TaskEvent {
+	event := task_manager.TaskEvent{
+		Type:           task_manager.TaskEventType(message.GetType()),
+		TaskID:         message.GetTaskId(),
+		PreviousStatus: task_manager.WorkflowStatus(message.GetPreviousStatus()),
+		OccurredAt:     timestampFromProto(message.GetOccurredAt()),
+	}
+	if message.GetTask() != nil {
+		task := taskResponseFromProto(message.GetTask())
+		event.Task = &task
+	}
+	event.Conflicts = fieldConflictsFromProto(message.GetConflicts())
+	if notification := message.GetNotification(); notification != nil {
+		event.Notification = &task_manager.RuleNotification{
+			RuleID:      notification.GetRuleId(),
+			RuleName:    notification.GetRuleName(),
+			TriggerType: notification.GetTriggerType(),
+			Message:     notification.GetMessage(),
+		}
+	}
+	return event
+}
+
+// fieldConflictsToProto converts task field conflicts to their protobuf messages
+func fieldConflictsToProto(conflicts []task_manager.TaskFieldConflict) []*api.TaskFieldConflict {
+	if len(conflicts) == 0 {
+		return nil
+	}
+	messages := make([]*api.TaskFieldConflict, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		messages = append(messages, &api.TaskFieldConflict{
+			TaskId:      conflict.TaskID,
+			Field:       conflict.Field,
+			LocalValue:  conflict.LocalValue,
+			RemoteValue: conflict.RemoteValue,
+			Resolution:  conflict.Resolution,
+			Reason:      conflict.Reason,
+		})
+	}
+	return messages
+}
+
+// fieldConflictsFromProto converts protobuf task field conflict messages to their Go type
+func fieldConflictsFromProto(messages []*api.TaskFieldConflict) []task_manager.TaskFieldConflict {
+	if len(messages) == 0 {
+		return nil
+	}
+	conflicts := make([]task_manager.TaskFieldConflict, 0, len(messages))
+	for _, message := range messages {
+		conflicts = append(conflicts, task_manager.TaskFieldConflict{
+			TaskID:      message.GetTaskId(),
+			Field:       message.GetField(),
+			LocalValue:  message.GetLocalValue(),
+			RemoteValue: message.GetRemoteValue(),
+			Resolution:  message.GetResolution(),
+			Reason:      message.GetReason(),
+		})
+	}
+	return conflicts
+}
+
+// archivedTaskListToProto converts an archived task list to its protobuf message
+func archivedTaskListToProto(tasks []task_manager.ArchivedTaskResponse) *api.ArchivedTaskList {
+	list := &api.ArchivedTaskList{Tasks: make([]*api.ArchivedTask, 0, len(tasks))}
+	for _, task := range tasks {
+		list.Tasks = append(list.Tasks, &api.ArchivedTask{
+			Task:       taskResponseToProto(task.TaskResponse),
+			ArchivedAt: timestampToProto(task.ArchivedAt),
+		})
+	}
+	return list
+}
+
+// archivedTaskListFromProto converts a protobuf archived task list message to its Go type
+func archivedTaskListFromProto(list *api.ArchivedTaskList) []task_manager.ArchivedTaskResponse {
+	tasks := make([]task_manager.ArchivedTaskResponse, 0, len(list.GetTasks()))
+	for _, task := range list.GetTasks() {
+		tasks = append(tasks, task_manager.ArchivedTaskResponse{
+			TaskResponse: taskResponseFromProto(task.GetTask()),
+			ArchivedAt:   timestampFromProto(task.GetArchivedAt()),
+		})
+	}
+	return tasks
+}
+
+// dateRangeToProto converts a date range to its protobuf message
+func dateRangeToProto(dateRange *board_access.DateRange) *api.DateRange {
+	if dateRange == nil {
+		return nil
+	}
+	return &api.DateRange{From: optionalTimestampToProto(dateRange.From), To: optionalTimestampToProto(dateRange.To)}
+}
+
+// dateRangeFromProto converts a protobuf date range message to its Go type
+func dateRangeFromProto(dateRange *api.DateRange) *board_access.DateRange {
+	if dateRange == nil {
+		return nil
+	}
+	return &board_access.DateRange{From: optionalTimestampFromProto(dateRange.From), To: optionalTimestampFromProto(dateRange.To)}
+}
+
+// queryCriteriaToProto converts query criteria to its protobuf message
+func queryCriteriaToProto(criteria task_manager.QueryCriteria) *api.QueryCriteria {
+	result := &api.QueryCriteria{
+		Columns:               criteria.Columns,
+		Sections:              criteria.Sections,
+		Tags:                  criteria.Tags,
+		DateRange:             dateRangeToProto(criteria.DateRange),
+		PriorityPromotionDate: dateRangeToProto(criteria.PriorityPromotionDate),
+		ParentTaskId:          criteria.ParentTaskID,
+		Hierarchy:             string(criteria.Hierarchy),
+	}
+	if criteria.Priority != nil {
+		result.Priority = priorityToProto(*criteria.Priority)
+	}
+	return result
+}
+
+// queryCriteriaFromProto converts a protobuf query criteria message to its Go type
+func queryCriteriaFromProto(criteria *api.QueryCriteria) task_manager.QueryCriteria {
+	result := task_manager.QueryCriteria{
+		Columns:               criteria.GetColumns(),
+		Sections:              criteria.GetSections(),
+		Tags:                  criteria.GetTags(),
+		DateRange:             dateRangeFromProto(criteria.GetDateRange()),
+		PriorityPromotionDate: dateRangeFromProto(criteria.GetPriorityPromotionDate()),
+		ParentTaskID:          criteria.ParentTaskId,
+		Hierarchy:             board_access.HierarchyFilter(criteria.GetHierarchy()),
+	}
+	if criteria.GetPriority() != nil {
+		priority := priorityFromProto(criteria.GetPriority())
+		result.Priority = &priority
+	}
+	return result
+}
+
+// violationsToProto converts rule violations to their protobuf messages
+func violationsToProto(violations []engines.RuleViolation) []*api.RuleViolation {
+	result := make([]*api.RuleViolation, 0, len(violations))
+	for _, violation := range violations {
+		result = append(result, &api.RuleViolation{
+			RuleId:   violation.RuleID,
+			Priority: int32(violation.Priority),
+			Message:  violation.Message,
+			Category: violation.Category,
+			Details:  violation.Details,
+		})
+	}
+	return result
+}
+
+// violationsFromProto converts protobuf rule violations to their Go type
+func violationsFromProto(violations []*api.RuleViolation) []engines.RuleViolation {
+	result := make([]engines.RuleViolation, 0, len(violations))
+	for _, violation := range violations {
+		result = append(result, engines.RuleViolation{
+			RuleID:   violation.GetRuleId(),
+			Priority: int(violation.GetPriority()),
+			Message:  violation.GetMessage(),
+			Category: violation.GetCategory(),
+			Details:  violation.GetDetails(),
+		})
+	}
+	return result
+}
+
+// validationResultToProto converts a validation result to its protobuf message
+func validationResultToProto(result task_manager.ValidationResult) *api.ValidationResult {
+	return &api.ValidationResult{Valid: result.Valid, Violations: violationsToProto(result.Violations)}
+}
+
+// validationResultFromProto converts a protobuf validation result message to its Go type
+func validationResultFromProto(result *api.ValidationResult) task_manager.ValidationResult {
+	validation := task_manager.ValidationResult{Valid: result.GetValid()}
+	if len(result.GetViolations()) > 0 {
+		validation.Violations = violationsFromProto(result.GetViolations())
+	}
+	return validation
+}
+
+// ruleSetToProto converts a rule set to its protobuf message; a nil rule set stays unset
+func ruleSetToProto(ruleSet *resource_access.RuleSet) (*api.RuleSet, error) {
+	if ruleSet == nil {
+		return nil, nil
+	}
+	message := &api.RuleSet{Version: ruleSet.Version, Metadata: ruleSet.Metadata}
+	for _, rule := range ruleSet.Rules {
+		conditions, err := valuesToStruct(rule.Conditions)
+		if err != nil {
+			return nil, fmt.Errorf("conditions of rule %s are not serializable: %w", rule.ID, err)
+		}
+		actions, err := valuesToStruct(rule.Actions)
+		if err != nil {
+			return nil, fmt.Errorf("actions of rule %s are not serializable: %w", rule.ID, err)
+		}
+		message.Rules = append(message.Rules, &api.Rule{
+			Id:          rule.ID,
+			Name:        rule.Name,
+			Category:    rule.Category,
+			TriggerType: rule.TriggerType,
+			Conditions:  conditions,
+			Actions:     actions,
+			Priority:    int32(rule.Priority),
+			Enabled:     rule.Enabled,
+			Metadata:    rule.Metadata,
+		})
+	}
+	if len(ruleSet.Dependencies) > 0 {
+		message.Dependencies = make(map[string]*api.RuleDependencies, len(ruleSet.Dependencies))
+		for ruleID, dependents := range ruleSet.Dependencies {
+			message.Dependencies[ruleID] = &api.RuleDependencies{RuleIds: dependents}
+		}
+	}
+	return message, nil
+}
+
+// ruleSetFromProto converts a protobuf rule set message to its Go type; an unset message yields nil
+func ruleSetFromProto(message *api.RuleSet) *resource_access.RuleSet {
+	if message == nil {
+		return nil
+	}
+	ruleSet := &resource_access.RuleSet{Version: message.GetVersion(), Metadata: message.GetMetadata()}
+	for _, rule := range message.GetRules() {
+		ruleSet.Rules = append(ruleSet.Rules, resource_access.Rule{
+			ID:          rule.GetId(),
+			Name:        rule.GetName(),
+			Category:    rule.GetCategory(),
+			TriggerType: rule.GetTriggerType(),
+			Conditions:  rule.GetConditions().AsMap(),
+			Actions:     rule.GetActions().AsMap(),
+			Priority:    int(rule.GetPriority()),
+			Enabled:     rule.GetEnabled(),
+			Metadata:    rule.GetMetadata(),
+		})
+	}
+	if len(message.GetDependencies()) > 0 {
+		ruleSet.Dependencies = make(map[string][]string, len(message.GetDependencies()))
+		for ruleID, dependents := range message.GetDependencies() {
+			ruleSet.Dependencies[ruleID] = dependents.GetRuleIds()
+		}
+	}
+	return ruleSet
+}
+
+// valuesToStruct converts decoded JSON values to a protobuf struct
+func valuesToStruct(values map[string]interface{}) (*structpb.Struct, error) {
+	// A JSON round trip reduces arbitrary Go values to the types a Struct can hold
+	encoded, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+	var generic map[string]interface{}
+	if err := json.Unmarshal(encoded, &generic); err != nil {
+		return nil, err
+	}
+	return structpb.NewStruct(generic)
+}
+
+// simulationReportToProto converts a rule simulation report to its protobuf message
+func simulationReportToProto(report *engines.SimulationReport) *api.SimulationReport {
+	message := &api.SimulationReport{
+		Since:             timestampToProto(report.Since),
+		RulesSimulated:    int32(report.RulesSimulated),
+		TasksEvaluated:    int32(report.TasksEvaluated),
+		RevisionsReplayed: int32(report.RevisionsReplayed),
+		ChangesReplayed:   int32(report.ChangesReplayed),
+	}
+	for _, violation := range report.Violations {
+		message.Violations = append(message.Violations, &api.SimulatedViolation{
+			Violation: &api.RuleViolation{
+				RuleId:   violation.RuleID,
+				Priority: int32(violation.Priority),
+				Message:  violation.Message,
+				Category: violation.Category,
+				Details:  violation.Details,
+			},
+			TaskId:     violation.TaskID,
+			TaskTitle:  violation.TaskTitle,
+			EventType:  violation.EventType,
+			FromColumn: violation.FromColumn,
+			ToColumn:   violation.ToColumn,
+			Commit:     violation.Commit,
+			OccurredAt: timestampToProto(violation.OccurredAt),
+		})
+	}
+	return message
+}
+
+// simulationReportFromProto converts a protobuf rule simulation report message to its Go type
+func simulationReportFromProto(message *api.SimulationReport) *engines.SimulationReport {
+	report := &engines.SimulationReport{
+		Since:             timestampFromProto(message.GetSince()),
+		RulesSimulated:    int(message.GetRulesSimulated()),
+		TasksEvaluated:    int(message.GetTasksEvaluated()),
+		RevisionsReplayed: int(message.GetRevisionsReplayed()),
+		ChangesReplayed:   int(message.GetChangesReplayed()),
+	}
+	for _, violation := range message.GetViolations() {
+		report.Violations = append(report.Violations, engines.SimulatedViolation{
+			RuleViolation: engines.RuleViolation{
+				RuleID:   violation.GetViolation().GetRuleId(),
+				Priority: int(violation.GetViolation().GetPriority()),
+				Message:  violation.GetViolation().GetMessage(),
+				Category: violation.GetViolation().GetCategory(),
+				Details:  violation.GetViolation().GetDetails(),
+			},
+			TaskID:     violation.GetTaskId(),
+			TaskTitle:  violation.GetTaskTitle(),
+			EventType:  violation.GetEventType(),
+			FromColumn: violation.GetFromColumn(),
+			ToColumn:   violation.GetToColumn(),
+			Commit:     violation.GetCommit(),
+			OccurredAt: timestampFromProto(violation.GetOccurredAt()),
+		})
+	}
+	return report
+}
+
+// boardValidationToProto converts a board validation to its protobuf message
+func boardValidationToProto(response task_manager.BoardValidationResponse) *api.BoardValidationResponse {
+	return &api.BoardValidationResponse{
+		IsValid:       response.IsValid,
+		GitRepoValid:  response.GitRepoValid,
+		ConfigValid:   response.ConfigValid,
+		DataIntegrity: response.DataIntegrity,
+		Issues:        response.Issues,
+		Warnings:      response.Warnings,
+	}
+}
+
+// boardValidationFromProto converts a protobuf board validation message to its Go type
+func boardValidationFromProto(response *api.BoardValidationResponse) task_manager.BoardValidationResponse {
+	return task_manager.BoardValidationResponse{
+		IsValid:       response.GetIsValid(),
+		GitRepoValid:  response.GetGitRepoValid(),
+		ConfigValid:   response.GetConfigValid(),
+		DataIntegrity: response.GetDataIntegrity(),
+		Issues:        response.GetIssues(),
+		Warnings:      response.GetWarnings(),
+	}
+}
+
+// boardRepairToProto converts a board repair to its protobuf message
+func boardRepairToProto(response task_manager.BoardRepairResponse) *api.BoardRepairResponse {
+	return &api.BoardRepairResponse{
+		Actions:    response.Actions,
+		Commit:     response.Commit,
+		Validation: boardValidationToProto(response.Validation),
+	}
+}
+
+// boardRepairFromProto converts a protobuf board repair message to its Go type
+func boardRepairFromProto(response *api.BoardRepairResponse) task_manager.BoardRepairResponse {
+	result := task_manager.BoardRepairResponse{
+		Actions: response.GetActions(),
+		Commit:  response.GetCommit(),
+	}
+	if response.GetValidation() != nil {
+		result.Validation = boardValidationFromProto(response.GetValidation())
+	}
+	return result
+}
+
+// boardMetadataToProto converts board metadata to its protobuf message
+func boardMetadataToProto(response task_manager.BoardMetadataResponse) *api.BoardMetadataResponse {
+	return &api.BoardMetadataResponse{
+		Title:         response.Title,
+		Description:   response.Description,
+		TaskCount:     int32(response.TaskCount),
+		ColumnCounts:  countsToProto(response.ColumnCounts),
+		SchemaVersion: response.SchemaVersion,
+		CreatedAt:     optionalTimestampToProto(response.CreatedAt),
+		ModifiedAt:    optionalTimestampToProto(response.ModifiedAt),
+		Metadata:      response.Metadata,
+		Columns:       boardColumnsToProto(response.Columns),
+	}
+}
+
+// boardMetadataFromProto converts a protobuf board metadata message to its Go type
+func boardMetadataFromProto(response *api.BoardMetadataResponse) task_manager.BoardMetadataResponse {
+	return task_manager.BoardMetadataResponse{
+		Title:         response.GetTitle(),
+		Description:   response.GetDescription(),
+		TaskCount:     int(response.GetTaskCount()),
+		ColumnCounts:  countsFromProto(response.GetColumnCounts()),
+		SchemaVersion: response.GetSchemaVersion(),
+		CreatedAt:     optionalTimestampFromProto(response.GetCreatedAt()),
+		ModifiedAt:    optionalTimestampFromProto(response.GetModifiedAt()),
+		Metadata:      response.GetMetadata(),
+		Columns:       boardColumnsFromProto(response.GetColumns()),
+	}
+}
+
+// boardColumnsToProto converts board columns to their protobuf messages
+func boardColumnsToProto(columns []task_manager.BoardColumn) []*api.BoardColumn {
+	var result []*api.BoardColumn
+	for _, column := range columns {
+		result = append(result, &api.BoardColumn{
+			Id:          column.ID,
+			Name:        column.Name,
+			Color:       column.Color,
+			WipLimit:    int32(column.WIPLimit),
+			Done:        column.Done,
+			EntryPolicy: column.EntryPolicy,
+			ExitPolicy:  column.ExitPolicy,
+		})
+	}
+	return result
+}
+
+// boardColumnsFromProto converts protobuf board column messages to their Go type
+func boardColumnsFromProto(columns []*api.BoardColumn) []task_manager.BoardColumn {
+	var result []task_manager.BoardColumn
+	for _, column := range columns {
+		result = append(result, task_manager.BoardColumn{
+			ID:          column.GetId(),
+			Name:        column.GetName(),
+			Color:       column.GetColor(),
+			WIPLimit:    int(column.GetWipLimit()),
+			Done:        column.GetDone(),
+			EntryPolicy: column.GetEntryPolicy(),
+			ExitPolicy:  column.GetExitPolicy(),
+		})
+	}
+	return result
+}
+
+// boardStatisticsToProto converts board statistics to its protobuf message
+func boardStatisticsToProto(stats *board_access.BoardStatistics) *api.BoardStatistics {
+	return &api.BoardStatistics{
+		TotalTasks:           int32(stats.TotalTasks),
+		ActiveTasks:          int32(stats.ActiveTasks),
+		CompletedTasks:       int32(stats.CompletedTasks),
+		TasksByColumn:        countsToProto(stats.TasksByColumn),
+		TasksByPriority:      countsToProto(stats.TasksByPriority),
+		AverageTaskAgeDays:   stats.AverageTaskAge,
+		OldestTaskAgeDays:    stats.OldestTaskAge,
+		AverageColumnAgeDays: stats.AverageColumnAge,
+		LastActivity:         optionalTimestampToProto(stats.LastActivity),
+		BoardHealthScore:     stats.BoardHealthScore,
+	}
+}
+
+// boardStatisticsFromProto converts a protobuf board statistics message to its Go type
+func boardStatisticsFromProto(stats *api.BoardStatistics) *board_access.BoardStatistics {
+	return &board_access.BoardStatistics{
+		TotalTasks:       int(stats.GetTotalTasks()),
+		ActiveTasks:      int(stats.GetActiveTasks()),
+		CompletedTasks:   int(stats.GetCompletedTasks()),
+		TasksByColumn:    countsFromProto(stats.GetTasksByColumn()),
+		TasksByPriority:  countsFromProto(stats.GetTasksByPriority()),
+		AverageTaskAge:   stats.GetAverageTaskAgeDays(),
+		OldestTaskAge:    stats.GetOldestTaskAgeDays(),
+		AverageColumnAge: stats.GetAverageColumnAgeDays(),
+		LastActivity:     optionalTimestampFromProto(stats.GetLastActivity()),
+		BoardHealthScore: stats.GetBoardHealthScore(),
+	}
+}
+
+// flowTimeToProto converts flow time statistics to its protobuf message
+func flowTimeToProto(stats board_access.FlowTimeStatistics) *api.FlowTimeStatistics {
+	return &api.FlowTimeStatistics{
+		Count:       int32(stats.Count),
+		AverageDays: stats.AverageDays,
+		MedianDays:  stats.MedianDays,
+		P85Days:     stats.P85Days,
+		MaxDays:     stats.MaxDays,
+	}
+}
+
+// flowTimeFromProto converts a protobuf flow time statistics message to its Go type
+func flowTimeFromProto(stats *api.FlowTimeStatistics) board_access.FlowTimeStatistics {
+	return board_access.FlowTimeStatistics{
+		Count:       int(stats.GetCount()),
+		AverageDays: stats.GetAverageDays(),
+		MedianDays:  stats.GetMedianDays(),
+		P85Days:     stats.GetP85Days(),
+		MaxDays:     stats.GetMaxDays(),
+	}
+}
+
+// flowTimeMapToProto converts flow time statistics by quadrant to protobuf messages
+func flowTimeMapToProto(stats map[string]board_access.FlowTimeStatistics) map[string]*api.FlowTimeStatistics {
+	result := make(map[string]*api.FlowTimeStatistics, len(stats))
+	for key, value := range stats {
+		result[key] = flowTimeToProto(value)
+	}
+	return result
+}
+
+// flowTimeMapFromProto converts protobuf flow time statistics by quadrant to their Go type
+func flowTimeMapFromProto(stats map[string]*api.FlowTimeStatistics) map[string]board_access.FlowTimeStatistics {
+	result := make(map[string]board_access.FlowTimeStatistics, len(stats))
+	for key, value := range stats {
+		result[key] = flowTimeFromProto(value)
+	}
+	return result
+}
+
+// flowMetricsToProto converts flow metrics to its protobuf message
+func flowMetricsToProto(metrics *board_access.FlowMetrics) *api.FlowMetrics {
+	result := &api.FlowMetrics{
+		From:                timestampToProto(metrics.From),
+		To:                  timestampToProto(metrics.To),
+		Columns:             metrics.Columns,
+		DoneColumn:          metrics.DoneColumn,
+		LeadTime:            flowTimeToProto(metrics.LeadTime),
+		CycleTime:           flowTimeToProto(metrics.CycleTime),
+		LeadTimeByQuadrant:  flowTimeMapToProto(metrics.LeadTimeByQuadrant),
+		CycleTimeByQuadrant: flowTimeMapToProto(metrics.CycleTimeByQuadrant),
+	}
+	for _, task := range metrics.Tasks {
+		result.Tasks = append(result.Tasks, &api.TaskFlow{
+			TaskId:        task.TaskID,
+			Title:         task.Title,
+			Quadrant:      task.Quadrant,
+			Column:        task.Column,
+			CreatedAt:     timestampToProto(task.CreatedAt),
+			StartedAt:     optionalTimestampToProto(task.StartedAt),
+			CompletedAt:   optionalTimestampToProto(task.CompletedAt),
+			LeadTimeDays:  task.LeadTimeDays,
+			CycleTimeDays: task.CycleTimeDays,
+		})
+	}
+	for _, period := range metrics.Throughput {
+		result.Throughput = append(result.Throughput, &api.ThroughputPeriod{
+			WeekStart:  timestampToProto(period.WeekStart),
+			Completed:  int32(period.Completed),
+			ByQuadrant: countsToProto(period.ByQuadrant),
+		})
+	}
+	for _, point := range metrics.CumulativeFlow {
+		quadrants := make(map[string]*api.ColumnCounts, len(point.Quadrants))
+		for quadrant, columns := range point.Quadrants {
+			quadrants[quadrant] = &api.ColumnCounts{Columns: countsToProto(columns)}
+		}
+		result.CumulativeFlow = append(result.CumulativeFlow, &api.CumulativeFlowPoint{
+			Date:          timestampToProto(point.Date),
+			Columns:       countsToProto(point.Columns),
+			Quadrants:     quadrants,
+			Wip:           int32(point.WIP),
+			WipByQuadrant: countsToProto(point.WIPByQuadrant),
+		})
+	}
+	return result
+}
+
+// flowMetricsFromProto converts a protobuf flow metrics message to its Go type
+func flowMetricsFromProto(metrics *api.FlowMetrics) *board_access.FlowMetrics {
+	result := &board_access.FlowMetrics{
+		From:                timestampFromProto(metrics.GetFrom()),
+		To:                  timestampFromProto(metrics.GetTo()),
+		Columns:             metrics.GetColumns(),
+		DoneColumn:          metrics.GetDoneColumn(),
+		LeadTime:            flowTimeFromProto(metrics.GetLeadTime()),
+		CycleTime:           flowTimeFromProto(metrics.GetCycleTime()),
+		LeadTimeByQuadrant:  flowTimeMapFromProto(metrics.GetLeadTimeByQuadrant()),
+		CycleTimeByQuadrant: flowTimeMapFromProto(metrics.GetCycleTimeByQuadrant()),
+		Tasks:               make([]board_access.TaskFlow, 0, len(metrics.GetTasks())),
+		Throughput:          make([]board_access.ThroughputPeriod, 0, len(metrics.GetThroughput())),
+		CumulativeFlow:      make([]board_access.CumulativeFlowPoint, 0, len(metrics.GetCumulativeFlow())),
+	}
+	for _, task := range metrics.GetTasks() {
+		result.Tasks = append(result.Tasks, board_access.TaskFlow{
+			TaskID:        task.GetTaskId(),
+			Title:         task.GetTitle(),
+			Quadrant:      task.GetQuadrant(),
+			Column:        task.GetColumn(),
+			CreatedAt:     timestampFromProto(task.GetCreatedAt()),
+			StartedAt:     optionalTimestampFromProto(task.GetStartedAt()),
+			CompletedAt:   optionalTimestampFromProto(task.GetCompletedAt()),
+			LeadTimeDays:  task.GetLeadTimeDays(),
+			CycleTimeDays: task.GetCycleTimeDays(),
+		})
+	}
+	for _, period := range metrics.GetThroughput() {
+		result.Throughput = append(result.Throughput, board_access.ThroughputPeriod{
+			WeekStart:  timestampFromProto(period.GetWeekStart()),
+			Completed:  int(period.GetCompleted()),
+			ByQuadrant: countsFromProto(period.GetByQuadrant()),
+		})
+	}
+	for _, point := range metrics.GetCumulativeFlow() {
+		quadrants := make(map[string]map[string]int, len(point.GetQuadrants()))
+		for quadrant, columns := range point.GetQuadrants() {
+			quadrants[quadrant] = countsFromProto(columns.GetColumns())
+		}
+		result.CumulativeFlow = append(result.CumulativeFlow, board_access.CumulativeFlowPoint{
+			Date:          timestampFromProto(point.GetDate()),
+			Columns:       countsFromProto(point.GetColumns()),
+			Quadrants:     quadrants,
+			WIP:           int(point.GetWip()),
+			WIPByQuadrant: countsFromProto(point.GetWipByQuadrant()),
+		})
+	}
+	return result
+}
+
+// contextDataToProto converts context data to its protobuf message
+func contextDataToProto(data task_manager.ContextData) (*api.ContextData, error) {
+	// A JSON round trip reduces arbitrary Go values to the types a Struct can hold
+	var generic map[string]interface{}
+	if data.Data != nil {
+		encoded, err := json.Marshal(data.Data)
+		if err != nil {
+			return nil, fmt.Errorf("context data is not serializable: %w", err)
+		}
+		if err := json.Unmarshal(encoded, &generic); err != nil {
+			return nil, fmt.Errorf("context data is not serializable: %w", err)
+		}
+	}
+	structData, err := structpb.NewStruct(generic)
+	if err != nil {
+		return nil, fmt.Errorf("context data is not serializable: %w", err)
+	}
+	return &api.ContextData{Type: data.Type, Version: data.Version, Data: structData, Metadata: data.Metadata}, nil
+}
+
+// contextDataFromProto converts a protobuf context data message to its Go type
+func contextDataFromProto(data *api.ContextData) task_manager.ContextData {
+	return task_manager.ContextData{
+		Type:     data.GetType(),
+		Version:  data.GetVersion(),
+		Data:     data.GetData().AsMap(),
+		Metadata: data.GetMetadata(),
+	}
+}
+
+// undoResponseToProto converts an undo response to its protobuf message
+func undoResponseToProto(response task_manager.UndoResponse) *api.UndoResponse {
+	return &api.UndoResponse{
+		Description:    response.Description,
+		Commit:         response.Commit,
+		ChangedTaskIds: response.ChangedTaskIDs,
+		CanUndo:        response.CanUndo,
+		CanRedo:        response.CanRedo,
+	}
+}
+
+// undoResponseFromProto converts an undo response message back to TaskManager format
+func undoResponseFromProto(response *api.UndoResponse) task_manager.UndoResponse {
+	return task_manager.UndoResponse{
+		Description:    response.GetDescription(),
+		Commit:         response.GetCommit(),
+		ChangedTaskIDs: response.GetChangedTaskIds(),
+		CanUndo:        response.GetCanUndo(),
+		CanRedo:        response.GetCanRedo(),
+	}
+}
+
+// boardRevisionToProto converts a board revision to its protobuf message
+func boardRevisionToProto(revision task_manager.BoardRevision) *api.BoardRevision {
+	return &api.BoardRevision{
+		Commit:    revision.Commit,
+		Author:    revision.Author,
+		Timestamp: timestampToProto(revision.Timestamp),
+		Message:   revision.Message,
+	}
+}
+
+// boardRevisionFromProto converts a protobuf board revision message to its Go type
+func boardRevisionFromProto(revision *api.BoardRevision) task_manager.BoardRevision {
+	return task_manager.BoardRevision{
+		Commit:    revision.GetCommit(),
+		Author:    revision.GetAuthor(),
+		Timestamp: timestampFromProto(revision.GetTimestamp()),
+		Message:   revision.GetMessage(),
+	}
+}
+
+// boardRevisionListToProto converts board revisions to their protobuf message
+func boardRevisionListToProto(revisions []task_manager.BoardRevision) *api.BoardRevisionList {
+	list := &api.BoardRevisionList{Revisions: make([]*api.BoardRevision, 0, len(revisions))}
+	for _, revision := range revisions {
+		list.Revisions = append(list.Revisions, boardRevisionToProto(revision))
+	}
+	return list
+}
+
+// boardRevisionListFromProto converts a protobuf board revision list message to its Go type
+func boardRevisionListFromProto(list *api.BoardRevisionList) []task_manager.BoardRevision {
+	revisions := make([]task_manager.BoardRevision, 0, len(list.GetRevisions()))
+	for _, revision := range list.GetRevisions() {
+		revisions = append(revisions, boardRevisionFromProto(revision))
+	}
+	return revisions
+}
+
+// taskRevisionListToProto converts task revisions to their protobuf message
+func taskRevisionListToProto(revisions []task_manager.TaskRevision) *api.TaskRevisionList {
+	list := &api.TaskRevisionList{Revisions: make([]*api.TaskRevision, 0, len(revisions))}
+	for _, revision := range revisions {
+		message := &api.TaskRevision{
+			Revision:  boardRevisionToProto(revision.Revision),
+			Operation: revision.Operation,
+			Changes:   make([]*api.TaskFieldChange, 0, len(revision.Changes)),
+		}
+		for _, change := range revision.Changes {
+			message.Changes = append(message.Changes, &api.TaskFieldChange{Field: change.Field, Before: change.Before, After: change.After})
+		}
+		list.Revisions = append(list.Revisions, message)
+	}
+	return list
+}
+
+// taskRevisionListFromProto converts a protobuf task revision list message to its Go type
+func taskRevisionListFromProto(list *api.TaskRevisionList) []task_manager.TaskRevision {
+	revisions := make([]task_manager.TaskRevision, 0, len(list.GetRevisions()))
+	for _, message := range list.GetRevisions() {
+		revision := task_manager.TaskRevision{
+			Revision:  boardRevisionFromProto(message.GetRevision()),
+			Operation: message.GetOperation(),
+		}
+		for _, change := range message.GetChanges() {
+			revision.Changes = append(revision.Changes, task_manager.TaskFieldChange{Field: change.GetField(), Before: change.GetBefore(), After: change.GetAfter()})
+		}
+		revisions = append(revisions, revision)
+	}
+	return revisions
+}
+
+// boardSnapshotToProto converts a board snapshot to its protobuf message
+func boardSnapshotToProto(snapshot task_manager.BoardSnapshotResponse) *api.BoardSnapshot {
+	return &api.BoardSnapshot{
+		Revision:      boardRevisionToProto(snapshot.Revision),
+		Tasks:         taskListToProto(snapshot.Tasks).GetTasks(),
+		ArchivedTasks: archivedTaskListToProto(snapshot.ArchivedTasks).GetTasks(),
+	}
+}
+
+// boardSnapshotFromProto converts a protobuf board snapshot message to its Go type
+func boardSnapshotFromProto(snapshot *api.BoardSnapshot) task_manager.BoardSnapshotResponse {
+	response := task_manager.BoardSnapshotResponse{
+		Revision: boardRevisionFromProto(snapshot.GetRevision()),
+		Tasks:    taskListFromProto(&api.TaskList{Tasks: snapshot.GetTasks()}),
+	}
+	if len(snapshot.GetArchivedTasks()) > 0 {
+		response.ArchivedTasks = archivedTaskListFromProto(&api.ArchivedTaskList{Tasks: snapshot.GetArchivedTasks()})
+	}
+	return response
+}
@@ -0,0 +1,535 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/rknuus/eisenkan/api"
+	"github.com/rknuus/eisenkan/internal/engines"
+	"github.com/rknuus/eisenkan/internal/managers/task_manager"
+	"github.com/rknuus/eisenkan/internal/resource_access"
+	"github.com/rknuus/eisenkan/internal/resource_access/board_access"
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+const wipLimitRules = `{"version":"1.0","rules":[{"id":"wip-limit","name":"WIP limit","category":"validation","trigger_type":"all","conditions":{"max_wip_limit":1},"priority":1,"enabled":true}]}`
+
+// newTestConnection serves a fresh board over an in-memory gRPC connection, optionally with the given rules.json content
+func newTestConnection(t *testing.T, rules string) *grpc.ClientConn {
+	t.Helper()
+	boardPath := t.TempDir()
+	if rules != "" {
+		if err := os.WriteFile(filepath.Join(boardPath, "rules.json"), []byte(rules), 0644); err != nil {
+			t.Fatalf("Failed to write rules: %v", err)
+		}
+	}
+
+	logger := utilities.NewLoggingUtility()
+	repository, err := utilities.InitializeRepositoryWithConfig(boardPath, &utilities.AuthorConfiguration{User: "Test", Email: "test@example.com"})
+	if err != nil {
+		t.Fatalf("Failed to initialize repository: %v", err)
+	}
+	boardAccess, err := board_access.NewBoardAccess(boardPath)
+	if err != nil {
+		t.Fatalf("Failed to create BoardAccess: %v", err)
+	}
+	rulesAccess, err := resource_access.NewRulesAccess(boardPath)
+	if err != nil {
+		t.Fatalf("Failed to create RulesAccess: %v", err)
+	}
+	ruleEngine, err := engines.NewRuleEngine(rulesAccess, boardAccess)
+	if err != nil {
+		t.Fatalf("Failed to create RuleEngine: %v", err)
+	}
+	taskManager := task_manager.NewTaskManager(boardAccess, ruleEngine, logger, repository, boardPath)
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	api.RegisterTaskManagerServiceServer(server, NewServer(taskManager, boardPath, logger))
+	go server.Serve(listener)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	t.Cleanup(func() {
+		conn.Close()
+		server.Stop()
+		ruleEngine.Close()
+		rulesAccess.Close()
+		boardAccess.Close()
+		repository.Close()
+	})
+	return conn
+}
+
+func TestUnit_Conversion_TaskRoundTrip(t *testing.T) {
+	deadline := time.Date(2025, 6, 30, 12, 0, 0, 0, time.Local)
+	parent := "parent-1"
+	request := task_manager.TaskRequest{
+		Description:    "Write report",
+		Priority:       board_access.Priority{Urgent: true, Important: true, Label: "urgent-important"},
+		WorkflowStatus: task_manager.Todo,
+		Tags:           []string{"work"},
+		Deadline:       &deadline,
+		ParentTaskID:   &parent,
+	}
+
+	converted := taskRequestFromProto(taskRequestToProto(request))
+	if converted.Description != request.Description || converted.Priority != request.Priority || converted.WorkflowStatus != request.WorkflowStatus {
+		t.Errorf("Expected %+v, got %+v", request, converted)
+	}
+	if converted.Deadline == nil || !converted.Deadline.Equal(deadline) {
+		t.Errorf("Expected deadline %v, got %v", deadline, converted.Deadline)
+	}
+	if converted.PriorityPromotionDate != nil {
+		t.Errorf("Expected no promotion date, got %v", converted.PriorityPromotionDate)
+	}
+	if converted.ParentTaskID == nil || *converted.ParentTaskID != parent {
+		t.Errorf("Expected parent %q, got %v", parent, converted.ParentTaskID)
+	}
+
+	request.ParentTaskID = nil
+	if converted := taskRequestFromProto(taskRequestToProto(request)); converted.ParentTaskID != nil {
+		t.Errorf("Expected no parent, got %q", *converted.ParentTaskID)
+	}
+}
+
+func TestUnit_Conversion_ContextData(t *testing.T) {
+	data := task_manager.ContextData{
+		Type:     "ui",
+		Version:  "1",
+		Data:     map[string]interface{}{"window": map[string]interface{}{"width": 1024}, "boards": []string{"a", "b"}},
+		Metadata: map[string]string{"source": "test"},
+	}
+
+	message, err := contextDataToProto(data)
+	if err != nil {
+		t.Fatalf("Failed to convert context data: %v", err)
+	}
+	converted := contextDataFromProto(message)
+	if converted.Type != "ui" || converted.Version != "1" || converted.Metadata["source"] != "test" {
+		t.Errorf("Expected context fields to round trip, got %+v", converted)
+	}
+	window, ok := converted.Data["window"].(map[string]interface{})
+	if !ok || window["width"] != float64(1024) {
+		t.Errorf("Expected nested data to round trip, got %+v", converted.Data)
+	}
+}
+
+func TestUnit_Conversion_ConflictedTaskEvent(t *testing.T) {
+	event := task_manager.TaskEvent{
+		Type:       task_manager.TaskConflicted,
+		TaskID:     "task-1",
+		OccurredAt: time.Date(2025, 6, 30, 12, 0, 0, 0, time.UTC),
+		Conflicts: []task_manager.TaskFieldConflict{{
+			TaskID: "task-1", Field: "title", LocalValue: "A", RemoteValue: "B",
+			Resolution: task_manager.ConflictUnresolved, Reason: "changed at the same time, kept local",
+		}},
+	}
+
+	converted := taskEventFromProto(taskEventToProto(event))
+	if converted.Type != event.Type || len(converted.Conflicts) != 1 || converted.Conflicts[0] != event.Conflicts[0] {
+		t.Errorf("Expected %+v, got %+v", event, converted)
+	}
+}
+
+func TestUnit_Conversion_NotifiedTaskEvent(t *testing.T) {
+	event := task_manager.TaskEvent{
+		Type:       task_manager.RuleNotified,
+		TaskID:     "task-1",
+		OccurredAt: time.Date(2025, 6, 30, 12, 0, 0, 0, time.UTC),
+		Notification: &task_manager.RuleNotification{
+			RuleID: "due-soon", RuleName: "Due Soon", TriggerType: "due_date", Message: "Task 'Report' is due on 2025-07-01",
+		},
+	}
+
+	converted := taskEventFromProto(taskEventToProto(event))
+	if converted.Type != event.Type || converted.Notification == nil || *converted.Notification != *event.Notification {
+		t.Errorf("Expected %+v, got %+v", event, converted)
+	}
+}
+
+func TestUnit_Conversion_BoardColumns(t *testing.T) {
+	response := task_manager.BoardMetadataResponse{
+		Title: "Kanban",
+		Columns: []task_manager.BoardColumn{
+			{ID: "doing", Name: "In Progress", Color: "orange", WIPLimit: 3, EntryPolicy: "Assigned", ExitPolicy: "Reviewed"},
+			{ID: "done", Done: true},
+		},
+	}
+
+	converted := boardMetadataFromProto(boardMetadataToProto(response))
+	if len(converted.Columns) != 2 || converted.Columns[0] != response.Columns[0] || converted.Columns[1] != response.Columns[1] {
+		t.Errorf("Expected columns %+v, got %+v", response.Columns, converted.Columns)
+	}
+}
+
+func TestIntegration_RPC_TaskLifecycle(t *testing.T) {
+	client := NewTaskManagerClient(newTestConnection(t, ""))
+
+	created, err := client.CreateTask(task_manager.TaskRequest{
+		Description:    "Remote task",
+		Priority:       board_access.Priority{Urgent: true, Important: true, Label: "urgent-important"},
+		WorkflowStatus: task_manager.Todo,
+		Tags:           []string{"remote"},
+	})
+	if err != nil {
+		t.Fatalf("CreateTask failed: %v", err)
+	}
+	if created.ID == "" || created.Description != "Remote task" || created.CreatedAt.IsZero() {
+		t.Fatalf("Expected a stored task, got %+v", created)
+	}
+
+	fetched, err := client.GetTask(created.ID)
+	if err != nil {
+		t.Fatalf("GetTask failed: %v", err)
+	}
+	if !fetched.UpdatedAt.Equal(created.UpdatedAt) {
+		t.Errorf("Expected timestamps to survive the round trip, got %v and %v", created.UpdatedAt, fetched.UpdatedAt)
+	}
+
+	moved, err := client.ChangeTaskStatus(created.ID, task_manager.InProgress, nil)
+	if err != nil {
+		t.Fatalf("ChangeTaskStatus failed: %v", err)
+	}
+	if moved.WorkflowStatus != task_manager.InProgress {
+		t.Errorf("Expected status %q, got %q", task_manager.InProgress, moved.WorkflowStatus)
+	}
+
+	tasks, err := client.ListTasks(task_manager.QueryCriteria{Columns: []string{string(task_manager.InProgress)}})
+	if err != nil {
+		t.Fatalf("ListTasks failed: %v", err)
+	}
+	if len(tasks) != 1 || tasks[0].ID != created.ID {
+		t.Errorf("Expected the moved task in the doing column, got %+v", tasks)
+	}
+
+	// The expected update time reaches the TaskManager, and a mismatch comes back as ErrTaskModified
+	err = client.DeleteTask(created.ID, &created.UpdatedAt)
+	if !errors.Is(err, task_manager.ErrTaskModified) || errors.Is(err, board_access.ErrModifiedExternally) {
+		t.Errorf("Expected ErrTaskModified for a stale update time, got %v", err)
+	}
+	if err := client.DeleteTask(created.ID, &moved.UpdatedAt); err != nil {
+		t.Fatalf("DeleteTask failed: %v", err)
+	}
+	if _, err := client.GetTask(created.ID); !errors.Is(err, task_manager.ErrTaskNotFound) {
+		t.Errorf("Expected ErrTaskNotFound after deletion, got %v", err)
+	}
+}
+
+func TestIntegration_RPC_BoardSync(t *testing.T) {
+	client := NewTaskManagerClient(newTestConnection(t, ""))
+	remotePath := filepath.Join(t.TempDir(), "remote.git")
+	if _, err := git.PlainInit(remotePath, true); err != nil {
+		t.Fatalf("Failed to create bare repository: %v", err)
+	}
+
+	if err := client.AddBoardRemote("origin", remotePath); err != nil {
+		t.Fatalf("AddBoardRemote failed: %v", err)
+	}
+	remotes, err := client.ListBoardRemotes()
+	if err != nil || len(remotes) != 1 || remotes[0].Name != "origin" || remotes[0].URL != remotePath {
+		t.Fatalf("Expected the origin remote, got %+v (%v)", remotes, err)
+	}
+
+	if _, err := client.CreateTask(task_manager.TaskRequest{Description: "Shared", Priority: board_access.Priority{Urgent: true, Important: true}, WorkflowStatus: task_manager.Todo}); err != nil {
+		t.Fatalf("CreateTask failed: %v", err)
+	}
+	response, err := client.SyncBoard("")
+	if err != nil {
+		t.Fatalf("SyncBoard failed: %v", err)
+	}
+	if response.Remote != "origin" || !response.UpToDate {
+		t.Errorf("Expected an up to date sync pushing to origin, got %+v", response)
+	}
+
+	if err := client.RemoveBoardRemote("origin"); err != nil {
+		t.Fatalf("RemoveBoardRemote failed: %v", err)
+	}
+	if _, err := client.SyncBoard("origin"); err == nil {
+		t.Error("Expected syncing with a removed remote to fail")
+	}
+}
+
+func TestIntegration_RPC_UndoRedo(t *testing.T) {
+	client := NewTaskManagerClient(newTestConnection(t, ""))
+
+	if _, err := client.Redo(); !errors.Is(err, task_manager.ErrNothingToRedo) {
+		t.Errorf("Expected ErrNothingToRedo, got %v", err)
+	}
+
+	created, err := client.CreateTask(task_manager.TaskRequest{Description: "Undone", Priority: board_access.Priority{Urgent: true, Important: true}, WorkflowStatus: task_manager.Todo})
+	if err != nil {
+		t.Fatalf("CreateTask failed: %v", err)
+	}
+	response, err := client.Undo()
+	if err != nil {
+		t.Fatalf("Undo failed: %v", err)
+	}
+	if response.Commit == "" || response.CanUndo || !response.CanRedo || len(response.ChangedTaskIDs) != 1 || response.ChangedTaskIDs[0] != created.ID {
+		t.Errorf("Expected the creation to be undone, got %+v", response)
+	}
+	if _, err := client.Undo(); !errors.Is(err, task_manager.ErrNothingToUndo) {
+		t.Errorf("Expected ErrNothingToUndo, got %v", err)
+	}
+
+	if _, err := client.Redo(); err != nil {
+		t.Fatalf("Redo failed: %v", err)
+	}
+	if _, err := client.GetTask(created.ID); err != nil {
+		t.Errorf("Expected the task to be back, got %v", err)
+	}
+}
+
+func TestIntegration_RPC_BoardHistory(t *testing.T) {
+	client := NewTaskManagerClient(newTestConnection(t, ""))
+
+	created, err := client.CreateTask(task_manager.TaskRequest{Description: "First draft", Priority: board_access.Priority{Urgent: true, Important: true}, WorkflowStatus: task_manager.Todo})
+	if err != nil {
+		t.Fatalf("CreateTask failed: %v", err)
+	}
+	revisions, err := client.ListBoardRevisions(0)
+	if err != nil || len(revisions) == 0 || revisions[0].Commit == "" || revisions[0].Timestamp.IsZero() {
+		t.Fatalf("Expected board revisions, got %+v (%v)", revisions, err)
+	}
+	if _, err := client.UpdateTask(created.ID, task_manager.TaskRequest{Description: "Second draft", Priority: created.Priority, WorkflowStatus: task_manager.Todo}, nil); err != nil {
+		t.Fatalf("UpdateTask failed: %v", err)
+	}
+
+	snapshot, err := client.LoadBoardAt(revisions[0].Commit, time.Time{})
+	if err != nil {
+		t.Fatalf("LoadBoardAt failed: %v", err)
+	}
+	if snapshot.Revision.Commit != revisions[0].Commit || len(snapshot.Tasks) != 1 || snapshot.Tasks[0].Description != "First draft" {
+		t.Errorf("Expected the first draft in the snapshot, got %+v", snapshot)
+	}
+
+	restored, err := client.RestoreTaskFrom(revisions[0].Commit, created.ID)
+	if err != nil || restored.Description != "First draft" {
+		t.Errorf("Expected the first draft to be restored, got %+v (%v)", restored, err)
+	}
+
+	history, err := client.GetTaskHistory(created.ID, 0)
+	if err != nil {
+		t.Fatalf("GetTaskHistory failed: %v", err)
+	}
+	if len(history) != 3 || history[0].Operation != "updated" || history[2].Operation != "created" || history[0].Revision.Commit == "" {
+		t.Fatalf("Expected the restore, the update and the creation, got %+v", history)
+	}
+	if len(history[0].Changes) == 0 || history[0].Changes[0] != (task_manager.TaskFieldChange{Field: "title", Before: "Second draft", After: "First draft"}) {
+		t.Errorf("Expected the restore to change the title back, got %+v", history[0].Changes)
+	}
+}
+
+func TestIntegration_RPC_SimulateRules(t *testing.T) {
+	client := NewTaskManagerClient(newTestConnection(t, ""))
+
+	first, err := client.CreateTask(task_manager.TaskRequest{Description: "First", Priority: board_access.Priority{Important: true}, WorkflowStatus: task_manager.Todo})
+	if err != nil {
+		t.Fatalf("CreateTask failed: %v", err)
+	}
+	second, err := client.CreateTask(task_manager.TaskRequest{Description: "Second", Priority: board_access.Priority{Important: true}, WorkflowStatus: task_manager.Todo})
+	if err != nil {
+		t.Fatalf("CreateTask failed: %v", err)
+	}
+	if _, err := client.ChangeTaskStatus(first.ID, task_manager.InProgress, nil); err != nil {
+		t.Fatalf("ChangeTaskStatus failed: %v", err)
+	}
+
+	// The candidate rules would have rejected the second task and the move of the first one
+	candidate := &resource_access.RuleSet{Version: "1.0", Rules: []resource_access.Rule{
+		{ID: "wip-limit", Name: "WIP limit", Category: "validation", TriggerType: "task_create", Conditions: map[string]interface{}{"max_wip_limit": 1},
+			Actions: map[string]interface{}{"block": true}, Priority: 1},
+		{ID: "todo-to-done", Name: "Todo to done", Category: "workflow", TriggerType: "task_transition",
+			Conditions: map[string]interface{}{"allowed_transitions": []interface{}{"todo->done"}}, Actions: map[string]interface{}{"block": true}, Priority: 2},
+	}}
+	report, err := client.SimulateRules(candidate, time.Time{})
+	if err != nil {
+		t.Fatalf("SimulateRules failed: %v", err)
+	}
+	if report.RulesSimulated != 2 || report.TasksEvaluated != 2 || report.ChangesReplayed != 3 || len(report.Violations) != 2 {
+		t.Fatalf("Expected two violations among three replayed changes, got %+v", report)
+	}
+	created, moved := report.Violations[0], report.Violations[1]
+	if created.RuleID != "wip-limit" || created.TaskID != second.ID || created.EventType != "task_create" || created.Commit == "" || created.OccurredAt.IsZero() {
+		t.Errorf("Expected the second task to violate the WIP limit, got %+v", created)
+	}
+	if moved.RuleID != "todo-to-done" || moved.TaskID != first.ID || moved.FromColumn != "todo" || moved.ToColumn != "doing" {
+		t.Errorf("Expected the move of the first task to violate the transition rule, got %+v", moved)
+	}
+
+	// The board's own rules are simulated without a candidate, and invalid candidates are rejected
+	if report, err := client.SimulateRules(nil, time.Now()); err != nil || report.RulesSimulated != 0 || report.ChangesReplayed != 0 {
+		t.Errorf("Expected nothing to simulate for the board rules, got %+v (%v)", report, err)
+	}
+	if _, err := client.SimulateRules(&resource_access.RuleSet{Rules: []resource_access.Rule{{ID: "incomplete"}}}, time.Time{}); err == nil {
+		t.Error("Expected an invalid rule set to be rejected")
+	}
+}
+
+func TestIntegration_RPC_ExecuteBatch(t *testing.T) {
+	client := NewTaskManagerClient(newTestConnection(t, ""))
+
+	var taskIDs []string
+	for _, description := range []string{"First", "Second"} {
+		created, err := client.CreateTask(task_manager.TaskRequest{Description: description, Priority: board_access.Priority{Urgent: true, Important: true}, WorkflowStatus: task_manager.Todo})
+		if err != nil {
+			t.Fatalf("CreateTask failed: %v", err)
+		}
+		taskIDs = append(taskIDs, created.ID)
+	}
+
+	response, err := client.ExecuteBatch(task_manager.BatchRequest{Operation: task_manager.BatchChangePriority, TaskIDs: taskIDs, Priority: board_access.Priority{Important: true}})
+	if err != nil {
+		t.Fatalf("ExecuteBatch failed: %v", err)
+	}
+	if response.Commit == "" || len(response.Tasks) != 2 || response.Tasks[1].Priority.Label != "not-urgent-important" {
+		t.Errorf("Expected both tasks with the new priority, got %+v", response)
+	}
+
+	_, err = client.ExecuteBatch(task_manager.BatchRequest{Operation: task_manager.BatchArchive, TaskIDs: []string{taskIDs[0], "missing"}, CascadePolicy: board_access.ArchiveSubtasks})
+	var batchErr *task_manager.BatchError
+	if !errors.As(err, &batchErr) || batchErr.TaskID != "missing" || !errors.Is(err, task_manager.ErrTaskNotFound) {
+		t.Fatalf("Expected a BatchError for the missing task, got %v", err)
+	}
+	if _, err := client.GetTask(taskIDs[0]); err != nil {
+		t.Errorf("Expected the first task to stay on the board, got %v", err)
+	}
+}
+
+func TestIntegration_RPC_RuleViolations(t *testing.T) {
+	client := NewTaskManagerClient(newTestConnection(t, wipLimitRules))
+
+	request := task_manager.TaskRequest{
+		Description:    "First",
+		Priority:       board_access.Priority{Urgent: true, Important: true, Label: "urgent-important"},
+		WorkflowStatus: task_manager.InProgress,
+	}
+	if _, err := client.CreateTask(request); err != nil {
+		t.Fatalf("CreateTask failed: %v", err)
+	}
+
+	request.Description = "Second"
+	_, err := client.CreateTask(request)
+	var violationErr *task_manager.RuleViolationError
+	if !errors.As(err, &violationErr) {
+		t.Fatalf("Expected a RuleViolationError, got %v", err)
+	}
+	if len(violationErr.Violations) == 0 || violationErr.Violations[0].RuleID != "wip-limit" {
+		t.Errorf("Expected the WIP limit violation, got %+v", violationErr.Violations)
+	}
+
+	result, err := client.ValidateTask(request)
+	if err != nil {
+		t.Fatalf("ValidateTask failed: %v", err)
+	}
+	if result.Valid || len(result.Violations) == 0 {
+		t.Errorf("Expected the dry run to report violations, got %+v", result)
+	}
+}
+
+func TestIntegration_RPC_InvalidArguments(t *testing.T) {
+	service := api.NewTaskManagerServiceClient(newTestConnection(t, ""))
+
+	_, err := service.ChangeTaskStatus(context.Background(), &api.ChangeTaskStatusRequest{TaskId: "x", WorkflowStatus: "blocked"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected INVALID_ARGUMENT for an unknown status, got %v", err)
+	}
+
+	_, err = service.UpdateTask(context.Background(), &api.UpdateTaskRequest{TaskId: "x"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected INVALID_ARGUMENT for a missing task, got %v", err)
+	}
+
+	_, err = service.GetTask(context.Background(), &api.TaskIdentifier{TaskId: "missing"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NOT_FOUND for an unknown task, got %v", err)
+	}
+}
+
+func TestIntegration_RPC_BoardPathsPinnedToServedBoard(t *testing.T) {
+	conn := newTestConnection(t, "")
+	service := api.NewTaskManagerServiceClient(conn)
+
+	// An empty path names the served board
+	if _, err := service.GetBoardStatistics(context.Background(), &api.BoardPath{}); err != nil {
+		t.Errorf("Expected statistics of the served board, got %v", err)
+	}
+
+	// Other directories of the server cannot be reached
+	other := t.TempDir()
+	if _, err := service.GetBoardMetadata(context.Background(), &api.BoardPath{BoardPath: other}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PERMISSION_DENIED for another board, got %v", err)
+	}
+	_, err := service.UpdateBoardMetadata(context.Background(), &api.UpdateBoardMetadataRequest{
+		BoardPath: other,
+		Metadata:  &api.BoardMetadataRequest{Title: "Taken over"},
+	})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PERMISSION_DENIED for updating another board, got %v", err)
+	}
+
+	// Boards are neither created nor deleted remotely
+	client := NewTaskManagerClient(conn)
+	if _, err := client.CreateBoard(task_manager.BoardCreationRequest{BoardPath: other}); !errors.Is(err, ErrLocalBoardOperation) {
+		t.Errorf("Expected ErrLocalBoardOperation for creating a board, got %v", err)
+	}
+	if _, err := client.DeleteBoard(task_manager.BoardDeletionRequest{BoardPath: other}); !errors.Is(err, ErrLocalBoardOperation) {
+		t.Errorf("Expected ErrLocalBoardOperation for deleting a board, got %v", err)
+	}
+}
+
+func TestIntegration_RPC_TaskEventStream(t *testing.T) {
+	client := NewTaskManagerClient(newTestConnection(t, ""))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := client.SubscribeTaskEvents(ctx)
+
+	// The subscription is registered asynchronously on the server, so retry until an event arrives
+	var event task_manager.TaskEvent
+	deadline := time.After(5 * time.Second)
+	for received := false; !received; {
+		_, err := client.CreateTask(task_manager.TaskRequest{
+			Description:    "Streamed task",
+			Priority:       board_access.Priority{Urgent: true, Important: true, Label: "urgent-important"},
+			WorkflowStatus: task_manager.Todo,
+		})
+		if err != nil {
+			t.Fatalf("CreateTask failed: %v", err)
+		}
+		select {
+		case event = <-events:
+			received = true
+		case <-time.After(100 * time.Millisecond):
+		case <-deadline:
+			t.Fatal("Timed out waiting for a streamed event")
+		}
+	}
+
+	if event.Type != task_manager.TaskCreated || event.Task == nil || event.Task.Description != "Streamed task" {
+		t.Errorf("Expected a created event with the task, got %+v", event)
+	}
+
+	cancel()
+	for range events {
+		// Drain until the client closes the channel
+	}
+}
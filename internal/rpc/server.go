@@ -0,0 +1,450 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/rknuus/eisenkan/api"
+	"github.com/rknuus/eisenkan/internal/managers/task_manager"
+	"github.com/rknuus/eisenkan/internal/resource_access/board_access"
+	"github.com/rknuus/eisenkan/internal/utilities"
+)
+
+// Server implements api.TaskManagerServiceServer on top of a TaskManager
+type Server struct {
+	api.UnimplementedTaskManagerServiceServer
+
+	taskManager task_manager.TaskManager
+	boardPath   string
+	logger      utilities.ILoggingUtility
+}
+
+// NewServer creates the gRPC service for a TaskManager serving the board at boardPath; board operations cannot
+// reach other boards
+func NewServer(taskManager task_manager.TaskManager, boardPath string, logger utilities.ILoggingUtility) *Server {
+	return &Server{taskManager: taskManager, boardPath: boardPath, logger: logger}
+}
+
+// CreateTask implements api.TaskManagerServiceServer
+func (s *Server) CreateTask(ctx context.Context, request *api.TaskRequest) (*api.TaskResponse, error) {
+	task, err := s.taskManager.CreateTask(taskRequestFromProto(request))
+	if err != nil {
+		return nil, s.toStatus("CreateTask", err)
+	}
+	return taskResponseToProto(task), nil
+}
+
+// UpdateTask implements api.TaskManagerServiceServer
+func (s *Server) UpdateTask(ctx context.Context, request *api.UpdateTaskRequest) (*api.TaskResponse, error) {
+	if request.GetTaskId() == "" || request.GetTask() == nil {
+		return nil, status.Error(codes.InvalidArgument, "task_id and task are required")
+	}
+	task, err := s.taskManager.UpdateTask(request.GetTaskId(), taskRequestFromProto(request.GetTask()), optionalTimestampFromProto(request.GetExpectedUpdatedAt()))
+	if err != nil {
+		return nil, s.toStatus("UpdateTask", err)
+	}
+	return taskResponseToProto(task), nil
+}
+
+// GetTask implements api.TaskManagerServiceServer
+func (s *Server) GetTask(ctx context.Context, request *api.TaskIdentifier) (*api.TaskResponse, error) {
+	task, err := s.taskManager.GetTask(request.GetTaskId())
+	if err != nil {
+		return nil, s.toStatus("GetTask", err)
+	}
+	return taskResponseToProto(task), nil
+}
+
+// DeleteTask implements api.TaskManagerServiceServer
+func (s *Server) DeleteTask(ctx context.Context, request *api.DeleteTaskRequest) (*emptypb.Empty, error) {
+	if err := s.taskManager.DeleteTask(request.GetTaskId(), optionalTimestampFromProto(request.GetExpectedUpdatedAt())); err != nil {
+		return nil, s.toStatus("DeleteTask", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListTasks implements api.TaskManagerServiceServer
+func (s *Server) ListTasks(ctx context.Context, criteria *api.QueryCriteria) (*api.TaskList, error) {
+	tasks, err := s.taskManager.ListTasks(queryCriteriaFromProto(criteria))
+	if err != nil {
+		return nil, s.toStatus("ListTasks", err)
+	}
+	return taskListToProto(tasks), nil
+}
+
+// ChangeTaskStatus implements api.TaskManagerServiceServer
+func (s *Server) ChangeTaskStatus(ctx context.Context, request *api.ChangeTaskStatusRequest) (*api.TaskResponse, error) {
+	switch task_manager.WorkflowStatus(request.GetWorkflowStatus()) {
+	case task_manager.Todo, task_manager.InProgress, task_manager.Done:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown workflow status %q", request.GetWorkflowStatus())
+	}
+	task, err := s.taskManager.ChangeTaskStatus(request.GetTaskId(), task_manager.WorkflowStatus(request.GetWorkflowStatus()), optionalTimestampFromProto(request.GetExpectedUpdatedAt()))
+	if err != nil {
+		return nil, s.toStatus("ChangeTaskStatus", err)
+	}
+	return taskResponseToProto(task), nil
+}
+
+// ValidateTask implements api.TaskManagerServiceServer
+func (s *Server) ValidateTask(ctx context.Context, request *api.TaskRequest) (*api.ValidationResult, error) {
+	result, err := s.taskManager.ValidateTask(taskRequestFromProto(request))
+	if err != nil {
+		return nil, s.toStatus("ValidateTask", err)
+	}
+	return validationResultToProto(result), nil
+}
+
+// SimulateRules implements api.TaskManagerServiceServer
+func (s *Server) SimulateRules(ctx context.Context, request *api.SimulateRulesRequest) (*api.SimulationReport, error) {
+	report, err := s.taskManager.SimulateRules(ruleSetFromProto(request.GetRuleSet()), timestampFromProto(request.GetSince()))
+	if err != nil {
+		return nil, s.toStatus("SimulateRules", err)
+	}
+	return simulationReportToProto(report), nil
+}
+
+// ProcessPriorityPromotions implements api.TaskManagerServiceServer
+func (s *Server) ProcessPriorityPromotions(ctx context.Context, _ *emptypb.Empty) (*api.TaskList, error) {
+	tasks, err := s.taskManager.ProcessPriorityPromotions()
+	if err != nil {
+		return nil, s.toStatus("ProcessPriorityPromotions", err)
+	}
+	return taskListToProto(tasks), nil
+}
+
+// RunScheduledRules implements api.TaskManagerServiceServer
+func (s *Server) RunScheduledRules(ctx context.Context, request *api.RunScheduledRulesRequest) (*api.ScheduledRulesResponse, error) {
+	response, err := s.taskManager.RunScheduledRules(timestampFromProto(request.GetNow()))
+	if err != nil {
+		return nil, s.toStatus("RunScheduledRules", err)
+	}
+	return scheduledRulesResponseToProto(response), nil
+}
+
+// ArchiveTask implements api.TaskManagerServiceServer
+func (s *Server) ArchiveTask(ctx context.Context, request *api.ArchiveTaskRequest) (*api.TaskResponse, error) {
+	task, err := s.taskManager.ArchiveTask(request.GetTaskId(), board_access.CascadePolicy(request.GetCascadePolicy()), optionalTimestampFromProto(request.GetExpectedUpdatedAt()))
+	if err != nil {
+		return nil, s.toStatus("ArchiveTask", err)
+	}
+	return taskResponseToProto(task), nil
+}
+
+// ListArchivedTasks implements api.TaskManagerServiceServer
+func (s *Server) ListArchivedTasks(ctx context.Context, _ *emptypb.Empty) (*api.ArchivedTaskList, error) {
+	tasks, err := s.taskManager.ListArchivedTasks()
+	if err != nil {
+		return nil, s.toStatus("ListArchivedTasks", err)
+	}
+	return archivedTaskListToProto(tasks), nil
+}
+
+// RestoreTask implements api.TaskManagerServiceServer
+func (s *Server) RestoreTask(ctx context.Context, request *api.CascadeTaskRequest) (*api.TaskResponse, error) {
+	task, err := s.taskManager.RestoreTask(request.GetTaskId(), board_access.CascadePolicy(request.GetCascadePolicy()))
+	if err != nil {
+		return nil, s.toStatus("RestoreTask", err)
+	}
+	return taskResponseToProto(task), nil
+}
+
+// PurgeArchive implements api.TaskManagerServiceServer
+func (s *Server) PurgeArchive(ctx context.Context, request *api.PurgeArchiveRequest) (*api.PurgeArchiveResponse, error) {
+	purged, err := s.taskManager.PurgeArchive(request.GetOlderThan().AsDuration())
+	if err != nil {
+		return nil, s.toStatus("PurgeArchive", err)
+	}
+	return &api.PurgeArchiveResponse{TaskIds: purged}, nil
+}
+
+// ValidateBoardDirectory implements api.TaskManagerServiceServer
+func (s *Server) ValidateBoardDirectory(ctx context.Context, request *api.BoardPath) (*api.BoardValidationResponse, error) {
+	boardPath, err := s.servedBoard(request.GetBoardPath())
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.taskManager.ValidateBoardDirectory(boardPath)
+	if err != nil {
+		return nil, s.toStatus("ValidateBoardDirectory", err)
+	}
+	return boardValidationToProto(result), nil
+}
+
+// RepairBoard implements api.TaskManagerServiceServer
+func (s *Server) RepairBoard(ctx context.Context, _ *emptypb.Empty) (*api.BoardRepairResponse, error) {
+	result, err := s.taskManager.RepairBoard()
+	if err != nil {
+		return nil, s.toStatus("RepairBoard", err)
+	}
+	return boardRepairToProto(result), nil
+}
+
+// GetBoardMetadata implements api.TaskManagerServiceServer
+func (s *Server) GetBoardMetadata(ctx context.Context, request *api.BoardPath) (*api.BoardMetadataResponse, error) {
+	boardPath, err := s.servedBoard(request.GetBoardPath())
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := s.taskManager.GetBoardMetadata(boardPath)
+	if err != nil {
+		return nil, s.toStatus("GetBoardMetadata", err)
+	}
+	return boardMetadataToProto(metadata), nil
+}
+
+// GetBoardStatistics implements api.TaskManagerServiceServer
+func (s *Server) GetBoardStatistics(ctx context.Context, request *api.BoardPath) (*api.BoardStatistics, error) {
+	boardPath, err := s.servedBoard(request.GetBoardPath())
+	if err != nil {
+		return nil, err
+	}
+	stats, err := s.taskManager.GetBoardStatistics(boardPath)
+	if err != nil {
+		return nil, s.toStatus("GetBoardStatistics", err)
+	}
+	return boardStatisticsToProto(stats), nil
+}
+
+// GetFlowMetrics implements api.TaskManagerServiceServer
+func (s *Server) GetFlowMetrics(ctx context.Context, request *api.FlowMetricsRequest) (*api.FlowMetrics, error) {
+	boardPath, err := s.servedBoard(request.GetBoardPath())
+	if err != nil {
+		return nil, err
+	}
+	metrics, err := s.taskManager.GetFlowMetrics(boardPath, dateRangeFromProto(request.GetDateRange()))
+	if err != nil {
+		return nil, s.toStatus("GetFlowMetrics", err)
+	}
+	return flowMetricsToProto(metrics), nil
+}
+
+// UpdateBoardMetadata implements api.TaskManagerServiceServer
+func (s *Server) UpdateBoardMetadata(ctx context.Context, request *api.UpdateBoardMetadataRequest) (*api.BoardMetadataResponse, error) {
+	boardPath, err := s.servedBoard(request.GetBoardPath())
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := s.taskManager.UpdateBoardMetadata(boardPath, task_manager.BoardMetadataRequest{
+		Title:       request.GetMetadata().GetTitle(),
+		Description: request.GetMetadata().GetDescription(),
+		Metadata:    request.GetMetadata().GetMetadata(),
+	})
+	if err != nil {
+		return nil, s.toStatus("UpdateBoardMetadata", err)
+	}
+	return boardMetadataToProto(metadata), nil
+}
+
+// ListBoardRemotes implements api.TaskManagerServiceServer
+func (s *Server) ListBoardRemotes(ctx context.Context, _ *emptypb.Empty) (*api.BoardRemoteList, error) {
+	remotes, err := s.taskManager.ListBoardRemotes()
+	if err != nil {
+		return nil, s.toStatus("ListBoardRemotes", err)
+	}
+	result := &api.BoardRemoteList{}
+	for _, remote := range remotes {
+		result.Remotes = append(result.Remotes, &api.BoardRemote{Name: remote.Name, Url: remote.URL})
+	}
+	return result, nil
+}
+
+// AddBoardRemote implements api.TaskManagerServiceServer
+func (s *Server) AddBoardRemote(ctx context.Context, request *api.BoardRemote) (*emptypb.Empty, error) {
+	if err := s.taskManager.AddBoardRemote(request.GetName(), request.GetUrl()); err != nil {
+		return nil, s.toStatus("AddBoardRemote", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// RemoveBoardRemote implements api.TaskManagerServiceServer
+func (s *Server) RemoveBoardRemote(ctx context.Context, request *api.BoardRemote) (*emptypb.Empty, error) {
+	if err := s.taskManager.RemoveBoardRemote(request.GetName()); err != nil {
+		return nil, s.toStatus("RemoveBoardRemote", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// SyncBoard implements api.TaskManagerServiceServer
+func (s *Server) SyncBoard(ctx context.Context, request *api.SyncBoardRequest) (*api.SyncResponse, error) {
+	response, err := s.taskManager.SyncBoard(request.GetRemote())
+	if err != nil {
+		return nil, s.toStatus("SyncBoard", err)
+	}
+	return &api.SyncResponse{
+		Remote:               response.Remote,
+		UpToDate:             response.UpToDate,
+		FastForward:          response.FastForward,
+		MergeCommit:          response.MergeCommit,
+		ChangedTaskIds:       response.ChangedTaskIDs,
+		ConfigurationChanged: response.ConfigurationChanged,
+		Conflicts:            fieldConflictsToProto(response.Conflicts),
+	}, nil
+}
+
+// Undo implements api.TaskManagerServiceServer
+func (s *Server) Undo(ctx context.Context, _ *emptypb.Empty) (*api.UndoResponse, error) {
+	response, err := s.taskManager.Undo()
+	if err != nil {
+		return nil, s.toStatus("Undo", err)
+	}
+	return undoResponseToProto(response), nil
+}
+
+// Redo implements api.TaskManagerServiceServer
+func (s *Server) Redo(ctx context.Context, _ *emptypb.Empty) (*api.UndoResponse, error) {
+	response, err := s.taskManager.Redo()
+	if err != nil {
+		return nil, s.toStatus("Redo", err)
+	}
+	return undoResponseToProto(response), nil
+}
+
+// ListBoardRevisions implements api.TaskManagerServiceServer
+func (s *Server) ListBoardRevisions(ctx context.Context, request *api.ListBoardRevisionsRequest) (*api.BoardRevisionList, error) {
+	revisions, err := s.taskManager.ListBoardRevisions(int(request.GetLimit()))
+	if err != nil {
+		return nil, s.toStatus("ListBoardRevisions", err)
+	}
+	return boardRevisionListToProto(revisions), nil
+}
+
+// LoadBoardAt implements api.TaskManagerServiceServer
+func (s *Server) LoadBoardAt(ctx context.Context, request *api.LoadBoardAtRequest) (*api.BoardSnapshot, error) {
+	snapshot, err := s.taskManager.LoadBoardAt(request.GetCommit(), timestampFromProto(request.GetAt()))
+	if err != nil {
+		return nil, s.toStatus("LoadBoardAt", err)
+	}
+	return boardSnapshotToProto(snapshot), nil
+}
+
+// RestoreTaskFrom implements api.TaskManagerServiceServer
+func (s *Server) RestoreTaskFrom(ctx context.Context, request *api.RestoreTaskFromRequest) (*api.TaskResponse, error) {
+	task, err := s.taskManager.RestoreTaskFrom(request.GetCommit(), request.GetTaskId())
+	if err != nil {
+		return nil, s.toStatus("RestoreTaskFrom", err)
+	}
+	return taskResponseToProto(task), nil
+}
+
+// GetTaskHistory implements api.TaskManagerServiceServer
+func (s *Server) GetTaskHistory(ctx context.Context, request *api.GetTaskHistoryRequest) (*api.TaskRevisionList, error) {
+	revisions, err := s.taskManager.GetTaskHistory(request.GetTaskId(), int(request.GetLimit()))
+	if err != nil {
+		return nil, s.toStatus("GetTaskHistory", err)
+	}
+	return taskRevisionListToProto(revisions), nil
+}
+
+// ExecuteBatch implements api.TaskManagerServiceServer
+func (s *Server) ExecuteBatch(ctx context.Context, request *api.BatchRequest) (*api.BatchResponse, error) {
+	response, err := s.taskManager.ExecuteBatch(batchRequestFromProto(request))
+	if err != nil {
+		return nil, s.toStatus("ExecuteBatch", err)
+	}
+	return batchResponseToProto(response), nil
+}
+
+// LoadContext implements api.TaskManagerServiceServer
+func (s *Server) LoadContext(ctx context.Context, request *api.LoadContextRequest) (*api.ContextData, error) {
+	data, err := s.taskManager.Load(request.GetType())
+	if err != nil {
+		return nil, s.toStatus("LoadContext", err)
+	}
+	result, err := contextDataToProto(data)
+	if err != nil {
+		return nil, s.toStatus("LoadContext", err)
+	}
+	return result, nil
+}
+
+// StoreContext implements api.TaskManagerServiceServer
+func (s *Server) StoreContext(ctx context.Context, request *api.ContextData) (*emptypb.Empty, error) {
+	if err := s.taskManager.Store(request.GetType(), contextDataFromProto(request)); err != nil {
+		return nil, s.toStatus("StoreContext", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// SubscribeTaskEvents implements api.TaskManagerServiceServer by streaming task events until the client cancels
+func (s *Server) SubscribeTaskEvents(_ *emptypb.Empty, stream grpc.ServerStreamingServer[api.TaskEvent]) error {
+	for event := range s.taskManager.SubscribeTaskEvents(stream.Context()) {
+		if err := stream.Send(taskEventToProto(event)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// servedBoard resolves the board path of a request to the served board; an empty path means the served board and
+// any other path is refused, so clients cannot reach the server's file system beyond it
+func (s *Server) servedBoard(requested string) (string, error) {
+	if requested == "" || sameBoardPath(requested, s.boardPath) {
+		return s.boardPath, nil
+	}
+	return "", status.Errorf(codes.PermissionDenied, "board %s is not served, only %s is", requested, s.boardPath)
+}
+
+// sameBoardPath reports whether two paths name the same board directory
+func sameBoardPath(a, b string) bool {
+	absA, errA := filepath.Abs(a)
+	absB, errB := filepath.Abs(b)
+	return errA == nil && errB == nil && absA == absB
+}
+
+// toStatus maps TaskManager errors to gRPC status errors
+func (s *Server) toStatus(operation string, err error) error {
+	var violationErr *task_manager.RuleViolationError
+	var batchErr *task_manager.BatchError
+	switch {
+	case errors.As(err, &batchErr):
+		// The status of the failed task, extended by the task the batch failed on
+		failed := status.Convert(s.toStatus(operation, batchErr.Err)).Proto()
+		failed.Message = err.Error()
+		detailed, detailErr := status.FromProto(failed).WithDetails(&api.BatchFailure{
+			TaskId:  batchErr.TaskID,
+			Message: batchErr.Err.Error(),
+		})
+		if detailErr != nil {
+			return status.FromProto(failed).Err()
+		}
+		return detailed.Err()
+	case errors.As(err, &violationErr):
+		st := status.New(codes.FailedPrecondition, err.Error())
+		detailed, detailErr := st.WithDetails(&api.RuleViolations{
+			Operation:  violationErr.Operation,
+			Violations: violationsToProto(violationErr.Violations),
+		})
+		if detailErr != nil {
+			return st.Err()
+		}
+		return detailed.Err()
+	case errors.Is(err, task_manager.ErrTaskNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, task_manager.ErrTaskModified):
+		st := status.New(codes.Aborted, err.Error())
+		detailed, detailErr := st.WithDetails(&api.TaskModified{})
+		if detailErr != nil {
+			return st.Err()
+		}
+		return detailed.Err()
+	case errors.Is(err, board_access.ErrModifiedExternally):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, board_access.ErrBoardReadOnly):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, task_manager.ErrNothingToUndo), errors.Is(err, task_manager.ErrNothingToRedo):
+		return status.Error(codes.OutOfRange, err.Error())
+	default:
+		s.logger.Log(utilities.Error, "RPCServer", "Operation failed", map[string]interface{}{
+			"operation": operation,
+			"error":     err.Error(),
+		})
+		return status.Error(codes.Unknown, err.Error())
+	}
+}
@@ -0,0 +1,98 @@
+// Package utilities provides core utilities implementing the iDesign methodology.
+// This file implements the clock periodic work is timed by, with a manual clock for deterministic tests.
+package utilities
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock tells the time and waits for durations to pass
+type Clock interface {
+	// Now returns the current time
+	Now() time.Time
+
+	// After returns a channel receiving the time once the duration passed
+	After(d time.Duration) <-chan time.Time
+}
+
+// systemClock is the Clock of the operating system
+type systemClock struct{}
+
+// NewSystemClock returns the Clock of the operating system
+func NewSystemClock() Clock {
+	return systemClock{}
+}
+
+// Now returns the current time
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// After returns a channel receiving the time once the duration passed
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// manualWaiter is a pending After call of a ManualClock
+type manualWaiter struct {
+	until   time.Time
+	channel chan time.Time
+}
+
+// ManualClock is a Clock whose time only moves when advanced, for deterministic tests
+type ManualClock struct {
+	mutex   sync.Mutex
+	now     time.Time
+	waiters []manualWaiter
+}
+
+// NewManualClock returns a ManualClock standing at the given time
+func NewManualClock(now time.Time) *ManualClock {
+	return &ManualClock{now: now}
+}
+
+// Now returns the time the clock stands at
+func (c *ManualClock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.now
+}
+
+// After returns a channel receiving the time once the clock is advanced by the duration
+func (c *ManualClock) After(d time.Duration) <-chan time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	channel := make(chan time.Time, 1)
+	if d <= 0 {
+		channel <- c.now
+		return channel
+	}
+	c.waiters = append(c.waiters, manualWaiter{until: c.now.Add(d), channel: channel})
+	return channel
+}
+
+// Advance moves the clock forward and fires the After channels whose duration passed
+func (c *ManualClock) Advance(d time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.now = c.now.Add(d)
+	pending := c.waiters[:0]
+	for _, waiter := range c.waiters {
+		if waiter.until.After(c.now) {
+			pending = append(pending, waiter)
+			continue
+		}
+		waiter.channel <- c.now
+	}
+	c.waiters = pending
+}
+
+// Waiters returns the number of After channels that did not fire yet
+func (c *ManualClock) Waiters() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return len(c.waiters)
+}
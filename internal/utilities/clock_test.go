@@ -0,0 +1,52 @@
+package utilities
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUnit_ManualClock_Advance(t *testing.T) {
+	start := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	clock := NewManualClock(start)
+
+	minute := clock.After(time.Minute)
+	hour := clock.After(time.Hour)
+	if clock.Waiters() != 2 {
+		t.Fatalf("Expected 2 waiters, got %d", clock.Waiters())
+	}
+
+	// Only the waiters whose duration passed fire
+	clock.Advance(30 * time.Second)
+	select {
+	case <-minute:
+		t.Fatal("Expected the minute not to have passed")
+	default:
+	}
+
+	clock.Advance(30 * time.Second)
+	select {
+	case fired := <-minute:
+		if !fired.Equal(start.Add(time.Minute)) {
+			t.Errorf("Expected the minute to fire at %v, got %v", start.Add(time.Minute), fired)
+		}
+	default:
+		t.Fatal("Expected the minute to have passed")
+	}
+	if clock.Waiters() != 1 || !clock.Now().Equal(start.Add(time.Minute)) {
+		t.Errorf("Expected the hour to be pending at %v, got %d waiters at %v", start.Add(time.Minute), clock.Waiters(), clock.Now())
+	}
+
+	clock.Advance(2 * time.Hour)
+	select {
+	case <-hour:
+	default:
+		t.Fatal("Expected the hour to have passed")
+	}
+
+	// A duration that already passed fires immediately
+	select {
+	case <-clock.After(0):
+	default:
+		t.Error("Expected After(0) to fire immediately")
+	}
+}
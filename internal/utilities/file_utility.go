@@ -0,0 +1,71 @@
+// Package utilities provides core utilities implementing the iDesign methodology.
+// This file implements crash-safe file writes.
+package utilities
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// atomicTempInfix separates the name of the target file from the random suffix of its temporary file
+const atomicTempInfix = ".tmp-"
+
+// WriteFileAtomic replaces the content of a file so that readers and crashes see either the old or the new
+// content: data is written to a temporary file in the same directory, synced to disk and renamed over the
+// target, and the rename is synced by syncing the directory.
+func WriteFileAtomic(path string, data []byte, perm os.FileMode) error {
+	dir, base := filepath.Split(path)
+	if dir == "" {
+		dir = "."
+	}
+
+	temp, err := os.CreateTemp(dir, "."+base+atomicTempInfix+"*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file for %s: %w", path, err)
+	}
+	tempPath := temp.Name()
+	committed := false
+	defer func() {
+		if !committed {
+			temp.Close()
+			os.Remove(tempPath)
+		}
+	}()
+
+	if _, err := temp.Write(data); err != nil {
+		return fmt.Errorf("failed to write temporary file for %s: %w", path, err)
+	}
+	if err := temp.Chmod(perm); err != nil {
+		return fmt.Errorf("failed to set permissions of %s: %w", path, err)
+	}
+	if err := temp.Sync(); err != nil {
+		return fmt.Errorf("failed to sync temporary file for %s: %w", path, err)
+	}
+	if err := temp.Close(); err != nil {
+		return fmt.Errorf("failed to close temporary file for %s: %w", path, err)
+	}
+	if err := os.Rename(tempPath, path); err != nil {
+		return fmt.Errorf("failed to replace %s: %w", path, err)
+	}
+	committed = true
+
+	syncDirectory(dir)
+	return nil
+}
+
+// IsAtomicTempFile reports whether a file name is that of a temporary file left behind by an interrupted WriteFileAtomic
+func IsAtomicTempFile(name string) bool {
+	return strings.HasPrefix(name, ".") && strings.Contains(name, atomicTempInfix)
+}
+
+// syncDirectory makes a rename in a directory durable; file systems that cannot sync directories are ignored
+func syncDirectory(dir string) {
+	handle, err := os.Open(dir)
+	if err != nil {
+		return
+	}
+	defer handle.Close()
+	handle.Sync()
+}
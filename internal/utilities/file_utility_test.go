@@ -0,0 +1,48 @@
+package utilities
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestUnit_FileUtility_WriteFileAtomic(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "board.json")
+
+	if err := WriteFileAtomic(path, []byte(`{"name":"first"}`), 0644); err != nil {
+		t.Fatalf("WriteFileAtomic failed: %v", err)
+	}
+	if err := WriteFileAtomic(path, []byte(`{"name":"second"}`), 0600); err != nil {
+		t.Fatalf("WriteFileAtomic failed to replace the file: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil || string(data) != `{"name":"second"}` {
+		t.Errorf("Expected the new content, got %q (%v)", data, err)
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0600 {
+		t.Errorf("Expected the requested permissions, got %v (%v)", info.Mode(), err)
+	}
+
+	// No temporary file is left behind, also when the target cannot be replaced
+	if err := os.Mkdir(filepath.Join(dir, "todo"), 0755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	if err := WriteFileAtomic(filepath.Join(dir, "todo"), []byte("x"), 0644); err == nil {
+		t.Error("Expected replacing a directory to fail")
+	}
+	entries, _ := os.ReadDir(dir)
+	for _, entry := range entries {
+		if IsAtomicTempFile(entry.Name()) {
+			t.Errorf("Temporary file %s left behind", entry.Name())
+		}
+	}
+	if len(entries) != 2 {
+		t.Errorf("Expected only board.json and todo, got %d entries", len(entries))
+	}
+
+	if !IsAtomicTempFile(".001-task-a.json.tmp-123456") || IsAtomicTempFile("001-task-a.json") || IsAtomicTempFile(".eisenkan") {
+		t.Error("IsAtomicTempFile misclassified a file name")
+	}
+}
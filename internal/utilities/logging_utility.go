@@ -5,6 +5,7 @@ package utilities
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"runtime"
@@ -70,7 +71,7 @@ type LoggingUtility struct {
 func NewLoggingUtility() ILoggingUtility {
 	utility := &LoggingUtility{
 		minLevel:      getLogLevelFromEnv(),
-		consoleLogger: log.New(os.Stdout, "", 0), // Custom format will be applied
+		consoleLogger: log.New(getConsoleOutputFromEnv(), "", 0), // Custom format will be applied
 	}
 
 	// Setup file logging if configured
@@ -285,6 +286,18 @@ func (l *LoggingUtility) writeLog(logEntry string) {
 	}
 }
 
+// getConsoleOutputFromEnv selects the console log destination: stdout (default), stderr or none
+func getConsoleOutputFromEnv() io.Writer {
+	switch os.Getenv("LOG_CONSOLE") {
+	case "stderr":
+		return os.Stderr
+	case "none":
+		return io.Discard
+	default:
+		return os.Stdout
+	}
+}
+
 // getLogLevelFromEnv reads the log level from environment variables
 func getLogLevelFromEnv() LogLevel {
 	levelStr := os.Getenv("LOG_LEVEL")
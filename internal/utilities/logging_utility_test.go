@@ -4,6 +4,7 @@ package utilities
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"strings"
@@ -428,6 +429,29 @@ func TestUnit_LoggingUtility_GetLogLevelFromEnv(t *testing.T) {
 	}
 }
 
+// TestLoggingUtility_GetConsoleOutputFromEnv tests console destination selection
+func TestUnit_LoggingUtility_GetConsoleOutputFromEnv(t *testing.T) {
+	testCases := []struct {
+		envValue string
+		expected io.Writer
+	}{
+		{"", os.Stdout}, // Default
+		{"stdout", os.Stdout},
+		{"stderr", os.Stderr},
+		{"none", io.Discard},
+		{"INVALID", os.Stdout}, // Invalid defaults to stdout
+	}
+
+	for _, tc := range testCases {
+		t.Setenv("LOG_CONSOLE", tc.envValue)
+
+		result := getConsoleOutputFromEnv()
+		if result != tc.expected {
+			t.Errorf("Env value '%s': unexpected console output", tc.envValue)
+		}
+	}
+}
+
 // TestLoggingUtility_InvalidFilePathPanic tests that invalid file paths cause panic
 func TestUnit_LoggingUtility_InvalidFilePathPanic(t *testing.T) {
 	// Set invalid file path
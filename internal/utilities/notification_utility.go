@@ -0,0 +1,201 @@
+// Package utilities provides Utility layer components for the EisenKan system following iDesign methodology.
+// This file implements delivering notifications to the user through pluggable notifiers.
+package utilities
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// DefaultNotificationWindow is how long a NotificationDispatcher suppresses a notification it delivered already
+const DefaultNotificationWindow = 24 * time.Hour
+
+// webhookTimeout bounds a webhook request when no HTTP client is given
+const webhookTimeout = 10 * time.Second
+
+// Notification is a message for the user, such as a reminder raised by a notification rule
+type Notification struct {
+	Key        string    `json:"key"` // identifies the reminder; notifications with the same key are duplicates
+	Title      string    `json:"title"`
+	Message    string    `json:"message"`
+	RuleID     string    `json:"rule_id,omitempty"`
+	TaskID     string    `json:"task_id,omitempty"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// INotifier defines the contract for delivering notifications to the user
+type INotifier interface {
+	// Notify delivers the notification
+	Notify(notification Notification) error
+}
+
+// NotifierFunc adapts a function to the INotifier interface
+type NotifierFunc func(notification Notification) error
+
+// Notify calls the function
+func (f NotifierFunc) Notify(notification Notification) error {
+	return f(notification)
+}
+
+// logFileNotifier appends notifications to a local log file
+type logFileNotifier struct {
+	path  string
+	mutex sync.Mutex
+}
+
+// NewLogFileNotifier creates a notifier appending a line per notification to the file, which is created if missing
+func NewLogFileNotifier(path string) INotifier {
+	return &logFileNotifier{path: path}
+}
+
+// Notify appends the notification to the log file
+func (n *logFileNotifier) Notify(notification Notification) error {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	file, err := os.OpenFile(n.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open notification log: %w", err)
+	}
+	_, writeErr := fmt.Fprintf(file, "%s %s: %s\n", notification.OccurredAt.Format(time.RFC3339), notification.Title, notification.Message)
+	if closeErr := file.Close(); writeErr == nil {
+		writeErr = closeErr
+	}
+	if writeErr != nil {
+		return fmt.Errorf("failed to write notification log: %w", writeErr)
+	}
+	return nil
+}
+
+// webhookNotifier posts notifications as JSON to a URL
+type webhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookNotifier creates a notifier posting each notification as JSON to the URL; a nil client uses a
+// client with a ten second timeout
+func NewWebhookNotifier(url string, client *http.Client) INotifier {
+	if client == nil {
+		client = &http.Client{Timeout: webhookTimeout}
+	}
+	return &webhookNotifier{url: url, client: client}
+}
+
+// Notify posts the notification to the webhook; responses other than 2xx are errors
+func (n *webhookNotifier) Notify(notification Notification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("failed to encode notification: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := n.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with %s", response.Status)
+	}
+	return nil
+}
+
+// NotificationDispatcher delivers notifications to all its notifiers, suppressing notifications whose key a notifier
+// delivered within the window, so the same reminder is not sent repeatedly
+type NotificationDispatcher struct {
+	clock  Clock
+	window time.Duration
+	logger ILoggingUtility
+
+	mutex     sync.Mutex
+	notifiers []INotifier
+	sent      map[sentNotification]time.Time // time the notification was last delivered by the notifier
+}
+
+// sentNotification identifies a notification delivered by one of the dispatcher's notifiers
+type sentNotification struct {
+	key      string
+	notifier int // index in notifiers
+}
+
+// NewNotificationDispatcher creates a dispatcher without notifiers; a window that is not positive uses
+// DefaultNotificationWindow
+func NewNotificationDispatcher(clock Clock, window time.Duration, logger ILoggingUtility) *NotificationDispatcher {
+	if window <= 0 {
+		window = DefaultNotificationWindow
+	}
+	return &NotificationDispatcher{
+		clock:  clock,
+		window: window,
+		logger: logger,
+		sent:   make(map[sentNotification]time.Time),
+	}
+}
+
+// AddNotifier adds a notifier notifications are delivered to
+func (d *NotificationDispatcher) AddNotifier(notifier INotifier) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.notifiers = append(d.notifiers, notifier)
+}
+
+// Notify delivers the notification to every notifier that did not deliver it already. A notifier failing does not
+// keep the others from delivering; the failures are returned together, and the failed notifiers deliver the
+// notification when it is raised again.
+func (d *NotificationDispatcher) Notify(notification Notification) error {
+	now := d.clock.Now()
+	if notification.OccurredAt.IsZero() {
+		notification.OccurredAt = now
+	}
+
+	d.mutex.Lock()
+	for sent, sentAt := range d.sent {
+		if now.Sub(sentAt) >= d.window {
+			delete(d.sent, sent)
+		}
+	}
+	var pending []int
+	for i := range d.notifiers {
+		if _, duplicate := d.sent[sentNotification{key: notification.Key, notifier: i}]; notification.Key == "" || !duplicate {
+			pending = append(pending, i)
+		}
+	}
+	notifiers := make([]INotifier, len(d.notifiers))
+	copy(notifiers, d.notifiers)
+	d.mutex.Unlock()
+
+	if len(pending) == 0 && len(notifiers) > 0 {
+		d.logger.LogMessage(Debug, "NotificationDispatcher", fmt.Sprintf("Suppressing duplicate notification %s", notification.Key))
+		return nil
+	}
+
+	var errs []error
+	for _, i := range pending {
+		if err := notifiers[i].Notify(notification); err != nil {
+			d.logger.LogMessage(Warning, "NotificationDispatcher", fmt.Sprintf("Failed to deliver notification %q: %v", notification.Title, err))
+			errs = append(errs, err)
+			continue
+		}
+		// Only a delivered notification counts, so a failed one is retried
+		if notification.Key != "" {
+			d.mutex.Lock()
+			d.sent[sentNotification{key: notification.Key, notifier: i}] = now
+			d.mutex.Unlock()
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,123 @@
+package utilities
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestUnit_NotificationDispatcher_SuppressesDuplicates(t *testing.T) {
+	clock := NewManualClock(time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC))
+	dispatcher := NewNotificationDispatcher(clock, time.Hour, NewLoggingUtility())
+
+	var delivered []Notification
+	dispatcher.AddNotifier(NotifierFunc(func(notification Notification) error {
+		delivered = append(delivered, notification)
+		return nil
+	}))
+	failure := errors.New("unreachable")
+	var retried []Notification
+	dispatcher.AddNotifier(NotifierFunc(func(notification Notification) error {
+		if failure != nil {
+			return failure
+		}
+		retried = append(retried, notification)
+		return nil
+	}))
+
+	reminder := Notification{Key: "due-soon/task-1", Title: "Due Soon", Message: "Task 'Report' is due today"}
+	if err := dispatcher.Notify(reminder); !errors.Is(err, failure) {
+		t.Errorf("Expected the failing notifier's error, got %v", err)
+	}
+	if len(delivered) != 1 || !delivered[0].OccurredAt.Equal(clock.Now()) {
+		t.Fatalf("Expected the notification delivered at %v despite the failing notifier, got %+v", clock.Now(), delivered)
+	}
+
+	// The same reminder is suppressed within the window for the notifier that delivered it, and retried by the one that failed
+	clock.Advance(30 * time.Minute)
+	failure = nil
+	if err := dispatcher.Notify(reminder); err != nil {
+		t.Errorf("Expected the retried notification to succeed, got %v", err)
+	}
+	if len(delivered) != 1 || len(retried) != 1 {
+		t.Fatalf("Expected only the failed notifier to deliver the reminder again, got %d and %d notifications", len(delivered), len(retried))
+	}
+	if err := dispatcher.Notify(reminder); err != nil || len(retried) != 1 {
+		t.Errorf("Expected a suppressed duplicate to succeed, got %v and %d notifications", err, len(retried))
+	}
+	dispatcher.Notify(Notification{Key: "due-soon/task-2", Title: "Due Soon"})
+	dispatcher.Notify(Notification{Title: "Without key"})
+	dispatcher.Notify(Notification{Title: "Without key"})
+	if len(delivered) != 4 {
+		t.Fatalf("Expected 4 notifications delivered, got %d", len(delivered))
+	}
+
+	clock.Advance(30 * time.Minute)
+	dispatcher.Notify(reminder)
+	if len(delivered) != 5 {
+		t.Errorf("Expected the reminder delivered again after the window, got %d notifications", len(delivered))
+	}
+}
+
+func TestUnit_LogFileNotifier_AppendsNotifications(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notifications.log")
+	notifier := NewLogFileNotifier(path)
+	occurredAt := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+
+	for _, message := range []string{"Task 'Report' is due today", "Task 'Review' is overdue"} {
+		if err := notifier.Notify(Notification{Title: "Due Soon", Message: message, OccurredAt: occurredAt}); err != nil {
+			t.Fatalf("Notify() error = %v", err)
+		}
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read the notification log: %v", err)
+	}
+	expected := "2025-03-10T09:00:00Z Due Soon: Task 'Report' is due today\n2025-03-10T09:00:00Z Due Soon: Task 'Review' is overdue\n"
+	if string(content) != expected {
+		t.Errorf("Expected log %q, got %q", expected, string(content))
+	}
+
+	if err := NewLogFileNotifier(filepath.Join(path, "not-a-directory", "log")).Notify(Notification{}); err == nil {
+		t.Error("Expected an error for a log file that cannot be created")
+	}
+}
+
+func TestIntegration_WebhookNotifier_PostsNotifications(t *testing.T) {
+	var received []Notification
+	status := http.StatusNoContent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("Unexpected request %s with content type %q", r.Method, r.Header.Get("Content-Type"))
+		}
+		var notification Notification
+		if err := json.NewDecoder(r.Body).Decode(&notification); err != nil {
+			t.Errorf("Failed to decode the notification: %v", err)
+		}
+		received = append(received, notification)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	notifier := NewWebhookNotifier(server.URL, server.Client())
+	notification := Notification{Key: "due-soon/task-1", Title: "Due Soon", Message: "Task 'Report' is due today", RuleID: "due-soon", TaskID: "task-1",
+		OccurredAt: time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)}
+	if err := notifier.Notify(notification); err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+	if len(received) != 1 || received[0] != notification {
+		t.Errorf("Expected the webhook to receive %+v, got %+v", notification, received)
+	}
+
+	status = http.StatusInternalServerError
+	if err := notifier.Notify(notification); err == nil || !strings.Contains(err.Error(), "500") {
+		t.Errorf("Expected an error for a failing webhook, got %v", err)
+	}
+}
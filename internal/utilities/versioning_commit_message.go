@@ -0,0 +1,147 @@
+// Package utilities provides core utilities implementing the iDesign methodology.
+// This file implements structured commit messages with machine-readable trailers.
+package utilities
+
+import (
+	"strings"
+)
+
+// Trailer keys of structured commit messages
+const (
+	TrailerTaskID        = "Task-ID"
+	TrailerOperation     = "Operation"
+	TrailerFromColumn    = "From-Column"
+	TrailerToColumn      = "To-Column"
+	TrailerFromSection   = "From-Section"
+	TrailerToSection     = "To-Section"
+	TrailerConfigType    = "Config-Type"
+	TrailerRevision      = "Revision"
+	TrailerSchemaVersion = "Schema-Version"
+	TrailerRuleID        = "Rule-ID"
+)
+
+// CommitTrailer is a "Key: Value" line at the end of a commit message
+type CommitTrailer struct {
+	Key   string
+	Value string
+}
+
+// CommitMessage is a commit message made of a summary line, an optional body and trailers
+type CommitMessage struct {
+	Summary  string
+	Body     string
+	Trailers []CommitTrailer
+}
+
+// NewCommitMessage creates a commit message with the given summary and operation trailer
+func NewCommitMessage(operation string, summary string) *CommitMessage {
+	message := &CommitMessage{Summary: summary}
+	return message.With(TrailerOperation, operation)
+}
+
+// With appends a trailer; empty values are left out
+func (m *CommitMessage) With(key, value string) *CommitMessage {
+	value = strings.TrimSpace(strings.ReplaceAll(value, "\n", " "))
+	if value != "" {
+		m.Trailers = append(m.Trailers, CommitTrailer{Key: key, Value: value})
+	}
+	return m
+}
+
+// Trailer returns the value of the first trailer with the given key
+func (m *CommitMessage) Trailer(key string) (string, bool) {
+	for _, trailer := range m.Trailers {
+		if strings.EqualFold(trailer.Key, key) {
+			return trailer.Value, true
+		}
+	}
+	return "", false
+}
+
+// TrailerValues returns the values of all trailers with the given key in order
+func (m *CommitMessage) TrailerValues(key string) []string {
+	var values []string
+	for _, trailer := range m.Trailers {
+		if strings.EqualFold(trailer.Key, key) {
+			values = append(values, trailer.Value)
+		}
+	}
+	return values
+}
+
+// Operation returns the value of the operation trailer, empty for unstructured messages
+func (m *CommitMessage) Operation() string {
+	operation, _ := m.Trailer(TrailerOperation)
+	return operation
+}
+
+// String formats the message as git expects it: summary, blank line, body, blank line, trailers
+func (m *CommitMessage) String() string {
+	parts := []string{strings.TrimSpace(m.Summary)}
+	if body := strings.TrimSpace(m.Body); body != "" {
+		parts = append(parts, body)
+	}
+	if len(m.Trailers) > 0 {
+		lines := make([]string, len(m.Trailers))
+		for i, trailer := range m.Trailers {
+			lines[i] = trailer.Key + ": " + trailer.Value
+		}
+		parts = append(parts, strings.Join(lines, "\n"))
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+// ParseCommitMessage splits a commit message into summary, body and trailers. The trailers are the last
+// paragraph if all its lines are "Key: Value" with a key made of letters, digits and dashes; messages
+// without such a paragraph, like those of commits made before structured messages, have no trailers.
+func ParseCommitMessage(message string) *CommitMessage {
+	message = strings.TrimSpace(strings.ReplaceAll(message, "\r\n", "\n"))
+	summary, rest, _ := strings.Cut(message, "\n")
+	parsed := &CommitMessage{Summary: strings.TrimSpace(summary)}
+
+	paragraphs := strings.Split(strings.TrimSpace(rest), "\n\n")
+	if last := len(paragraphs) - 1; last >= 0 {
+		if trailers, ok := parseTrailers(paragraphs[last]); ok {
+			parsed.Trailers = trailers
+			paragraphs = paragraphs[:last]
+		}
+	}
+	parsed.Body = strings.TrimSpace(strings.Join(paragraphs, "\n\n"))
+	return parsed
+}
+
+// ParseCommitInfo parses the message of a commit
+func ParseCommitInfo(commit CommitInfo) *CommitMessage {
+	return ParseCommitMessage(commit.Message)
+}
+
+// parseTrailers parses a paragraph of trailer lines
+func parseTrailers(paragraph string) ([]CommitTrailer, bool) {
+	if strings.TrimSpace(paragraph) == "" {
+		return nil, false
+	}
+
+	var trailers []CommitTrailer
+	for _, line := range strings.Split(paragraph, "\n") {
+		key, value, found := strings.Cut(line, ":")
+		if !found || !isTrailerKey(key) {
+			return nil, false
+		}
+		trailers = append(trailers, CommitTrailer{Key: key, Value: strings.TrimSpace(value)})
+	}
+	return trailers, true
+}
+
+// isTrailerKey reports whether a string is a valid trailer key
+func isTrailerKey(key string) bool {
+	if key == "" {
+		return false
+	}
+	for _, r := range key {
+		isLetter := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+		if !isLetter && !(r >= '0' && r <= '9') && r != '-' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,79 @@
+package utilities
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUnit_VersioningUtility_CommitMessage(t *testing.T) {
+	message := NewCommitMessage("move", "Move task 'Write report' todo/urgent-important → doing").
+		With(TrailerTaskID, "task-1").
+		With(TrailerFromColumn, "todo").
+		With(TrailerToColumn, "doing").
+		With(TrailerToSection, "")
+
+	expected := "Move task 'Write report' todo/urgent-important → doing\n\nOperation: move\nTask-ID: task-1\nFrom-Column: todo\nTo-Column: doing"
+	if got := message.String(); got != expected {
+		t.Errorf("Expected message %q, got %q", expected, got)
+	}
+
+	// Git adds a trailing newline to stored messages
+	parsed := ParseCommitMessage(message.String() + "\n")
+	if !reflect.DeepEqual(parsed, message) {
+		t.Errorf("Expected round trip to give %+v, got %+v", message, parsed)
+	}
+	if parsed.Operation() != "move" {
+		t.Errorf("Expected operation move, got %q", parsed.Operation())
+	}
+	if column, ok := parsed.Trailer("to-column"); !ok || column != "doing" {
+		t.Errorf("Expected To-Column doing, got %q (%v)", column, ok)
+	}
+	if _, ok := parsed.Trailer(TrailerToSection); ok {
+		t.Error("Expected empty trailers to be left out")
+	}
+}
+
+func TestUnit_VersioningUtility_ParseCommitMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		message  string
+		expected *CommitMessage
+	}{
+		{
+			name:     "unstructured summary",
+			message:  "Create task 1234\n",
+			expected: &CommitMessage{Summary: "Create task 1234"},
+		},
+		{
+			name:     "body without trailers",
+			message:  "Merge remote changes\n\nKept local title: both sides changed it",
+			expected: &CommitMessage{Summary: "Merge remote changes", Body: "Kept local title: both sides changed it"},
+		},
+		{
+			name:    "body and repeated trailers",
+			message: "Purge 2 archived tasks\n\nOlder than 30 days.\n\nOperation: purge\nTask-ID: a\nTask-ID: b\n",
+			expected: &CommitMessage{
+				Summary:  "Purge 2 archived tasks",
+				Body:     "Older than 30 days.",
+				Trailers: []CommitTrailer{{"Operation", "purge"}, {"Task-ID", "a"}, {"Task-ID", "b"}},
+			},
+		},
+		{
+			name:     "trailer paragraph with a free text line",
+			message:  "Update rules\n\nOperation: update-rules\nsee the board for details",
+			expected: &CommitMessage{Summary: "Update rules", Body: "Operation: update-rules\nsee the board for details"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseCommitMessage(tt.message); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %+v, got %+v", tt.expected, got)
+			}
+		})
+	}
+
+	if values := ParseCommitMessage(tests[2].message).TrailerValues(TrailerTaskID); !reflect.DeepEqual(values, []string{"a", "b"}) {
+		t.Errorf("Expected both task IDs, got %v", values)
+	}
+}
@@ -0,0 +1,109 @@
+// Package utilities provides core utilities implementing the iDesign methodology.
+// This file implements discarding uncommitted changes to files of a Repository.
+package utilities
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// DiscardChanges restores files in the working tree and the index to their state in the latest commit;
+// files the commit does not contain are removed. Paths are relative to the repository root. It returns the
+// slash-separated paths of the files it changed.
+func (r *repository) DiscardChanges(paths []string) ([]string, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.discardChanges(paths)
+}
+
+// discardChanges restores files to the latest commit without locking (for internal use)
+func (r *repository) discardChanges(paths []string) ([]string, error) {
+	workTree, err := r.gitRepo.Worktree()
+	if err != nil {
+		return nil, fmt.Errorf("repository.DiscardChanges failed to get worktree for %s: %w", r.path, err)
+	}
+
+	// Without any commit every file is discarded
+	var headTree *object.Tree
+	if headRef, err := r.gitRepo.Head(); err == nil {
+		head, err := r.gitRepo.CommitObject(headRef.Hash())
+		if err != nil {
+			return nil, fmt.Errorf("repository.DiscardChanges failed to read commit %s: %w", headRef.Hash(), err)
+		}
+		if headTree, err = commitTree(head); err != nil {
+			return nil, err
+		}
+	} else if !errors.Is(err, plumbing.ErrReferenceNotFound) {
+		return nil, fmt.Errorf("repository.DiscardChanges failed to resolve HEAD of %s: %w", r.path, err)
+	}
+
+	idx, err := r.gitRepo.Storer.Index()
+	if err != nil {
+		return nil, fmt.Errorf("repository.DiscardChanges failed to read index for %s: %w", r.path, err)
+	}
+
+	unique := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		unique[filepath.ToSlash(filepath.Clean(path))] = true
+	}
+	sorted := make([]string, 0, len(unique))
+	for path := range unique {
+		sorted = append(sorted, path)
+	}
+	sort.Strings(sorted)
+
+	var changed []string
+	for _, path := range sorted {
+		fullPath := filepath.Join(r.path, filepath.FromSlash(path))
+		hash := treeEntryHash(headTree, path)
+
+		if !hash.IsZero() {
+			entry, err := headTree.FindEntry(path)
+			if err != nil {
+				return nil, fmt.Errorf("repository.DiscardChanges failed to read %s: %w", path, err)
+			}
+			if current, err := os.ReadFile(fullPath); err == nil && plumbing.ComputeHash(plumbing.BlobObject, current) == hash {
+				if indexed, err := idx.Entry(path); err == nil && indexed.Hash == hash {
+					continue
+				}
+			}
+			if err := r.writeBlob(fullPath, treeEdit{hash: hash, mode: entry.Mode}); err != nil {
+				return nil, fmt.Errorf("repository.DiscardChanges failed to restore %s: %w", path, err)
+			}
+			if _, err := workTree.Add(path); err != nil {
+				return nil, fmt.Errorf("repository.DiscardChanges failed to stage %s: %w", path, err)
+			}
+			changed = append(changed, path)
+			continue
+		}
+
+		_, statErr := os.Stat(fullPath)
+		_, indexErr := idx.Entry(path)
+		if os.IsNotExist(statErr) && indexErr != nil {
+			continue
+		}
+		if err := os.Remove(fullPath); err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("repository.DiscardChanges failed to remove %s: %w", path, err)
+		}
+		removeEmptyParents(r.path, filepath.Dir(fullPath))
+		if indexErr == nil {
+			if _, err := workTree.Remove(path); err != nil {
+				return nil, fmt.Errorf("repository.DiscardChanges failed to unstage %s: %w", path, err)
+			}
+		}
+		changed = append(changed, path)
+	}
+
+	r.logger.Log(Info, "Repository", "Changes discarded", map[string]interface{}{
+		"path":          r.path,
+		"changed_files": len(changed),
+	})
+	return changed, nil
+}
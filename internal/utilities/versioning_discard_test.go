@@ -0,0 +1,66 @@
+package utilities
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestIntegration_VersioningUtility_DiscardChanges(t *testing.T) {
+	repo, err := InitializeRepositoryWithConfig(t.TempDir(), testAuthorConfig())
+	if err != nil {
+		t.Fatalf("Failed to create repository: %v", err)
+	}
+	defer repo.Close()
+
+	commitFile(t, repo, "todo/task-1.json", "first")
+	commitFile(t, repo, "todo/task-2.json", "second")
+	head := headCommit(t, repo)
+
+	// Staged edits, a staged removal and a staged new file in a new directory
+	writeFile := func(relPath, content string) {
+		fullPath := filepath.Join(repo.Path(), relPath)
+		if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+			t.Fatalf("Failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(fullPath, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", relPath, err)
+		}
+	}
+	writeFile("todo/task-1.json", "edited")
+	writeFile("doing/task-3.json", "third")
+	if err := os.Remove(filepath.Join(repo.Path(), "todo", "task-2.json")); err != nil {
+		t.Fatalf("Failed to remove file: %v", err)
+	}
+	if err := repo.Stage([]string{"todo/task-1.json", "todo/task-2.json", "doing/task-3.json"}); err != nil {
+		t.Fatalf("Failed to stage changes: %v", err)
+	}
+
+	changed, err := repo.DiscardChanges([]string{"todo/task-1.json", "todo/task-2.json", "doing/task-3.json", "done/task-4.json"})
+	if err != nil {
+		t.Fatalf("DiscardChanges failed: %v", err)
+	}
+	if expected := []string{"doing/task-3.json", "todo/task-1.json", "todo/task-2.json"}; !reflect.DeepEqual(changed, expected) {
+		t.Errorf("Expected changed files %v, got %v", expected, changed)
+	}
+	assertFile(t, repo, "todo/task-1.json", "first")
+	assertFile(t, repo, "todo/task-2.json", "second")
+	if _, err := os.Stat(filepath.Join(repo.Path(), "doing")); !os.IsNotExist(err) {
+		t.Errorf("Expected the new file and its directory to be removed, got %v", err)
+	}
+
+	// Nothing is left to commit and the history is unchanged
+	status, err := repo.Status()
+	if err != nil || len(status.ModifiedFiles)+len(status.StagedFiles)+len(status.UntrackedFiles) > 0 {
+		t.Errorf("Expected a clean repository, got %+v (%v)", status, err)
+	}
+	if headCommit(t, repo) != head {
+		t.Error("Expected no new commit")
+	}
+
+	// Discarding unchanged files changes nothing
+	if changed, err := repo.DiscardChanges([]string{"todo/task-1.json"}); err != nil || len(changed) != 0 {
+		t.Errorf("Expected no changes, got %v (%v)", changed, err)
+	}
+}
@@ -0,0 +1,736 @@
+// Package utilities provides core utilities implementing the iDesign methodology.
+// This file implements the remote operations of Repository used to share a repository between clones.
+package utilities
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+)
+
+// DefaultRemoteName is the remote used when none is specified
+const DefaultRemoteName = "origin"
+
+// ErrMergeConflict reports that remote changes could not be merged with local changes
+var ErrMergeConflict = errors.New("merge conflict")
+
+// ErrUncommittedChanges reports that a pull would overwrite changes that have not been committed
+var ErrUncommittedChanges = errors.New("uncommitted changes")
+
+// ErrPushRejected reports that the remote has commits that must be pulled before pushing
+var ErrPushRejected = errors.New("push rejected, remote has changes that are not merged locally")
+
+// RemoteInfo describes a configured remote
+type RemoteInfo struct {
+	Name string   // Remote name, e.g. origin
+	URLs []string // Fetch and push URLs
+}
+
+// PullResult describes how remote changes were integrated
+type PullResult struct {
+	Remote       string   // Remote pulled from
+	Branch       string   // Branch name on both sides
+	UpToDate     bool     // Nothing to integrate
+	FastForward  bool     // Local branch only moved forward
+	MergeCommit  string   // Merge commit hash when both sides had changes
+	ChangedFiles []string // Slash-separated paths changed in the working tree
+
+	Conflicts []FieldConflict // Field conflicts the merge driver settled or left for review
+}
+
+// MergeVersions holds a file's content at the merge base and on both sides; nil means the file does not exist there
+type MergeVersions struct {
+	Base   []byte
+	Ours   []byte
+	Theirs []byte
+}
+
+// ConflictResolution tells how a merge driver settled a field changed differently on both sides
+type ConflictResolution string
+
+const (
+	ResolvedOurs   ConflictResolution = "ours"       // the local value was kept
+	ResolvedTheirs ConflictResolution = "theirs"     // the remote value was taken
+	Unresolved     ConflictResolution = "unresolved" // a value was kept provisionally and needs review
+)
+
+// FieldConflict describes a field of a record that was changed differently on both sides of a merge
+type FieldConflict struct {
+	Record     string             // Record the field belongs to, e.g. a task ID
+	Field      string             // Field name
+	Ours       string             // Local value
+	Theirs     string             // Remote value
+	Resolution ConflictResolution // Which value the merge kept
+	Reason     string             // Why the value was kept
+}
+
+// String describes the conflict for commit messages
+func (c FieldConflict) String() string {
+	switch c.Resolution {
+	case ResolvedOurs:
+		return fmt.Sprintf("%s %s: kept local %q over remote %q (%s)", c.Record, c.Field, c.Ours, c.Theirs, c.Reason)
+	case ResolvedTheirs:
+		return fmt.Sprintf("%s %s: took remote %q over local %q (%s)", c.Record, c.Field, c.Theirs, c.Ours, c.Reason)
+	default:
+		return fmt.Sprintf("%s %s: unresolved, local %q, remote %q (%s)", c.Record, c.Field, c.Ours, c.Theirs, c.Reason)
+	}
+}
+
+// MergeOutcome is the result of a merge driver
+type MergeOutcome struct {
+	Files     map[string][]byte // Merged content of the paths the driver handled; nil removes the file
+	Conflicts []FieldConflict   // Field conflicts to record in the merge commit
+}
+
+// MergeDriver merges files with knowledge of their content, where whole-file merging would conflict.
+// It receives every path changed on either side since the merge base; paths it leaves out of the
+// outcome are merged as whole files.
+type MergeDriver interface {
+	Merge(files map[string]MergeVersions) (*MergeOutcome, error)
+}
+
+// MergeConflictError lists the files changed differently on both sides; the repository is left untouched
+type MergeConflictError struct {
+	Remote string
+	Paths  []string
+}
+
+// Error implements the error interface
+func (e *MergeConflictError) Error() string {
+	return fmt.Sprintf("changes from %s conflict with local changes in %s", e.Remote, strings.Join(e.Paths, ", "))
+}
+
+// Unwrap allows errors.Is to match ErrMergeConflict
+func (e *MergeConflictError) Unwrap() error {
+	return ErrMergeConflict
+}
+
+// treeEdit is the state of a file after one side of a merge changed it
+type treeEdit struct {
+	hash    plumbing.Hash
+	mode    filemode.FileMode
+	deleted bool
+}
+
+// AddRemote configures a remote fetching all branches
+func (r *repository) AddRemote(name, url string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if strings.TrimSpace(name) == "" || strings.TrimSpace(url) == "" {
+		return fmt.Errorf("repository.AddRemote requires a remote name and URL")
+	}
+
+	if _, err := r.gitRepo.CreateRemote(&config.RemoteConfig{Name: name, URLs: []string{url}}); err != nil {
+		return fmt.Errorf("repository.AddRemote failed to add remote %s to %s: %w", name, r.path, err)
+	}
+
+	r.logger.Log(Info, "Repository", "Remote added", map[string]interface{}{
+		"path":   r.path,
+		"remote": name,
+		"url":    url,
+	})
+	return nil
+}
+
+// RemoveRemote removes a remote and its remote-tracking branches
+func (r *repository) RemoveRemote(name string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if err := r.gitRepo.DeleteRemote(name); err != nil {
+		return fmt.Errorf("repository.RemoveRemote failed to remove remote %s from %s: %w", name, r.path, err)
+	}
+
+	refs, err := r.gitRepo.References()
+	if err != nil {
+		return fmt.Errorf("repository.RemoveRemote failed to list references of %s: %w", r.path, err)
+	}
+	prefix := "refs/remotes/" + name + "/"
+	var stale []plumbing.ReferenceName
+	refs.ForEach(func(ref *plumbing.Reference) error {
+		if strings.HasPrefix(ref.Name().String(), prefix) {
+			stale = append(stale, ref.Name())
+		}
+		return nil
+	})
+	for _, refName := range stale {
+		if err := r.gitRepo.Storer.RemoveReference(refName); err != nil {
+			return fmt.Errorf("repository.RemoveRemote failed to remove %s: %w", refName, err)
+		}
+	}
+
+	r.logger.Log(Info, "Repository", "Remote removed", map[string]interface{}{
+		"path":   r.path,
+		"remote": name,
+	})
+	return nil
+}
+
+// ListRemotes returns the configured remotes sorted by name
+func (r *repository) ListRemotes() ([]RemoteInfo, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	remotes, err := r.gitRepo.Remotes()
+	if err != nil {
+		return nil, fmt.Errorf("repository.ListRemotes failed to read remotes of %s: %w", r.path, err)
+	}
+
+	infos := make([]RemoteInfo, 0, len(remotes))
+	for _, remote := range remotes {
+		infos = append(infos, RemoteInfo{Name: remote.Config().Name, URLs: remote.Config().URLs})
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos, nil
+}
+
+// Fetch downloads the branches of a remote without changing the working tree
+func (r *repository) Fetch(remote string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.fetchInternal(remoteOrDefault(remote))
+}
+
+// fetchInternal fetches without acquiring locks; an empty remote repository is not an error
+func (r *repository) fetchInternal(remote string) error {
+	err := r.gitRepo.Fetch(&git.FetchOptions{RemoteName: remote})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) && !errors.Is(err, transport.ErrEmptyRemoteRepository) {
+		return fmt.Errorf("repository.Fetch failed to fetch %s into %s: %w", remote, r.path, err)
+	}
+	return nil
+}
+
+// Pull fetches the current branch from a remote and integrates it, fast-forwarding when possible and
+// otherwise creating a merge commit. Files changed differently on both sides fail with a MergeConflictError.
+func (r *repository) Pull(remote string) (*PullResult, error) {
+	return r.PullWithMergeDriver(remote, nil)
+}
+
+// PullWithMergeDriver pulls like Pull, letting the driver merge files changed on both sides
+func (r *repository) PullWithMergeDriver(remote string, driver MergeDriver) (*PullResult, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	remote = remoteOrDefault(remote)
+	if err := r.fetchInternal(remote); err != nil {
+		return nil, err
+	}
+
+	branch, err := r.currentBranch()
+	if err != nil {
+		return nil, err
+	}
+	result := &PullResult{Remote: remote, Branch: branch.Short()}
+
+	remoteRef, err := r.gitRepo.Reference(plumbing.NewRemoteReferenceName(remote, branch.Short()), true)
+	if errors.Is(err, plumbing.ErrReferenceNotFound) {
+		// The remote does not have the branch yet
+		result.UpToDate = true
+		return result, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repository.Pull failed to resolve %s/%s: %w", remote, branch.Short(), err)
+	}
+	theirs, err := r.gitRepo.CommitObject(remoteRef.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("repository.Pull failed to read commit %s: %w", remoteRef.Hash(), err)
+	}
+
+	var ours *object.Commit
+	if localRef, err := r.gitRepo.Reference(branch, true); err == nil {
+		if ours, err = r.gitRepo.CommitObject(localRef.Hash()); err != nil {
+			return nil, fmt.Errorf("repository.Pull failed to read commit %s: %w", localRef.Hash(), err)
+		}
+	} else if !errors.Is(err, plumbing.ErrReferenceNotFound) {
+		return nil, fmt.Errorf("repository.Pull failed to resolve %s: %w", branch, err)
+	}
+
+	if ours != nil {
+		if ours.Hash == theirs.Hash {
+			result.UpToDate = true
+			return result, nil
+		}
+		if merged, err := theirs.IsAncestor(ours); err != nil {
+			return nil, fmt.Errorf("repository.Pull failed to compare histories: %w", err)
+		} else if merged {
+			result.UpToDate = true
+			return result, nil
+		}
+	}
+
+	fastForward := ours == nil
+	if !fastForward {
+		if fastForward, err = ours.IsAncestor(theirs); err != nil {
+			return nil, fmt.Errorf("repository.Pull failed to compare histories: %w", err)
+		}
+	}
+
+	var edits map[string]treeEdit
+	if fastForward {
+		edits, err = commitEdits(ours, theirs)
+	} else {
+		edits, result.Conflicts, err = r.mergeEdits(remote, ours, theirs, driver)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.integrateEdits(edits, branch, remote, ours, theirs, fastForward, result); err != nil {
+		return nil, err
+	}
+
+	for path := range edits {
+		result.ChangedFiles = append(result.ChangedFiles, path)
+	}
+	sort.Strings(result.ChangedFiles)
+
+	r.logger.Log(Info, "Repository", "Remote changes integrated", map[string]interface{}{
+		"path":          r.path,
+		"remote":        remote,
+		"fast_forward":  result.FastForward,
+		"merge_commit":  result.MergeCommit,
+		"changed_files": len(result.ChangedFiles),
+		"conflicts":     len(result.Conflicts),
+	})
+	return result, nil
+}
+
+// Push sends the current branch to a remote; the remote must not have commits missing locally
+func (r *repository) Push(remote string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	remote = remoteOrDefault(remote)
+	branch, err := r.currentBranch()
+	if err != nil {
+		return err
+	}
+	if _, err := r.gitRepo.Reference(branch, true); errors.Is(err, plumbing.ErrReferenceNotFound) {
+		// Nothing committed yet
+		return nil
+	}
+
+	refSpec := config.RefSpec(fmt.Sprintf("%s:%s", branch, branch))
+	err = r.gitRepo.Push(&git.PushOptions{RemoteName: remote, RefSpecs: []config.RefSpec{refSpec}})
+	switch {
+	case err == nil, errors.Is(err, git.NoErrAlreadyUpToDate):
+	case errors.Is(err, git.ErrForceNeeded), strings.Contains(err.Error(), "non-fast-forward"):
+		// go-git reports rejected updates of local remotes without a sentinel error
+		return fmt.Errorf("repository.Push failed to push %s to %s: %w", branch.Short(), remote, ErrPushRejected)
+	default:
+		return fmt.Errorf("repository.Push failed to push %s to %s: %w", branch.Short(), remote, err)
+	}
+
+	r.logger.Log(Info, "Repository", "Branch pushed", map[string]interface{}{
+		"path":   r.path,
+		"remote": remote,
+		"branch": branch.Short(),
+	})
+	return nil
+}
+
+// remoteOrDefault returns the remote name, defaulting to origin
+func remoteOrDefault(remote string) string {
+	if strings.TrimSpace(remote) == "" {
+		return DefaultRemoteName
+	}
+	return remote
+}
+
+// currentBranch returns the branch HEAD points to, which may not have commits yet
+func (r *repository) currentBranch() (plumbing.ReferenceName, error) {
+	head, err := r.gitRepo.Reference(plumbing.HEAD, false)
+	if err != nil {
+		return "", fmt.Errorf("repository failed to read HEAD of %s: %w", r.path, err)
+	}
+	if head.Type() != plumbing.SymbolicReference || !head.Target().IsBranch() {
+		return "", fmt.Errorf("repository %s is not on a branch", r.path)
+	}
+	return head.Target(), nil
+}
+
+// commitEdits returns the files changed between two commits; a nil commit stands for the empty tree
+func commitEdits(from, to *object.Commit) (map[string]treeEdit, error) {
+	fromTree, err := commitTree(from)
+	if err != nil {
+		return nil, err
+	}
+	toTree, err := commitTree(to)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := object.DiffTree(fromTree, toTree)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare trees: %w", err)
+	}
+
+	edits := make(map[string]treeEdit, len(changes))
+	for _, change := range changes {
+		if change.From.Name != "" && change.From.Name != change.To.Name {
+			edits[change.From.Name] = treeEdit{deleted: true}
+		}
+		if change.To.Name != "" {
+			edits[change.To.Name] = treeEdit{hash: change.To.TreeEntry.Hash, mode: change.To.TreeEntry.Mode}
+		}
+	}
+	return edits, nil
+}
+
+// commitTree returns the tree of a commit, or nil for a nil commit
+func commitTree(commit *object.Commit) (*object.Tree, error) {
+	if commit == nil {
+		return nil, nil
+	}
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tree for commit %s: %w", commit.Hash, err)
+	}
+	return tree, nil
+}
+
+// mergeEdits returns the remote changes to apply on top of the local branch. Paths handled by the driver take
+// its merged content; other files are merged as a whole: changes made on one side only are taken, identical
+// changes on both sides are kept, anything else conflicts.
+func (r *repository) mergeEdits(remote string, ours, theirs *object.Commit, driver MergeDriver) (map[string]treeEdit, []FieldConflict, error) {
+	// Unrelated histories are merged as if both started from an empty board
+	var base *object.Commit
+	bases, err := ours.MergeBase(theirs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("repository.Pull failed to find merge base: %w", err)
+	}
+	if len(bases) > 0 {
+		base = bases[0]
+	}
+
+	ourEdits, err := commitEdits(base, ours)
+	if err != nil {
+		return nil, nil, err
+	}
+	theirEdits, err := commitEdits(base, theirs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	edits := make(map[string]treeEdit)
+	handled := make(map[string]bool)
+	var fieldConflicts []FieldConflict
+	if driver != nil && len(ourEdits) > 0 && len(theirEdits) > 0 {
+		outcome, err := r.runMergeDriver(driver, base, ours, theirs, ourEdits, theirEdits)
+		if err != nil {
+			return nil, nil, err
+		}
+		for path, edit := range outcome.edits {
+			edits[path] = edit
+		}
+		handled = outcome.handled
+		fieldConflicts = outcome.conflicts
+	}
+
+	var conflicts []string
+	for path, their := range theirEdits {
+		if handled[path] {
+			continue
+		}
+		our, changedLocally := ourEdits[path]
+		switch {
+		case !changedLocally:
+			edits[path] = their
+		case our != their:
+			conflicts = append(conflicts, path)
+		}
+	}
+
+	if len(conflicts) > 0 {
+		sort.Strings(conflicts)
+		return nil, nil, &MergeConflictError{Remote: remote, Paths: conflicts}
+	}
+	return edits, fieldConflicts, nil
+}
+
+// driverOutcome is a merge driver's result translated into edits on top of the local branch
+type driverOutcome struct {
+	edits     map[string]treeEdit
+	handled   map[string]bool
+	conflicts []FieldConflict
+}
+
+// runMergeDriver hands every path changed on either side to the driver and turns its merged content into edits
+func (r *repository) runMergeDriver(driver MergeDriver, base, ours, theirs *object.Commit, ourEdits, theirEdits map[string]treeEdit) (*driverOutcome, error) {
+	trees := make([]*object.Tree, 0, 3)
+	for _, commit := range []*object.Commit{base, ours, theirs} {
+		tree, err := commitTree(commit)
+		if err != nil {
+			return nil, err
+		}
+		trees = append(trees, tree)
+	}
+
+	files := make(map[string]MergeVersions, len(ourEdits)+len(theirEdits))
+	for _, changed := range []map[string]treeEdit{ourEdits, theirEdits} {
+		for path := range changed {
+			if _, done := files[path]; done {
+				continue
+			}
+			var versions [3][]byte
+			for i, tree := range trees {
+				content, err := treeFileContent(tree, path)
+				if err != nil {
+					return nil, fmt.Errorf("repository.Pull failed to read %s: %w", path, err)
+				}
+				versions[i] = content
+			}
+			files[path] = MergeVersions{Base: versions[0], Ours: versions[1], Theirs: versions[2]}
+		}
+	}
+
+	merged, err := driver.Merge(files)
+	if err != nil {
+		return nil, fmt.Errorf("repository.Pull merge driver failed: %w", err)
+	}
+
+	outcome := &driverOutcome{edits: make(map[string]treeEdit), handled: make(map[string]bool)}
+	if merged == nil {
+		return outcome, nil
+	}
+	outcome.conflicts = merged.Conflicts
+
+	for path, content := range merged.Files {
+		outcome.handled[path] = true
+
+		current, err := treeFileContent(trees[1], path)
+		if err != nil {
+			return nil, fmt.Errorf("repository.Pull failed to read %s: %w", path, err)
+		}
+		switch {
+		case content == nil && current == nil:
+		case content == nil:
+			outcome.edits[path] = treeEdit{deleted: true}
+		case current != nil && bytes.Equal(content, current):
+		default:
+			hash, err := r.storeBlob(content)
+			if err != nil {
+				return nil, fmt.Errorf("repository.Pull failed to store merged %s: %w", path, err)
+			}
+			outcome.edits[path] = treeEdit{hash: hash, mode: filemode.Regular}
+		}
+	}
+	return outcome, nil
+}
+
+// treeFileContent returns the content of a file in a tree, or nil if the tree or file does not exist
+func treeFileContent(tree *object.Tree, path string) ([]byte, error) {
+	if tree == nil {
+		return nil, nil
+	}
+	file, err := tree.File(path)
+	if errors.Is(err, object.ErrFileNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	content, err := file.Contents()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(content), nil
+}
+
+// storeBlob adds merged file content to the object database
+func (r *repository) storeBlob(content []byte) (plumbing.Hash, error) {
+	obj := r.gitRepo.Storer.NewEncodedObject()
+	obj.SetType(plumbing.BlobObject)
+	obj.SetSize(int64(len(content)))
+
+	writer, err := obj.Writer()
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	if _, err := writer.Write(content); err != nil {
+		writer.Close()
+		return plumbing.ZeroHash, err
+	}
+	if err := writer.Close(); err != nil {
+		return plumbing.ZeroHash, err
+	}
+	return r.gitRepo.Storer.SetEncodedObject(obj)
+}
+
+// mergeMessage describes a merge commit, listing how field conflicts were settled
+func mergeMessage(remote, branch string, conflicts []FieldConflict) string {
+	var message strings.Builder
+	fmt.Fprintf(&message, "Merge %s/%s", remote, branch)
+	if len(conflicts) > 0 {
+		message.WriteString("\n\nConflicts:\n")
+		for _, conflict := range conflicts {
+			fmt.Fprintf(&message, "  %s\n", conflict)
+		}
+	}
+	return message.String()
+}
+
+// integrateEdits applies the edits and moves the branch to the remote commit or commits the merge; if the branch
+// cannot be updated the edited files are restored to the local commit
+func (r *repository) integrateEdits(edits map[string]treeEdit, branch plumbing.ReferenceName, remote string, ours, theirs *object.Commit, fastForward bool, result *PullResult) error {
+	if err := r.applyEdits("Pull", edits); err != nil {
+		return err
+	}
+
+	if fastForward {
+		if err := r.gitRepo.Storer.SetReference(plumbing.NewHashReference(branch, theirs.Hash)); err != nil {
+			return r.restoreEdits(edits, fmt.Errorf("repository.Pull failed to update %s: %w", branch, err))
+		}
+		result.FastForward = true
+		return nil
+	}
+
+	hash, err := r.commitMerge(mergeMessage(remote, branch.Short(), result.Conflicts), ours.Hash, theirs.Hash)
+	if err != nil {
+		return r.restoreEdits(edits, err)
+	}
+	result.MergeCommit = hash
+	return nil
+}
+
+// restoreEdits restores the files of edits that could not be committed to the latest commit and returns the
+// error that prevented the commit
+func (r *repository) restoreEdits(edits map[string]treeEdit, cause error) error {
+	paths := make([]string, 0, len(edits))
+	for path := range edits {
+		paths = append(paths, path)
+	}
+	if _, err := r.discardChanges(paths); err != nil {
+		return fmt.Errorf("%w; restoring the working tree failed: %v", cause, err)
+	}
+	return cause
+}
+
+// applyEdits writes changed files to the working tree and stages them, refusing to overwrite uncommitted changes;
+// if a file cannot be written or staged, the files already edited are restored to the latest commit
+func (r *repository) applyEdits(operation string, edits map[string]treeEdit) error {
+	if len(edits) == 0 {
+		return nil
+	}
+
+	workTree, err := r.gitRepo.Worktree()
+	if err != nil {
+		return fmt.Errorf("repository.%s failed to get worktree for %s: %w", operation, r.path, err)
+	}
+	status, err := workTree.Status()
+	if err != nil {
+		return fmt.Errorf("repository.%s failed to get status for %s: %w", operation, r.path, err)
+	}
+
+	var dirty []string
+	for path, fileStatus := range status {
+		_, touched := edits[path]
+		if fileStatus.Staging != git.Unmodified && fileStatus.Staging != git.Untracked {
+			dirty = append(dirty, path)
+		} else if touched && fileStatus.Worktree != git.Unmodified {
+			dirty = append(dirty, path)
+		}
+	}
+	if len(dirty) > 0 {
+		sort.Strings(dirty)
+		return fmt.Errorf("repository.%s cannot update %s: %w in %s", operation, r.path, ErrUncommittedChanges, strings.Join(dirty, ", "))
+	}
+
+	paths := make([]string, 0, len(edits))
+	for path := range edits {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		edit := edits[path]
+		fullPath := filepath.Join(r.path, filepath.FromSlash(path))
+		if edit.deleted {
+			if err := os.Remove(fullPath); err != nil && !os.IsNotExist(err) {
+				return r.restoreEdits(edits, fmt.Errorf("repository.%s failed to remove %s: %w", operation, path, err))
+			}
+			removeEmptyParents(r.path, filepath.Dir(fullPath))
+		} else if err := r.writeBlob(fullPath, edit); err != nil {
+			return r.restoreEdits(edits, fmt.Errorf("repository.%s failed to write %s: %w", operation, path, err))
+		}
+
+		if _, err := workTree.Add(path); err != nil {
+			return r.restoreEdits(edits, fmt.Errorf("repository.%s failed to stage %s: %w", operation, path, err))
+		}
+	}
+	return nil
+}
+
+// writeBlob replaces a working tree file with the content of a blob
+func (r *repository) writeBlob(fullPath string, edit treeEdit) error {
+	blob, err := r.gitRepo.BlobObject(edit.hash)
+	if err != nil {
+		return err
+	}
+	reader, err := blob.Reader()
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+		return err
+	}
+	perm := os.FileMode(0644)
+	if edit.mode == filemode.Executable {
+		perm = 0755
+	}
+	return WriteFileAtomic(fullPath, data, perm)
+}
+
+// removeEmptyParents removes directories left empty by a deleted file, up to the repository root
+func removeEmptyParents(root, dir string) {
+	root = filepath.Clean(root)
+	for dir != root && strings.HasPrefix(dir, root) {
+		if os.Remove(dir) != nil {
+			return
+		}
+		dir = filepath.Dir(dir)
+	}
+}
+
+// commitMerge commits the staged merge result with both branch heads as parents
+func (r *repository) commitMerge(message string, ours, theirs plumbing.Hash) (string, error) {
+	workTree, err := r.gitRepo.Worktree()
+	if err != nil {
+		return "", fmt.Errorf("repository.Pull failed to get worktree for %s: %w", r.path, err)
+	}
+
+	commitHash, err := workTree.Commit(message, &git.CommitOptions{
+		Author: &object.Signature{
+			Name:  r.gitConfig.User,
+			Email: r.gitConfig.Email,
+			When:  time.Now(),
+		},
+		Parents:           []plumbing.Hash{ours, theirs},
+		AllowEmptyCommits: true,
+	})
+	if err != nil {
+		return "", fmt.Errorf("repository.Pull failed to create merge commit in %s: %w", r.path, err)
+	}
+	return commitHash.String(), nil
+}
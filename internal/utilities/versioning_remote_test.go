@@ -0,0 +1,313 @@
+package utilities
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+)
+
+// newSharedRepositories creates a bare repository and two clones configured to use it as origin
+func newSharedRepositories(t *testing.T) (Repository, Repository) {
+	t.Helper()
+	root := t.TempDir()
+
+	remotePath := filepath.Join(root, "remote.git")
+	if _, err := git.PlainInit(remotePath, true); err != nil {
+		t.Fatalf("Failed to create bare repository: %v", err)
+	}
+
+	open := func(name string) Repository {
+		repo, err := InitializeRepositoryWithConfig(filepath.Join(root, name), testAuthorConfig())
+		if err != nil {
+			t.Fatalf("Failed to create %s: %v", name, err)
+		}
+		t.Cleanup(func() { repo.Close() })
+		if err := repo.AddRemote(DefaultRemoteName, remotePath); err != nil {
+			t.Fatalf("Failed to add remote to %s: %v", name, err)
+		}
+		return repo
+	}
+	return open("laptop"), open("desktop")
+}
+
+// commitFile writes a file and commits it
+func commitFile(t *testing.T, repo Repository, relPath, content string) {
+	t.Helper()
+	fullPath := filepath.Join(repo.Path(), relPath)
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	if err := os.WriteFile(fullPath, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write %s: %v", relPath, err)
+	}
+	if err := repo.Stage([]string{relPath}); err != nil {
+		t.Fatalf("Failed to stage %s: %v", relPath, err)
+	}
+	if _, err := repo.Commit("Change " + relPath); err != nil {
+		t.Fatalf("Failed to commit %s: %v", relPath, err)
+	}
+}
+
+// assertFile checks the content of a working tree file
+func assertFile(t *testing.T, repo Repository, relPath, expected string) {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join(repo.Path(), relPath))
+	if err != nil || string(data) != expected {
+		t.Errorf("Expected %s to contain %q, got %q (%v)", relPath, expected, data, err)
+	}
+}
+
+func TestUnit_VersioningUtility_RemoteManagement(t *testing.T) {
+	repo, err := InitializeRepositoryWithConfig(t.TempDir(), testAuthorConfig())
+	if err != nil {
+		t.Fatalf("Failed to create repository: %v", err)
+	}
+	defer repo.Close()
+
+	if err := repo.AddRemote("team", "https://example.com/board.git"); err != nil {
+		t.Fatalf("AddRemote failed: %v", err)
+	}
+	if err := repo.AddRemote("team", "https://example.com/other.git"); err == nil {
+		t.Error("Expected adding a remote twice to fail")
+	}
+	if err := repo.AddRemote("", "https://example.com/board.git"); err == nil {
+		t.Error("Expected a remote without name to be rejected")
+	}
+
+	remotes, err := repo.ListRemotes()
+	if err != nil || len(remotes) != 1 || remotes[0].Name != "team" || remotes[0].URLs[0] != "https://example.com/board.git" {
+		t.Fatalf("Expected the team remote, got %+v (%v)", remotes, err)
+	}
+
+	if err := repo.RemoveRemote("team"); err != nil {
+		t.Fatalf("RemoveRemote failed: %v", err)
+	}
+	if remotes, _ := repo.ListRemotes(); len(remotes) != 0 {
+		t.Errorf("Expected no remotes after removal, got %+v", remotes)
+	}
+}
+
+func TestIntegration_VersioningUtility_PushAndFastForwardPull(t *testing.T) {
+	laptop, desktop := newSharedRepositories(t)
+
+	// Pulling from an empty remote is not an error
+	if result, err := laptop.Pull(""); err != nil || !result.UpToDate {
+		t.Fatalf("Expected an empty remote to be up to date, got %+v (%v)", result, err)
+	}
+
+	commitFile(t, laptop, "todo/task-1.json", "first")
+	if err := laptop.Push(""); err != nil {
+		t.Fatalf("Push failed: %v", err)
+	}
+
+	// A clone without commits takes the remote history as is
+	result, err := desktop.Pull("")
+	if err != nil {
+		t.Fatalf("Pull failed: %v", err)
+	}
+	if !result.FastForward || len(result.ChangedFiles) != 1 || result.ChangedFiles[0] != "todo/task-1.json" {
+		t.Errorf("Expected a fast-forward adding the task, got %+v", result)
+	}
+	assertFile(t, desktop, "todo/task-1.json", "first")
+
+	commitFile(t, desktop, "todo/task-1.json", "edited on desktop")
+	if err := desktop.Push(DefaultRemoteName); err != nil {
+		t.Fatalf("Push failed: %v", err)
+	}
+	if result, err := laptop.Pull(""); err != nil || !result.FastForward {
+		t.Fatalf("Expected a fast-forward, got %+v (%v)", result, err)
+	}
+	assertFile(t, laptop, "todo/task-1.json", "edited on desktop")
+
+	if status, err := laptop.Status(); err != nil || len(status.ModifiedFiles) != 0 || len(status.StagedFiles) != 0 {
+		t.Errorf("Expected a clean working tree after pulling, got %+v (%v)", status, err)
+	}
+	if result, err := laptop.Pull(""); err != nil || !result.UpToDate {
+		t.Errorf("Expected nothing left to pull, got %+v (%v)", result, err)
+	}
+}
+
+func TestIntegration_VersioningUtility_PullMergesDivergedHistories(t *testing.T) {
+	laptop, desktop := newSharedRepositories(t)
+
+	commitFile(t, laptop, "todo/task-1.json", "first")
+	commitFile(t, laptop, "todo/task-2.json", "second")
+	laptop.Push("")
+	desktop.Pull("")
+
+	// Both clones change different tasks; the desktop also removes one
+	commitFile(t, desktop, "doing/task-3.json", "third")
+	if err := os.Remove(filepath.Join(desktop.Path(), "todo", "task-2.json")); err != nil {
+		t.Fatalf("Failed to remove task: %v", err)
+	}
+	desktop.Stage([]string{"todo/task-2.json"})
+	desktop.Commit("Remove task-2")
+	if err := desktop.Push(""); err != nil {
+		t.Fatalf("Push failed: %v", err)
+	}
+
+	commitFile(t, laptop, "todo/task-1.json", "edited on laptop")
+	if err := laptop.Push(""); !errors.Is(err, ErrPushRejected) {
+		t.Fatalf("Expected the push of a diverged branch to be rejected, got %v", err)
+	}
+
+	result, err := laptop.Pull("")
+	if err != nil {
+		t.Fatalf("Pull failed: %v", err)
+	}
+	if result.FastForward || result.MergeCommit == "" || len(result.ChangedFiles) != 2 {
+		t.Errorf("Expected a merge commit changing two files, got %+v", result)
+	}
+	assertFile(t, laptop, "todo/task-1.json", "edited on laptop")
+	assertFile(t, laptop, "doing/task-3.json", "third")
+	if _, err := os.Stat(filepath.Join(laptop.Path(), "todo", "task-2.json")); !os.IsNotExist(err) {
+		t.Errorf("Expected the removed task to be gone, got %v", err)
+	}
+
+	history, err := laptop.GetHistory(1)
+	if err != nil || len(history) != 1 || history[0].ID != result.MergeCommit {
+		t.Errorf("Expected the merge commit on top of the history, got %+v (%v)", history, err)
+	}
+
+	// The merged history can be pushed and fast-forwards the other clone
+	if err := laptop.Push(""); err != nil {
+		t.Fatalf("Push after merge failed: %v", err)
+	}
+	if result, err := desktop.Pull(""); err != nil || !result.FastForward {
+		t.Fatalf("Expected a fast-forward to the merge, got %+v (%v)", result, err)
+	}
+	assertFile(t, desktop, "todo/task-1.json", "edited on laptop")
+}
+
+func TestIntegration_VersioningUtility_PullReportsConflicts(t *testing.T) {
+	laptop, desktop := newSharedRepositories(t)
+
+	commitFile(t, laptop, "todo/task-1.json", "first")
+	laptop.Push("")
+	desktop.Pull("")
+
+	commitFile(t, desktop, "todo/task-1.json", "edited on desktop")
+	desktop.Push("")
+	commitFile(t, laptop, "todo/task-1.json", "edited on laptop")
+	head, _ := laptop.GetHistory(1)
+
+	_, err := laptop.Pull("")
+	var conflictErr *MergeConflictError
+	if !errors.As(err, &conflictErr) || !errors.Is(err, ErrMergeConflict) {
+		t.Fatalf("Expected a merge conflict, got %v", err)
+	}
+	if len(conflictErr.Paths) != 1 || conflictErr.Paths[0] != "todo/task-1.json" {
+		t.Errorf("Expected the conflicting task file to be reported, got %v", conflictErr.Paths)
+	}
+
+	// The local branch and working tree are left as they were
+	assertFile(t, laptop, "todo/task-1.json", "edited on laptop")
+	if after, _ := laptop.GetHistory(1); after[0].ID != head[0].ID {
+		t.Errorf("Expected the local branch to be unchanged")
+	}
+}
+
+func TestIntegration_VersioningUtility_PullKeepsUncommittedChanges(t *testing.T) {
+	laptop, desktop := newSharedRepositories(t)
+
+	commitFile(t, laptop, "todo/task-1.json", "first")
+	laptop.Push("")
+	desktop.Pull("")
+	commitFile(t, desktop, "todo/task-1.json", "edited on desktop")
+	desktop.Push("")
+
+	if err := os.WriteFile(filepath.Join(laptop.Path(), "todo", "task-1.json"), []byte("being edited"), 0644); err != nil {
+		t.Fatalf("Failed to edit task: %v", err)
+	}
+	if _, err := laptop.Pull(""); !errors.Is(err, ErrUncommittedChanges) {
+		t.Fatalf("Expected ErrUncommittedChanges, got %v", err)
+	}
+	assertFile(t, laptop, "todo/task-1.json", "being edited")
+}
+
+// unionMergeDriver merges files by joining both sides' content, reporting a conflict per merged file
+type unionMergeDriver struct{}
+
+// Merge implements MergeDriver
+func (unionMergeDriver) Merge(files map[string]MergeVersions) (*MergeOutcome, error) {
+	outcome := &MergeOutcome{Files: make(map[string][]byte)}
+	for path, versions := range files {
+		if versions.Ours == nil || versions.Theirs == nil || string(versions.Ours) == string(versions.Theirs) {
+			continue
+		}
+		outcome.Files[path] = []byte(string(versions.Ours) + "+" + string(versions.Theirs))
+		outcome.Conflicts = append(outcome.Conflicts, FieldConflict{
+			Record: path, Field: "content", Ours: string(versions.Ours), Theirs: string(versions.Theirs),
+			Resolution: Unresolved, Reason: "joined both",
+		})
+	}
+	return outcome, nil
+}
+
+func TestIntegration_VersioningUtility_PullWithMergeDriver(t *testing.T) {
+	laptop, desktop := newSharedRepositories(t)
+
+	commitFile(t, laptop, "todo/task-1.json", "first")
+	laptop.Push("")
+	desktop.Pull("")
+
+	commitFile(t, desktop, "todo/task-1.json", "desktop")
+	commitFile(t, desktop, "todo/task-2.json", "second")
+	desktop.Push("")
+	commitFile(t, laptop, "todo/task-1.json", "laptop")
+
+	result, err := laptop.PullWithMergeDriver("", unionMergeDriver{})
+	if err != nil {
+		t.Fatalf("Expected the driver to merge the conflicting file, got %v", err)
+	}
+	if result.MergeCommit == "" || len(result.ChangedFiles) != 2 || len(result.Conflicts) != 1 {
+		t.Errorf("Expected a merge commit changing two files with one field conflict, got %+v", result)
+	}
+	assertFile(t, laptop, "todo/task-1.json", "laptop+desktop")
+	assertFile(t, laptop, "todo/task-2.json", "second")
+
+	// The resolution is recorded in the merge commit and the merged content is committed
+	history, err := laptop.GetHistory(1)
+	if err != nil || len(history) != 1 || !strings.Contains(history[0].Message, `todo/task-1.json content: unresolved, local "laptop", remote "desktop" (joined both)`) {
+		t.Errorf("Expected the conflict in the merge commit message, got %+v (%v)", history, err)
+	}
+	if status, err := laptop.Status(); err != nil || len(status.ModifiedFiles) != 0 || len(status.StagedFiles) != 0 {
+		t.Errorf("Expected a clean working tree after merging, got %+v (%v)", status, err)
+	}
+}
+
+func TestIntegration_VersioningUtility_PullRestoresWorkingTreeOnFailure(t *testing.T) {
+	laptop, desktop := newSharedRepositories(t)
+
+	commitFile(t, laptop, "todo/task-1.json", "first")
+	laptop.Push("")
+	desktop.Pull("")
+	commitFile(t, desktop, "todo/task-1.json", "edited on desktop")
+	commitFile(t, desktop, "todo/task-2.json", "added on desktop")
+	desktop.Push("")
+
+	// An untracked directory in place of the added file makes writing it fail after the edit was applied
+	if err := os.MkdirAll(filepath.Join(laptop.Path(), "todo", "task-2.json", "notes"), 0755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(laptop.Path(), "todo", "task-2.json", "notes", "draft.txt"), []byte("draft"), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	if _, err := laptop.Pull(""); err == nil {
+		t.Fatal("Expected the pull to fail")
+	}
+
+	// The edited file is restored and nothing is left staged
+	assertFile(t, laptop, "todo/task-1.json", "first")
+	status, err := laptop.Status()
+	if err != nil {
+		t.Fatalf("Failed to get status: %v", err)
+	}
+	if len(status.StagedFiles) != 0 || len(status.ModifiedFiles) != 0 {
+		t.Errorf("Expected a clean working tree, got staged %v and modified %v", status.StagedFiles, status.ModifiedFiles)
+	}
+}
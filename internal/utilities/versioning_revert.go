@@ -0,0 +1,154 @@
+// Package utilities provides core utilities implementing the iDesign methodology.
+// This file implements reverting earlier commits of a Repository without rewriting its history.
+package utilities
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// ErrRevertConflict reports that files changed by the reverted commits were changed again afterwards
+var ErrRevertConflict = errors.New("files changed again since")
+
+// RevertResult describes a revert commit
+type RevertResult struct {
+	Previous     string   // Commit the branch was at before the revert
+	Commit       string   // Revert commit; empty if there was nothing to revert
+	ChangedFiles []string // Slash-separated paths changed in the working tree
+}
+
+// RevertChanges undoes the changes made between commit from and its descendant to in a new commit on
+// top of the current branch; an empty from stands for the empty repository. Files changed again after
+// to are left alone and fail the revert with ErrRevertConflict.
+func (r *repository) RevertChanges(from, to, message string) (*RevertResult, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.gitConfig == nil {
+		return nil, fmt.Errorf("repository.RevertChanges no git configuration available - repository must be initialized with AuthorConfiguration")
+	}
+
+	toCommit, err := r.commitByHash(to)
+	if err != nil {
+		return nil, err
+	}
+	var fromCommit *object.Commit
+	if from != "" {
+		if fromCommit, err = r.commitByHash(from); err != nil {
+			return nil, err
+		}
+	}
+
+	headRef, err := r.gitRepo.Head()
+	if err != nil {
+		return nil, fmt.Errorf("repository.RevertChanges failed to resolve HEAD of %s: %w", r.path, err)
+	}
+	head, err := r.gitRepo.CommitObject(headRef.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("repository.RevertChanges failed to read commit %s: %w", headRef.Hash(), err)
+	}
+	if head.Hash != toCommit.Hash {
+		if reachable, err := toCommit.IsAncestor(head); err != nil {
+			return nil, fmt.Errorf("repository.RevertChanges failed to compare histories: %w", err)
+		} else if !reachable {
+			return nil, fmt.Errorf("repository.RevertChanges commit %s is not part of the current branch", to)
+		}
+	}
+
+	edits, err := commitEdits(toCommit, fromCommit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Later commits may have changed other files, but the reverted ones must be as the commits left them
+	if head.Hash != toCommit.Hash {
+		toTree, err := commitTree(toCommit)
+		if err != nil {
+			return nil, err
+		}
+		headTree, err := commitTree(head)
+		if err != nil {
+			return nil, err
+		}
+		var changed []string
+		for path := range edits {
+			if treeEntryHash(toTree, path) != treeEntryHash(headTree, path) {
+				changed = append(changed, path)
+			}
+		}
+		if len(changed) > 0 {
+			sort.Strings(changed)
+			return nil, fmt.Errorf("repository.RevertChanges cannot revert %s: %w in %s", to, ErrRevertConflict, strings.Join(changed, ", "))
+		}
+	}
+
+	result := &RevertResult{Previous: head.Hash.String()}
+	if len(edits) == 0 {
+		return result, nil
+	}
+
+	if err := r.applyEdits("RevertChanges", edits); err != nil {
+		return nil, err
+	}
+
+	workTree, err := r.gitRepo.Worktree()
+	if err != nil {
+		return nil, r.restoreEdits(edits, fmt.Errorf("repository.RevertChanges failed to get worktree for %s: %w", r.path, err))
+	}
+	commitHash, err := workTree.Commit(message, &git.CommitOptions{
+		Author: &object.Signature{
+			Name:  r.gitConfig.User,
+			Email: r.gitConfig.Email,
+			When:  time.Now(),
+		},
+	})
+	if err != nil {
+		return nil, r.restoreEdits(edits, fmt.Errorf("repository.RevertChanges failed to create commit in %s: %w", r.path, err))
+	}
+	result.Commit = commitHash.String()
+
+	for path := range edits {
+		result.ChangedFiles = append(result.ChangedFiles, path)
+	}
+	sort.Strings(result.ChangedFiles)
+
+	r.logger.Log(Info, "Repository", "Changes reverted", map[string]interface{}{
+		"path":          r.path,
+		"from":          from,
+		"to":            to,
+		"commit":        result.Commit,
+		"changed_files": len(result.ChangedFiles),
+	})
+	return result, nil
+}
+
+// commitByHash returns the commit with the given full hash
+func (r *repository) commitByHash(hash string) (*object.Commit, error) {
+	if !plumbing.IsHash(hash) {
+		return nil, fmt.Errorf("repository %s: invalid commit hash %q", r.path, hash)
+	}
+	commit, err := r.gitRepo.CommitObject(plumbing.NewHash(hash))
+	if err != nil {
+		return nil, fmt.Errorf("repository %s failed to read commit %s: %w", r.path, hash, err)
+	}
+	return commit, nil
+}
+
+// treeEntryHash returns the blob hash of a file in a tree, or the zero hash if it does not exist
+func treeEntryHash(tree *object.Tree, path string) plumbing.Hash {
+	if tree == nil {
+		return plumbing.ZeroHash
+	}
+	entry, err := tree.FindEntry(path)
+	if err != nil {
+		return plumbing.ZeroHash
+	}
+	return entry.Hash
+}
@@ -0,0 +1,80 @@
+package utilities
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// headCommit returns the hash of the latest commit
+func headCommit(t *testing.T, repo Repository) string {
+	t.Helper()
+	history, err := repo.GetHistory(1)
+	if err != nil || len(history) != 1 {
+		t.Fatalf("Failed to read HEAD: %v", err)
+	}
+	return history[0].ID
+}
+
+func TestIntegration_VersioningUtility_RevertChanges(t *testing.T) {
+	repo, err := InitializeRepositoryWithConfig(t.TempDir(), testAuthorConfig())
+	if err != nil {
+		t.Fatalf("Failed to create repository: %v", err)
+	}
+	defer repo.Close()
+
+	commitFile(t, repo, "todo/task-1.json", "first")
+	base := headCommit(t, repo)
+	commitFile(t, repo, "todo/task-1.json", "edited")
+	commitFile(t, repo, "todo/task-2.json", "second")
+	edited := headCommit(t, repo)
+	commitFile(t, repo, "doing/task-3.json", "third")
+
+	// Reverting earlier commits keeps later unrelated changes and adds a new commit
+	result, err := repo.RevertChanges(base, edited, "Undo edits")
+	if err != nil {
+		t.Fatalf("RevertChanges failed: %v", err)
+	}
+	if result.Commit == "" || result.Previous == edited || len(result.ChangedFiles) != 2 {
+		t.Errorf("Expected a revert commit changing two files, got %+v", result)
+	}
+	assertFile(t, repo, "todo/task-1.json", "first")
+	assertFile(t, repo, "doing/task-3.json", "third")
+	if _, err := os.Stat(filepath.Join(repo.Path(), "todo", "task-2.json")); !os.IsNotExist(err) {
+		t.Errorf("Expected the added file to be removed, got %v", err)
+	}
+	if history, err := repo.GetHistory(10); err != nil || len(history) != 5 || history[0].Message != "Undo edits" {
+		t.Errorf("Expected the revert on top of the unchanged history, got %+v (%v)", history, err)
+	}
+
+	// Reverting the revert restores the edits
+	if _, err := repo.RevertChanges(result.Previous, result.Commit, "Redo edits"); err != nil {
+		t.Fatalf("Reverting the revert failed: %v", err)
+	}
+	assertFile(t, repo, "todo/task-1.json", "edited")
+	assertFile(t, repo, "todo/task-2.json", "second")
+}
+
+func TestIntegration_VersioningUtility_RevertChangesRefusesLaterEdits(t *testing.T) {
+	repo, err := InitializeRepositoryWithConfig(t.TempDir(), testAuthorConfig())
+	if err != nil {
+		t.Fatalf("Failed to create repository: %v", err)
+	}
+	defer repo.Close()
+
+	commitFile(t, repo, "todo/task-1.json", "first")
+	base := headCommit(t, repo)
+	commitFile(t, repo, "todo/task-1.json", "edited")
+	edited := headCommit(t, repo)
+	commitFile(t, repo, "todo/task-1.json", "edited again")
+
+	if _, err := repo.RevertChanges(base, edited, "Undo edit"); !errors.Is(err, ErrRevertConflict) {
+		t.Errorf("Expected ErrRevertConflict, got %v", err)
+	}
+	assertFile(t, repo, "todo/task-1.json", "edited again")
+
+	if _, err := repo.RevertChanges(base, "not-a-commit", "Undo edit"); err == nil {
+		t.Error("Expected an invalid commit to be rejected")
+	}
+}
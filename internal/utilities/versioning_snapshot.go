@@ -0,0 +1,79 @@
+// Package utilities provides core utilities implementing the iDesign methodology.
+// This file implements reading the files of a Repository as of a past commit.
+package utilities
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// CommitSnapshot holds the files of a repository as of a commit
+type CommitSnapshot struct {
+	Commit CommitInfo
+	Files  map[string][]byte // slash-separated path -> content
+}
+
+// GetSnapshot returns every file of a commit together with the commit's information without touching the working tree
+func (r *repository) GetSnapshot(hash string) (*CommitSnapshot, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	commit, err := r.commitByHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("repository.GetSnapshot failed to get tree for commit %s: %w", hash, err)
+	}
+
+	snapshot := &CommitSnapshot{
+		Commit: CommitInfo{
+			ID:        commit.Hash.String(),
+			Author:    commit.Author.Name,
+			Email:     commit.Author.Email,
+			Timestamp: commit.Author.When,
+			Message:   commit.Message,
+		},
+		Files: make(map[string][]byte),
+	}
+	err = tree.Files().ForEach(func(file *object.File) error {
+		reader, err := file.Reader()
+		if err != nil {
+			return fmt.Errorf("failed to open %s: %w", file.Name, err)
+		}
+		defer reader.Close()
+		content, err := io.ReadAll(reader)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", file.Name, err)
+		}
+		snapshot.Files[file.Name] = content
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("repository.GetSnapshot failed to read commit %s: %w", hash, err)
+	}
+	return snapshot, nil
+}
+
+// GetFileAt returns the content of a file, given by its slash-separated path, as of a commit
+func (r *repository) GetFileAt(hash, filePath string) ([]byte, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	commit, err := r.commitByHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	file, err := commit.File(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("repository.GetFileAt failed to find %s in commit %s: %w", filePath, hash, err)
+	}
+	content, err := file.Contents()
+	if err != nil {
+		return nil, fmt.Errorf("repository.GetFileAt failed to read %s in commit %s: %w", filePath, hash, err)
+	}
+	return []byte(content), nil
+}
@@ -0,0 +1,56 @@
+package utilities
+
+import (
+	"testing"
+)
+
+func TestIntegration_VersioningUtility_GetSnapshot(t *testing.T) {
+	repo, err := InitializeRepositoryWithConfig(t.TempDir(), testAuthorConfig())
+	if err != nil {
+		t.Fatalf("Failed to create repository: %v", err)
+	}
+	defer repo.Close()
+
+	commitFile(t, repo, "todo/task-1.json", "first")
+	commitFile(t, repo, "board.json", "{}")
+	earlier := headCommit(t, repo)
+	commitFile(t, repo, "todo/task-1.json", "edited")
+	commitFile(t, repo, "doing/task-2.json", "second")
+
+	snapshot, err := repo.GetSnapshot(earlier)
+	if err != nil {
+		t.Fatalf("GetSnapshot failed: %v", err)
+	}
+	if snapshot.Commit.ID != earlier || snapshot.Commit.Message != "Change board.json" {
+		t.Errorf("Expected the information of commit %s, got %+v", earlier, snapshot.Commit)
+	}
+	if len(snapshot.Files) != 2 || string(snapshot.Files["todo/task-1.json"]) != "first" || string(snapshot.Files["board.json"]) != "{}" {
+		t.Errorf("Expected the files as of the commit, got %q", snapshot.Files)
+	}
+
+	// The working tree keeps its current content
+	assertFile(t, repo, "todo/task-1.json", "edited")
+
+	if _, err := repo.GetSnapshot("0000000000000000000000000000000000000000"); err == nil {
+		t.Error("Expected an unknown commit to be rejected")
+	}
+}
+
+func TestIntegration_VersioningUtility_GetFileAt(t *testing.T) {
+	repo, err := InitializeRepositoryWithConfig(t.TempDir(), testAuthorConfig())
+	if err != nil {
+		t.Fatalf("Failed to create repository: %v", err)
+	}
+	defer repo.Close()
+
+	commitFile(t, repo, "todo/task-1.json", "first")
+	earlier := headCommit(t, repo)
+	commitFile(t, repo, "todo/task-1.json", "edited")
+
+	if content, err := repo.GetFileAt(earlier, "todo/task-1.json"); err != nil || string(content) != "first" {
+		t.Errorf("Expected the content as of the commit, got %q (%v)", content, err)
+	}
+	if _, err := repo.GetFileAt(earlier, "doing/task-2.json"); err == nil {
+		t.Error("Expected a file missing from the commit to be rejected")
+	}
+}
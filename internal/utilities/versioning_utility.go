@@ -4,6 +4,7 @@
 package utilities
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -13,7 +14,9 @@ import (
 
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/format/index"
 	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
 )
 
 // AuthorConfiguration represents git user configuration
@@ -31,6 +34,18 @@ type CommitInfo struct {
 	Message   string    // Commit message
 }
 
+// FileChange describes a file added, modified, deleted or moved by a commit
+type FileChange struct {
+	FromPath string // Path before the commit (empty when added)
+	ToPath   string // Path after the commit (empty when deleted)
+}
+
+// CommitChanges pairs a commit with the files it changed relative to its first parent
+type CommitChanges struct {
+	Commit  CommitInfo   // Commit metadata
+	Changes []FileChange // Files changed by the commit
+}
+
 // RepositoryStatus represents the current state of a repository
 type RepositoryStatus struct {
 	CurrentBranch  string   // Active branch name
@@ -265,10 +280,27 @@ type Repository interface {
 	GetFileHistoryStream(filePath string) <-chan CommitInfo
 
 	GetFileDifferences(hash1, hash2 string) ([]byte, error)
+	GetChangeHistory(limit int) ([]CommitChanges, error)
+	GetChangeHistorySince(hash string) ([]CommitChanges, bool, error)
+	GetSnapshot(hash string) (*CommitSnapshot, error)
+	GetFileAt(hash, filePath string) ([]byte, error)
+
+	// Undoing earlier commits with new commits
+	RevertChanges(from, to, message string) (*RevertResult, error)
+	DiscardChanges(paths []string) ([]string, error)
 
 	// Repository validation
 	ValidateRepositoryAndPaths(request RepositoryValidationRequest) (*RepositoryValidationResult, error)
 
+	// Remote synchronisation
+	AddRemote(name, url string) error
+	RemoveRemote(name string) error
+	ListRemotes() ([]RemoteInfo, error)
+	Fetch(remote string) error
+	Pull(remote string) (*PullResult, error)
+	PullWithMergeDriver(remote string, driver MergeDriver) (*PullResult, error)
+	Push(remote string) error
+
 	Close() error
 }
 
@@ -356,14 +388,17 @@ func (r *repository) Stage(patterns []string) error {
 		return fmt.Errorf("repository.Stage failed to get worktree for %s: %w", r.path, err)
 	}
 
-	// First check for conflicts to prevent staging conflicted files
-	status, err := r.statusInternal()
+	// First check for conflicts to prevent staging conflicted files.
+	// Unmerged entries (stages 1-3) are read from the index, a full status would hash every file of the worktree.
+	idx, err := r.gitRepo.Storer.Index()
 	if err != nil {
-		return fmt.Errorf("repository.Stage failed to get status for %s: %w", r.path, err)
+		return fmt.Errorf("repository.Stage failed to read index for %s: %w", r.path, err)
 	}
 
-	if status.HasConflicts {
-		return fmt.Errorf("repository.Stage cannot stage files while conflicts exist in %s", r.path)
+	for _, entry := range idx.Entries {
+		if entry.Stage >= index.AncestorMode {
+			return fmt.Errorf("repository.Stage cannot stage files while conflicts exist in %s", r.path)
+		}
 	}
 
 	// Stage matching files
@@ -413,8 +448,8 @@ func (r *repository) Stage(patterns []string) error {
 				}
 			}
 		} else {
-			// Stage specific file path
-			_, err := workTree.Add(pattern)
+			// Stage specific file path, existing files need no worktree status
+			err := workTree.AddWithOptions(&git.AddOptions{Path: pattern, SkipStatus: true})
 			if err != nil {
 				r.logger.LogError("Repository", err, map[string]interface{}{
 					"operation": "Stage",
@@ -436,6 +471,9 @@ func (r *repository) Stage(patterns []string) error {
 	return nil
 }
 
+// ErrNothingToCommit reports a commit without changes to the last commit
+var ErrNothingToCommit = errors.New("nothing to commit")
+
 // Commit creates a commit with all staged changes
 func (r *repository) Commit(message string) (string, error) {
 	r.mutex.Lock()
@@ -462,6 +500,9 @@ func (r *repository) Commit(message string) (string, error) {
 			When:  time.Now(),
 		},
 	})
+	if errors.Is(err, git.ErrEmptyCommit) {
+		return "", fmt.Errorf("repository.Commit found no staged changes in %s: %w", r.path, ErrNothingToCommit)
+	}
 	if err != nil {
 		return "", fmt.Errorf("repository.Commit failed to create commit in %s: %w", r.path, err)
 	}
@@ -721,6 +762,106 @@ func (r *repository) GetFileDifferences(hash1, hash2 string) ([]byte, error) {
 	return []byte(patch.String()), nil
 }
 
+// GetChangeHistory returns a limited number of commits, newest first, with the files each commit changed
+func (r *repository) GetChangeHistory(limit int) ([]CommitChanges, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	history, _, err := r.changeHistory(limit, "")
+	if err != nil {
+		return nil, fmt.Errorf("repository.GetChangeHistory %w", err)
+	}
+	return history, nil
+}
+
+// GetChangeHistorySince returns the commits following the given one, newest first, with the files each commit
+// changed. It reports whether the walk reached the commit; if not, e.g. because history was rewritten or the hash
+// is empty, the whole history is returned.
+func (r *repository) GetChangeHistorySince(hash string) ([]CommitChanges, bool, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	history, found, err := r.changeHistory(0, hash)
+	if err != nil {
+		return nil, false, fmt.Errorf("repository.GetChangeHistorySince %w", err)
+	}
+	return history, found, nil
+}
+
+// changeHistory walks the commits reachable from HEAD, newest first, until the limit or the stop commit is reached
+func (r *repository) changeHistory(limit int, stop string) ([]CommitChanges, bool, error) {
+	ref, err := r.gitRepo.Head()
+	if err != nil {
+		// Repository might be empty
+		return []CommitChanges{}, false, nil
+	}
+
+	commitIter, err := r.gitRepo.Log(&git.LogOptions{From: ref.Hash()})
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get commit log for %s: %w", r.path, err)
+	}
+
+	var history []CommitChanges
+	found := false
+
+	err = commitIter.ForEach(func(c *object.Commit) error {
+		if limit > 0 && len(history) >= limit {
+			return storer.ErrStop
+		}
+		if stop != "" && c.Hash.String() == stop {
+			found = true
+			return storer.ErrStop
+		}
+
+		tree, err := c.Tree()
+		if err != nil {
+			return fmt.Errorf("failed to get tree for commit %s: %w", c.Hash, err)
+		}
+
+		// The root commit is compared against an empty tree
+		var parentTree *object.Tree
+		if c.NumParents() > 0 {
+			parent, err := c.Parent(0)
+			if err != nil {
+				return fmt.Errorf("failed to get parent of commit %s: %w", c.Hash, err)
+			}
+			if parentTree, err = parent.Tree(); err != nil {
+				return fmt.Errorf("failed to get tree for commit %s: %w", parent.Hash, err)
+			}
+		}
+
+		changes, err := object.DiffTree(parentTree, tree)
+		if err != nil {
+			return fmt.Errorf("failed to diff commit %s: %w", c.Hash, err)
+		}
+
+		fileChanges := make([]FileChange, 0, len(changes))
+		for _, change := range changes {
+			fileChanges = append(fileChanges, FileChange{
+				FromPath: change.From.Name,
+				ToPath:   change.To.Name,
+			})
+		}
+
+		history = append(history, CommitChanges{
+			Commit: CommitInfo{
+				ID:        c.Hash.String(),
+				Author:    c.Author.Name,
+				Email:     c.Author.Email,
+				Timestamp: c.Author.When,
+				Message:   c.Message,
+			},
+			Changes: fileChanges,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to iterate commits for %s: %w", r.path, err)
+	}
+
+	return history, found, nil
+}
+
 // ValidateRepositoryAndPaths validates the repository and optionally checks file/directory existence
 func (r *repository) ValidateRepositoryAndPaths(request RepositoryValidationRequest) (*RepositoryValidationResult, error) {
 	// Use the repository's path if no directory path specified
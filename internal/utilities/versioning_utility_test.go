@@ -527,6 +527,100 @@ func TestUnit_VersioningUtility_FileDifferences(t *testing.T) {
 	}
 }
 
+// TestVersioningUtility_ChangeHistory tests retrieval of files changed per commit
+func TestUnit_VersioningUtility_ChangeHistory(t *testing.T) {
+	tempDir := t.TempDir()
+	repoPath := filepath.Join(tempDir, "change_history_test")
+
+	repo, err := InitializeRepositoryWithConfig(repoPath, testAuthorConfig())
+	if err != nil {
+		t.Fatalf("Failed to initialize repository: %v", err)
+	}
+	defer repo.Close()
+
+	// Empty repository has no history
+	history, err := repo.GetChangeHistory(0)
+	if err != nil {
+		t.Fatalf("Failed to get change history of empty repository: %v", err)
+	}
+	if len(history) != 0 {
+		t.Errorf("Expected empty change history, got %d entries", len(history))
+	}
+
+	oldPath := filepath.Join("todo", "task.json")
+	newPath := filepath.Join("doing", "task.json")
+
+	if err := os.MkdirAll(filepath.Join(repoPath, "todo"), 0755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(repoPath, oldPath), []byte("{}"), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+	if err := repo.Stage([]string{oldPath}); err != nil {
+		t.Fatalf("Failed to stage file: %v", err)
+	}
+	if _, err := repo.Commit("Add task"); err != nil {
+		t.Fatalf("Failed to commit: %v", err)
+	}
+
+	// Move the file to another directory
+	if err := os.MkdirAll(filepath.Join(repoPath, "doing"), 0755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	if err := os.Rename(filepath.Join(repoPath, oldPath), filepath.Join(repoPath, newPath)); err != nil {
+		t.Fatalf("Failed to move file: %v", err)
+	}
+	if err := repo.Stage([]string{oldPath, newPath}); err != nil {
+		t.Fatalf("Failed to stage move: %v", err)
+	}
+	if _, err := repo.Commit("Move task"); err != nil {
+		t.Fatalf("Failed to commit move: %v", err)
+	}
+
+	history, err = repo.GetChangeHistory(0)
+	if err != nil {
+		t.Fatalf("Failed to get change history: %v", err)
+	}
+	if len(history) != 2 {
+		t.Fatalf("Expected 2 commits, got %d", len(history))
+	}
+
+	// Newest commit first, recording both sides of the move
+	paths := make(map[string]bool)
+	for _, change := range history[0].Changes {
+		paths[change.FromPath+"->"+change.ToPath] = true
+	}
+	if !paths[oldPath+"->"] || !paths["->"+newPath] {
+		t.Errorf("Expected move to be recorded as deletion and addition, got %+v", history[0].Changes)
+	}
+
+	// Root commit is compared against an empty tree
+	if len(history[1].Changes) != 1 || history[1].Changes[0].ToPath != oldPath {
+		t.Errorf("Expected root commit to add %s, got %+v", oldPath, history[1].Changes)
+	}
+
+	// Limit restricts the number of commits
+	limited, err := repo.GetChangeHistory(1)
+	if err != nil {
+		t.Fatalf("Failed to get limited change history: %v", err)
+	}
+	if len(limited) != 1 || !strings.HasPrefix(limited[0].Commit.Message, "Move task") {
+		t.Errorf("Expected only the latest commit, got %d entries", len(limited))
+	}
+
+	// Only the commits following a known one are walked
+	since, found, err := repo.GetChangeHistorySince(history[1].Commit.ID)
+	if err != nil || !found || len(since) != 1 || since[0].Commit.ID != history[0].Commit.ID {
+		t.Errorf("Expected only the move following the root commit, got %d entries (found %v, err %v)", len(since), found, err)
+	}
+	if since, found, err := repo.GetChangeHistorySince(history[0].Commit.ID); err != nil || !found || len(since) != 0 {
+		t.Errorf("Expected no commits following HEAD, got %d entries (found %v, err %v)", len(since), found, err)
+	}
+	if since, found, err := repo.GetChangeHistorySince("0123456789abcdef0123456789abcdef01234567"); err != nil || found || len(since) != 2 {
+		t.Errorf("Expected the whole history for an unknown commit, got %d entries (found %v, err %v)", len(since), found, err)
+	}
+}
+
 // TestVersioningUtility_InvalidCommitHash tests error handling for invalid commit hashes
 func TestUnit_VersioningUtility_InvalidCommitHash(t *testing.T) {
 	tempDir := t.TempDir()